@@ -1,15 +1,27 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/user"
 	"path/filepath"
+	"strings"
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/logger"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/checks"
+	infraevents "github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/filesync"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/git"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/hooks"
 	infralogger "github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/logger"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/persistence"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/policy"
 )
 
 // App contains all dependencies for the tm binary
@@ -19,24 +31,40 @@ type App struct {
 	WorkingDir       string
 	ActiveProject    string
 	RepositoryCommon *persistence.SQLiteRepositoryComposite
+	EventBus         events.EventBus
+	VersionControl   services.VersionControl
 
 	// Domain services (stateless)
 	ValidationService      *services.ValidationService
 	DomainIterationService *services.IterationService
 
 	// Application services
-	TrackService     *application.TrackApplicationService
-	TaskService      *application.TaskApplicationService
-	IterationService *application.IterationApplicationService
-	ADRService       *application.ADRApplicationService
-	ACService        *application.ACApplicationService
-	RoadmapService   *application.RoadmapApplicationService
-	DocumentService  *application.DocumentApplicationService
-	ProjectService   *application.ProjectApplicationService
+	TrackService         *application.TrackApplicationService
+	TaskService          *application.TaskApplicationService
+	IterationService     *application.IterationApplicationService
+	ADRService           *application.ADRApplicationService
+	ACService            *application.ACApplicationService
+	ACCheckService       *application.ACCheckApplicationService
+	GitService           *application.GitApplicationService
+	RoadmapService       *application.RoadmapApplicationService
+	DocumentService      *application.DocumentApplicationService
+	ProjectService       *application.ProjectApplicationService
+	HistoryService       *application.HistoryApplicationService
+	SearchService        *application.SearchApplicationService
+	ReportService        *application.ReportApplicationService
+	MetricsService       *application.MetricsApplicationService
+	SyncService          *application.SyncApplicationService
+	GraphService         *application.GraphApplicationService
+	TrackPlanService     *application.TrackPlanApplicationService
+	IterationPlanService *application.IterationPlanApplicationService
+	DoctorService        *application.DoctorApplicationService
+	SchemaService        *application.SchemaApplicationService
+	SnapshotService      *application.SnapshotApplicationService
 }
 
-// BootstrapApp initializes the application
-func BootstrapApp() (*App, error) {
+// BootstrapApp initializes the application.
+// project is the --project flag value; when empty, TM_PROJECT and then the active project are used.
+func BootstrapApp(project string) (*App, error) {
 	// Determine config path
 	configPath := GetConfigPath()
 
@@ -46,10 +74,10 @@ func BootstrapApp() (*App, error) {
 	// Resolve working directory
 	workingDir := persistence.ResolveWorkingDirectory()
 
-	// Get active project
-	activeProject, err := persistence.GetActiveProject(workingDir)
+	// Resolve project: --project flag, then TM_PROJECT, then active-project.txt
+	activeProject, err := persistence.ResolveProject(workingDir, project)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get active project: %w", err)
+		return nil, fmt.Errorf("failed to resolve project: %w", err)
 	}
 
 	// Open database for active project
@@ -66,12 +94,57 @@ func BootstrapApp() (*App, error) {
 	validationService := services.NewValidationService()
 	domainIterationService := services.NewIterationService()
 
+	// Create history service so every published event lands in the audit log
+	historyService := application.NewHistoryApplicationService(
+		repoComposite.Events,
+		resolveActor(),
+	)
+
+	// Load hooks from ~/.tm/config.yaml and .tm/projects/<name>/hooks/
+	hookConfig, err := hooks.LoadConfig(configPath, filepath.Join(workingDir, "projects", activeProject, "hooks"))
+	if err != nil {
+		repoComposite.Close()
+		return nil, fmt.Errorf("failed to load hooks: %w", err)
+	}
+	hookRunner := hooks.NewRunner(hookConfig, activeProject, logger)
+
+	// Load completion rules from .tm/projects/<name>/policy.yaml
+	completionPolicy, err := policy.LoadIterationCompletionPolicy(filepath.Join(workingDir, "projects", activeProject, policy.FileName))
+	if err != nil {
+		repoComposite.Close()
+		return nil, fmt.Errorf("failed to load policy: %w", err)
+	}
+
+	// Load snapshot retention from the backups section of ~/.tm/config.yaml
+	snapshotPolicy, err := policy.LoadSnapshotPolicy(configPath)
+	if err != nil {
+		repoComposite.Close()
+		return nil, fmt.Errorf("failed to load backup settings: %w", err)
+	}
+
+	// Create event bus and register subscribers before any service publishes
+	eventBus := infraevents.NewInMemoryEventBus(logger)
+	registerEventSubscribers(eventBus, logger, historyService, hookRunner)
+
+	// Create snapshot service; snapshots live in .tm/backups/ and outlive their projects
+	snapshotService := application.NewSnapshotApplicationService(
+		persistence.NewFileSystemSnapshotRepository(workingDir),
+		snapshotPolicy,
+		activeProject,
+	)
+
 	// Create application services with injected dependencies
 	trackService := application.NewTrackApplicationService(
 		repoComposite.Track,
 		repoComposite.Roadmap,
+		repoComposite.Task,
+		repoComposite.AC,
+		repoComposite.ADR,
+		repoComposite.Document,
 		repoComposite.Aggregate,
 		validationService,
+		eventBus,
+		snapshotService,
 	)
 
 	taskService := application.NewTaskApplicationService(
@@ -80,14 +153,23 @@ func BootstrapApp() (*App, error) {
 		repoComposite.Aggregate,
 		repoComposite.AC,
 		validationService,
+		eventBus,
+		hookRunner,
+		snapshotService,
 	)
 
 	iterationAppService := application.NewIterationApplicationService(
 		repoComposite.Iteration,
 		repoComposite.Task,
+		repoComposite.AC,
+		repoComposite.Document,
 		repoComposite.Aggregate,
 		domainIterationService,
 		validationService,
+		completionPolicy,
+		eventBus,
+		hookRunner,
+		snapshotService,
 	)
 
 	adrService := application.NewADRApplicationService(
@@ -95,6 +177,7 @@ func BootstrapApp() (*App, error) {
 		repoComposite.Track,
 		repoComposite.Aggregate,
 		validationService,
+		eventBus,
 	)
 
 	acService := application.NewACApplicationService(
@@ -102,6 +185,27 @@ func BootstrapApp() (*App, error) {
 		repoComposite.Task,
 		repoComposite.Aggregate,
 		validationService,
+		eventBus,
+		hookRunner,
+	)
+
+	// Checks run in the directory tm was invoked from, typically the code repository
+	acCheckService := application.NewACCheckApplicationService(
+		repoComposite.AC,
+		repoComposite.Task,
+		repoComposite.Iteration,
+		checks.NewShellRunner(""),
+		eventBus,
+		hookRunner,
+	)
+
+	// Git commands run in the directory tm was invoked from
+	gitClient := git.NewClient("")
+	gitService := application.NewGitApplicationService(
+		repoComposite.Task,
+		taskService,
+		iterationAppService,
+		gitClient,
 	)
 
 	roadmapService := application.NewRoadmapApplicationService(
@@ -110,12 +214,83 @@ func BootstrapApp() (*App, error) {
 		repoComposite.Task,
 		repoComposite.Iteration,
 		validationService,
+		eventBus,
 	)
 
 	documentService := application.NewDocumentApplicationService(
 		repoComposite.Document,
 		repoComposite.Track,
 		repoComposite.Iteration,
+		eventBus,
+		snapshotService,
+	)
+
+	searchService := application.NewSearchApplicationService(repoComposite.SearchIndex)
+
+	reportService := application.NewReportApplicationService(
+		repoComposite.Iteration,
+		repoComposite.AC,
+		repoComposite.ADR,
+		repoComposite.Document,
+		repoComposite.Events,
+		documentService,
+	)
+
+	metricsService := application.NewMetricsApplicationService(
+		repoComposite.Roadmap,
+		repoComposite.Track,
+		repoComposite.Task,
+		repoComposite.Iteration,
+		services.NewMetricsService(),
+	)
+
+	graphService := application.NewGraphApplicationService(
+		repoComposite.Roadmap,
+		repoComposite.Track,
+		repoComposite.Task,
+		services.NewDependencyService(),
+		documentService,
+	)
+
+	trackPlanService := application.NewTrackPlanApplicationService(
+		repoComposite.Roadmap,
+		repoComposite.Track,
+		services.NewDependencyService(),
+		trackService,
+	)
+
+	iterationPlanService := application.NewIterationPlanApplicationService(
+		repoComposite.Iteration,
+		repoComposite.Task,
+		repoComposite.Roadmap,
+		repoComposite.Track,
+		services.NewDependencyService(),
+		iterationAppService,
+	)
+
+	syncService := application.NewSyncApplicationService(
+		repoComposite.Roadmap,
+		repoComposite.Track,
+		repoComposite.Task,
+		repoComposite.Iteration,
+		repoComposite.AC,
+		repoComposite.ADR,
+		repoComposite.Document,
+		repoComposite.SyncState,
+		filesync.NewMarkdownStore(),
+		repoComposite,
+		eventBus,
+		hookRunner,
+		snapshotService,
+	)
+
+	doctorService := application.NewDoctorApplicationService(
+		repoComposite.Integrity,
+		repoComposite.Roadmap,
+		repoComposite.Track,
+		repoComposite.Task,
+		repoComposite.Iteration,
+		services.NewDependencyService(),
 	)
 
 	// Create project management repository and service
@@ -125,6 +300,11 @@ func BootstrapApp() (*App, error) {
 		validationService,
 	)
 
+	// Create schema service for tm db, which opens the database on its own
+	schemaService := application.NewSchemaApplicationService(
+		persistence.NewSQLiteSchemaRepository(workingDir, activeProject),
+	)
+
 	// Create app instance with all dependencies
 	app := &App{
 		Logger:                 logger,
@@ -132,6 +312,8 @@ func BootstrapApp() (*App, error) {
 		WorkingDir:             workingDir,
 		ActiveProject:          activeProject,
 		RepositoryCommon:       repoComposite,
+		EventBus:               eventBus,
+		VersionControl:         gitClient,
 		ValidationService:      validationService,
 		DomainIterationService: domainIterationService,
 		TrackService:           trackService,
@@ -139,14 +321,125 @@ func BootstrapApp() (*App, error) {
 		IterationService:       iterationAppService,
 		ADRService:             adrService,
 		ACService:              acService,
+		ACCheckService:         acCheckService,
+		GitService:             gitService,
 		RoadmapService:         roadmapService,
 		DocumentService:        documentService,
 		ProjectService:         projectService,
+		HistoryService:         historyService,
+		SearchService:          searchService,
+		ReportService:          reportService,
+		MetricsService:         metricsService,
+		SyncService:            syncService,
+		GraphService:           graphService,
+		TrackPlanService:       trackPlanService,
+		IterationPlanService:   iterationPlanService,
+		DoctorService:          doctorService,
+		SchemaService:          schemaService,
+		SnapshotService:        snapshotService,
 	}
 
 	return app, nil
 }
 
+// BootstrapDatabaseApp initializes an application serving only the tm db commands.
+// The project database is not opened, so it is neither migrated nor created.
+func BootstrapDatabaseApp(project string) (*App, error) {
+	workingDir := persistence.ResolveWorkingDirectory()
+
+	activeProject, err := persistence.ResolveProject(workingDir, project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve project: %w", err)
+	}
+
+	return &App{
+		Logger:        infralogger.NewStandardLogger(logger.LevelInfo),
+		ConfigPath:    GetConfigPath(),
+		WorkingDir:    workingDir,
+		ActiveProject: activeProject,
+		SchemaService: application.NewSchemaApplicationService(
+			persistence.NewSQLiteSchemaRepository(workingDir, activeProject),
+		),
+	}, nil
+}
+
+// BootstrapProjectApp initializes an application serving only the project commands.
+// No project database is opened, and the selected project need not exist: project
+// commands name the projects they work on, starting with tm project create.
+func BootstrapProjectApp(project string) (*App, error) {
+	configPath := GetConfigPath()
+	workingDir := persistence.ResolveWorkingDirectory()
+
+	activeProject, err := persistence.ResolveProject(workingDir, project)
+	if errors.Is(err, tmerrors.ErrNotFound) {
+		// Keep the missing project selected, so snapshot defaults never fall back to another one
+		activeProject, err = project, nil
+		if activeProject == "" {
+			activeProject = strings.TrimSpace(os.Getenv(persistence.ProjectEnvVar))
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve project: %w", err)
+	}
+
+	snapshotPolicy, err := policy.LoadSnapshotPolicy(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load backup settings: %w", err)
+	}
+
+	return &App{
+		Logger:        infralogger.NewStandardLogger(logger.LevelInfo),
+		ConfigPath:    configPath,
+		WorkingDir:    workingDir,
+		ActiveProject: activeProject,
+		ProjectService: application.NewProjectService(
+			persistence.NewFileSystemProjectManagementRepository(workingDir),
+			services.NewValidationService(),
+		),
+		SnapshotService: application.NewSnapshotApplicationService(
+			persistence.NewFileSystemSnapshotRepository(workingDir),
+			snapshotPolicy,
+			activeProject,
+		),
+	}, nil
+}
+
+// registerEventSubscribers wires the handlers that react to domain events.
+func registerEventSubscribers(bus events.EventBus, log logger.Logger, history *application.HistoryApplicationService, hookRunner *hooks.Runner) {
+	// Trace every event at debug level
+	bus.Subscribe(events.EventAll, func(ctx context.Context, event events.Event) error {
+		log.Debug("domain event", "type", event.Type, "entity", event.EntityID)
+		return nil
+	})
+
+	// Persist every event in the append-only entity history
+	bus.Subscribe(events.EventAll, history.RecordEvent)
+
+	// Run post-hooks after the change is recorded; they observe but cannot veto
+	bus.Subscribe(events.EventAll, hookRunner.AfterEvent)
+}
+
+// unknownActor is recorded when no user can be determined, e.g. in a container without one
+const unknownActor = "unknown"
+
+// currentUser looks up the OS user; replaced in tests
+var currentUser = user.Current
+
+// resolveActor identifies who is making changes, for the entity history.
+// TM_ACTOR takes precedence over the OS user, which the environment names ($USER, %USERNAME%)
+// or, when neither is set, the OS reports.
+func resolveActor() string {
+	for _, name := range []string{"TM_ACTOR", "USER", "USERNAME"} {
+		if actor := os.Getenv(name); actor != "" {
+			return actor
+		}
+	}
+	if u, err := currentUser(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return unknownActor
+}
+
 // Close closes database connections and cleanup
 func (a *App) Close() error {
 	if a.RepositoryCommon != nil {
@@ -1,8 +1,17 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"os/user"
 	"path/filepath"
+	"reflect"
 	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/persistence"
 )
 
 func TestBootstrapApp(t *testing.T) {
@@ -11,9 +20,10 @@ func TestBootstrapApp(t *testing.T) {
 
 	// Set working directory via env var
 	t.Setenv("TM_WORKING_DIR", tempDir)
+	t.Setenv("TM_PROJECT", "")
 
 	// Bootstrap app
-	app, err := BootstrapApp()
+	app, err := BootstrapApp("")
 	if err != nil {
 		t.Fatalf("BootstrapApp() failed: %v", err)
 	}
@@ -59,9 +69,10 @@ func TestAppClose(t *testing.T) {
 	tempDir := t.TempDir()
 
 	t.Setenv("TM_WORKING_DIR", tempDir)
+	t.Setenv("TM_PROJECT", "")
 
 	// Bootstrap app
-	app, err := BootstrapApp()
+	app, err := BootstrapApp("")
 	if err != nil {
 		t.Fatalf("BootstrapApp() failed: %v", err)
 	}
@@ -76,3 +87,239 @@ func TestAppClose(t *testing.T) {
 		t.Errorf("Close() second time failed: %v", err)
 	}
 }
+
+func TestBootstrapApp_SelectedProject(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("TM_WORKING_DIR", tempDir)
+	t.Setenv("TM_PROJECT", "")
+
+	if err := os.MkdirAll(filepath.Join(tempDir, "projects", "other"), 0755); err != nil {
+		t.Fatalf("failed to create project dir: %v", err)
+	}
+
+	app, err := BootstrapApp("other")
+	if err != nil {
+		t.Fatalf("BootstrapApp() failed: %v", err)
+	}
+	defer app.Close()
+
+	if app.ActiveProject != "other" {
+		t.Errorf("ActiveProject = %v, want other", app.ActiveProject)
+	}
+
+	// Unknown projects are rejected instead of being created
+	if _, err := BootstrapApp("missing"); err == nil {
+		t.Error("BootstrapApp() with unknown project should fail")
+	}
+}
+
+func TestParseProjectFlag(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"task", "list"}, ""},
+		{[]string{"--project", "alpha", "task", "list"}, "alpha"},
+		{[]string{"task", "create", "--title", "x", "--project=beta", "-o", "json"}, "beta"},
+		{[]string{"task", "list", "--", "--project", "gamma"}, ""},
+		{[]string{"--help"}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := parseProjectFlag(tt.args); got != tt.want {
+			t.Errorf("parseProjectFlag(%v) = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestParseCommandName(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{}, ""},
+		{[]string{"db", "status"}, "db"},
+		{[]string{"--project", "alpha", "db", "migrate", "--to", "14"}, "db"},
+		{[]string{"-o", "json", "task", "list"}, "task"},
+		{[]string{"--help"}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := parseCommandName(tt.args); got != tt.want {
+			t.Errorf("parseCommandName(%v) = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestBootstrapDatabaseApp(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("TM_WORKING_DIR", tempDir)
+	t.Setenv("TM_PROJECT", "")
+
+	app, err := BootstrapDatabaseApp("")
+	if err != nil {
+		t.Fatalf("BootstrapDatabaseApp() failed: %v", err)
+	}
+	defer app.Close()
+
+	if app.SchemaService == nil {
+		t.Error("SchemaService not initialized")
+	}
+	if app.RepositoryCommon != nil {
+		t.Error("BootstrapDatabaseApp() should not open the database")
+	}
+	if _, err := os.Stat(filepath.Join(tempDir, "projects", "default", "roadmap.db")); !os.IsNotExist(err) {
+		t.Errorf("BootstrapDatabaseApp() should not create the database: %v", err)
+	}
+
+	// Only the db commands are registered
+	if _, _, err := NewRootCmd(app).Find([]string{"db", "status"}); err != nil {
+		t.Errorf("db status should be registered: %v", err)
+	}
+}
+
+// TestBootstrapProjectApp verifies project commands run while the selected project doesn't exist yet
+func TestBootstrapProjectApp(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("TM_WORKING_DIR", tempDir)
+	t.Setenv("TM_PROJECT", "newp")
+	t.Setenv("HOME", tempDir)
+
+	app, err := BootstrapProjectApp("")
+	if err != nil {
+		t.Fatalf("BootstrapProjectApp() failed: %v", err)
+	}
+	defer app.Close()
+
+	if app.RepositoryCommon != nil {
+		t.Error("BootstrapProjectApp() should not open a project database")
+	}
+	if app.ActiveProject != "newp" {
+		t.Errorf("ActiveProject = %v, want the selected project newp", app.ActiveProject)
+	}
+
+	rootCmd := NewRootCmd(app)
+	rootCmd.SetOut(io.Discard)
+	rootCmd.SetArgs([]string{"project", "create", "newp"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("tm project create newp failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tempDir, "projects", "newp")); err != nil {
+		t.Errorf("project newp should have been created: %v", err)
+	}
+
+	// The created project can now be opened
+	full, err := BootstrapApp("")
+	if err != nil {
+		t.Fatalf("BootstrapApp() after create failed: %v", err)
+	}
+	full.Close()
+}
+
+func TestBootstrapFor(t *testing.T) {
+	tests := []struct {
+		command string
+		want    string
+	}{
+		{"db", "database"},
+		{"project", "project"},
+		{"p", "project"},
+		{"version", "project"},
+		{"task", "full"},
+		{"", "full"},
+	}
+
+	bootstraps := map[string]func(string) (*App, error){
+		"database": BootstrapDatabaseApp,
+		"project":  BootstrapProjectApp,
+		"full":     BootstrapApp,
+	}
+	for _, tt := range tests {
+		got := reflect.ValueOf(bootstrapFor(tt.command)).Pointer()
+		if got != reflect.ValueOf(bootstraps[tt.want]).Pointer() {
+			t.Errorf("bootstrapFor(%q) should use the %s app", tt.command, tt.want)
+		}
+	}
+}
+
+// TestBootstrapApp_MigrationKeepsStdoutClean runs a structured-output command against an
+// old-schema database: the automatic migration must not write in front of the JSON.
+func TestBootstrapApp_MigrationKeepsStdoutClean(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("TM_WORKING_DIR", tempDir)
+	t.Setenv("TM_PROJECT", "")
+	t.Setenv("HOME", tempDir)
+
+	schemaRepo := persistence.NewSQLiteSchemaRepository(tempDir, "default")
+	if _, err := schemaRepo.MigrateSchema(context.Background(), persistence.SchemaVersion); err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	if _, err := schemaRepo.MigrateSchema(context.Background(), 9); err != nil {
+		t.Fatalf("failed to roll back database: %v", err)
+	}
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	defer func() { os.Stdout = stdout }()
+
+	app, err := BootstrapApp("")
+	if err == nil {
+		defer app.Close()
+		rootCmd := NewRootCmd(app)
+		rootCmd.SetArgs([]string{"project", "list", "-o", "json"})
+		err = rootCmd.Execute()
+	}
+	writer.Close()
+	os.Stdout = stdout
+	output, readErr := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("tm project list failed: %v", err)
+	}
+	if readErr != nil {
+		t.Fatalf("failed to read stdout: %v", readErr)
+	}
+
+	var envelope map[string]any
+	if err := json.Unmarshal(output, &envelope); err != nil {
+		t.Fatalf("stdout is not JSON: %v\n%s", err, output)
+	}
+	if envelope["kind"] != "project_list" {
+		t.Errorf("unexpected envelope %v", envelope)
+	}
+}
+
+func TestResolveActor(t *testing.T) {
+	lookupFails := func() (*user.User, error) { return nil, errors.New("no such user") }
+	lookupFinds := func() (*user.User, error) { return &user.User{Username: "os-user"}, nil }
+
+	tests := []struct {
+		name   string
+		env    map[string]string
+		lookup func() (*user.User, error)
+		want   string
+	}{
+		{"TM_ACTOR wins", map[string]string{"TM_ACTOR": "agent-1", "USER": "alice"}, lookupFinds, "agent-1"},
+		{"USER", map[string]string{"USER": "alice", "USERNAME": "bob"}, lookupFinds, "alice"},
+		{"USERNAME", map[string]string{"USERNAME": "bob"}, lookupFinds, "bob"},
+		{"OS user", map[string]string{}, lookupFinds, "os-user"},
+		{"unknown", map[string]string{}, lookupFails, "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"TM_ACTOR", "USER", "USERNAME"} {
+				t.Setenv(name, tt.env[name])
+			}
+			original := currentUser
+			currentUser = tt.lookup
+			defer func() { currentUser = original }()
+
+			if got := resolveActor(); got != tt.want {
+				t.Errorf("resolveActor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
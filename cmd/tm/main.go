@@ -3,11 +3,16 @@ package main
 import (
 	"fmt"
 	"os"
+	"slices"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/spf13/cobra"
 )
 
 func main() {
-	// Bootstrap the application with all dependencies
-	app, err := BootstrapApp()
+	// Bootstrap the application for the project selected on the command line
+	bootstrap := bootstrapFor(parseCommandName(os.Args[1:]))
+	app, err := bootstrap(parseProjectFlag(os.Args[1:]))
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: failed to initialize task manager: %v\n", err)
 		os.Exit(1)
@@ -18,6 +23,36 @@ func main() {
 	rootCmd := NewRootCmd(app)
 
 	if err := rootCmd.Execute(); err != nil {
+		reportError(rootCmd, err)
 		os.Exit(1)
 	}
 }
+
+// bootstrapFor returns how to bootstrap the application for a top-level command.
+func bootstrapFor(command string) func(project string) (*App, error) {
+	switch {
+	case command == cli.DatabaseCommandName:
+		// tm db works on the database as it is, so it must not be opened (and migrated) first
+		return BootstrapDatabaseApp
+	case command == cli.ProjectCommandName || slices.Contains(cli.ProjectCommandAliases, command) || command == versionCommandName:
+		// The selected project may not exist yet, e.g. for tm project create
+		return BootstrapProjectApp
+	default:
+		return BootstrapApp
+	}
+}
+
+// reportError prints a command failure in the format selected by --output.
+// Structured errors go to stdout so scripts can parse a single stream.
+func reportError(rootCmd *cobra.Command, err error) {
+	if cli.IsReported(err) {
+		return
+	}
+	value, _ := rootCmd.PersistentFlags().GetString(cli.OutputFlagName)
+	format, parseErr := cli.ParseOutputFormat(value)
+	if parseErr != nil || format == cli.OutputTable {
+		cli.WriteError(os.Stderr, cli.OutputTable, err)
+		return
+	}
+	cli.WriteError(os.Stdout, format, err)
+}
@@ -1,12 +1,20 @@
 package main
 
 import (
+	"io"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/api"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/mcp"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 const version = "1.0.0"
 
+// projectFlagName is the global flag selecting the project for a single invocation.
+const projectFlagName = "project"
+
 // NewRootCmd creates the root Cobra command for the task manager.
 func NewRootCmd(app *App) *cobra.Command {
 	rootCmd := &cobra.Command{
@@ -14,6 +22,12 @@ func NewRootCmd(app *App) *cobra.Command {
 		Short:   "Task Manager - Manage tasks, iterations, and roadmaps",
 		Long:    "tm is a command-line tool for managing tasks, iterations, tracks, and roadmaps",
 		Version: version,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Reject unknown output formats before any command runs
+			format, _ := cmd.Flags().GetString(cli.OutputFlagName)
+			_, err := cli.ParseOutputFormat(format)
+			return err
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Show help if no subcommand is provided
 			return cmd.Help()
@@ -21,35 +35,45 @@ func NewRootCmd(app *App) *cobra.Command {
 	}
 
 	// Set up cobra configuration
+	// Errors are reported by main so they can honor the selected output format
 	rootCmd.SilenceUsage = true
+	rootCmd.SilenceErrors = true
 
 	// Add global flags
-	rootCmd.PersistentFlags().String("project", "", "Specify project name")
+	rootCmd.PersistentFlags().String(projectFlagName, "", "Project to operate on (overrides TM_PROJECT and the active project)")
+	rootCmd.PersistentFlags().StringP(cli.OutputFlagName, "o", string(cli.OutputTable), "Output format: table, json, yaml")
 
 	// Add special commands (version, ui, completion, prompt)
 	rootCmd.AddCommand(NewVersionCommand())
 	rootCmd.AddCommand(NewCompletionCommand())
 	rootCmd.AddCommand(cli.NewPromptCommand(cli.GetSystemPrompt))
 
+	// Add database maintenance commands; they are all a database-only app provides
+	if app != nil && app.SchemaService != nil {
+		rootCmd.AddCommand(cli.NewDBCommands(app.SchemaService))
+	}
+
+	// Add project commands; they are all a project-only app provides
+	if app != nil && app.ProjectService != nil {
+		rootCmd.AddCommand(cli.NewProjectCommands(app.ProjectService, app.SnapshotService))
+	}
+
 	// Add application commands from the Cobra command groups
-	if app != nil {
+	if app != nil && app.RepositoryCommon != nil {
 		// Register TUI command (implementation varies by build tag)
 		registerTUICommand(rootCmd, app)
 
-		// Add project commands
-		rootCmd.AddCommand(cli.NewProjectCommands(app.ProjectService))
-
 		// Add task commands from the Cobra command group
-		rootCmd.AddCommand(cli.NewTaskCommands(app.TaskService, app.ACService))
+		rootCmd.AddCommand(cli.NewTaskCommands(app.TaskService, app.ACService, app.GitService))
 
 		// Add iteration commands from the Cobra command group
-		rootCmd.AddCommand(cli.NewIterationCommands(app.IterationService, app.DocumentService, app.ACService))
+		rootCmd.AddCommand(cli.NewIterationCommands(app.IterationService, app.DocumentService, app.ACService, app.ReportService, app.IterationPlanService))
 
 		// Add AC commands from the Cobra command group
-		rootCmd.AddCommand(cli.NewACCommands(app.ACService, app.TaskService))
+		rootCmd.AddCommand(cli.NewACCommands(app.ACService, app.TaskService, app.ACCheckService))
 
 		// Add track commands from the Cobra command group
-		rootCmd.AddCommand(cli.NewTrackCommands(app.TrackService, app.DocumentService))
+		rootCmd.AddCommand(cli.NewTrackCommands(app.TrackService, app.DocumentService, app.TrackPlanService))
 
 		// Add ADR commands from the Cobra command group
 		rootCmd.AddCommand(cli.NewADRCommands(app.ADRService))
@@ -59,7 +83,84 @@ func NewRootCmd(app *App) *cobra.Command {
 
 		// Add document commands from the Cobra command group
 		rootCmd.AddCommand(cli.NewDocCommands(app.DocumentService))
+
+		// Add git integration commands (hooks run tm against this working directory and project)
+		rootCmd.AddCommand(cli.NewGitCommands(app.GitService, app.WorkingDir, app.ActiveProject))
+
+		// Add history command for the entity audit log
+		rootCmd.AddCommand(cli.NewHistoryCommand(app.HistoryService))
+
+		// Add full-text search command
+		rootCmd.AddCommand(cli.NewSearchCommand(app.SearchService))
+
+		// Add metrics command for velocity, burndown, cycle time and throughput
+		rootCmd.AddCommand(cli.NewStatsCommand(app.MetricsService))
+
+		// Add graph command rendering the hierarchy and track dependencies
+		rootCmd.AddCommand(cli.NewGraphCommand(app.GraphService))
+
+		// Add sync command mirroring the project to markdown files
+		rootCmd.AddCommand(cli.NewSyncCommands(app.SyncService))
+
+		// Add doctor command checking the project database for inconsistencies
+		rootCmd.AddCommand(cli.NewDoctorCommand(app.DoctorService))
+
+		// Add serve command exposing the application services as a REST/JSON API
+		rootCmd.AddCommand(api.NewServeCommand(api.NewServer(
+			app.RoadmapService,
+			app.TrackService,
+			app.TaskService,
+			app.IterationService,
+			app.ACService,
+			app.ADRService,
+			app.DocumentService,
+		)))
+
+		// Add mcp command serving the application services to agents over stdio
+		rootCmd.AddCommand(mcp.NewMCPCommand(mcp.NewServer(
+			app.RoadmapService,
+			app.TrackService,
+			app.TaskService,
+			app.IterationService,
+			app.ACService,
+			app.ADRService,
+			app.DocumentService,
+			cli.GetSystemPrompt,
+			version,
+		)))
 	}
 
 	return rootCmd
 }
+
+// parseProjectFlag extracts the --project value from the raw command line.
+// The database must be opened before the command tree is built, so the flag is read
+// ahead of Cobra's own parsing; every other flag is ignored here and validated later.
+func parseProjectFlag(args []string) string {
+	flags := parseGlobalFlags(args)
+	project, _ := flags.GetString(projectFlagName)
+	return project
+}
+
+// parseCommandName returns the name of the top-level command on the raw command line,
+// or "" if there is none, read ahead of Cobra's parsing like parseProjectFlag.
+func parseCommandName(args []string) string {
+	flags := parseGlobalFlags(args)
+	if flags.NArg() == 0 {
+		return ""
+	}
+	return flags.Arg(0)
+}
+
+// parseGlobalFlags parses the raw command line for the global flags only.
+func parseGlobalFlags(args []string) *pflag.FlagSet {
+	flags := pflag.NewFlagSet("tm", pflag.ContinueOnError)
+	flags.ParseErrorsWhitelist.UnknownFlags = true
+	flags.SetOutput(io.Discard)
+	flags.BoolP("help", "h", false, "")
+	flags.String(projectFlagName, "", "")
+
+	// Parse errors (e.g. a missing value) are reported by Cobra when the command runs
+	_ = flags.Parse(args)
+	return flags
+}
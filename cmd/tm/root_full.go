@@ -12,5 +12,10 @@ import (
 // This implementation is used for the full build (default).
 // When built with -tags headless, root_headless.go provides a stub implementation instead.
 func registerTUICommand(rootCmd *cobra.Command, app *App) {
-	rootCmd.AddCommand(tui.NewUICommand(app.RepositoryCommon, app.Logger))
+	rootCmd.AddCommand(tui.NewUICommand(
+		app.RepositoryCommon,
+		tui.NewServiceCommands(app.TaskService, app.ACService, app.IterationService, app.DocumentService),
+		app.VersionControl,
+		app.Logger,
+	))
 }
@@ -6,10 +6,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// versionCommandName is the name of the version command, which needs no project
+const versionCommandName = "version"
+
 // NewVersionCommand creates a Cobra command for showing version information
 func NewVersionCommand() *cobra.Command {
 	return &cobra.Command{
-		Use:   "version",
+		Use:   versionCommandName,
 		Short: "Show version information",
 		Long: `Display the version of the task manager CLI.
 
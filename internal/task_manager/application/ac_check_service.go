@@ -0,0 +1,206 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// DefaultACCheckTimeout bounds a single AC check when no timeout is given.
+const DefaultACCheckTimeout = 5 * time.Minute
+
+// maxCheckNotes caps how much check output is kept in an AC's notes; the tail is kept.
+const maxCheckNotes = 4000
+
+// ACCheckApplicationService executes the checks attached to acceptance criteria
+// and records their outcome as automatically_verified or failed.
+type ACCheckApplicationService struct {
+	acRepo        repositories.AcceptanceCriteriaRepository
+	taskRepo      repositories.TaskRepository
+	iterationRepo repositories.IterationRepository
+	runner        services.CheckRunner
+	eventBus      events.EventBus
+	guard         events.TransitionGuard
+}
+
+// NewACCheckApplicationService creates a new AC check service.
+// eventBus may be nil, in which case no domain events are published.
+// guard may be nil, in which case check outcomes are never vetoed.
+func NewACCheckApplicationService(
+	acRepo repositories.AcceptanceCriteriaRepository,
+	taskRepo repositories.TaskRepository,
+	iterationRepo repositories.IterationRepository,
+	runner services.CheckRunner,
+	eventBus events.EventBus,
+	guard events.TransitionGuard,
+) *ACCheckApplicationService {
+	return &ACCheckApplicationService{
+		acRepo:        acRepo,
+		taskRepo:      taskRepo,
+		iterationRepo: iterationRepo,
+		runner:        runner,
+		eventBus:      eventBus,
+		guard:         guard,
+	}
+}
+
+// RunChecks executes the checks of a single AC, of every AC of a task, or of every AC in an iteration.
+// Only automated ACs are run for a task or iteration: manual and skipped ACs are left alone.
+// A rejected status change is reported on its result and does not stop the remaining checks.
+func (s *ACCheckApplicationService) RunChecks(ctx context.Context, input dto.RunACChecksDTO) ([]*dto.ACCheckResultDTO, error) {
+	acs, err := s.resolveACs(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := input.Timeout
+	if timeout <= 0 {
+		timeout = DefaultACCheckTimeout
+	}
+
+	results := []*dto.ACCheckResultDTO{}
+	for _, ac := range acs {
+		result, err := s.runCheck(ctx, ac, timeout)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// resolveACs finds the ACs to check. A target ID is tried as an AC ID first, then as a task ID.
+func (s *ACCheckApplicationService) resolveACs(ctx context.Context, input dto.RunACChecksDTO) ([]*entities.AcceptanceCriteriaEntity, error) {
+	if (input.TargetID == "") == (input.IterationNumber == nil) {
+		return nil, fmt.Errorf("%w: specify either an AC or task ID, or an iteration number", tmerrors.ErrInvalidArgument)
+	}
+
+	if input.IterationNumber != nil {
+		if _, err := s.iterationRepo.GetIteration(ctx, *input.IterationNumber); err != nil {
+			return nil, fmt.Errorf("failed to get iteration: %w", err)
+		}
+		acs, err := s.acRepo.ListACByIteration(ctx, *input.IterationNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list ACs by iteration: %w", err)
+		}
+		return runnableACs(acs), nil
+	}
+
+	ac, err := s.acRepo.GetAC(ctx, input.TargetID)
+	if err == nil {
+		if !ac.HasCheck() {
+			return nil, fmt.Errorf("%w: AC %s has no check command (set one with `tm ac update %s --check`)",
+				tmerrors.ErrInvalidArgument, ac.ID, ac.ID)
+		}
+		return []*entities.AcceptanceCriteriaEntity{ac}, nil
+	}
+	if !errors.Is(err, tmerrors.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get AC: %w", err)
+	}
+
+	if _, err := s.taskRepo.GetTask(ctx, input.TargetID); err != nil {
+		if errors.Is(err, tmerrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: no AC or task with ID %s", tmerrors.ErrNotFound, input.TargetID)
+		}
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	acs, err := s.acRepo.ListAC(ctx, input.TargetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ACs: %w", err)
+	}
+	return runnableACs(acs), nil
+}
+
+// runnableACs keeps the automated ACs that have a check and were not intentionally skipped.
+// A manual AC is verified by a person even if it carries a check command.
+func runnableACs(acs []*entities.AcceptanceCriteriaEntity) []*entities.AcceptanceCriteriaEntity {
+	var runnable []*entities.AcceptanceCriteriaEntity
+	for _, ac := range acs {
+		if ac.VerificationType == entities.VerificationTypeAutomated && ac.HasCheck() && !ac.IsSkipped() {
+			runnable = append(runnable, ac)
+		}
+	}
+	return runnable
+}
+
+// runCheck executes one check and persists the resulting status and notes.
+func (s *ACCheckApplicationService) runCheck(ctx context.Context, ac *entities.AcceptanceCriteriaEntity, timeout time.Duration) (*dto.ACCheckResultDTO, error) {
+	checkResult, err := s.runner.RunCheck(ctx, ac, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run check for AC %s: %w", ac.ID, err)
+	}
+
+	previous := *ac
+	eventType := events.EventACFailed
+	ac.Status = entities.ACStatusFailed
+	if checkResult.Passed {
+		eventType = events.EventACAutomaticallyVerified
+		ac.Status = entities.ACStatusAutomaticallyVerified
+	}
+	ac.Notes = checkNotes(ac.CheckCommand, checkResult, timeout)
+	ac.UpdatedAt = time.Now().UTC()
+
+	result := &dto.ACCheckResultDTO{
+		ACID:       ac.ID,
+		TaskID:     ac.TaskID,
+		Command:    ac.CheckCommand,
+		Status:     string(ac.Status),
+		ExitCode:   checkResult.ExitCode,
+		TimedOut:   checkResult.TimedOut,
+		DurationMs: checkResult.Duration.Milliseconds(),
+		Output:     checkResult.Output,
+	}
+
+	if err := guardTransition(ctx, s.guard, eventType, events.EntityTypeAC, ac.ID, ac, &previous); err != nil {
+		if !errors.Is(err, tmerrors.ErrRejected) {
+			return nil, err
+		}
+		result.Status = string(previous.Status)
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	if err := s.acRepo.UpdateAC(ctx, ac); err != nil {
+		return nil, fmt.Errorf("failed to record check result for AC %s: %w", ac.ID, err)
+	}
+
+	publishEvent(ctx, s.eventBus, eventType, events.EntityTypeAC, ac.ID, ac, &previous)
+
+	return result, nil
+}
+
+// checkNotes summarizes a check run for the AC's notes, keeping the tail of long output.
+func checkNotes(command string, result services.CheckResult, timeout time.Duration) string {
+	var summary string
+	switch {
+	case result.TimedOut:
+		summary = fmt.Sprintf("Check timed out after %s: %s", timeout, command)
+	case result.Passed:
+		summary = fmt.Sprintf("Check passed in %s: %s", result.Duration.Round(time.Millisecond), command)
+	default:
+		summary = fmt.Sprintf("Check failed with exit code %d in %s: %s", result.ExitCode, result.Duration.Round(time.Millisecond), command)
+	}
+
+	output := result.Output
+	if output == "" {
+		return summary
+	}
+	if len(output) > maxCheckNotes {
+		// Cut on a rune boundary so multi-byte characters are not split
+		start := len(output) - maxCheckNotes
+		for start < len(output) && !utf8.RuneStart(output[start]) {
+			start++
+		}
+		output = "..." + output[start:]
+	}
+	return summary + "\n\n" + output
+}
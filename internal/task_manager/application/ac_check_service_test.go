@@ -0,0 +1,242 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// fakeCheckRunner returns canned results keyed by check command
+type fakeCheckRunner struct {
+	results map[string]services.CheckResult
+	ran     []string
+	timeout time.Duration
+}
+
+func (r *fakeCheckRunner) RunCheck(ctx context.Context, ac *entities.AcceptanceCriteriaEntity, timeout time.Duration) (services.CheckResult, error) {
+	r.ran = append(r.ran, ac.ID)
+	r.timeout = timeout
+	return r.results[ac.CheckCommand], nil
+}
+
+// newCheckedAC creates an AC with a check command
+func newCheckedAC(id, taskID, command string) *entities.AcceptanceCriteriaEntity {
+	now := time.Now().UTC()
+	ac := entities.NewAcceptanceCriteriaEntity(id, taskID, "Checked AC", entities.VerificationTypeManual, "", now, now)
+	ac.SetCheckCommand(command)
+	return ac
+}
+
+// setupACCheckTestService creates a check service over the given ACs of task TM-task-1
+func setupACCheckTestService(acs []*entities.AcceptanceCriteriaEntity, runner *fakeCheckRunner, bus events.EventBus, guard events.TransitionGuard) (*application.ACCheckApplicationService, map[string]*entities.AcceptanceCriteriaEntity) {
+	updated := map[string]*entities.AcceptanceCriteriaEntity{}
+	acRepo := &mocks.MockAcceptanceCriteriaRepository{
+		GetACFunc: func(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
+			for _, ac := range acs {
+				if ac.ID == id {
+					return ac, nil
+				}
+			}
+			return nil, tmerrors.ErrNotFound
+		},
+		ListACFunc: func(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+			return acs, nil
+		},
+		ListACByIterationFunc: func(ctx context.Context, iterationNum int) ([]*entities.AcceptanceCriteriaEntity, error) {
+			return acs, nil
+		},
+		UpdateACFunc: func(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+			updated[ac.ID] = ac
+			return nil
+		},
+	}
+	taskRepo := &mocks.MockTaskRepository{
+		GetTaskFunc: func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+			if id != "TM-task-1" {
+				return nil, tmerrors.ErrNotFound
+			}
+			now := time.Now().UTC()
+			return entities.NewTaskEntity(id, "TM-track-1", "Task", "", "todo", 100, "", now, now)
+		},
+	}
+	iterationRepo := &mocks.MockIterationRepository{
+		GetIterationFunc: func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+			return &entities.IterationEntity{Number: number}, nil
+		},
+	}
+
+	service := application.NewACCheckApplicationService(acRepo, taskRepo, iterationRepo, runner, bus, guard)
+	return service, updated
+}
+
+// TestACCheckService_RunChecks_Task runs every check of a task and records the outcomes
+func TestACCheckService_RunChecks_Task(t *testing.T) {
+	skipped := newCheckedAC("TM-ac-4", "TM-task-1", "true")
+	skipped.Status = entities.ACStatusSkipped
+	// An AC imported or migrated as manual can still carry a check command
+	manualWithCheck := newCheckedAC("TM-ac-5", "TM-task-1", "true")
+	manualWithCheck.VerificationType = entities.VerificationTypeManual
+	acs := []*entities.AcceptanceCriteriaEntity{
+		newCheckedAC("TM-ac-1", "TM-task-1", "go test ./... -run TestPass"),
+		newCheckedAC("TM-ac-2", "TM-task-1", "go test ./... -run TestFail"),
+		createTestACEntity(t, "TM-ac-3", "TM-task-1"), // manual, no check
+		skipped,
+		manualWithCheck,
+	}
+	runner := &fakeCheckRunner{results: map[string]services.CheckResult{
+		"go test ./... -run TestPass": {Passed: true, Output: "ok"},
+		"go test ./... -run TestFail": {ExitCode: 1, Output: "--- FAIL: TestFail"},
+	}}
+	bus := &recordingEventBus{}
+	service, updated := setupACCheckTestService(acs, runner, bus, nil)
+
+	results, err := service.RunChecks(context.Background(), dto.RunACChecksDTO{TargetID: "TM-task-1"})
+	if err != nil {
+		t.Fatalf("RunChecks() failed: %v", err)
+	}
+
+	if len(results) != 2 || len(runner.ran) != 2 {
+		t.Fatalf("ran %v, want only TM-ac-1 and TM-ac-2", runner.ran)
+	}
+	if runner.timeout != application.DefaultACCheckTimeout {
+		t.Errorf("timeout = %s, want default %s", runner.timeout, application.DefaultACCheckTimeout)
+	}
+	if updated["TM-ac-1"].Status != entities.ACStatusAutomaticallyVerified {
+		t.Errorf("TM-ac-1 status = %s, want automatically_verified", updated["TM-ac-1"].Status)
+	}
+	if updated["TM-ac-2"].Status != entities.ACStatusFailed {
+		t.Errorf("TM-ac-2 status = %s, want failed", updated["TM-ac-2"].Status)
+	}
+	if !strings.Contains(updated["TM-ac-2"].Notes, "exit code 1") || !strings.Contains(updated["TM-ac-2"].Notes, "--- FAIL: TestFail") {
+		t.Errorf("TM-ac-2 notes should contain exit code and output, got %q", updated["TM-ac-2"].Notes)
+	}
+	assertEventTypes(t, bus, events.EventACAutomaticallyVerified, events.EventACFailed)
+}
+
+// TestACCheckService_RunChecks_SingleAC runs one AC's check with the given timeout
+func TestACCheckService_RunChecks_SingleAC(t *testing.T) {
+	acs := []*entities.AcceptanceCriteriaEntity{newCheckedAC("TM-ac-1", "TM-task-1", "sleep 10")}
+	runner := &fakeCheckRunner{results: map[string]services.CheckResult{
+		"sleep 10": {ExitCode: -1, TimedOut: true},
+	}}
+	service, updated := setupACCheckTestService(acs, runner, nil, nil)
+
+	results, err := service.RunChecks(context.Background(), dto.RunACChecksDTO{TargetID: "TM-ac-1", Timeout: time.Second})
+	if err != nil {
+		t.Fatalf("RunChecks() failed: %v", err)
+	}
+
+	if len(results) != 1 || !results[0].TimedOut {
+		t.Fatalf("results = %+v, want one timed out result", results)
+	}
+	if runner.timeout != time.Second {
+		t.Errorf("timeout = %s, want 1s", runner.timeout)
+	}
+	if updated["TM-ac-1"].Status != entities.ACStatusFailed || !strings.Contains(updated["TM-ac-1"].Notes, "timed out after 1s") {
+		t.Errorf("AC = %s %q, want failed with timeout note", updated["TM-ac-1"].Status, updated["TM-ac-1"].Notes)
+	}
+}
+
+// TestACCheckService_RunChecks_Iteration runs the checks of every AC in an iteration
+func TestACCheckService_RunChecks_Iteration(t *testing.T) {
+	acs := []*entities.AcceptanceCriteriaEntity{newCheckedAC("TM-ac-1", "TM-task-1", "true")}
+	runner := &fakeCheckRunner{results: map[string]services.CheckResult{"true": {Passed: true}}}
+	service, _ := setupACCheckTestService(acs, runner, nil, nil)
+
+	iterationNum := 2
+	results, err := service.RunChecks(context.Background(), dto.RunACChecksDTO{IterationNumber: &iterationNum})
+	if err != nil {
+		t.Fatalf("RunChecks() failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Status != string(entities.ACStatusAutomaticallyVerified) {
+		t.Errorf("results = %+v, want one automatically_verified result", results)
+	}
+}
+
+// TestACCheckService_RunChecks_InvalidTargets covers AC without a check, unknown IDs and ambiguous input
+func TestACCheckService_RunChecks_InvalidTargets(t *testing.T) {
+	acs := []*entities.AcceptanceCriteriaEntity{createTestACEntity(t, "TM-ac-1", "TM-task-1")}
+	service, _ := setupACCheckTestService(acs, &fakeCheckRunner{}, nil, nil)
+	ctx := context.Background()
+	iterationNum := 1
+
+	tests := []struct {
+		name    string
+		input   dto.RunACChecksDTO
+		wantErr error
+	}{
+		{"AC without check", dto.RunACChecksDTO{TargetID: "TM-ac-1"}, tmerrors.ErrInvalidArgument},
+		{"unknown ID", dto.RunACChecksDTO{TargetID: "TM-task-99"}, tmerrors.ErrNotFound},
+		{"no target", dto.RunACChecksDTO{}, tmerrors.ErrInvalidArgument},
+		{"both targets", dto.RunACChecksDTO{TargetID: "TM-task-1", IterationNumber: &iterationNum}, tmerrors.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.RunChecks(ctx, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RunChecks() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestACCheckService_RunChecks_GuardRejects reports a vetoed outcome without persisting it
+func TestACCheckService_RunChecks_GuardRejects(t *testing.T) {
+	acs := []*entities.AcceptanceCriteriaEntity{newCheckedAC("TM-ac-1", "TM-task-1", "false")}
+	runner := &fakeCheckRunner{results: map[string]services.CheckResult{"false": {ExitCode: 1}}}
+	bus := &recordingEventBus{}
+	guard := &vetoingGuard{reject: events.EventACFailed}
+	service, updated := setupACCheckTestService(acs, runner, bus, guard)
+
+	results, err := service.RunChecks(context.Background(), dto.RunACChecksDTO{TargetID: "TM-ac-1"})
+	if err != nil {
+		t.Fatalf("RunChecks() failed: %v", err)
+	}
+
+	if len(results) != 1 || results[0].Error == "" {
+		t.Fatalf("results = %+v, want one rejected result", results)
+	}
+	if results[0].Status != string(entities.ACStatusNotStarted) {
+		t.Errorf("rejected result status = %s, want unchanged not_started", results[0].Status)
+	}
+	if len(updated) != 0 {
+		t.Error("rejected outcome should not be persisted")
+	}
+	assertEventTypes(t, bus)
+}
+
+// TestACCheckService_RunChecks_TruncatesOnRuneBoundary keeps valid UTF-8 when long output is cut
+func TestACCheckService_RunChecks_TruncatesOnRuneBoundary(t *testing.T) {
+	acs := []*entities.AcceptanceCriteriaEntity{newCheckedAC("TM-ac-1", "TM-task-1", "false")}
+	// 3-byte runes whose boundaries do not line up with the cut
+	output := "x" + strings.Repeat("€", 2000)
+	runner := &fakeCheckRunner{results: map[string]services.CheckResult{"false": {ExitCode: 1, Output: output}}}
+	service, updated := setupACCheckTestService(acs, runner, nil, nil)
+
+	if _, err := service.RunChecks(context.Background(), dto.RunACChecksDTO{TargetID: "TM-ac-1"}); err != nil {
+		t.Fatalf("RunChecks() failed: %v", err)
+	}
+
+	notes := updated["TM-ac-1"].Notes
+	if !utf8.ValidString(notes) {
+		t.Errorf("notes are not valid UTF-8: %q", notes[len(notes)-20:])
+	}
+	if !strings.Contains(notes, "\n\n...€") || !strings.HasSuffix(notes, "€€€") {
+		t.Errorf("notes should keep the tail of the output after an ellipsis, got %q", notes[:120])
+	}
+	if len(notes) > len("Check failed with exit code 1 in 0s: false\n\n...")+4000 {
+		t.Errorf("notes are %d bytes, want the output capped", len(notes))
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
 )
@@ -17,20 +18,28 @@ type ACApplicationService struct {
 	taskRepo          repositories.TaskRepository
 	aggregateRepo     repositories.AggregateRepository
 	validationService *services.ValidationService
+	eventBus          events.EventBus
+	guard             events.TransitionGuard
 }
 
-// NewACApplicationService creates a new AC service
+// NewACApplicationService creates a new AC service.
+// eventBus may be nil, in which case no domain events are published.
+// guard may be nil, in which case verification transitions are never vetoed.
 func NewACApplicationService(
 	acRepo repositories.AcceptanceCriteriaRepository,
 	taskRepo repositories.TaskRepository,
 	aggregateRepo repositories.AggregateRepository,
 	validationService *services.ValidationService,
+	eventBus events.EventBus,
+	guard events.TransitionGuard,
 ) *ACApplicationService {
 	return &ACApplicationService{
 		acRepo:            acRepo,
 		taskRepo:          taskRepo,
 		aggregateRepo:     aggregateRepo,
 		validationService: validationService,
+		eventBus:          eventBus,
+		guard:             guard,
 	}
 }
 
@@ -62,7 +71,7 @@ func (s *ACApplicationService) CreateAC(ctx context.Context, input dto.CreateACD
 
 	now := time.Now().UTC()
 
-	// Create AC entity (default status: not-started, default type: manual)
+	// Create AC entity (default status: not-started; automated when a check is given)
 	ac := entities.NewAcceptanceCriteriaEntity(
 		id,
 		input.TaskID,
@@ -72,12 +81,15 @@ func (s *ACApplicationService) CreateAC(ctx context.Context, input dto.CreateACD
 		now,
 		now,
 	)
+	ac.SetCheckCommand(input.CheckCommand)
 
 	// Persist AC
 	if err := s.acRepo.SaveAC(ctx, ac); err != nil {
 		return nil, fmt.Errorf("failed to save AC: %w", err)
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventACCreated, events.EntityTypeAC, ac.ID, ac, nil)
+
 	return ac, nil
 }
 
@@ -88,6 +100,10 @@ func (s *ACApplicationService) UpdateAC(ctx context.Context, input dto.UpdateACD
 	if err != nil {
 		return nil, fmt.Errorf("failed to get AC: %w", err)
 	}
+	if err := checkVersion("AC", ac.ID, ac.Version, input.Version); err != nil {
+		return nil, err
+	}
+	previous := *ac
 
 	// Apply updates
 	if input.Description != nil {
@@ -101,6 +117,10 @@ func (s *ACApplicationService) UpdateAC(ctx context.Context, input dto.UpdateACD
 		ac.TestingInstructions = *input.TestingInstructions
 	}
 
+	if input.CheckCommand != nil {
+		ac.SetCheckCommand(*input.CheckCommand)
+	}
+
 	// Update timestamp
 	ac.UpdatedAt = time.Now().UTC()
 
@@ -109,6 +129,8 @@ func (s *ACApplicationService) UpdateAC(ctx context.Context, input dto.UpdateACD
 		return nil, fmt.Errorf("failed to update AC: %w", err)
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventACUpdated, events.EntityTypeAC, ac.ID, ac, &previous)
+
 	return ac, nil
 }
 
@@ -119,17 +141,23 @@ func (s *ACApplicationService) VerifyAC(ctx context.Context, input dto.VerifyACD
 	if err != nil {
 		return fmt.Errorf("AC not found: %w", err)
 	}
+	previous := *ac
 
 	// Update status to verified
 	ac.Status = entities.ACStatusVerified
 	ac.Notes = fmt.Sprintf("Verified by: %s at %s", input.VerifiedBy, input.VerifiedAt)
 	ac.UpdatedAt = time.Now().UTC()
+	if err := guardTransition(ctx, s.guard, events.EventACVerified, events.EntityTypeAC, ac.ID, ac, &previous); err != nil {
+		return err
+	}
 
 	// Persist updates
 	if err := s.acRepo.UpdateAC(ctx, ac); err != nil {
 		return fmt.Errorf("failed to verify AC: %w", err)
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventACVerified, events.EntityTypeAC, ac.ID, ac, &previous)
+
 	return nil
 }
 
@@ -145,17 +173,23 @@ func (s *ACApplicationService) FailAC(ctx context.Context, input dto.FailACDTO)
 	if err != nil {
 		return fmt.Errorf("AC not found: %w", err)
 	}
+	previous := *ac
 
 	// Update status to failed
 	ac.Status = entities.ACStatusFailed
 	ac.Notes = input.Feedback
 	ac.UpdatedAt = time.Now().UTC()
+	if err := guardTransition(ctx, s.guard, events.EventACFailed, events.EntityTypeAC, ac.ID, ac, &previous); err != nil {
+		return err
+	}
 
 	// Persist updates
 	if err := s.acRepo.UpdateAC(ctx, ac); err != nil {
 		return fmt.Errorf("failed to mark AC as failed: %w", err)
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventACFailed, events.EntityTypeAC, ac.ID, ac, &previous)
+
 	return nil
 }
 
@@ -171,25 +205,41 @@ func (s *ACApplicationService) SkipAC(ctx context.Context, input dto.SkipACDTO)
 	if err != nil {
 		return fmt.Errorf("AC not found: %w", err)
 	}
+	previous := *ac
 
 	// Update status to skipped
 	ac.Status = entities.ACStatusSkipped
 	ac.Notes = input.Reason
 	ac.UpdatedAt = time.Now().UTC()
+	if err := guardTransition(ctx, s.guard, events.EventACSkipped, events.EntityTypeAC, ac.ID, ac, &previous); err != nil {
+		return err
+	}
 
 	// Persist updates
 	if err := s.acRepo.UpdateAC(ctx, ac); err != nil {
 		return fmt.Errorf("failed to skip AC: %w", err)
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventACSkipped, events.EntityTypeAC, ac.ID, ac, &previous)
+
 	return nil
 }
 
 // DeleteAC removes an acceptance criterion
 func (s *ACApplicationService) DeleteAC(ctx context.Context, acID string) error {
+	// Capture the AC for the deletion event
+	ac, err := s.acRepo.GetAC(ctx, acID)
+	if err != nil {
+		return fmt.Errorf("failed to delete AC: %w", err)
+	}
+
 	if err := s.acRepo.DeleteAC(ctx, acID); err != nil {
 		return fmt.Errorf("failed to delete AC: %w", err)
 	}
+
+	if ac != nil {
+		publishEvent(ctx, s.eventBus, events.EventACDeleted, events.EntityTypeAC, ac.ID, ac, ac)
+	}
 	return nil
 }
 
@@ -20,7 +20,7 @@ func setupACTestService(t *testing.T) (*application.ACApplicationService, contex
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 	validationService := services.NewValidationService()
 
-	service := application.NewACApplicationService(mockACRepo, mockTaskRepo, mockAggregateRepo, validationService)
+	service := application.NewACApplicationService(mockACRepo, mockTaskRepo, mockAggregateRepo, validationService, nil, nil)
 	ctx := context.Background()
 
 	return service, ctx, mockACRepo, mockTaskRepo, mockAggregateRepo
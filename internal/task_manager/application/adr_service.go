@@ -8,6 +8,7 @@ import (
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
 	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
 )
@@ -18,20 +19,24 @@ type ADRApplicationService struct {
 	trackRepo         repositories.TrackRepository
 	aggregateRepo     repositories.AggregateRepository
 	validationService *services.ValidationService
+	eventBus          events.EventBus
 }
 
-// NewADRApplicationService creates a new ADR service
+// NewADRApplicationService creates a new ADR service.
+// eventBus may be nil, in which case no domain events are published.
 func NewADRApplicationService(
 	adrRepo repositories.ADRRepository,
 	trackRepo repositories.TrackRepository,
 	aggregateRepo repositories.AggregateRepository,
 	validationService *services.ValidationService,
+	eventBus events.EventBus,
 ) *ADRApplicationService {
 	return &ADRApplicationService{
 		adrRepo:           adrRepo,
 		trackRepo:         trackRepo,
 		aggregateRepo:     aggregateRepo,
 		validationService: validationService,
+		eventBus:          eventBus,
 	}
 }
 
@@ -108,6 +113,8 @@ func (s *ADRApplicationService) CreateADR(ctx context.Context, input dto.CreateA
 		return nil, fmt.Errorf("failed to save ADR: %w", err)
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventADRCreated, events.EntityTypeADR, adr.ID, adr, nil)
+
 	return adr, nil
 }
 
@@ -118,6 +125,7 @@ func (s *ADRApplicationService) UpdateADR(ctx context.Context, input dto.UpdateA
 	if err != nil {
 		return nil, fmt.Errorf("failed to get ADR: %w", err)
 	}
+	previous := *adr
 
 	// Apply updates
 	if input.Title != nil {
@@ -167,6 +175,8 @@ func (s *ADRApplicationService) UpdateADR(ctx context.Context, input dto.UpdateA
 		return nil, fmt.Errorf("failed to update ADR: %w", err)
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventADRUpdated, events.EntityTypeADR, adr.ID, adr, &previous)
+
 	return adr, nil
 }
 
@@ -177,6 +187,7 @@ func (s *ADRApplicationService) SupersedeADR(ctx context.Context, adrID, superse
 	if err != nil {
 		return fmt.Errorf("ADR not found: %w", err)
 	}
+	previous := *adr
 
 	_, err = s.adrRepo.GetADR(ctx, supersededByID)
 	if err != nil {
@@ -193,6 +204,8 @@ func (s *ADRApplicationService) SupersedeADR(ctx context.Context, adrID, superse
 		return fmt.Errorf("failed to supersede ADR: %w", err)
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventADRSuperseded, events.EntityTypeADR, adr.ID, adr, &previous)
+
 	return nil
 }
 
@@ -203,6 +216,7 @@ func (s *ADRApplicationService) DeprecateADR(ctx context.Context, adrID string)
 	if err != nil {
 		return fmt.Errorf("ADR not found: %w", err)
 	}
+	previous := *adr
 
 	// Update status
 	adr.Status = string(entities.ADRStatusDeprecated)
@@ -213,6 +227,8 @@ func (s *ADRApplicationService) DeprecateADR(ctx context.Context, adrID string)
 		return fmt.Errorf("failed to deprecate ADR: %w", err)
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventADRDeprecated, events.EntityTypeADR, adr.ID, adr, &previous)
+
 	return nil
 }
 
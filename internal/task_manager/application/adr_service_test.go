@@ -20,7 +20,7 @@ func setupADRTestService(t *testing.T) (*application.ADRApplicationService, cont
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 	validationService := services.NewValidationService()
 
-	service := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService)
+	service := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService, nil)
 	ctx := context.Background()
 
 	return service, ctx, mockADRRepo, mockTrackRepo, mockAggregateRepo
@@ -0,0 +1,97 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+)
+
+// cascadedDeletion is an entity the database removes together with its parent (ON DELETE CASCADE).
+type cascadedDeletion struct {
+	eventType  string
+	entityType string
+	entityID   string
+	entity     interface{}
+}
+
+// taskCascade lists the acceptance criteria deleted together with a task.
+func taskCascade(ctx context.Context, acRepo repositories.AcceptanceCriteriaRepository, taskID string) ([]cascadedDeletion, error) {
+	acs, err := acRepo.ListAC(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list acceptance criteria of task %s: %w", taskID, err)
+	}
+	deletions := make([]cascadedDeletion, 0, len(acs))
+	for _, ac := range acs {
+		deletions = append(deletions, cascadedDeletion{events.EventACDeleted, events.EntityTypeAC, ac.ID, ac})
+	}
+	return deletions, nil
+}
+
+// trackCascade lists the tasks (with their acceptance criteria), ADRs and documents
+// deleted together with a track.
+func trackCascade(
+	ctx context.Context,
+	taskRepo repositories.TaskRepository,
+	acRepo repositories.AcceptanceCriteriaRepository,
+	adrRepo repositories.ADRRepository,
+	documentRepo repositories.DocumentRepository,
+	trackID string,
+) ([]cascadedDeletion, error) {
+	var deletions []cascadedDeletion
+
+	tasks, err := taskRepo.ListTasks(ctx, entities.TaskFilters{TrackID: trackID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks of track %s: %w", trackID, err)
+	}
+	for _, task := range tasks {
+		acs, err := taskCascade(ctx, acRepo, task.ID)
+		if err != nil {
+			return nil, err
+		}
+		deletions = append(deletions, acs...)
+		deletions = append(deletions, cascadedDeletion{events.EventTaskDeleted, events.EntityTypeTask, task.ID, task})
+	}
+
+	adrs, err := adrRepo.GetADRsByTrack(ctx, trackID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ADRs of track %s: %w", trackID, err)
+	}
+	for _, adr := range adrs {
+		deletions = append(deletions, cascadedDeletion{events.EventADRDeleted, events.EntityTypeADR, adr.ID, adr})
+	}
+
+	docs, err := documentRepo.FindDocumentsByTrack(ctx, trackID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents of track %s: %w", trackID, err)
+	}
+	for _, doc := range docs {
+		deletions = append(deletions, cascadedDeletion{events.EventDocumentDeleted, events.EntityTypeDocument, doc.ID, doc})
+	}
+
+	return deletions, nil
+}
+
+// iterationCascade lists the documents deleted together with an iteration.
+// Its tasks are only unlinked, not deleted.
+func iterationCascade(ctx context.Context, documentRepo repositories.DocumentRepository, number int) ([]cascadedDeletion, error) {
+	docs, err := documentRepo.FindDocumentsByIteration(ctx, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents of iteration %d: %w", number, err)
+	}
+	deletions := make([]cascadedDeletion, 0, len(docs))
+	for _, doc := range docs {
+		deletions = append(deletions, cascadedDeletion{events.EventDocumentDeleted, events.EntityTypeDocument, doc.ID, doc})
+	}
+	return deletions, nil
+}
+
+// publishCascade announces the deletion of every cascaded entity, so the history records
+// them and hooks see them like entities deleted on their own.
+func publishCascade(ctx context.Context, bus events.EventBus, deletions []cascadedDeletion) {
+	for _, d := range deletions {
+		publishEvent(ctx, bus, d.eventType, d.entityType, d.entityID, d.entity, d.entity)
+	}
+}
@@ -0,0 +1,172 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// DoctorApplicationService checks a project for inconsistencies and repairs the safe ones.
+// Storage-level problems come from the integrity repository; dependency cycles and
+// competing current iterations are found on the loaded entities and never repaired.
+type DoctorApplicationService struct {
+	integrityRepo     repositories.IntegrityRepository
+	roadmapRepo       repositories.RoadmapRepository
+	trackRepo         repositories.TrackRepository
+	taskRepo          repositories.TaskRepository
+	iterationRepo     repositories.IterationRepository
+	dependencyService *services.DependencyService
+}
+
+// NewDoctorApplicationService creates a new doctor application service.
+func NewDoctorApplicationService(
+	integrityRepo repositories.IntegrityRepository,
+	roadmapRepo repositories.RoadmapRepository,
+	trackRepo repositories.TrackRepository,
+	taskRepo repositories.TaskRepository,
+	iterationRepo repositories.IterationRepository,
+	dependencyService *services.DependencyService,
+) *DoctorApplicationService {
+	return &DoctorApplicationService{
+		integrityRepo:     integrityRepo,
+		roadmapRepo:       roadmapRepo,
+		trackRepo:         trackRepo,
+		taskRepo:          taskRepo,
+		iterationRepo:     iterationRepo,
+		dependencyService: dependencyService,
+	}
+}
+
+// Diagnose checks the project and returns every problem found, ordered by check.
+// With fix set, the safe repairs are applied in a single transaction before the
+// remaining checks run; repaired issues are marked Fixed.
+func (s *DoctorApplicationService) Diagnose(ctx context.Context, fix bool) (*entities.IntegrityReport, error) {
+	issues, err := s.integrityRepo.CheckIntegrity(ctx, fix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check storage: %w", err)
+	}
+
+	current, err := s.checkCurrentIterations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	issues = append(issues, current...)
+
+	cycles, err := s.checkDependencyCycles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	issues = append(issues, cycles...)
+
+	order := make(map[string]int)
+	for i, check := range entities.IntegrityChecks() {
+		order[check] = i
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		return order[issues[i].Check] < order[issues[j].Check]
+	})
+
+	if issues == nil {
+		issues = []entities.IntegrityIssue{}
+	}
+	return &entities.IntegrityReport{Issues: issues}, nil
+}
+
+// checkCurrentIterations reports more than one iteration marked current.
+// Which one the team is working on is for a human to decide.
+func (s *DoctorApplicationService) checkCurrentIterations(ctx context.Context) ([]entities.IntegrityIssue, error) {
+	iterations, err := s.iterationRepo.ListIterations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list iterations: %w", err)
+	}
+
+	var current []string
+	for _, iteration := range iterations {
+		if iteration.Status == string(entities.IterationStatusCurrent) {
+			current = append(current, strconv.Itoa(iteration.Number))
+		}
+	}
+	if len(current) < 2 {
+		return nil, nil
+	}
+	return []entities.IntegrityIssue{{
+		Check:      entities.IntegrityCheckCurrentIterations,
+		EntityType: "iteration",
+		EntityID:   strings.Join(current, ", "),
+		Message: fmt.Sprintf("iterations %s are all current; complete or revert all but one",
+			strings.Join(current, ", ")),
+	}}, nil
+}
+
+// checkDependencyCycles reports tracks and tasks that transitively depend on themselves.
+// Which dependency is wrong is for a human to decide.
+func (s *DoctorApplicationService) checkDependencyCycles(ctx context.Context) ([]entities.IntegrityIssue, error) {
+	issues := []entities.IntegrityIssue{}
+
+	roadmap, err := s.roadmapRepo.GetActiveRoadmap(ctx)
+	if err != nil && !errors.Is(err, tmerrors.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+	if roadmap != nil {
+		tracks, err := s.trackRepo.ListTracks(ctx, roadmap.ID, entities.TrackFilters{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tracks: %w", err)
+		}
+		trackDeps := make(map[string][]string, len(tracks))
+		for _, track := range tracks {
+			trackDeps[track.ID] = track.Dependencies
+		}
+		found, err := s.findCycles(ctx, "track", trackDeps)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, found...)
+	}
+
+	tasks, err := s.taskRepo.ListTasks(ctx, entities.TaskFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	taskDeps := make(map[string][]string, len(tasks))
+	for _, task := range tasks {
+		taskDeps[task.ID] = task.BlockedBy
+	}
+	found, err := s.findCycles(ctx, "task", taskDeps)
+	if err != nil {
+		return nil, err
+	}
+	return append(issues, found...), nil
+}
+
+// findCycles reports the cycles in a dependency graph of one entity type
+func (s *DoctorApplicationService) findCycles(ctx context.Context, entityType string, deps map[string][]string) ([]entities.IntegrityIssue, error) {
+	ids := make([]string, 0, len(deps))
+	for id := range deps {
+		ids = append(ids, id)
+	}
+	cycles, err := s.dependencyService.FindCycles(ctx, ids, func(ctx context.Context, id string) ([]string, error) {
+		return deps[id], nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s dependency cycles: %w", entityType, err)
+	}
+
+	issues := make([]entities.IntegrityIssue, 0, len(cycles))
+	for _, cycle := range cycles {
+		issues = append(issues, entities.IntegrityIssue{
+			Check:      entities.IntegrityCheckDependencyCycles,
+			EntityType: entityType,
+			EntityID:   cycle[0],
+			Message:    fmt.Sprintf("%s dependency cycle: %s → %s", entityType, strings.Join(cycle, " → "), cycle[0]),
+		})
+	}
+	return issues, nil
+}
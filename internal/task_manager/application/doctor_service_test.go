@@ -0,0 +1,87 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// TestDoctorService_Diagnose verifies storage issues and entity-level checks are combined in check order
+func TestDoctorService_Diagnose(t *testing.T) {
+	var repaired bool
+	integrityRepo := &mocks.MockIntegrityRepository{
+		CheckIntegrityFunc: func(ctx context.Context, repair bool) ([]entities.IntegrityIssue, error) {
+			repaired = repair
+			return []entities.IntegrityIssue{
+				{Check: entities.IntegrityCheckSequences, EntityID: "entity_events", Repair: "advance", Fixed: repair},
+				{Check: entities.IntegrityCheckOrphans, EntityID: "1", Repair: "remove", Fixed: repair},
+			}, nil
+		},
+	}
+
+	roadmapRepo := mocks.NewMockRoadmapRepository()
+	roadmapRepo.GetActiveRoadmapFunc = func(ctx context.Context) (*entities.RoadmapEntity, error) {
+		return &entities.RoadmapEntity{ID: "roadmap-1"}, nil
+	}
+	trackRepo := mocks.NewMockTrackRepository()
+	trackRepo.ListTracksFunc = func(ctx context.Context, roadmapID string, filters entities.TrackFilters) ([]*entities.TrackEntity, error) {
+		return []*entities.TrackEntity{
+			{ID: "TM-track-1", Dependencies: []string{"TM-track-2"}},
+			{ID: "TM-track-2", Dependencies: []string{"TM-track-1"}},
+		}, nil
+	}
+	taskRepo := mocks.NewMockTaskRepository()
+	taskRepo.ListTasksFunc = func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+		return []*entities.TaskEntity{
+			{ID: "TM-task-1", BlockedBy: []string{"TM-task-2"}},
+			{ID: "TM-task-2"},
+		}, nil
+	}
+	iterationRepo := mocks.NewMockIterationRepository()
+	iterationRepo.ListIterationsFunc = func(ctx context.Context) ([]*entities.IterationEntity, error) {
+		return []*entities.IterationEntity{
+			{Number: 1, Status: string(entities.IterationStatusComplete)},
+			{Number: 2, Status: string(entities.IterationStatusCurrent)},
+			{Number: 3, Status: string(entities.IterationStatusCurrent)},
+		}, nil
+	}
+
+	service := application.NewDoctorApplicationService(integrityRepo, roadmapRepo, trackRepo, taskRepo, iterationRepo, services.NewDependencyService())
+
+	report, err := service.Diagnose(context.Background(), true)
+	if err != nil {
+		t.Fatalf("Diagnose failed: %v", err)
+	}
+	if !repaired {
+		t.Error("expected repairs to be requested from the repository")
+	}
+
+	var checks []string
+	for _, issue := range report.Issues {
+		checks = append(checks, issue.Check+" "+issue.EntityID)
+	}
+	want := []string{
+		"orphans 1",
+		"current_iterations 2, 3",
+		"dependency_cycles TM-track-1",
+		"sequences entity_events",
+	}
+	if len(checks) != len(want) {
+		t.Fatalf("issues = %v, want %v", checks, want)
+	}
+	for i := range want {
+		if checks[i] != want[i] {
+			t.Errorf("issue %d = %q, want %q", i, checks[i], want[i])
+		}
+	}
+	if got := report.Issues[2].Message; got != "track dependency cycle: TM-track-1 → TM-track-2 → TM-track-1" {
+		t.Errorf("unexpected cycle message: %q", got)
+	}
+	if len(report.Unresolved()) != 2 {
+		t.Errorf("expected the cycle and current iterations to stay unresolved, got %+v", report.Unresolved())
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
 	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
 )
 
@@ -18,18 +19,25 @@ type DocumentApplicationService struct {
 	documentRepo  repositories.DocumentRepository
 	trackRepo     repositories.TrackRepository
 	iterationRepo repositories.IterationRepository
+	eventBus      events.EventBus
+	snapshots     *SnapshotApplicationService
 }
 
-// NewDocumentApplicationService creates a new document application service
+// NewDocumentApplicationService creates a new document application service.
+// snapshots may be nil, in which case no snapshot is taken before a document is deleted.
 func NewDocumentApplicationService(
 	documentRepo repositories.DocumentRepository,
 	trackRepo repositories.TrackRepository,
 	iterationRepo repositories.IterationRepository,
+	eventBus events.EventBus,
+	snapshots *SnapshotApplicationService,
 ) *DocumentApplicationService {
 	return &DocumentApplicationService{
 		documentRepo:  documentRepo,
 		trackRepo:     trackRepo,
 		iterationRepo: iterationRepo,
+		eventBus:      eventBus,
+		snapshots:     snapshots,
 	}
 }
 
@@ -107,6 +115,7 @@ func (s *DocumentApplicationService) CreateDocument(ctx context.Context, input d
 		return "", err
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventDocumentCreated, events.EntityTypeDocument, doc.ID, doc, nil)
 	return id, nil
 }
 
@@ -117,11 +126,15 @@ func (s *DocumentApplicationService) UpdateDocument(ctx context.Context, input d
 	if err != nil {
 		return fmt.Errorf("document not found: %w", err)
 	}
+	if err := checkVersion("document", doc.ID, doc.Version, input.Version); err != nil {
+		return err
+	}
+	previous := *doc
 
 	// Handle detach
 	if input.Detach {
 		doc.Detach()
-		return s.documentRepo.UpdateDocument(ctx, doc)
+		return s.saveUpdate(ctx, doc, &previous)
 	}
 
 	// Update content if provided
@@ -171,11 +184,7 @@ func (s *DocumentApplicationService) UpdateDocument(ctx context.Context, input d
 	}
 
 	// Persist updates
-	if err := s.documentRepo.UpdateDocument(ctx, doc); err != nil {
-		return err
-	}
-
-	return nil
+	return s.saveUpdate(ctx, doc, &previous)
 }
 
 // GetDocument retrieves a document by ID
@@ -195,6 +204,7 @@ func (s *DocumentApplicationService) GetDocument(ctx context.Context, id string)
 		IterationNumber: doc.IterationNumber,
 		CreatedAt:       doc.CreatedAt,
 		UpdatedAt:       doc.UpdatedAt,
+		Version:         doc.Version,
 	}, nil
 }
 
@@ -235,6 +245,7 @@ func (s *DocumentApplicationService) ListDocuments(ctx context.Context, trackID
 			IterationNumber: doc.IterationNumber,
 			CreatedAt:       doc.CreatedAt,
 			UpdatedAt:       doc.UpdatedAt,
+			Version:         doc.Version,
 		}
 	}
 
@@ -253,6 +264,7 @@ func (s *DocumentApplicationService) AttachDocument(ctx context.Context, id stri
 	if trackID != nil && iterationNumber != nil {
 		return fmt.Errorf("%w: document cannot have both TrackID and IterationNumber (choose one or neither)", tmerrors.ErrInvalidArgument)
 	}
+	previous := *doc
 
 	// Attach to track
 	if trackID != nil && *trackID != "" {
@@ -277,11 +289,7 @@ func (s *DocumentApplicationService) AttachDocument(ctx context.Context, id stri
 	}
 
 	// Persist
-	if err := s.documentRepo.UpdateDocument(ctx, doc); err != nil {
-		return err
-	}
-
-	return nil
+	return s.saveUpdate(ctx, doc, &previous)
 }
 
 // DetachDocument removes a document from track or iteration attachment
@@ -292,22 +300,39 @@ func (s *DocumentApplicationService) DetachDocument(ctx context.Context, id stri
 		return fmt.Errorf("document not found: %w", err)
 	}
 
+	previous := *doc
+
 	// Detach
 	doc.Detach()
 
 	// Persist
-	if err := s.documentRepo.UpdateDocument(ctx, doc); err != nil {
-		return err
-	}
-
-	return nil
+	return s.saveUpdate(ctx, doc, &previous)
 }
 
-// DeleteDocument deletes a document
-func (s *DocumentApplicationService) DeleteDocument(ctx context.Context, id string) error {
+// DeleteDocument deletes a document, snapshotting the project first.
+// Returns the snapshot, or nil if automatic snapshots are disabled.
+func (s *DocumentApplicationService) DeleteDocument(ctx context.Context, id string) (*entities.ProjectSnapshot, error) {
+	doc, err := s.documentRepo.FindDocumentByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete document: %w", err)
+	}
+	snapshot, err := snapshotBeforeDelete(ctx, s.snapshots, entities.SnapshotReasonDocumentDelete)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.documentRepo.DeleteDocument(ctx, id); err != nil {
-		return fmt.Errorf("failed to delete document: %w", err)
+		return snapshot, fmt.Errorf("failed to delete document: %w", err)
+	}
+	publishEvent(ctx, s.eventBus, events.EventDocumentDeleted, events.EntityTypeDocument, id, doc, doc)
+	return snapshot, nil
+}
+
+// saveUpdate persists an updated document and announces the change
+func (s *DocumentApplicationService) saveUpdate(ctx context.Context, doc, previous *entities.DocumentEntity) error {
+	if err := s.documentRepo.UpdateDocument(ctx, doc); err != nil {
+		return err
 	}
+	publishEvent(ctx, s.eventBus, events.EventDocumentUpdated, events.EntityTypeDocument, doc.ID, doc, previous)
 	return nil
 }
 
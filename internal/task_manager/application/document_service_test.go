@@ -18,7 +18,7 @@ func setupDocumentTestService(t *testing.T) (*application.DocumentApplicationSer
 	mockTrackRepo := &mocks.MockTrackRepository{}
 	mockIterationRepo := &mocks.MockIterationRepository{}
 
-	service := application.NewDocumentApplicationService(mockDocRepo, mockTrackRepo, mockIterationRepo)
+	service := application.NewDocumentApplicationService(mockDocRepo, mockTrackRepo, mockIterationRepo, nil, nil)
 	ctx := context.Background()
 
 	return service, ctx, mockDocRepo, mockTrackRepo, mockIterationRepo
@@ -663,7 +663,7 @@ func TestDocumentService_DeleteDocument_Success(t *testing.T) {
 		return nil
 	}
 
-	err := service.DeleteDocument(ctx, "TM-doc-123")
+	_, err := service.DeleteDocument(ctx, "TM-doc-123")
 	if err != nil {
 		t.Fatalf("DeleteDocument() failed: %v", err)
 	}
@@ -677,7 +677,7 @@ func TestDocumentService_DeleteDocument_NotFound(t *testing.T) {
 		return tmerrors.ErrNotFound
 	}
 
-	err := service.DeleteDocument(ctx, "TM-doc-nonexistent")
+	_, err := service.DeleteDocument(ctx, "TM-doc-nonexistent")
 	if err == nil {
 		t.Fatal("DeleteDocument() should fail with non-existent document")
 	}
@@ -1,10 +1,13 @@
 package dto
 
+import "time"
+
 // CreateACDTO represents input for creating acceptance criteria
 type CreateACDTO struct {
 	TaskID              string
 	Description         string
 	TestingInstructions string
+	CheckCommand        string // Optional executable check; makes the AC automated
 }
 
 // UpdateACDTO represents input for updating acceptance criteria
@@ -12,6 +15,8 @@ type UpdateACDTO struct {
 	ID                  string
 	Description         *string
 	TestingInstructions *string
+	CheckCommand        *string // Empty string removes the check
+	Version             *int    // Version the change is based on; a newer stored AC is a conflict
 }
 
 // VerifyACDTO represents input for verifying acceptance criteria
@@ -39,3 +44,24 @@ type ACFilters struct {
 	IterationNum *int
 	Status       []string
 }
+
+// RunACChecksDTO selects which acceptance criteria checks to execute.
+// Exactly one of TargetID (an AC or task ID) and IterationNumber is set.
+type RunACChecksDTO struct {
+	TargetID        string
+	IterationNumber *int
+	Timeout         time.Duration // Per-check timeout; zero uses the default
+}
+
+// ACCheckResultDTO reports the outcome of a single executed check
+type ACCheckResultDTO struct {
+	ACID       string `json:"ac_id"`
+	TaskID     string `json:"task_id"`
+	Command    string `json:"command"`
+	Status     string `json:"status"`    // Resulting AC status
+	ExitCode   int    `json:"exit_code"` // -1 if the check timed out
+	TimedOut   bool   `json:"timed_out"`
+	DurationMs int64  `json:"duration_ms"`
+	Output     string `json:"output"`
+	Error      string `json:"error,omitempty"` // Set when the status change was rejected
+}
@@ -20,17 +20,19 @@ type UpdateDocumentDTO struct {
 	TrackID         *string // Optional, new track attachment
 	IterationNumber *int    // Optional, new iteration attachment
 	Detach          bool    // If true, remove all attachments
+	Version         *int    // Optional, version the change is based on; a newer stored document is a conflict
 }
 
 // DocumentViewDTO represents the output representation of a document
 type DocumentViewDTO struct {
-	ID              string    // Document ID (TM-doc-X)
-	Title           string    // Document title
-	Type            string    // Document type (adr, plan, retrospective, other)
-	Status          string    // Document status (draft, published, archived)
-	Content         string    // Markdown content
-	TrackID         *string   // Optional track attachment
-	IterationNumber *int      // Optional iteration attachment
-	CreatedAt       time.Time // Creation timestamp
-	UpdatedAt       time.Time // Last update timestamp
+	ID              string    `json:"id"`               // Document ID (TM-doc-X)
+	Title           string    `json:"title"`            // Document title
+	Type            string    `json:"type"`             // Document type (adr, plan, retrospective, other)
+	Status          string    `json:"status"`           // Document status (draft, published, archived)
+	Content         string    `json:"content"`          // Markdown content
+	TrackID         *string   `json:"track_id"`         // Optional track attachment
+	IterationNumber *int      `json:"iteration_number"` // Optional iteration attachment
+	CreatedAt       time.Time `json:"created_at"`       // Creation timestamp
+	UpdatedAt       time.Time `json:"updated_at"`       // Last update timestamp
+	Version         int       `json:"version"`          // Bumped on every update
 }
@@ -0,0 +1,18 @@
+package dto
+
+// InstallGitHooksDTO represents input for installing the task manager's git hooks
+type InstallGitHooksDTO struct {
+	Command    string // Shell command that runs tm, including any environment it needs
+	MainBranch string // Branch whose merges apply commit trailers; defaults to "main"
+	Force      bool   // Replace hooks that were not installed by tm
+}
+
+// CommitTransitionDTO reports a task status change requested by a commit trailer
+type CommitTransitionDTO struct {
+	TaskID     string `json:"task_id"`
+	Commit     string `json:"commit"`
+	Trailer    string `json:"trailer"`
+	FromStatus string `json:"from_status,omitempty"`
+	ToStatus   string `json:"to_status"`
+	Error      string `json:"error,omitempty"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+// Graph output formats
+const (
+	GraphFormatDOT     = "dot"     // Graphviz DOT
+	GraphFormatMermaid = "mermaid" // Mermaid flowchart
+	GraphFormatASCII   = "ascii"   // Plain-text tree for terminals
+)
+
+// GraphDTO is the input of a graph rendering
+type GraphDTO struct {
+	Format     string // GraphFormatDOT, GraphFormatMermaid or GraphFormatASCII
+	TracksOnly bool   // Leave tasks out of the hierarchy
+}
+
+// RoadmapGraphDTO is the roadmap → track → task hierarchy with the track dependency DAG
+type RoadmapGraphDTO struct {
+	RoadmapID          string           `json:"roadmap_id"`
+	Vision             string           `json:"vision"`
+	Tracks             []*GraphTrackDTO `json:"tracks"`               // Ordered by rank, then ID
+	CriticalPath       []string         `json:"critical_path"`        // Unfinished track IDs, prerequisites first
+	CriticalPathWeight int              `json:"critical_path_weight"` // Open tasks along the critical path; a track without open tasks counts as 1
+	Format             string           `json:"format"`
+	Rendered           string           `json:"rendered"`              // The graph in Format
+	DocumentID         string           `json:"document_id,omitempty"` // Set once the graph is saved as a document
+}
+
+// GraphTrackDTO is a track node of the graph
+type GraphTrackDTO struct {
+	ID           string          `json:"id"`
+	Title        string          `json:"title"`
+	Status       string          `json:"status"`
+	Dependencies []string        `json:"dependencies"` // Tracks that must complete first
+	Critical     bool            `json:"critical"`     // On the critical path
+	Tasks        []*GraphTaskDTO `json:"tasks"`        // Empty when only tracks are graphed
+}
+
+// GraphTaskDTO is a task node of the graph
+type GraphTaskDTO struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+}
@@ -7,6 +7,7 @@ type CreateIterationDTO struct {
 	Goal        string
 	Deliverable string
 	Status      string
+	Capacity    *float64 // Estimate total the iteration can take on (optional)
 }
 
 // UpdateIterationDTO represents input for updating an iteration
@@ -15,6 +16,9 @@ type UpdateIterationDTO struct {
 	Name        *string
 	Goal        *string
 	Deliverable *string
+	Capacity    *float64 // Zero clears the capacity
+	Rank        *float64 // Fractional values place an iteration between two others
+	Version     *int     // Version the change is based on; a newer stored iteration is a conflict
 }
 
 // IterationFilters represents filters for listing iterations
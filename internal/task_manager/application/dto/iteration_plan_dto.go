@@ -0,0 +1,38 @@
+package dto
+
+// PlanIterationDTO is the input of an iteration scope proposal
+type PlanIterationDTO struct {
+	Number   int  // Iteration to plan
+	Capacity int  // Total number of tasks the iteration can hold, including the tasks already in it
+	Apply    bool // Add the proposed tasks to the iteration
+}
+
+// IterationPlanDTO is a proposed iteration scope, drawn from the backlog
+type IterationPlanDTO struct {
+	IterationNumber int                     `json:"iteration_number"`
+	IterationName   string                  `json:"iteration_name"`
+	Capacity        int                     `json:"capacity"`
+	Existing        []*IterationPlanTaskDTO `json:"existing"`    // Tasks already in the iteration
+	Proposed        []*IterationPlanTaskDTO `json:"proposed"`    // Backlog tasks to add, in pick order
+	Skipped         []*IterationPlanSkipDTO `json:"skipped"`     // Backlog tasks that cannot be picked
+	Unscheduled     int                     `json:"unscheduled"` // Eligible backlog tasks that did not fit
+	Remaining       int                     `json:"remaining"`   // Capacity left once the proposal is added
+	Applied         bool                    `json:"applied"`     // The proposed tasks were added to the iteration
+}
+
+// IterationPlanTaskDTO is a task of an iteration plan
+type IterationPlanTaskDTO struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Status  string `json:"status"`
+	Rank    int    `json:"rank"`
+	TrackID string `json:"track_id"`
+}
+
+// IterationPlanSkipDTO is a backlog task left out of an iteration plan, with the reason
+type IterationPlanSkipDTO struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	TrackID string `json:"track_id"`
+	Reason  string `json:"reason"`
+}
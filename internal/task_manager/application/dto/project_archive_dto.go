@@ -0,0 +1,189 @@
+package dto
+
+import "time"
+
+// ProjectArchiveFormat identifies a project archive document
+const ProjectArchiveFormat = "tm-project-archive"
+
+// ProjectArchiveVersion is the archive structure written by export.
+// Bump it whenever a section or field is renamed or removed.
+const ProjectArchiveVersion = 1
+
+// ProjectArchiveDTO is a self-describing export of a whole project.
+// Sections mirror the project database tables, so links between entities are listed separately.
+type ProjectArchiveDTO struct {
+	Format             string                         `json:"format"`  // Always ProjectArchiveFormat
+	Version            int                            `json:"version"` // ProjectArchiveVersion at export time
+	ExportedAt         time.Time                      `json:"exported_at"`
+	Project            ArchiveProjectDTO              `json:"project"`
+	Roadmap            *ArchiveRoadmapDTO             `json:"roadmap"` // Nil for projects without a roadmap
+	Tracks             []ArchiveTrackDTO              `json:"tracks"`
+	TrackDependencies  []ArchiveTrackDependencyDTO    `json:"track_dependencies"`
+	Tasks              []ArchiveTaskDTO               `json:"tasks"`
+	TaskDependencies   []ArchiveTaskDependencyDTO     `json:"task_dependencies"`
+	Iterations         []ArchiveIterationDTO          `json:"iterations"`
+	IterationTasks     []ArchiveIterationTaskDTO      `json:"iteration_tasks"` // In iteration order
+	AcceptanceCriteria []ArchiveAcceptanceCriteriaDTO `json:"acceptance_criteria"`
+	ADRs               []ArchiveADRDTO                `json:"adrs"`
+	Documents          []ArchiveDocumentDTO           `json:"documents"`
+	TaskStatusHistory  []ArchiveTaskStatusChangeDTO   `json:"task_status_history"` // Oldest first
+}
+
+// ArchiveProjectDTO identifies the exported project
+type ArchiveProjectDTO struct {
+	Name string `json:"name"`
+	Code string `json:"code"` // Prefix of the exported entity IDs
+}
+
+// ArchiveRoadmapDTO is an exported roadmap
+type ArchiveRoadmapDTO struct {
+	ID              string    `json:"id"`
+	Vision          string    `json:"vision"`
+	SuccessCriteria string    `json:"success_criteria"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// ArchiveTrackDTO is an exported track
+type ArchiveTrackDTO struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Status      string    `json:"status"`
+	Rank        int       `json:"rank"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ArchiveTrackDependencyDTO records that a track depends on another track
+type ArchiveTrackDependencyDTO struct {
+	TrackID     string `json:"track_id"`
+	DependsOnID string `json:"depends_on_id"`
+}
+
+// ArchiveTaskDTO is an exported task
+type ArchiveTaskDTO struct {
+	ID          string    `json:"id"`
+	TrackID     string    `json:"track_id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Status      string    `json:"status"`
+	Rank        int       `json:"rank"`
+	Branch      string    `json:"branch"`
+	Estimate    *float64  `json:"estimate,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ArchiveTaskDependencyDTO records that a task is blocked by another task
+type ArchiveTaskDependencyDTO struct {
+	TaskID      string `json:"task_id"`
+	BlockedByID string `json:"blocked_by_id"`
+}
+
+// ArchiveIterationDTO is an exported iteration
+type ArchiveIterationDTO struct {
+	Number      int        `json:"number"`
+	Name        string     `json:"name"`
+	Goal        string     `json:"goal"`
+	Deliverable string     `json:"deliverable"`
+	Status      string     `json:"status"`
+	Rank        float64    `json:"rank"`
+	Capacity    *float64   `json:"capacity,omitempty"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ArchiveIterationTaskDTO records that a task belongs to an iteration
+type ArchiveIterationTaskDTO struct {
+	IterationNumber int    `json:"iteration_number"`
+	TaskID          string `json:"task_id"`
+}
+
+// ArchiveAcceptanceCriteriaDTO is an exported acceptance criterion
+type ArchiveAcceptanceCriteriaDTO struct {
+	ID                  string    `json:"id"`
+	TaskID              string    `json:"task_id"`
+	Description         string    `json:"description"`
+	VerificationType    string    `json:"verification_type"`
+	Status              string    `json:"status"`
+	Notes               string    `json:"notes"`
+	TestingInstructions string    `json:"testing_instructions"`
+	CheckCommand        string    `json:"check_command"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// ArchiveADRDTO is an exported architecture decision record
+type ArchiveADRDTO struct {
+	ID           string    `json:"id"`
+	TrackID      string    `json:"track_id"`
+	Title        string    `json:"title"`
+	Status       string    `json:"status"`
+	Context      string    `json:"context"`
+	Decision     string    `json:"decision"`
+	Consequences string    `json:"consequences"`
+	Alternatives string    `json:"alternatives"`
+	SupersededBy *string   `json:"superseded_by"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ArchiveDocumentDTO is an exported document
+type ArchiveDocumentDTO struct {
+	ID              string    `json:"id"`
+	Title           string    `json:"title"`
+	Type            string    `json:"type"`
+	Status          string    `json:"status"`
+	Content         string    `json:"content"`
+	TrackID         *string   `json:"track_id"`
+	IterationNumber *int      `json:"iteration_number"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// ArchiveTaskStatusChangeDTO is an exported task status transition
+type ArchiveTaskStatusChangeDTO struct {
+	TaskID     string    `json:"task_id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ChangedAt  time.Time `json:"changed_at"`
+}
+
+// ImportProjectDTO represents input for importing a project archive
+type ImportProjectDTO struct {
+	Archive     *ProjectArchiveDTO
+	ProjectName string // Name of the new project; empty uses the archived name
+	ProjectCode string // Code to remap entity IDs to; empty keeps the archived code
+	DryRun      bool   // Validate the archive without creating the project
+
+	// AllowCheckCommands imports acceptance criteria that carry a check command.
+	// Without it such an import is rejected: tm ac run runs the command in a shell.
+	AllowCheckCommands bool
+}
+
+// ProjectImportResultDTO reports the outcome of an import
+type ProjectImportResultDTO struct {
+	ProjectName        string `json:"project_name"`
+	SourceCode         string `json:"source_code"` // Code of the archived IDs
+	ProjectCode        string `json:"project_code"`
+	Remapped           bool   `json:"remapped"` // Entity IDs were rewritten to ProjectCode
+	DryRun             bool   `json:"dry_run"`  // Nothing was written
+	Tracks             int    `json:"tracks"`
+	Tasks              int    `json:"tasks"`
+	Iterations         int    `json:"iterations"`
+	AcceptanceCriteria int    `json:"acceptance_criteria"`
+	ADRs               int    `json:"adrs"`
+	Documents          int    `json:"documents"`
+
+	// CheckCommands lists the check commands the archive sets, by imported AC ID
+	CheckCommands []ImportedCheckCommandDTO `json:"check_commands,omitempty"`
+}
+
+// ImportedCheckCommandDTO is a check command an imported acceptance criterion carries
+type ImportedCheckCommandDTO struct {
+	ACID    string `json:"ac_id"`
+	Command string `json:"command"`
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// IterationReportDTO is the data behind an iteration retrospective report
+type IterationReportDTO struct {
+	Iteration     *entities.IterationEntity `json:"iteration"`
+	GeneratedAt   time.Time                 `json:"generated_at"`
+	Tasks         []*ReportTaskDTO          `json:"tasks"`           // Ordered by status, then ID
+	TasksByStatus map[string]int            `json:"tasks_by_status"` // Task count per final status
+	Scope         ReportScopeDTO            `json:"scope"`
+	ACStats       ReportACStatsDTO          `json:"ac_stats"`
+	FailedACs     []*ReportACFailureDTO     `json:"failed_acs"`
+	ADRs          []*ReportLinkDTO          `json:"adrs"`                  // ADRs of the tracks the iteration's tasks belong to
+	Documents     []*ReportLinkDTO          `json:"documents"`             // Documents attached to the iteration
+	Markdown      string                    `json:"markdown"`              // The rendered report
+	DocumentID    string                    `json:"document_id,omitempty"` // Set once the report is saved as a document
+}
+
+// ReportTaskDTO is a task as it ended the iteration
+type ReportTaskDTO struct {
+	ID            string     `json:"id"`
+	Title         string     `json:"title"`
+	Status        string     `json:"status"`
+	CreatedAt     time.Time  `json:"created_at"`
+	DoneAt        *time.Time `json:"done_at,omitempty"`         // When the task last moved to done
+	LeadTimeHours *float64   `json:"lead_time_hours,omitempty"` // CreatedAt to DoneAt; only set for done tasks
+}
+
+// ReportScopeDTO compares the scope an iteration started with to what it delivered
+type ReportScopeDTO struct {
+	Planned   []string `json:"planned"`   // Tasks in the iteration when it started
+	Added     []string `json:"added"`     // Tasks added after the start
+	Removed   []string `json:"removed"`   // Tasks removed after the start
+	Delivered []string `json:"delivered"` // Tasks done at report time
+	Missing   []string `json:"missing"`   // Task IDs the iteration references that no longer exist
+}
+
+// ReportACStatsDTO counts the iteration's acceptance criteria by verification status
+type ReportACStatsDTO struct {
+	Total                 int `json:"total"`
+	Verified              int `json:"verified"`
+	AutomaticallyVerified int `json:"automatically_verified"`
+	PendingReview         int `json:"pending_review"`
+	NotStarted            int `json:"not_started"`
+	Failed                int `json:"failed"`
+	Skipped               int `json:"skipped"`
+}
+
+// ReportACFailureDTO is a failed acceptance criterion with its feedback
+type ReportACFailureDTO struct {
+	ID          string `json:"id"`
+	TaskID      string `json:"task_id"`
+	Description string `json:"description"`
+	Notes       string `json:"notes"` // Feedback recorded when the AC failed
+}
+
+// ReportLinkDTO is an ADR or document referenced by a report
+type ReportLinkDTO struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+	Type   string `json:"type,omitempty"` // Document type; empty for ADRs
+}
@@ -0,0 +1,59 @@
+package dto
+
+// Sync conflict resolution preferences
+const (
+	SyncPreferNone     = ""         // Leave conflicts unresolved
+	SyncPreferFiles    = "files"    // Resolve conflicts with the file version
+	SyncPreferDatabase = "database" // Resolve conflicts with the database version
+)
+
+// Sync change actions
+const (
+	SyncActionImport   = "import"   // Apply the file to the database
+	SyncActionExport   = "export"   // Apply the database to the file
+	SyncActionConflict = "conflict" // Both sides changed; nothing is applied
+)
+
+// Sync change operations
+const (
+	SyncOperationCreate = "create"
+	SyncOperationUpdate = "update"
+	SyncOperationDelete = "delete"
+)
+
+// SyncDTO is the input of a sync run
+type SyncDTO struct {
+	Directory string // Absolute path of the sync directory
+	Import    bool   // Apply file changes to the database
+	Export    bool   // Apply database changes to the files
+	Prefer    string // SyncPreferFiles or SyncPreferDatabase resolve conflicts; empty leaves them
+	DryRun    bool   // Plan only
+
+	// AllowCheckCommands imports acceptance criteria whose file sets a new check command.
+	// Without it such an import is rejected: tm ac run runs the command in a shell.
+	AllowCheckCommands bool
+}
+
+// SyncChangeDTO is one entity whose file and database row differ
+type SyncChangeDTO struct {
+	EntityType string `json:"entity_type"`
+	EntityID   string `json:"entity_id"`
+	Path       string `json:"path"`      // Relative to the sync directory
+	Action     string `json:"action"`    // import, export or conflict
+	Operation  string `json:"operation"` // create, update or delete on the receiving side; empty for conflicts
+	Reason     string `json:"reason"`
+	Applied    bool   `json:"applied"`
+
+	// CheckCommand is the check command an imported acceptance criterion sets or changes to
+	CheckCommand string `json:"check_command,omitempty"`
+}
+
+// SyncReportDTO is the outcome of a sync run
+type SyncReportDTO struct {
+	Directory string           `json:"directory"`
+	DryRun    bool             `json:"dry_run"`
+	Changes   []*SyncChangeDTO `json:"changes"` // Ordered by path
+	Unchanged int              `json:"unchanged"`
+	Conflicts int              `json:"conflicts"`          // Conflicts left unresolved
+	Snapshot  string           `json:"snapshot,omitempty"` // Snapshot taken before the import deleted entities
+}
@@ -7,6 +7,8 @@ type CreateTaskDTO struct {
 	Description string
 	Status      string
 	Rank        int
+	Branch      string   // Git branch name (optional)
+	Estimate    *float64 // Size in story points or ideal hours (optional)
 }
 
 // UpdateTaskDTO represents input for updating a task
@@ -17,6 +19,9 @@ type UpdateTaskDTO struct {
 	Status      *string
 	Rank        *int
 	TrackID     *string
+	Branch      *string  // Empty string unlinks the branch
+	Estimate    *float64 // Zero clears the estimate
+	Version     *int     // Version the change is based on; a newer stored task is a conflict
 }
 
 // TaskListFilters represents filters for listing tasks
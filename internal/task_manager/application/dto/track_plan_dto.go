@@ -0,0 +1,41 @@
+package dto
+
+// TrackPlanDTO is the order in which the roadmap's unfinished tracks can be worked on
+type TrackPlanDTO struct {
+	RoadmapID   string                      `json:"roadmap_id"`
+	Waves       []*TrackPlanWaveDTO         `json:"waves"`       // Each wave only depends on earlier waves
+	Completed   []string                    `json:"completed"`   // Complete track IDs, left out of the waves
+	Violations  []*TrackViolationDTO        `json:"violations"`  // Tracks in progress ahead of their dependencies
+	Suggestions []*TrackStatusSuggestionDTO `json:"suggestions"` // Status corrections implied by the dependencies
+	Applied     bool                        `json:"applied"`     // The suggestions were applied to the tracks
+}
+
+// TrackPlanWaveDTO is a group of tracks that can be worked on in parallel
+type TrackPlanWaveDTO struct {
+	Number int                 `json:"number"` // 1-based
+	Tracks []*TrackPlanItemDTO `json:"tracks"` // Ordered by rank, then ID
+}
+
+// TrackPlanItemDTO is a track scheduled in a wave
+type TrackPlanItemDTO struct {
+	ID               string   `json:"id"`
+	Title            string   `json:"title"`
+	Status           string   `json:"status"`
+	Rank             int      `json:"rank"`
+	OpenDependencies []string `json:"open_dependencies"` // Dependencies that are not complete yet
+}
+
+// TrackViolationDTO is a track that is in progress while a dependency is not complete
+type TrackViolationDTO struct {
+	TrackID                string   `json:"track_id"`
+	Status                 string   `json:"status"`
+	IncompleteDependencies []string `json:"incomplete_dependencies"`
+}
+
+// TrackStatusSuggestionDTO is a proposed status correction for a track
+type TrackStatusSuggestionDTO struct {
+	TrackID string `json:"track_id"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Reason  string `json:"reason"`
+}
@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+)
+
+// publishEvent announces a domain event after a successful mutation.
+// Services constructed without an event bus skip publishing entirely.
+func publishEvent(ctx context.Context, bus events.EventBus, eventType, entityType, entityID string, payload, previous interface{}) {
+	if bus == nil {
+		return
+	}
+	bus.Publish(ctx, events.NewEvent(eventType, entityType, entityID, payload, previous))
+}
+
+// publishDerivedEvent announces a specific kind of change of a mutation already announced
+// by publishEvent, such as a status change. Subscribers recording each mutation once skip it.
+func publishDerivedEvent(ctx context.Context, bus events.EventBus, eventType, entityType, entityID string, payload, previous interface{}) {
+	if bus == nil {
+		return
+	}
+	event := events.NewEvent(eventType, entityType, entityID, payload, previous)
+	event.Derived = true
+	bus.Publish(ctx, event)
+}
+
+// guardTransition lets the configured guard veto a transition before it is persisted.
+// Services constructed without a guard allow every transition.
+func guardTransition(ctx context.Context, guard events.TransitionGuard, eventType, entityType, entityID string, proposed, previous interface{}) error {
+	if guard == nil {
+		return nil
+	}
+	return guard.BeforeTransition(ctx, events.NewEvent(eventType, entityType, entityID, proposed, previous))
+}
+
+// cloneIteration snapshots an iteration so later in-place mutations don't leak into event payloads.
+func cloneIteration(iteration *entities.IterationEntity) *entities.IterationEntity {
+	clone := *iteration
+	clone.TaskIDs = append([]string(nil), iteration.TaskIDs...)
+	return &clone
+}
+
+// cloneTrack snapshots a track so later in-place mutations don't leak into event payloads.
+func cloneTrack(track *entities.TrackEntity) *entities.TrackEntity {
+	clone := *track
+	clone.Dependencies = append([]string(nil), track.Dependencies...)
+	return &clone
+}
@@ -0,0 +1,301 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// recordingEventBus captures published events for assertions
+type recordingEventBus struct {
+	published []events.Event
+}
+
+func (b *recordingEventBus) Publish(ctx context.Context, event events.Event) {
+	b.published = append(b.published, event)
+}
+
+func (b *recordingEventBus) Subscribe(eventType string, handler events.Handler) {}
+
+func (b *recordingEventBus) types() []string {
+	types := make([]string, 0, len(b.published))
+	for _, event := range b.published {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+func assertEventTypes(t *testing.T, bus *recordingEventBus, want ...string) {
+	t.Helper()
+	got := bus.types()
+	if len(got) != len(want) {
+		t.Fatalf("published events = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("published events = %v, want %v", got, want)
+		}
+	}
+}
+
+// ============================================================================
+// Task events
+// ============================================================================
+
+// TestTaskService_PublishesStatusEvents verifies status transitions emit updated, status_changed and completed
+func TestTaskService_PublishesStatusEvents(t *testing.T) {
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "review", 100, "", now, now)
+
+	mockTaskRepo := &mocks.MockTaskRepository{
+		GetTaskFunc: func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+			return task, nil
+		},
+	}
+	bus := &recordingEventBus{}
+	service := application.NewTaskApplicationService(mockTaskRepo, &mocks.MockTrackRepository{}, &mocks.MockAggregateRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, services.NewValidationService(), bus, nil, nil)
+
+	_, err := service.UpdateTask(context.Background(), dto.UpdateTaskDTO{ID: "TM-task-1", Status: dto.StringPtr("done")})
+	if err != nil {
+		t.Fatalf("UpdateTask() failed: %v", err)
+	}
+
+	assertEventTypes(t, bus, events.EventTaskUpdated, events.EventTaskStatusChanged, events.EventTaskCompleted)
+	for i, event := range bus.published {
+		if event.Derived != (i > 0) {
+			t.Errorf("%s: Derived = %v, only the events after task.updated repeat the mutation", event.Type, event.Derived)
+		}
+	}
+
+	event := bus.published[1]
+	if event.EntityType != events.EntityTypeTask || event.EntityID != "TM-task-1" {
+		t.Errorf("unexpected entity on event: %s %s", event.EntityType, event.EntityID)
+	}
+	previous, ok := event.Previous.(*entities.TaskEntity)
+	if !ok || previous.Status != "review" {
+		t.Errorf("Previous should hold the pre-update task, got %#v", event.Previous)
+	}
+	if event.Payload.(*entities.TaskEntity).Status != "done" {
+		t.Errorf("Payload should hold the updated task")
+	}
+}
+
+// TestTaskService_NoEventsOnFailure verifies failed mutations publish nothing
+func TestTaskService_NoEventsOnFailure(t *testing.T) {
+	bus := &recordingEventBus{}
+	service := application.NewTaskApplicationService(&mocks.MockTaskRepository{}, &mocks.MockTrackRepository{}, &mocks.MockAggregateRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, services.NewValidationService(), bus, nil, nil)
+
+	_, err := service.CreateTask(context.Background(), dto.CreateTaskDTO{TrackID: "TM-track-1", Title: "", Rank: 100})
+	if err == nil {
+		t.Fatal("CreateTask() should fail with empty title")
+	}
+
+	assertEventTypes(t, bus)
+}
+
+// ============================================================================
+// Iteration events
+// ============================================================================
+
+// TestIterationService_PublishesLifecycleEvents verifies start and complete emit their events
+func TestIterationService_PublishesLifecycleEvents(t *testing.T) {
+	now := time.Now().UTC()
+	iteration, _ := entities.NewIterationEntity(1, "Iteration", "Goal", "", []string{}, "planned", 100, time.Time{}, time.Time{}, now, now)
+
+	mockIterationRepo := &mocks.MockIterationRepository{
+		GetIterationFunc: func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+			return iteration, nil
+		},
+	}
+	bus := &recordingEventBus{}
+	service := application.NewIterationApplicationService(mockIterationRepo, &mocks.MockTaskRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, &mocks.MockDocumentRepository{}, &mocks.MockAggregateRepository{}, services.NewIterationService(), services.NewValidationService(), nil, bus, nil, nil)
+
+	if err := service.StartIteration(context.Background(), 1); err != nil {
+		t.Fatalf("StartIteration() failed: %v", err)
+	}
+	if err := service.CompleteIteration(context.Background(), 1, false); err != nil {
+		t.Fatalf("CompleteIteration() failed: %v", err)
+	}
+
+	assertEventTypes(t, bus, events.EventIterationStarted, events.EventIterationCompleted)
+	if bus.published[1].EntityID != "1" {
+		t.Errorf("EntityID = %q, want %q", bus.published[1].EntityID, "1")
+	}
+	if bus.published[1].Previous.(*entities.IterationEntity).Status != "current" {
+		t.Errorf("Previous should hold the iteration before completion")
+	}
+}
+
+// ============================================================================
+// AC events
+// ============================================================================
+
+// TestACService_PublishesFailedEvent verifies FailAC emits ac.failed
+func TestACService_PublishesFailedEvent(t *testing.T) {
+	now := time.Now().UTC()
+	ac := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "Works", entities.VerificationTypeManual, "", now, now)
+
+	mockACRepo := &mocks.MockAcceptanceCriteriaRepository{
+		GetACFunc: func(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
+			return ac, nil
+		},
+	}
+	bus := &recordingEventBus{}
+	service := application.NewACApplicationService(mockACRepo, &mocks.MockTaskRepository{}, &mocks.MockAggregateRepository{}, services.NewValidationService(), bus, nil)
+
+	if err := service.FailAC(context.Background(), dto.FailACDTO{ID: "TM-ac-1", Feedback: "Broken"}); err != nil {
+		t.Fatalf("FailAC() failed: %v", err)
+	}
+
+	assertEventTypes(t, bus, events.EventACFailed)
+	if bus.published[0].Payload.(*entities.AcceptanceCriteriaEntity).Notes != "Broken" {
+		t.Errorf("Payload should carry the failure feedback")
+	}
+}
+
+// ============================================================================
+// Track events
+// ============================================================================
+
+// TestTrackService_PublishesCompletedEvent verifies completing a track emits track.completed
+func TestTrackService_PublishesCompletedEvent(t *testing.T) {
+	now := time.Now().UTC()
+	track, _ := entities.NewTrackEntity("TM-track-1", "roadmap-1", "Track", "", "in-progress", 100, []string{}, now, now)
+
+	mockTrackRepo := &mocks.MockTrackRepository{
+		GetTrackFunc: func(ctx context.Context, id string) (*entities.TrackEntity, error) {
+			return track, nil
+		},
+	}
+	bus := &recordingEventBus{}
+	service := application.NewTrackApplicationService(mockTrackRepo, &mocks.MockRoadmapRepository{}, &mocks.MockTaskRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, &mocks.MockADRRepository{}, &mocks.MockDocumentRepository{}, &mocks.MockAggregateRepository{}, services.NewValidationService(), bus, nil)
+
+	if _, err := service.UpdateTrack(context.Background(), dto.UpdateTrackDTO{ID: "TM-track-1", Status: dto.StringPtr("complete")}); err != nil {
+		t.Fatalf("UpdateTrack() failed: %v", err)
+	}
+
+	assertEventTypes(t, bus, events.EventTrackUpdated, events.EventTrackStatusChanged, events.EventTrackCompleted)
+}
+
+// TestTrackService_DeletePublishesCascadedDeletions verifies deleting a track announces the
+// tasks, ACs, ADRs and documents the database deletes with it
+func TestTrackService_DeletePublishesCascadedDeletions(t *testing.T) {
+	now := time.Now().UTC()
+	track, _ := entities.NewTrackEntity("TM-track-1", "roadmap-1", "Track", "", "in-progress", 100, []string{}, now, now)
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "todo", 100, "", now, now)
+	ac := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "AC", entities.VerificationTypeManual, "", now, now)
+	adr, _ := entities.NewADREntity("TM-adr-1", "TM-track-1", "ADR", "accepted", "c", "d", "q", "", now, now, nil)
+	doc := createTestDocument(t, "TM-doc-1", "Plan", "plan", "draft", "Content")
+
+	mockTrackRepo := &mocks.MockTrackRepository{
+		GetTrackFunc: func(ctx context.Context, id string) (*entities.TrackEntity, error) {
+			return track, nil
+		},
+	}
+	mockTaskRepo := &mocks.MockTaskRepository{
+		ListTasksFunc: func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+			if filters.TrackID != "TM-track-1" {
+				t.Errorf("tasks listed for track %q, want TM-track-1", filters.TrackID)
+			}
+			return []*entities.TaskEntity{task}, nil
+		},
+	}
+	mockACRepo := &mocks.MockAcceptanceCriteriaRepository{
+		ListACFunc: func(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+			return []*entities.AcceptanceCriteriaEntity{ac}, nil
+		},
+	}
+	mockADRRepo := &mocks.MockADRRepository{
+		GetADRsByTrackFunc: func(ctx context.Context, trackID string) ([]*entities.ADREntity, error) {
+			return []*entities.ADREntity{adr}, nil
+		},
+	}
+	mockDocRepo := &mocks.MockDocumentRepository{
+		FindDocumentsByTrackFunc: func(ctx context.Context, trackID string) ([]*entities.DocumentEntity, error) {
+			return []*entities.DocumentEntity{doc}, nil
+		},
+	}
+	bus := &recordingEventBus{}
+	service := application.NewTrackApplicationService(mockTrackRepo, &mocks.MockRoadmapRepository{}, mockTaskRepo, mockACRepo, mockADRRepo, mockDocRepo, &mocks.MockAggregateRepository{}, services.NewValidationService(), bus, nil)
+
+	if _, err := service.DeleteTrack(context.Background(), "TM-track-1"); err != nil {
+		t.Fatalf("DeleteTrack() failed: %v", err)
+	}
+
+	assertEventTypes(t, bus, events.EventACDeleted, events.EventTaskDeleted, events.EventADRDeleted, events.EventDocumentDeleted, events.EventTrackDeleted)
+	if bus.published[1].EntityID != "TM-task-1" || bus.published[1].Previous.(*entities.TaskEntity) != task {
+		t.Errorf("task.deleted should carry the deleted task, got %+v", bus.published[1])
+	}
+}
+
+// ============================================================================
+// ADR events
+// ============================================================================
+
+// TestADRService_PublishesSupersededEvent verifies SupersedeADR emits adr.superseded
+func TestADRService_PublishesSupersededEvent(t *testing.T) {
+	now := time.Now().UTC()
+	oldADR, _ := entities.NewADREntity("TM-adr-1", "TM-track-1", "Old", "accepted", "c", "d", "q", "", now, now, nil)
+	newADR, _ := entities.NewADREntity("TM-adr-2", "TM-track-1", "New", "accepted", "c", "d", "q", "", now, now, nil)
+
+	mockADRRepo := &mocks.MockADRRepository{
+		GetADRFunc: func(ctx context.Context, id string) (*entities.ADREntity, error) {
+			if id == oldADR.ID {
+				return oldADR, nil
+			}
+			return newADR, nil
+		},
+	}
+	bus := &recordingEventBus{}
+	service := application.NewADRApplicationService(mockADRRepo, &mocks.MockTrackRepository{}, &mocks.MockAggregateRepository{}, services.NewValidationService(), bus)
+
+	if err := service.SupersedeADR(context.Background(), "TM-adr-1", "TM-adr-2"); err != nil {
+		t.Fatalf("SupersedeADR() failed: %v", err)
+	}
+
+	assertEventTypes(t, bus, events.EventADRSuperseded)
+	if bus.published[0].Previous.(*entities.ADREntity).Status != "accepted" {
+		t.Errorf("Previous should hold the ADR before superseding")
+	}
+}
+
+// ============================================================================
+// Document events
+// ============================================================================
+
+// TestDocumentService_PublishesEvents verifies document creation, updates and deletion emit their events
+func TestDocumentService_PublishesEvents(t *testing.T) {
+	doc := createTestDocument(t, "TM-doc-1", "Plan", "plan", "draft", "Content")
+
+	mockDocRepo := &mocks.MockDocumentRepository{
+		FindDocumentByIDFunc: func(ctx context.Context, id string) (*entities.DocumentEntity, error) {
+			return doc, nil
+		},
+	}
+	bus := &recordingEventBus{}
+	service := application.NewDocumentApplicationService(mockDocRepo, &mocks.MockTrackRepository{}, &mocks.MockIterationRepository{}, bus, nil)
+
+	if _, err := service.CreateDocument(context.Background(), dto.CreateDocumentDTO{Title: "Plan", Type: "plan", Status: "draft", Content: "Content"}); err != nil {
+		t.Fatalf("CreateDocument() failed: %v", err)
+	}
+	content := "Revised"
+	if err := service.UpdateDocument(context.Background(), dto.UpdateDocumentDTO{ID: "TM-doc-1", Content: &content}); err != nil {
+		t.Fatalf("UpdateDocument() failed: %v", err)
+	}
+	if _, err := service.DeleteDocument(context.Background(), "TM-doc-1"); err != nil {
+		t.Fatalf("DeleteDocument() failed: %v", err)
+	}
+
+	assertEventTypes(t, bus, events.EventDocumentCreated, events.EventDocumentUpdated, events.EventDocumentDeleted)
+	previous, ok := bus.published[1].Previous.(*entities.DocumentEntity)
+	if !ok || previous.Content != "Content" {
+		t.Errorf("Previous should hold the document before the update, got %#v", bus.published[1].Previous)
+	}
+}
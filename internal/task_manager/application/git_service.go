@@ -0,0 +1,231 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// DefaultMainBranch is the branch whose merges apply commit trailers when none is configured
+const DefaultMainBranch = "main"
+
+// commitTrailers maps commit trailers to the task status they request, in the order they are applied
+var commitTrailers = []struct {
+	key    string
+	status entities.TaskStatus
+}{
+	{"Review", entities.TaskStatusReview},
+	{"Closes", entities.TaskStatusDone},
+}
+
+// GitApplicationService links tasks to the code repository: task branches, the commits mentioning a task
+// and the git hooks that keep task status in step with commits.
+type GitApplicationService struct {
+	taskRepo         repositories.TaskRepository
+	taskService      *TaskApplicationService
+	iterationService *IterationApplicationService
+	vcs              services.VersionControl
+}
+
+// NewGitApplicationService creates a new git application service.
+// Task changes go through taskService so they are validated, guarded and published like any update.
+func NewGitApplicationService(
+	taskRepo repositories.TaskRepository,
+	taskService *TaskApplicationService,
+	iterationService *IterationApplicationService,
+	vcs services.VersionControl,
+) *GitApplicationService {
+	return &GitApplicationService{
+		taskRepo:         taskRepo,
+		taskService:      taskService,
+		iterationService: iterationService,
+		vcs:              vcs,
+	}
+}
+
+// StartTask checks out the task's branch, creating it from the task ID and title when the task has none,
+// records the branch on the task and moves a todo task to in-progress.
+// It reports whether the branch was newly created.
+func (s *GitApplicationService) StartTask(ctx context.Context, taskID string) (*entities.TaskEntity, bool, error) {
+	task, err := s.taskRepo.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, false, fmt.Errorf("task not found: %w", err)
+	}
+
+	branch := task.Branch
+	if branch == "" {
+		branch = task.DefaultBranchName()
+	}
+
+	created, err := s.vcs.CheckoutBranch(ctx, branch)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to check out branch %s: %w", branch, err)
+	}
+
+	input := dto.UpdateTaskDTO{ID: task.ID, Branch: &branch}
+	if task.Status == string(entities.TaskStatusTodo) {
+		input.Status = dto.StringPtr(string(entities.TaskStatusInProgress))
+	}
+	task, err = s.taskService.UpdateTask(ctx, input)
+	if err != nil {
+		return nil, created, fmt.Errorf("failed to start task: %w", err)
+	}
+	return task, created, nil
+}
+
+// ListTaskCommits returns the commits on local branches that mention the task ID, newest first.
+func (s *GitApplicationService) ListTaskCommits(ctx context.Context, taskID string) ([]entities.Commit, error) {
+	if _, err := s.taskRepo.GetTask(ctx, taskID); err != nil {
+		return nil, fmt.Errorf("task not found: %w", err)
+	}
+
+	candidates, err := s.vcs.FindCommits(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search commits: %w", err)
+	}
+
+	commits := []entities.Commit{}
+	for _, commit := range candidates {
+		if commit.References(taskID) {
+			commits = append(commits, commit)
+		}
+	}
+	return commits, nil
+}
+
+// InstallHooks installs the commit-msg and post-merge hooks and returns their paths.
+// Both hooks call back into tm through input.Command.
+func (s *GitApplicationService) InstallHooks(ctx context.Context, input dto.InstallGitHooksDTO) ([]string, error) {
+	if strings.TrimSpace(input.Command) == "" {
+		return nil, fmt.Errorf("%w: hook command must not be empty", tmerrors.ErrInvalidArgument)
+	}
+	mainBranch := input.MainBranch
+	if mainBranch == "" {
+		mainBranch = DefaultMainBranch
+	}
+	if strings.ContainsAny(mainBranch, "'\n") {
+		return nil, fmt.Errorf("%w: invalid main branch name %q", tmerrors.ErrInvalidArgument, mainBranch)
+	}
+
+	hooks := []struct {
+		name   string
+		script string
+	}{
+		{"commit-msg", fmt.Sprintf("#!/bin/sh\nexec %s git check-commit-msg \"$1\"\n", input.Command)},
+		{"post-merge", fmt.Sprintf("#!/bin/sh\nexec %s git post-merge --main-branch '%s'\n", input.Command, mainBranch)},
+	}
+
+	paths := []string{}
+	for _, hook := range hooks {
+		path, err := s.vcs.InstallHook(ctx, hook.name, hook.script, input.Force)
+		if err != nil {
+			return paths, fmt.Errorf("failed to install %s hook: %w", hook.name, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// CheckCommitMessage verifies that a commit message references a task of the current iteration
+// and returns the first such task ID. Messages git generates itself (merges, reverts, fixups) are accepted.
+// When there is neither a current nor a planned iteration there is nothing to enforce and "" is returned.
+func (s *GitApplicationService) CheckCommitMessage(ctx context.Context, message string) (string, error) {
+	commit := entities.ParseCommitMessage(message)
+	if commit.Subject == "" || isGeneratedCommitMessage(commit.Subject) {
+		return "", nil
+	}
+
+	result, err := s.iterationService.GetCurrentIteration(ctx)
+	if err != nil {
+		return "", err
+	}
+	iteration, ok := result.Iteration.(*entities.IterationEntity)
+	if !ok || iteration == nil {
+		return "", nil
+	}
+
+	for _, id := range commit.TaskIDs() {
+		if iteration.HasTask(id) {
+			return id, nil
+		}
+	}
+
+	if len(iteration.TaskIDs) == 0 {
+		return "", fmt.Errorf("%w: commit message must reference a task of iteration %d, which has no tasks",
+			tmerrors.ErrRejected, iteration.Number)
+	}
+	return "", fmt.Errorf("%w: commit message must reference a task of iteration %d (%s): %s",
+		tmerrors.ErrRejected, iteration.Number, iteration.Name, strings.Join(iteration.TaskIDs, ", "))
+}
+
+// ApplyMergedCommits moves the tasks named in Review: and Closes: trailers of the commits brought in
+// by the last merge, but only when the merge landed on mainBranch.
+// Transitions are applied oldest commit first; one that fails is reported without stopping the rest.
+func (s *GitApplicationService) ApplyMergedCommits(ctx context.Context, mainBranch string) ([]dto.CommitTransitionDTO, error) {
+	if mainBranch == "" {
+		mainBranch = DefaultMainBranch
+	}
+	results := []dto.CommitTransitionDTO{}
+
+	branch, err := s.vcs.CurrentBranch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine current branch: %w", err)
+	}
+	if branch != mainBranch {
+		return results, nil
+	}
+
+	commits, err := s.vcs.MergedCommits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list merged commits: %w", err)
+	}
+
+	for i := len(commits) - 1; i >= 0; i-- {
+		commit := commits[i]
+		for _, trailer := range commitTrailers {
+			for _, taskID := range commit.TrailerTaskIDs(trailer.key) {
+				if result, applied := s.applyTrailer(ctx, commit, trailer.key, taskID, string(trailer.status)); applied {
+					results = append(results, result)
+				}
+			}
+		}
+	}
+	return results, nil
+}
+
+// applyTrailer moves one task to the status requested by a trailer.
+// It reports false when the task is already in that status.
+func (s *GitApplicationService) applyTrailer(ctx context.Context, commit entities.Commit, key, taskID, status string) (dto.CommitTransitionDTO, bool) {
+	result := dto.CommitTransitionDTO{TaskID: taskID, Commit: commit.ShortHash(), Trailer: key, ToStatus: status}
+
+	task, err := s.taskRepo.GetTask(ctx, taskID)
+	if err != nil {
+		result.Error = err.Error()
+		return result, true
+	}
+	if task.Status == status {
+		return result, false
+	}
+	result.FromStatus = task.Status
+
+	if _, err := s.taskService.UpdateTask(ctx, dto.UpdateTaskDTO{ID: taskID, Status: &status}); err != nil {
+		result.Error = err.Error()
+	}
+	return result, true
+}
+
+// isGeneratedCommitMessage reports whether git or a rebase workflow wrote the subject line
+func isGeneratedCommitMessage(subject string) bool {
+	for _, prefix := range []string{"Merge ", "Revert \"", "fixup! ", "squash! ", "amend! "} {
+		if strings.HasPrefix(subject, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,351 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// fakeVersionControl records checkouts and returns canned commits
+type fakeVersionControl struct {
+	existing    map[string]bool
+	checkedOut  []string
+	checkoutErr error
+	commits     []entities.Commit
+	searched    string
+	branch      string
+	merged      []entities.Commit
+	hooks       map[string]string
+}
+
+func (v *fakeVersionControl) CheckoutBranch(ctx context.Context, name string) (bool, error) {
+	if v.checkoutErr != nil {
+		return false, v.checkoutErr
+	}
+	v.checkedOut = append(v.checkedOut, name)
+	return !v.existing[name], nil
+}
+
+func (v *fakeVersionControl) FindCommits(ctx context.Context, text string) ([]entities.Commit, error) {
+	v.searched = text
+	return v.commits, nil
+}
+
+func (v *fakeVersionControl) CurrentBranch(ctx context.Context) (string, error) {
+	return v.branch, nil
+}
+
+func (v *fakeVersionControl) MergedCommits(ctx context.Context) ([]entities.Commit, error) {
+	return v.merged, nil
+}
+
+func (v *fakeVersionControl) InstallHook(ctx context.Context, name, script string, force bool) (string, error) {
+	if v.hooks == nil {
+		v.hooks = map[string]string{}
+	}
+	v.hooks[name] = script
+	return ".git/hooks/" + name, nil
+}
+
+// setupGitTestService creates a git service over a single task and reports what was persisted
+func setupGitTestService(task *entities.TaskEntity, vcs *fakeVersionControl) (*application.GitApplicationService, *[]*entities.TaskEntity) {
+	persisted := []*entities.TaskEntity{}
+	taskRepo := &mocks.MockTaskRepository{
+		GetTaskFunc: func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+			if id != task.ID {
+				return nil, tmerrors.ErrNotFound
+			}
+			return task, nil
+		},
+		UpdateTaskFunc: func(ctx context.Context, task *entities.TaskEntity) error {
+			persisted = append(persisted, task)
+			return nil
+		},
+	}
+	taskService := application.NewTaskApplicationService(taskRepo, &mocks.MockTrackRepository{}, &mocks.MockAggregateRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, services.NewValidationService(), nil, nil, nil)
+	return application.NewGitApplicationService(taskRepo, taskService, nil, vcs), &persisted
+}
+
+// setupGitHookTestService creates a git service over several tasks and the given current iteration (nil for none).
+// The task named by pendingACTask has an unverified acceptance criterion.
+func setupGitHookTestService(tasks []*entities.TaskEntity, current *entities.IterationEntity, pendingACTask string, vcs *fakeVersionControl) *application.GitApplicationService {
+	taskRepo := &mocks.MockTaskRepository{
+		GetTaskFunc: func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+			for _, task := range tasks {
+				if task.ID == id {
+					return task, nil
+				}
+			}
+			return nil, tmerrors.ErrNotFound
+		},
+	}
+	acRepo := &mocks.MockAcceptanceCriteriaRepository{
+		ListACFunc: func(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+			if taskID == pendingACTask {
+				return []*entities.AcceptanceCriteriaEntity{newCheckedAC("TM-ac-1", taskID, "")}, nil
+			}
+			return nil, nil
+		},
+	}
+	iterationRepo := &mocks.MockIterationRepository{
+		GetCurrentIterationFunc: func(ctx context.Context) (*entities.IterationEntity, error) {
+			if current == nil {
+				return nil, tmerrors.ErrNotFound
+			}
+			return current, nil
+		},
+		GetNextPlannedIterationFunc: func(ctx context.Context) (*entities.IterationEntity, error) {
+			return nil, tmerrors.ErrNotFound
+		},
+	}
+	taskService := application.NewTaskApplicationService(taskRepo, &mocks.MockTrackRepository{}, &mocks.MockAggregateRepository{}, acRepo, services.NewValidationService(), nil, nil, nil)
+	iterationService := application.NewIterationApplicationService(iterationRepo, taskRepo, &mocks.MockAcceptanceCriteriaRepository{}, &mocks.MockDocumentRepository{}, &mocks.MockAggregateRepository{}, services.NewIterationService(), services.NewValidationService(), nil, nil, nil, nil)
+	return application.NewGitApplicationService(taskRepo, taskService, iterationService, vcs)
+}
+
+func TestGitService_StartTask_CreatesBranch(t *testing.T) {
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Add login form", "", "todo", 100, "", now, now)
+	vcs := &fakeVersionControl{}
+	service, persisted := setupGitTestService(task, vcs)
+
+	started, created, err := service.StartTask(context.Background(), "TM-task-1")
+	if err != nil {
+		t.Fatalf("StartTask() error = %v", err)
+	}
+	if !created {
+		t.Error("expected the branch to be created")
+	}
+	if len(vcs.checkedOut) != 1 || vcs.checkedOut[0] != "TM-task-1-add-login-form" {
+		t.Errorf("checked out %v, want [TM-task-1-add-login-form]", vcs.checkedOut)
+	}
+	if started.Branch != "TM-task-1-add-login-form" {
+		t.Errorf("Branch = %q, want TM-task-1-add-login-form", started.Branch)
+	}
+	if started.Status != string(entities.TaskStatusInProgress) {
+		t.Errorf("Status = %q, want in-progress", started.Status)
+	}
+	if len(*persisted) != 1 {
+		t.Errorf("expected one task update, got %d", len(*persisted))
+	}
+}
+
+func TestGitService_StartTask_ReusesRecordedBranch(t *testing.T) {
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Add login form", "", "review", 100, "feature/login", now, now)
+	vcs := &fakeVersionControl{existing: map[string]bool{"feature/login": true}}
+	service, _ := setupGitTestService(task, vcs)
+
+	started, created, err := service.StartTask(context.Background(), "TM-task-1")
+	if err != nil {
+		t.Fatalf("StartTask() error = %v", err)
+	}
+	if created {
+		t.Error("expected the existing branch to be reused")
+	}
+	if len(vcs.checkedOut) != 1 || vcs.checkedOut[0] != "feature/login" {
+		t.Errorf("checked out %v, want [feature/login]", vcs.checkedOut)
+	}
+	if started.Status != "review" {
+		t.Errorf("Status = %q, only todo tasks should move to in-progress", started.Status)
+	}
+}
+
+func TestGitService_StartTask_CheckoutFailureLeavesTaskUnchanged(t *testing.T) {
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Add login form", "", "todo", 100, "", now, now)
+	vcs := &fakeVersionControl{checkoutErr: errors.New("not a git repository")}
+	service, persisted := setupGitTestService(task, vcs)
+
+	if _, _, err := service.StartTask(context.Background(), "TM-task-1"); err == nil {
+		t.Fatal("expected checkout error")
+	}
+	if len(*persisted) != 0 {
+		t.Error("failed checkout should not update the task")
+	}
+	if task.Status != "todo" || task.Branch != "" {
+		t.Errorf("task changed after failed checkout: status=%q branch=%q", task.Status, task.Branch)
+	}
+}
+
+func TestGitService_StartTask_TaskNotFound(t *testing.T) {
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "todo", 100, "", now, now)
+	vcs := &fakeVersionControl{}
+	service, _ := setupGitTestService(task, vcs)
+
+	if _, _, err := service.StartTask(context.Background(), "TM-task-99"); !errors.Is(err, tmerrors.ErrNotFound) {
+		t.Errorf("StartTask() error = %v, want ErrNotFound", err)
+	}
+	if len(vcs.checkedOut) != 0 {
+		t.Error("unknown task should not check out a branch")
+	}
+}
+
+func TestGitService_ListTaskCommits_FiltersWholeIDs(t *testing.T) {
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "todo", 100, "", now, now)
+	vcs := &fakeVersionControl{commits: []entities.Commit{
+		{Hash: "aaa", Subject: "TM-task-1: add handler"},
+		{Hash: "bbb", Subject: "TM-task-12: unrelated"},
+		{Hash: "ccc", Subject: "Refactor", Body: "Refs: TM-task-1"},
+	}}
+	service, _ := setupGitTestService(task, vcs)
+
+	commits, err := service.ListTaskCommits(context.Background(), "TM-task-1")
+	if err != nil {
+		t.Fatalf("ListTaskCommits() error = %v", err)
+	}
+	if vcs.searched != "TM-task-1" {
+		t.Errorf("searched for %q, want TM-task-1", vcs.searched)
+	}
+	if len(commits) != 2 || commits[0].Hash != "aaa" || commits[1].Hash != "ccc" {
+		t.Errorf("unexpected commits: %+v", commits)
+	}
+}
+
+func TestGitService_InstallHooks(t *testing.T) {
+	vcs := &fakeVersionControl{}
+	service := setupGitHookTestService(nil, nil, "", vcs)
+
+	paths, err := service.InstallHooks(context.Background(), dto.InstallGitHooksDTO{Command: "'/usr/local/bin/tm'"})
+	if err != nil {
+		t.Fatalf("InstallHooks() error = %v", err)
+	}
+	if len(paths) != 2 {
+		t.Errorf("expected 2 hook paths, got %v", paths)
+	}
+	if !strings.Contains(vcs.hooks["commit-msg"], `'/usr/local/bin/tm' git check-commit-msg "$1"`) {
+		t.Errorf("unexpected commit-msg hook:\n%s", vcs.hooks["commit-msg"])
+	}
+	if !strings.Contains(vcs.hooks["post-merge"], "git post-merge --main-branch 'main'") {
+		t.Errorf("unexpected post-merge hook:\n%s", vcs.hooks["post-merge"])
+	}
+
+	if _, err := service.InstallHooks(context.Background(), dto.InstallGitHooksDTO{}); !errors.Is(err, tmerrors.ErrInvalidArgument) {
+		t.Errorf("InstallHooks() without command error = %v, want ErrInvalidArgument", err)
+	}
+}
+
+func TestGitService_CheckCommitMessage(t *testing.T) {
+	iteration := &entities.IterationEntity{Number: 2, Name: "Sprint 2", Status: "current", TaskIDs: []string{"TM-task-1", "TM-task-2"}}
+	service := setupGitHookTestService(nil, iteration, "", &fakeVersionControl{})
+
+	tests := []struct {
+		name    string
+		message string
+		taskID  string
+		reject  bool
+	}{
+		{"task in subject", "TM-task-2: add handler\n", "TM-task-2", false},
+		{"task in body", "Add handler\n\nRefs: TM-task-1\n", "TM-task-1", false},
+		{"task outside iteration", "TM-task-3: add handler\n", "", true},
+		{"longer ID does not count", "TM-task-12: add handler\n", "", true},
+		{"no task", "Add handler\n", "", true},
+		{"only in comments", "Add handler\n# On branch TM-task-1-login\n", "", true},
+		{"merge commit", "Merge branch 'feature'\n", "", false},
+		{"fixup", "fixup! Add handler\n", "", false},
+		{"empty message", "# Please enter the commit message\n", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskID, err := service.CheckCommitMessage(context.Background(), tt.message)
+			if tt.reject {
+				if !errors.Is(err, tmerrors.ErrRejected) {
+					t.Fatalf("CheckCommitMessage() error = %v, want ErrRejected", err)
+				}
+				if !strings.Contains(err.Error(), "TM-task-1, TM-task-2") {
+					t.Errorf("rejection should list the iteration's tasks: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CheckCommitMessage() error = %v", err)
+			}
+			if taskID != tt.taskID {
+				t.Errorf("CheckCommitMessage() = %q, want %q", taskID, tt.taskID)
+			}
+		})
+	}
+}
+
+func TestGitService_CheckCommitMessage_NoIteration(t *testing.T) {
+	service := setupGitHookTestService(nil, nil, "", &fakeVersionControl{})
+
+	if _, err := service.CheckCommitMessage(context.Background(), "Add handler\n"); err != nil {
+		t.Errorf("CheckCommitMessage() without an iteration error = %v, want nil", err)
+	}
+}
+
+func TestGitService_ApplyMergedCommits(t *testing.T) {
+	now := time.Now().UTC()
+	task1, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "One", "", "in-progress", 100, "", now, now)
+	task2, _ := entities.NewTaskEntity("TM-task-2", "TM-track-1", "Two", "", "in-progress", 100, "", now, now)
+	task3, _ := entities.NewTaskEntity("TM-task-3", "TM-track-1", "Three", "", "in-progress", 100, "", now, now)
+	task4, _ := entities.NewTaskEntity("TM-task-4", "TM-track-1", "Four", "", "done", 100, "", now, now)
+	vcs := &fakeVersionControl{
+		branch: "main",
+		merged: []entities.Commit{
+			{Hash: "3333333333", Subject: "Finish", Body: "Closes: TM-task-1, TM-task-3\nCloses: TM-task-4"},
+			{Hash: "2222222222", Subject: "Ready", Body: "Review: TM-task-1\nReview: TM-task-2"},
+			{Hash: "1111111111", Subject: "TM-task-1: start", Body: "Closes TM-task-2 is not a trailer"},
+		},
+	}
+	service := setupGitHookTestService([]*entities.TaskEntity{task1, task2, task3, task4}, nil, "TM-task-3", vcs)
+
+	results, err := service.ApplyMergedCommits(context.Background(), "main")
+	if err != nil {
+		t.Fatalf("ApplyMergedCommits() error = %v", err)
+	}
+
+	expected := []dto.CommitTransitionDTO{
+		{TaskID: "TM-task-1", Commit: "2222222", Trailer: "Review", FromStatus: "in-progress", ToStatus: "review"},
+		{TaskID: "TM-task-2", Commit: "2222222", Trailer: "Review", FromStatus: "in-progress", ToStatus: "review"},
+		{TaskID: "TM-task-1", Commit: "3333333", Trailer: "Closes", FromStatus: "review", ToStatus: "done"},
+		{TaskID: "TM-task-3", Commit: "3333333", Trailer: "Closes", FromStatus: "in-progress", ToStatus: "done"},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("got %d transitions, want %d: %+v", len(results), len(expected), results)
+	}
+	for i, want := range expected {
+		got := results[i]
+		got.Error = ""
+		if got != want {
+			t.Errorf("transition %d = %+v, want %+v", i, got, want)
+		}
+	}
+	if results[3].Error == "" {
+		t.Error("closing a task with unverified ACs should report an error")
+	}
+	if task1.Status != "done" || task2.Status != "review" || task3.Status != "in-progress" {
+		t.Errorf("unexpected statuses: %s %s %s", task1.Status, task2.Status, task3.Status)
+	}
+}
+
+func TestGitService_ApplyMergedCommits_OtherBranch(t *testing.T) {
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "One", "", "in-progress", 100, "", now, now)
+	vcs := &fakeVersionControl{
+		branch: "feature",
+		merged: []entities.Commit{{Hash: "1111111111", Subject: "Finish", Body: "Closes: TM-task-1"}},
+	}
+	service := setupGitHookTestService([]*entities.TaskEntity{task}, nil, "", vcs)
+
+	results, err := service.ApplyMergedCommits(context.Background(), "main")
+	if err != nil {
+		t.Fatalf("ApplyMergedCommits() error = %v", err)
+	}
+	if len(results) != 0 || task.Status != "in-progress" {
+		t.Errorf("merges outside the main branch should not move tasks: %+v", results)
+	}
+}
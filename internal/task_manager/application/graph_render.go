@@ -0,0 +1,304 @@
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+)
+
+// graphRenderers maps each graph format to its renderer
+var graphRenderers = map[string]func(*dto.RoadmapGraphDTO) string{
+	dto.GraphFormatDOT:     renderGraphDOT,
+	dto.GraphFormatMermaid: renderGraphMermaid,
+	dto.GraphFormatASCII:   renderGraphASCII,
+}
+
+// graphCodeFences maps each graph format to the language of its fenced code block in documents
+var graphCodeFences = map[string]string{
+	dto.GraphFormatDOT:     "dot",
+	dto.GraphFormatMermaid: "mermaid",
+	dto.GraphFormatASCII:   "text",
+}
+
+// graphStatusColors maps track and task statuses to node fill colors
+var graphStatusColors = map[string]string{
+	"not-started": "#e0e0e0",
+	"todo":        "#f5f5f5",
+	"in-progress": "#fff2b3",
+	"review":      "#bbdefb",
+	"waiting":     "#ffe0b2",
+	"blocked":     "#ffcdd2",
+	"complete":    "#c8e6c9",
+	"done":        "#c8e6c9",
+	"cancelled":   "#eeeeee",
+}
+
+// graphCriticalColor outlines critical path tracks and their dependency edges
+const graphCriticalColor = "#d32f2f"
+
+// graphVisionLength is the number of characters of the vision shown on the roadmap node
+const graphVisionLength = 60
+
+// ============================================================================
+// Graphviz DOT
+// ============================================================================
+
+// renderGraphDOT renders the graph for Graphviz: hierarchy edges are dashed,
+// dependency edges point from prerequisite to dependent
+func renderGraphDOT(graph *dto.RoadmapGraphDTO) string {
+	var b strings.Builder
+	b.WriteString("digraph roadmap {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\"];\n\n")
+
+	fmt.Fprintf(&b, "  %s [label=%s, shape=ellipse, fillcolor=\"#ffffff\"];\n",
+		dotQuote(graph.RoadmapID), dotQuote("Roadmap\n"+graphVision(graph.Vision)))
+
+	for _, track := range graph.Tracks {
+		b.WriteString("\n")
+		attrs := fmt.Sprintf("label=%s, fillcolor=%s", dotQuote(graphNodeLabel(track.ID, track.Title, track.Status)), dotQuote(graphStatusColor(track.Status)))
+		if track.Critical {
+			attrs += fmt.Sprintf(", color=%s, penwidth=3", dotQuote(graphCriticalColor))
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(track.ID), attrs)
+		fmt.Fprintf(&b, "  %s -> %s [style=dashed, arrowhead=none];\n", dotQuote(graph.RoadmapID), dotQuote(track.ID))
+		for _, task := range track.Tasks {
+			fmt.Fprintf(&b, "  %s [label=%s, shape=note, fillcolor=%s];\n",
+				dotQuote(task.ID), dotQuote(graphNodeLabel(task.ID, task.Title, task.Status)), dotQuote(graphStatusColor(task.Status)))
+			fmt.Fprintf(&b, "  %s -> %s [style=dashed, arrowhead=none];\n", dotQuote(track.ID), dotQuote(task.ID))
+		}
+	}
+
+	edges := graphDependencyEdges(graph)
+	if len(edges) > 0 {
+		b.WriteString("\n  // Track dependencies: prerequisite -> dependent\n")
+	}
+	for _, edge := range edges {
+		attrs := ""
+		if edge.critical {
+			attrs = fmt.Sprintf(" [color=%s, penwidth=3]", dotQuote(graphCriticalColor))
+		}
+		fmt.Fprintf(&b, "  %s -> %s%s;\n", dotQuote(edge.from), dotQuote(edge.to), attrs)
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotQuote returns s as a DOT string; newlines become centered line breaks
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// ============================================================================
+// Mermaid
+// ============================================================================
+
+// renderGraphMermaid renders the graph as a Mermaid flowchart: hierarchy links are dotted,
+// dependency arrows point from prerequisite to dependent
+func renderGraphMermaid(graph *dto.RoadmapGraphDTO) string {
+	var b strings.Builder
+	var criticalNodes []string
+	var criticalLinks []string
+	link := 0
+
+	b.WriteString("flowchart LR\n")
+	roadmapNode := mermaidID(graph.RoadmapID)
+	fmt.Fprintf(&b, "  %s([%s])\n", roadmapNode, mermaidLabel("Roadmap\n"+graphVision(graph.Vision)))
+
+	for _, track := range graph.Tracks {
+		trackNode := mermaidID(track.ID)
+		fmt.Fprintf(&b, "  %s[%s]:::%s\n", trackNode, mermaidLabel(graphNodeLabel(track.ID, track.Title, track.Status)), mermaidClass(track.Status))
+		fmt.Fprintf(&b, "  %s -.- %s\n", roadmapNode, trackNode)
+		link++
+		if track.Critical {
+			criticalNodes = append(criticalNodes, trackNode)
+		}
+		for _, task := range track.Tasks {
+			taskNode := mermaidID(task.ID)
+			fmt.Fprintf(&b, "  %s[%s]:::%s\n", taskNode, mermaidLabel(graphNodeLabel(task.ID, task.Title, task.Status)), mermaidClass(task.Status))
+			fmt.Fprintf(&b, "  %s -.- %s\n", trackNode, taskNode)
+			link++
+		}
+	}
+
+	for _, edge := range graphDependencyEdges(graph) {
+		fmt.Fprintf(&b, "  %s --> %s\n", mermaidID(edge.from), mermaidID(edge.to))
+		if edge.critical {
+			criticalLinks = append(criticalLinks, fmt.Sprint(link))
+		}
+		link++
+	}
+
+	for _, status := range []string{"not-started", "todo", "in-progress", "review", "waiting", "blocked", "complete", "done", "cancelled"} {
+		fmt.Fprintf(&b, "  classDef %s fill:%s,stroke:#757575\n", mermaidClass(status), graphStatusColors[status])
+	}
+	if len(criticalNodes) > 0 {
+		fmt.Fprintf(&b, "  classDef critical stroke:%s,stroke-width:3px\n", graphCriticalColor)
+		fmt.Fprintf(&b, "  class %s critical\n", strings.Join(criticalNodes, ","))
+	}
+	if len(criticalLinks) > 0 {
+		fmt.Fprintf(&b, "  linkStyle %s stroke:%s,stroke-width:3px\n", strings.Join(criticalLinks, ","), graphCriticalColor)
+	}
+
+	return b.String()
+}
+
+// mermaidID turns an entity ID into a Mermaid node ID
+func mermaidID(id string) string {
+	var b strings.Builder
+	b.WriteString("n_")
+	for _, r := range id {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
+
+// mermaidLabel returns s as a quoted Mermaid label; newlines become line breaks
+func mermaidLabel(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	s = strings.ReplaceAll(s, "\n", "<br/>")
+	return `"` + s + `"`
+}
+
+// mermaidClass returns the class name of a status
+func mermaidClass(status string) string {
+	return strings.ReplaceAll(status, "-", "_")
+}
+
+// ============================================================================
+// ASCII
+// ============================================================================
+
+// renderGraphASCII renders the hierarchy as a tree, followed by the dependency edges and the critical path
+func renderGraphASCII(graph *dto.RoadmapGraphDTO) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Roadmap: %s\n", graphVision(graph.Vision))
+	if len(graph.Tracks) == 0 {
+		b.WriteString("└── (no tracks)\n")
+	}
+	for i, track := range graph.Tracks {
+		branch, indent := "├── ", "│   "
+		if i == len(graph.Tracks)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintf(&b, "%s%s  %s [%s]%s\n", branch, track.ID, track.Title, track.Status, asciiCriticalMark(track.Critical))
+		for j, task := range track.Tasks {
+			taskBranch := "├── "
+			if j == len(track.Tasks)-1 {
+				taskBranch = "└── "
+			}
+			fmt.Fprintf(&b, "%s%s%s  %s [%s]\n", indent, taskBranch, task.ID, task.Title, task.Status)
+		}
+	}
+
+	edges := graphDependencyEdges(graph)
+	b.WriteString("\nDependencies (prerequisite → dependent):\n")
+	if len(edges) == 0 {
+		b.WriteString("  none\n")
+	}
+	for _, edge := range edges {
+		fmt.Fprintf(&b, "  %s → %s%s\n", edge.from, edge.to, asciiCriticalMark(edge.critical))
+	}
+
+	b.WriteString("\n" + graphCriticalPathLine(graph) + "\n")
+	if len(graph.CriticalPath) > 0 {
+		b.WriteString("★ = on the critical path\n")
+	}
+	return b.String()
+}
+
+// asciiCriticalMark marks critical path tracks and edges
+func asciiCriticalMark(critical bool) string {
+	if critical {
+		return " ★"
+	}
+	return ""
+}
+
+// ============================================================================
+// Shared helpers
+// ============================================================================
+
+// graphEdge is a track dependency edge, from prerequisite to dependent
+type graphEdge struct {
+	from     string
+	to       string
+	critical bool // Both ends are consecutive on the critical path
+}
+
+// graphDependencyEdges returns the dependency edges between graphed tracks, in track order
+func graphDependencyEdges(graph *dto.RoadmapGraphDTO) []graphEdge {
+	known := make(map[string]bool, len(graph.Tracks))
+	for _, track := range graph.Tracks {
+		known[track.ID] = true
+	}
+	criticalNext := make(map[string]string, len(graph.CriticalPath))
+	for i := 1; i < len(graph.CriticalPath); i++ {
+		criticalNext[graph.CriticalPath[i-1]] = graph.CriticalPath[i]
+	}
+
+	var edges []graphEdge
+	for _, track := range graph.Tracks {
+		for _, dep := range track.Dependencies {
+			if !known[dep] {
+				continue
+			}
+			edges = append(edges, graphEdge{from: dep, to: track.ID, critical: criticalNext[dep] == track.ID})
+		}
+	}
+	return edges
+}
+
+// graphNodeLabel returns the three-line label of a track or task node
+func graphNodeLabel(id, title, status string) string {
+	return fmt.Sprintf("%s\n%s\n[%s]", id, title, status)
+}
+
+// graphStatusColor returns the fill color of a status, white for unknown statuses
+func graphStatusColor(status string) string {
+	if color, ok := graphStatusColors[status]; ok {
+		return color
+	}
+	return "#ffffff"
+}
+
+// graphVision returns the first line of the vision, shortened for a node label
+func graphVision(vision string) string {
+	line := strings.TrimSpace(strings.SplitN(vision, "\n", 2)[0])
+	if runes := []rune(line); len(runes) > graphVisionLength {
+		line = string(runes[:graphVisionLength-1]) + "…"
+	}
+	return line
+}
+
+// graphCriticalPathLine describes the critical path in one line
+func graphCriticalPathLine(graph *dto.RoadmapGraphDTO) string {
+	if len(graph.CriticalPath) == 0 {
+		return "Critical path: none (all tracks complete)"
+	}
+	return fmt.Sprintf("Critical path (%d open task(s)): %s", graph.CriticalPathWeight, strings.Join(graph.CriticalPath, " → "))
+}
+
+// renderGraphDocument embeds a rendered graph in a markdown document
+func renderGraphDocument(graph *dto.RoadmapGraphDTO, title string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", title)
+	fmt.Fprintf(&b, "%s\n\n", graphCriticalPathLine(graph))
+	fmt.Fprintf(&b, "```%s\n", graphCodeFences[graph.Format])
+	b.WriteString(graph.Rendered)
+	if !strings.HasSuffix(graph.Rendered, "\n") {
+		b.WriteString("\n")
+	}
+	b.WriteString("```\n")
+	return b.String()
+}
@@ -0,0 +1,151 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// DefaultGraphTitle is the title of saved graph documents when none is given
+const DefaultGraphTitle = "Roadmap Graph"
+
+// GraphApplicationService renders the roadmap hierarchy and track dependency DAG as graphs.
+type GraphApplicationService struct {
+	roadmapRepo       repositories.RoadmapRepository
+	trackRepo         repositories.TrackRepository
+	taskRepo          repositories.TaskRepository
+	dependencyService *services.DependencyService
+	documentService   *DocumentApplicationService
+}
+
+// NewGraphApplicationService creates a new graph application service.
+// Saved graphs go through documentService so they are validated like any document.
+func NewGraphApplicationService(
+	roadmapRepo repositories.RoadmapRepository,
+	trackRepo repositories.TrackRepository,
+	taskRepo repositories.TaskRepository,
+	dependencyService *services.DependencyService,
+	documentService *DocumentApplicationService,
+) *GraphApplicationService {
+	return &GraphApplicationService{
+		roadmapRepo:       roadmapRepo,
+		trackRepo:         trackRepo,
+		taskRepo:          taskRepo,
+		dependencyService: dependencyService,
+		documentService:   documentService,
+	}
+}
+
+// GenerateGraph builds the roadmap → track → task hierarchy with the track dependency DAG
+// and the critical path through unfinished tracks, weighted by their open tasks.
+// The graph rendered in the requested format is in the Rendered field.
+func (s *GraphApplicationService) GenerateGraph(ctx context.Context, input dto.GraphDTO) (*dto.RoadmapGraphDTO, error) {
+	render, ok := graphRenderers[input.Format]
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid graph format %q: must be one of %s, %s, %s",
+			tmerrors.ErrInvalidArgument, input.Format, dto.GraphFormatDOT, dto.GraphFormatMermaid, dto.GraphFormatASCII)
+	}
+
+	roadmap, err := s.roadmapRepo.GetActiveRoadmap(ctx)
+	if err != nil {
+		if errors.Is(err, tmerrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: no roadmap found; run 'tm roadmap init' first", tmerrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+	tracks, err := s.trackRepo.ListTracks(ctx, roadmap.ID, entities.TrackFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracks: %w", err)
+	}
+	sort.Slice(tracks, func(i, j int) bool {
+		if tracks[i].Rank != tracks[j].Rank {
+			return tracks[i].Rank < tracks[j].Rank
+		}
+		return tracks[i].ID < tracks[j].ID
+	})
+
+	tasks, err := s.taskRepo.ListTasks(ctx, entities.TaskFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		if tasks[i].Rank != tasks[j].Rank {
+			return tasks[i].Rank < tasks[j].Rank
+		}
+		return tasks[i].ID < tasks[j].ID
+	})
+	tasksByTrack := make(map[string][]*entities.TaskEntity)
+	openTasks := make(map[string]int)
+	for _, task := range tasks {
+		tasksByTrack[task.TrackID] = append(tasksByTrack[task.TrackID], task)
+		if task.Status != string(entities.TaskStatusDone) && task.Status != string(entities.TaskStatusCancelled) {
+			openTasks[task.TrackID]++
+		}
+	}
+
+	criticalPath, weight := s.dependencyService.CriticalPath(tracks, openTasks)
+	critical := make(map[string]bool, len(criticalPath))
+	for _, id := range criticalPath {
+		critical[id] = true
+	}
+
+	graph := &dto.RoadmapGraphDTO{
+		RoadmapID:          roadmap.ID,
+		Vision:             roadmap.Vision,
+		Tracks:             make([]*dto.GraphTrackDTO, 0, len(tracks)),
+		CriticalPath:       criticalPath,
+		CriticalPathWeight: weight,
+		Format:             input.Format,
+	}
+	if graph.CriticalPath == nil {
+		graph.CriticalPath = []string{}
+	}
+	for _, track := range tracks {
+		node := &dto.GraphTrackDTO{
+			ID:           track.ID,
+			Title:        track.Title,
+			Status:       track.Status,
+			Dependencies: append([]string{}, track.Dependencies...),
+			Critical:     critical[track.ID],
+			Tasks:        []*dto.GraphTaskDTO{},
+		}
+		sort.Strings(node.Dependencies)
+		if !input.TracksOnly {
+			for _, task := range tasksByTrack[track.ID] {
+				node.Tasks = append(node.Tasks, &dto.GraphTaskDTO{ID: task.ID, Title: task.Title, Status: task.Status})
+			}
+		}
+		graph.Tracks = append(graph.Tracks, node)
+	}
+
+	graph.Rendered = render(graph)
+	return graph, nil
+}
+
+// SaveGraph stores a graph as a draft document and records the new document's ID on the graph.
+// The graph is embedded in a fenced code block so the document viewer shows it as is.
+func (s *GraphApplicationService) SaveGraph(ctx context.Context, graph *dto.RoadmapGraphDTO, title string) error {
+	if title == "" {
+		title = DefaultGraphTitle
+	}
+
+	id, err := s.documentService.CreateDocument(ctx, dto.CreateDocumentDTO{
+		Title:   title,
+		Type:    string(entities.DocumentTypeOther),
+		Status:  string(entities.DocumentStatusDraft),
+		Content: renderGraphDocument(graph, title),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save graph: %w", err)
+	}
+
+	graph.DocumentID = id
+	return nil
+}
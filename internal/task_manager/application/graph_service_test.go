@@ -0,0 +1,131 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupGraphTest returns a graph service over a roadmap with three tracks:
+// Core (complete) <- API (in-progress) <- UI (not-started)
+func setupGraphTest(t *testing.T) (*application.GraphApplicationService, *mocks.MockDocumentRepository) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	roadmapRepo := mocks.NewMockRoadmapRepository()
+	trackRepo := mocks.NewMockTrackRepository()
+	taskRepo := mocks.NewMockTaskRepository()
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "Ship the \"graph\" feature", "Graphs render", now, now)
+	require.NoError(t, roadmapRepo.SaveRoadmap(ctx, roadmap))
+	for _, track := range []struct {
+		id, title, status string
+		rank              int
+		deps              []string
+	}{
+		{"TM-track-1", "Core", "complete", 100, nil},
+		{"TM-track-2", "API", "in-progress", 200, []string{"TM-track-1"}},
+		{"TM-track-3", "UI", "not-started", 300, []string{"TM-track-2"}},
+	} {
+		entity, err := entities.NewTrackEntity(track.id, roadmap.ID, track.title, "", track.status, track.rank, track.deps, now, now)
+		require.NoError(t, err)
+		require.NoError(t, trackRepo.SaveTrack(ctx, entity))
+	}
+	for _, task := range []struct{ id, trackID, status string }{
+		{"TM-task-1", "TM-track-1", "done"},
+		{"TM-task-2", "TM-track-2", "in-progress"},
+		{"TM-task-3", "TM-track-2", "todo"},
+		{"TM-task-4", "TM-track-3", "done"},
+	} {
+		entity, err := entities.NewTaskEntity(task.id, task.trackID, "Task "+task.id, "", task.status, 100, "", now, now)
+		require.NoError(t, err)
+		require.NoError(t, taskRepo.SaveTask(ctx, entity))
+	}
+
+	docRepo := &mocks.MockDocumentRepository{}
+	documentService := application.NewDocumentApplicationService(docRepo, &mocks.MockTrackRepository{}, mocks.NewMockIterationRepository(), nil, nil)
+	return application.NewGraphApplicationService(roadmapRepo, trackRepo, taskRepo, services.NewDependencyService(), documentService), docRepo
+}
+
+// TestGraphApplicationService_GenerateGraph verifies the hierarchy, dependencies and critical path
+func TestGraphApplicationService_GenerateGraph(t *testing.T) {
+	service, _ := setupGraphTest(t)
+
+	graph, err := service.GenerateGraph(context.Background(), dto.GraphDTO{Format: dto.GraphFormatASCII})
+	require.NoError(t, err)
+
+	require.Len(t, graph.Tracks, 3)
+	assert.Equal(t, "TM-track-1", graph.Tracks[0].ID, "tracks should be ordered by rank")
+	assert.Len(t, graph.Tracks[1].Tasks, 2)
+	assert.Equal(t, []string{"TM-track-2", "TM-track-3"}, graph.CriticalPath, "complete tracks are not on the critical path")
+	assert.Equal(t, 3, graph.CriticalPathWeight, "API has 2 open tasks and UI none, which counts as 1")
+	assert.False(t, graph.Tracks[0].Critical)
+	assert.True(t, graph.Tracks[2].Critical)
+
+	assert.Contains(t, graph.Rendered, "├── TM-track-2  API [in-progress] ★")
+	assert.Contains(t, graph.Rendered, "TM-track-2 → TM-track-3 ★")
+	assert.Contains(t, graph.Rendered, "TM-track-1 → TM-track-2\n", "edges off the critical path are not marked")
+	assert.Contains(t, graph.Rendered, "Critical path (3 open task(s)): TM-track-2 → TM-track-3")
+}
+
+// TestGraphApplicationService_Formats verifies the DOT and Mermaid output
+func TestGraphApplicationService_Formats(t *testing.T) {
+	service, _ := setupGraphTest(t)
+	ctx := context.Background()
+
+	dot, err := service.GenerateGraph(ctx, dto.GraphDTO{Format: dto.GraphFormatDOT, TracksOnly: true})
+	require.NoError(t, err)
+	assert.Contains(t, dot.Rendered, "digraph roadmap {")
+	assert.Contains(t, dot.Rendered, `label="Roadmap\nShip the \"graph\" feature"`, "labels should be escaped")
+	assert.Contains(t, dot.Rendered, `"TM-track-2" -> "TM-track-3" [color="#d32f2f", penwidth=3];`)
+	assert.Contains(t, dot.Rendered, `"TM-track-1" -> "TM-track-2";`)
+	assert.NotContains(t, dot.Rendered, "TM-task-", "tracks only should leave tasks out")
+
+	mermaid, err := service.GenerateGraph(ctx, dto.GraphDTO{Format: dto.GraphFormatMermaid})
+	require.NoError(t, err)
+	assert.Contains(t, mermaid.Rendered, "flowchart LR")
+	assert.Contains(t, mermaid.Rendered, `n_TM_track_2["TM-track-2<br/>API<br/>[in-progress]"]:::in_progress`)
+	assert.Contains(t, mermaid.Rendered, "#quot;graph#quot;")
+	assert.Contains(t, mermaid.Rendered, "n_TM_track_1 --> n_TM_track_2")
+	assert.Contains(t, mermaid.Rendered, "class n_TM_track_2,n_TM_track_3 critical")
+}
+
+// TestGraphApplicationService_InvalidFormat verifies that unknown formats are rejected
+func TestGraphApplicationService_InvalidFormat(t *testing.T) {
+	service, _ := setupGraphTest(t)
+
+	_, err := service.GenerateGraph(context.Background(), dto.GraphDTO{Format: "svg"})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, tmerrors.ErrInvalidArgument))
+}
+
+// TestGraphApplicationService_SaveGraph verifies that a saved graph is a document embedding the rendered graph
+func TestGraphApplicationService_SaveGraph(t *testing.T) {
+	service, docRepo := setupGraphTest(t)
+	var saved *entities.DocumentEntity
+	docRepo.SaveDocumentFunc = func(ctx context.Context, doc *entities.DocumentEntity) error {
+		saved = doc
+		return nil
+	}
+
+	graph, err := service.GenerateGraph(context.Background(), dto.GraphDTO{Format: dto.GraphFormatMermaid})
+	require.NoError(t, err)
+	require.NoError(t, service.SaveGraph(context.Background(), graph, ""))
+
+	require.NotNil(t, saved)
+	assert.Equal(t, saved.ID, graph.DocumentID)
+	assert.Equal(t, application.DefaultGraphTitle, saved.Title)
+	assert.Equal(t, entities.DocumentTypeOther, saved.Type)
+	assert.Contains(t, saved.Content, "```mermaid\nflowchart LR\n")
+	assert.Contains(t, saved.Content, "Critical path (3 open task(s))")
+}
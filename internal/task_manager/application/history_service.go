@@ -0,0 +1,220 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+)
+
+// ignoredHistoryFields are entity fields that change on every mutation and carry no audit value.
+var ignoredHistoryFields = map[string]bool{
+	"updated_at": true,
+	"version":    true,
+}
+
+// deletionEvents are the event types whose payload is the last known state of a removed entity.
+var deletionEvents = map[string]bool{
+	events.EventTrackDeleted:     true,
+	events.EventTaskDeleted:      true,
+	events.EventIterationDeleted: true,
+	events.EventACDeleted:        true,
+	events.EventADRDeleted:       true,
+	events.EventDocumentDeleted:  true,
+}
+
+// derivedHistoryEvent describes a derived event type as the recorded update it repeats:
+// an entry of baseType whose status changed, to status if it is set.
+type derivedHistoryEvent struct {
+	baseType string
+	status   string
+}
+
+// derivedHistoryEvents are the derived event types that history filters can still select.
+var derivedHistoryEvents = map[string]derivedHistoryEvent{
+	events.EventTaskStatusChanged:  {baseType: events.EventTaskUpdated},
+	events.EventTaskCompleted:      {baseType: events.EventTaskUpdated, status: string(entities.TaskStatusDone)},
+	events.EventTrackStatusChanged: {baseType: events.EventTrackUpdated},
+	events.EventTrackCompleted:     {baseType: events.EventTrackUpdated, status: string(entities.TrackStatusComplete)},
+	events.EventTrackBlocked:       {baseType: events.EventTrackUpdated, status: string(entities.TrackStatusBlocked)},
+}
+
+// matches reports whether a recorded entry is the update this derived event repeats.
+func (d derivedHistoryEvent) matches(event *entities.EntityEvent) bool {
+	if event.EventType != d.baseType {
+		return false
+	}
+	for _, change := range event.Changes {
+		if change.Field == "status" {
+			return d.status == "" || change.After == d.status
+		}
+	}
+	return false
+}
+
+// HistoryApplicationService records domain events in the entity history log and queries it.
+type HistoryApplicationService struct {
+	eventRepo repositories.EntityEventRepository
+	actor     string
+}
+
+// NewHistoryApplicationService creates a new history application service.
+// actor is stamped on every recorded event (e.g. the OS user running tm).
+func NewHistoryApplicationService(
+	eventRepo repositories.EntityEventRepository,
+	actor string,
+) *HistoryApplicationService {
+	return &HistoryApplicationService{
+		eventRepo: eventRepo,
+		actor:     actor,
+	}
+}
+
+// RecordEvent appends a domain event to the history log with its field-level diff.
+// It matches the events.Handler signature so it can be subscribed to an event bus.
+// Derived events are skipped: the event they repeat already recorded the mutation.
+func (s *HistoryApplicationService) RecordEvent(ctx context.Context, event events.Event) error {
+	if event.Derived {
+		return nil
+	}
+
+	after := event.Payload
+	if deletionEvents[event.Type] {
+		after = nil
+	}
+
+	changes, err := diffFields(event.Previous, after)
+	if err != nil {
+		return fmt.Errorf("failed to diff %s %s: %w", event.EntityType, event.EntityID, err)
+	}
+
+	record := &entities.EntityEvent{
+		EntityType: event.EntityType,
+		EntityID:   event.EntityID,
+		EventType:  event.Type,
+		Changes:    changes,
+		Actor:      s.actor,
+		OccurredAt: event.OccurredAt,
+	}
+	if err := s.eventRepo.AppendEntityEvent(ctx, record); err != nil {
+		return fmt.Errorf("failed to record event: %w", err)
+	}
+	return nil
+}
+
+// ListHistory returns recorded events matching the filters, oldest first.
+// Event types may be given in full ("task-manager.task.created") or without the source prefix ("task.created").
+// Derived event types, which are not recorded themselves, select the updates they repeat:
+// "task.status_changed" matches the task.updated entries that changed the status.
+func (s *HistoryApplicationService) ListHistory(ctx context.Context, filters entities.EntityEventFilters) ([]*entities.EntityEvent, error) {
+	recorded := map[string]bool{}
+	derived := []derivedHistoryEvent{}
+	queried := make([]string, 0, len(filters.EventTypes))
+	for _, eventType := range filters.EventTypes {
+		eventType = normalizeEventType(eventType)
+		if d, ok := derivedHistoryEvents[eventType]; ok {
+			derived = append(derived, d)
+			eventType = d.baseType
+		} else {
+			recorded[eventType] = true
+		}
+		queried = append(queried, eventType)
+	}
+	filters.EventTypes = queried
+
+	history, err := s.eventRepo.ListEntityEvents(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list history: %w", err)
+	}
+	if len(derived) == 0 {
+		return history, nil
+	}
+
+	// Keep entries asked for directly, and only the updates a derived type repeats
+	matching := []*entities.EntityEvent{}
+	for _, event := range history {
+		keep := recorded[event.EventType]
+		for _, d := range derived {
+			keep = keep || d.matches(event)
+		}
+		if keep {
+			matching = append(matching, event)
+		}
+	}
+	return matching, nil
+}
+
+// normalizeEventType prefixes short event names with the plugin source name.
+func normalizeEventType(eventType string) string {
+	prefix := events.PluginSourceName + "."
+	if strings.HasPrefix(eventType, prefix) {
+		return eventType
+	}
+	return prefix + eventType
+}
+
+// diffFields compares two entity snapshots field by field using their JSON representation.
+// A nil before yields creation diffs; a nil after yields deletion diffs.
+func diffFields(before, after interface{}) ([]entities.FieldChange, error) {
+	beforeFields, err := toFieldMap(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := toFieldMap(after)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool)
+	for name := range beforeFields {
+		names[name] = true
+	}
+	for name := range afterFields {
+		names[name] = true
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		if !ignoredHistoryFields[name] {
+			sorted = append(sorted, name)
+		}
+	}
+	sort.Strings(sorted)
+
+	changes := []entities.FieldChange{}
+	for _, name := range sorted {
+		oldValue, newValue := beforeFields[name], afterFields[name]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, entities.FieldChange{
+			Field:  name,
+			Before: oldValue,
+			After:  newValue,
+		})
+	}
+	return changes, nil
+}
+
+// toFieldMap flattens an entity into its top-level JSON fields.
+func toFieldMap(entity interface{}) (map[string]interface{}, error) {
+	if entity == nil || reflect.ValueOf(entity).Kind() == reflect.Ptr && reflect.ValueOf(entity).IsNil() {
+		return map[string]interface{}{}, nil
+	}
+
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
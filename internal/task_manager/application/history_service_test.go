@@ -0,0 +1,190 @@
+package application_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+)
+
+func newRecordingHistoryService(recorded *[]*entities.EntityEvent) *application.HistoryApplicationService {
+	repo := &mocks.MockEntityEventRepository{
+		AppendEntityEventFunc: func(ctx context.Context, event *entities.EntityEvent) error {
+			*recorded = append(*recorded, event)
+			return nil
+		},
+	}
+	return application.NewHistoryApplicationService(repo, "alice")
+}
+
+func changesByField(changes []entities.FieldChange) map[string]entities.FieldChange {
+	byField := make(map[string]entities.FieldChange, len(changes))
+	for _, change := range changes {
+		byField[change.Field] = change
+	}
+	return byField
+}
+
+// TestHistoryService_RecordEvent_UpdateDiff verifies only changed fields are recorded on update
+func TestHistoryService_RecordEvent_UpdateDiff(t *testing.T) {
+	var recorded []*entities.EntityEvent
+	service := newRecordingHistoryService(&recorded)
+
+	now := time.Now().UTC()
+	before, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "todo", 100, "", now, now)
+	after := *before
+	after.Status = "in-progress"
+	after.UpdatedAt = now.Add(time.Minute)
+
+	event := events.NewEvent(events.EventTaskUpdated, events.EntityTypeTask, before.ID, &after, before)
+	if err := service.RecordEvent(context.Background(), event); err != nil {
+		t.Fatalf("RecordEvent failed: %v", err)
+	}
+
+	if len(recorded) != 1 {
+		t.Fatalf("expected 1 recorded event, got %d", len(recorded))
+	}
+	got := recorded[0]
+	if got.Actor != "alice" || got.EventType != events.EventTaskUpdated || got.EntityType != events.EntityTypeTask {
+		t.Errorf("unexpected record: %+v", got)
+	}
+	if len(got.Changes) != 1 {
+		t.Fatalf("expected only the status change, got %+v", got.Changes)
+	}
+	if got.Changes[0].Field != "status" || got.Changes[0].Before != "todo" || got.Changes[0].After != "in-progress" {
+		t.Errorf("unexpected change: %+v", got.Changes[0])
+	}
+}
+
+// TestHistoryService_RecordEvent_CreateAndDelete verifies creations have no before and deletions have no after
+func TestHistoryService_RecordEvent_CreateAndDelete(t *testing.T) {
+	var recorded []*entities.EntityEvent
+	service := newRecordingHistoryService(&recorded)
+
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "todo", 100, "", now, now)
+	ctx := context.Background()
+
+	if err := service.RecordEvent(ctx, events.NewEvent(events.EventTaskCreated, events.EntityTypeTask, task.ID, task, nil)); err != nil {
+		t.Fatalf("RecordEvent failed: %v", err)
+	}
+	if err := service.RecordEvent(ctx, events.NewEvent(events.EventTaskDeleted, events.EntityTypeTask, task.ID, task, task)); err != nil {
+		t.Fatalf("RecordEvent failed: %v", err)
+	}
+
+	created := changesByField(recorded[0].Changes)
+	if created["title"].Before != nil || created["title"].After != "Task" {
+		t.Errorf("unexpected creation diff: %+v", created["title"])
+	}
+	if _, ok := created["updated_at"]; ok {
+		t.Errorf("updated_at should not be recorded")
+	}
+	if _, ok := created["version"]; ok {
+		t.Errorf("version should not be recorded")
+	}
+
+	deleted := changesByField(recorded[1].Changes)
+	if deleted["title"].Before != "Task" || deleted["title"].After != nil {
+		t.Errorf("unexpected deletion diff: %+v", deleted["title"])
+	}
+}
+
+// TestHistoryService_ListHistory_NormalizesEventTypes verifies short event names get the source prefix
+func TestHistoryService_ListHistory_NormalizesEventTypes(t *testing.T) {
+	var gotFilters entities.EntityEventFilters
+	repo := &mocks.MockEntityEventRepository{
+		ListEntityEventsFunc: func(ctx context.Context, filters entities.EntityEventFilters) ([]*entities.EntityEvent, error) {
+			gotFilters = filters
+			return []*entities.EntityEvent{}, nil
+		},
+	}
+	service := application.NewHistoryApplicationService(repo, "alice")
+
+	_, err := service.ListHistory(context.Background(), entities.EntityEventFilters{
+		EntityID:   "TM-task-1",
+		EventTypes: []string{"task.created", events.EventTaskUpdated},
+	})
+	if err != nil {
+		t.Fatalf("ListHistory failed: %v", err)
+	}
+
+	want := []string{events.EventTaskCreated, events.EventTaskUpdated}
+	if len(gotFilters.EventTypes) != len(want) || gotFilters.EventTypes[0] != want[0] || gotFilters.EventTypes[1] != want[1] {
+		t.Errorf("event types = %v, want %v", gotFilters.EventTypes, want)
+	}
+	if gotFilters.EntityID != "TM-task-1" {
+		t.Errorf("entity ID filter not passed through")
+	}
+}
+
+// TestHistoryService_RecordEvent_SkipsDerived verifies a mutation announced by several events is recorded once
+func TestHistoryService_RecordEvent_SkipsDerived(t *testing.T) {
+	var recorded []*entities.EntityEvent
+	service := newRecordingHistoryService(&recorded)
+
+	now := time.Now().UTC()
+	before, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "review", 100, "", now, now)
+	after := *before
+	after.Status = "done"
+
+	updated := events.NewEvent(events.EventTaskUpdated, events.EntityTypeTask, before.ID, &after, before)
+	completed := events.NewEvent(events.EventTaskCompleted, events.EntityTypeTask, before.ID, &after, before)
+	completed.Derived = true
+	for _, event := range []events.Event{updated, completed} {
+		if err := service.RecordEvent(context.Background(), event); err != nil {
+			t.Fatalf("RecordEvent failed: %v", err)
+		}
+	}
+
+	if len(recorded) != 1 || recorded[0].EventType != events.EventTaskUpdated {
+		t.Fatalf("expected only task.updated to be recorded, got %d records", len(recorded))
+	}
+}
+
+// TestHistoryService_ListHistory_DerivedEventTypes verifies derived types select the updates that changed the status
+func TestHistoryService_ListHistory_DerivedEventTypes(t *testing.T) {
+	stored := []*entities.EntityEvent{
+		{ID: 1, EventType: events.EventTaskUpdated, Changes: []entities.FieldChange{{Field: "title", Before: "Old", After: "New"}}},
+		{ID: 2, EventType: events.EventTaskUpdated, Changes: []entities.FieldChange{{Field: "status", Before: "todo", After: "in-progress"}}},
+		{ID: 3, EventType: events.EventTaskUpdated, Changes: []entities.FieldChange{{Field: "status", Before: "review", After: "done"}}},
+		{ID: 4, EventType: events.EventTaskCreated},
+	}
+	var gotFilters entities.EntityEventFilters
+	repo := &mocks.MockEntityEventRepository{
+		ListEntityEventsFunc: func(ctx context.Context, filters entities.EntityEventFilters) ([]*entities.EntityEvent, error) {
+			gotFilters = filters
+			return stored, nil
+		},
+	}
+	service := application.NewHistoryApplicationService(repo, "alice")
+
+	tests := []struct {
+		eventTypes []string
+		want       []int64
+	}{
+		{[]string{"task.status_changed"}, []int64{2, 3}},
+		{[]string{"task.completed"}, []int64{3}},
+		{[]string{"task.completed", "task.created"}, []int64{3, 4}},
+	}
+	for _, tt := range tests {
+		history, err := service.ListHistory(context.Background(), entities.EntityEventFilters{EventTypes: tt.eventTypes})
+		if err != nil {
+			t.Fatalf("ListHistory failed: %v", err)
+		}
+		if gotFilters.EventTypes[0] != events.EventTaskUpdated {
+			t.Errorf("%v: expected task.updated to be queried, got %v", tt.eventTypes, gotFilters.EventTypes)
+		}
+		var got []int64
+		for _, event := range history {
+			got = append(got, event.ID)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("%v: got entries %v, want %v", tt.eventTypes, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// IterationPlanApplicationService proposes iteration scope from the backlog.
+type IterationPlanApplicationService struct {
+	iterationRepo     repositories.IterationRepository
+	taskRepo          repositories.TaskRepository
+	roadmapRepo       repositories.RoadmapRepository
+	trackRepo         repositories.TrackRepository
+	dependencyService *services.DependencyService
+	iterationService  *IterationApplicationService
+}
+
+// NewIterationPlanApplicationService creates a new iteration plan application service.
+// Proposals are applied through iterationService so they publish the usual events.
+func NewIterationPlanApplicationService(
+	iterationRepo repositories.IterationRepository,
+	taskRepo repositories.TaskRepository,
+	roadmapRepo repositories.RoadmapRepository,
+	trackRepo repositories.TrackRepository,
+	dependencyService *services.DependencyService,
+	iterationService *IterationApplicationService,
+) *IterationPlanApplicationService {
+	return &IterationPlanApplicationService{
+		iterationRepo:     iterationRepo,
+		taskRepo:          taskRepo,
+		roadmapRepo:       roadmapRepo,
+		trackRepo:         trackRepo,
+		dependencyService: dependencyService,
+		iterationService:  iterationService,
+	}
+}
+
+// PlanIteration fills the free capacity of an iteration with backlog tasks. Tasks are picked
+// in track dependency order (tracks whose unfinished dependencies come first), then by track
+// rank, then by task rank. Tasks of blocked tracks are skipped, and so are cancelled tasks.
+// With input.Apply the proposed tasks are added to the iteration in a single update.
+func (s *IterationPlanApplicationService) PlanIteration(ctx context.Context, input dto.PlanIterationDTO) (*dto.IterationPlanDTO, error) {
+	if input.Capacity < 1 {
+		return nil, fmt.Errorf("%w: capacity must be positive", tmerrors.ErrInvalidArgument)
+	}
+
+	iteration, err := s.iterationRepo.GetIteration(ctx, input.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iteration: %w", err)
+	}
+	if iteration.Status == string(entities.IterationStatusComplete) {
+		return nil, fmt.Errorf("%w: iteration %d is complete", tmerrors.ErrInvalidArgument, iteration.Number)
+	}
+
+	existing, err := s.iterationRepo.GetIterationTasks(ctx, iteration.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iteration tasks: %w", err)
+	}
+	backlog, err := s.taskRepo.GetBacklogTasks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backlog tasks: %w", err)
+	}
+	tracks, order, err := s.trackOrder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &dto.IterationPlanDTO{
+		IterationNumber: iteration.Number,
+		IterationName:   iteration.Name,
+		Capacity:        input.Capacity,
+		Existing:        make([]*dto.IterationPlanTaskDTO, 0, len(existing)),
+		Proposed:        []*dto.IterationPlanTaskDTO{},
+		Skipped:         []*dto.IterationPlanSkipDTO{},
+	}
+	for _, task := range existing {
+		plan.Existing = append(plan.Existing, iterationPlanTask(task))
+	}
+
+	var candidates []*entities.TaskEntity
+	for _, task := range backlog {
+		if task.Status == string(entities.TaskStatusCancelled) {
+			continue // Cancelled work is not scope
+		}
+		if track := tracks[task.TrackID]; track != nil && track.Status == string(entities.TrackStatusBlocked) {
+			plan.Skipped = append(plan.Skipped, &dto.IterationPlanSkipDTO{
+				ID:      task.ID,
+				Title:   task.Title,
+				TrackID: task.TrackID,
+				Reason:  fmt.Sprintf("track %s is blocked", task.TrackID),
+			})
+			continue
+		}
+		candidates = append(candidates, task)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if orderOf(order, a.TrackID) != orderOf(order, b.TrackID) {
+			return orderOf(order, a.TrackID) < orderOf(order, b.TrackID)
+		}
+		if a.Rank != b.Rank {
+			return a.Rank < b.Rank
+		}
+		return a.ID < b.ID
+	})
+	sort.Slice(plan.Skipped, func(i, j int) bool { return plan.Skipped[i].ID < plan.Skipped[j].ID })
+
+	free := input.Capacity - len(existing)
+	for _, task := range candidates {
+		if len(plan.Proposed) >= free {
+			plan.Unscheduled++
+			continue
+		}
+		plan.Proposed = append(plan.Proposed, iterationPlanTask(task))
+	}
+	if plan.Remaining = free - len(plan.Proposed); plan.Remaining < 0 {
+		plan.Remaining = 0
+	}
+
+	if input.Apply && len(plan.Proposed) > 0 {
+		ids := make([]string, 0, len(plan.Proposed))
+		for _, task := range plan.Proposed {
+			ids = append(ids, task.ID)
+		}
+		if err := s.iterationService.AddTasks(ctx, iteration.Number, ids); err != nil {
+			return nil, fmt.Errorf("failed to apply iteration plan: %w", err)
+		}
+		plan.Applied = true
+	}
+
+	return plan, nil
+}
+
+// trackOrder returns the tracks of the active roadmap by ID with their pick order:
+// dependency waves first, then track rank, then ID. Dependencies on complete tracks
+// do not hold a track back. Without a roadmap there are no tracks to order by.
+func (s *IterationPlanApplicationService) trackOrder(ctx context.Context) (map[string]*entities.TrackEntity, map[string]int, error) {
+	tracks := make(map[string]*entities.TrackEntity)
+	order := make(map[string]int)
+
+	roadmap, err := s.roadmapRepo.GetActiveRoadmap(ctx)
+	if err != nil {
+		if errors.Is(err, tmerrors.ErrNotFound) {
+			return tracks, order, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+	list, err := s.trackRepo.ListTracks(ctx, roadmap.ID, entities.TrackFilters{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list tracks: %w", err)
+	}
+
+	ids := make([]string, 0, len(list))
+	for _, track := range list {
+		tracks[track.ID] = track
+		ids = append(ids, track.ID)
+	}
+	waves, err := s.dependencyService.TopologicalWaves(ctx, ids, func(ctx context.Context, id string) ([]string, error) {
+		var open []string
+		for _, dep := range tracks[id].Dependencies {
+			if dependency := tracks[dep]; dependency != nil && dependency.Status != string(entities.TrackStatusComplete) {
+				open = append(open, dep)
+			}
+		}
+		return open, nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to order tracks: %w", err)
+	}
+
+	for _, wave := range waves {
+		sort.Slice(wave, func(a, b int) bool {
+			if tracks[wave[a]].Rank != tracks[wave[b]].Rank {
+				return tracks[wave[a]].Rank < tracks[wave[b]].Rank
+			}
+			return wave[a] < wave[b]
+		})
+		for _, id := range wave {
+			order[id] = len(order)
+		}
+	}
+	return tracks, order, nil
+}
+
+// orderOf returns the pick order of a track; tasks of unknown tracks come last
+func orderOf(order map[string]int, trackID string) int {
+	if position, ok := order[trackID]; ok {
+		return position
+	}
+	return len(order)
+}
+
+// iterationPlanTask converts a task to its iteration plan form
+func iterationPlanTask(task *entities.TaskEntity) *dto.IterationPlanTaskDTO {
+	return &dto.IterationPlanTaskDTO{
+		ID:      task.ID,
+		Title:   task.Title,
+		Status:  task.Status,
+		Rank:    task.Rank,
+		TrackID: task.TrackID,
+	}
+}
@@ -0,0 +1,156 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupIterationPlanTest returns an iteration plan service over iteration 1, which holds TM-task-9,
+// and a backlog spread over these tracks:
+//
+//	TM-track-1 Core (in-progress, rank 300)
+//	TM-track-2 API  (not-started, rank 100) depends on Core
+//	TM-track-3 Ops  (blocked, rank 100)
+//	TM-track-4 Docs (not-started, rank 200) depends on TM-track-5, which is complete
+func setupIterationPlanTest(t *testing.T) (*application.IterationPlanApplicationService, *mocks.MockIterationRepository) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+
+	roadmapRepo := mocks.NewMockRoadmapRepository()
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "Plan iterations", "Scope is proposed", now, now)
+	require.NoError(t, roadmapRepo.SaveRoadmap(ctx, roadmap))
+	trackRepo := mocks.NewMockTrackRepository()
+	for _, track := range []struct {
+		id, status string
+		rank       int
+		deps       []string
+	}{
+		{"TM-track-1", "in-progress", 300, nil},
+		{"TM-track-2", "not-started", 100, []string{"TM-track-1"}},
+		{"TM-track-3", "blocked", 100, nil},
+		{"TM-track-4", "not-started", 200, []string{"TM-track-5"}},
+		{"TM-track-5", "complete", 500, nil},
+	} {
+		entity, err := entities.NewTrackEntity(track.id, roadmap.ID, "Track "+track.id, "", track.status, track.rank, track.deps, now, now)
+		require.NoError(t, err)
+		require.NoError(t, trackRepo.SaveTrack(ctx, entity))
+	}
+
+	taskRepo := mocks.NewMockTaskRepository()
+	var backlog []*entities.TaskEntity
+	for _, task := range []struct {
+		id, trackID, status string
+		rank                int
+	}{
+		{"TM-task-1", "TM-track-2", "todo", 10},
+		{"TM-task-2", "TM-track-1", "todo", 500},
+		{"TM-task-3", "TM-track-1", "in-progress", 100},
+		{"TM-task-4", "TM-track-3", "todo", 1},
+		{"TM-task-5", "TM-track-4", "todo", 900},
+		{"TM-task-6", "TM-track-4", "cancelled", 100},
+		{"TM-task-9", "TM-track-1", "todo", 100},
+	} {
+		entity, err := entities.NewTaskEntity(task.id, task.trackID, "Task "+task.id, "", task.status, task.rank, "", now, now)
+		require.NoError(t, err)
+		require.NoError(t, taskRepo.SaveTask(ctx, entity))
+		if task.id != "TM-task-9" {
+			backlog = append(backlog, entity)
+		}
+	}
+	taskRepo.GetBacklogTasksFunc = func(ctx context.Context) ([]*entities.TaskEntity, error) {
+		return backlog, nil
+	}
+
+	iterationRepo := mocks.NewMockIterationRepository()
+	iteration, err := entities.NewIterationEntity(1, "Sprint 1", "Ship", "Release", []string{"TM-task-9"}, "planned", 500, time.Time{}, time.Time{}, now, now)
+	require.NoError(t, err)
+	require.NoError(t, iterationRepo.SaveIteration(ctx, iteration))
+	iterationRepo.GetIterationTasksFunc = func(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, error) {
+		task, _ := taskRepo.GetTask(ctx, "TM-task-9")
+		return []*entities.TaskEntity{task}, nil
+	}
+
+	iterationService := application.NewIterationApplicationService(iterationRepo, taskRepo, &mocks.MockAcceptanceCriteriaRepository{}, &mocks.MockDocumentRepository{}, &mocks.MockAggregateRepository{}, services.NewIterationService(), services.NewValidationService(), nil, nil, nil, nil)
+	service := application.NewIterationPlanApplicationService(iterationRepo, taskRepo, roadmapRepo, trackRepo, services.NewDependencyService(), iterationService)
+	return service, iterationRepo
+}
+
+// TestIterationPlanApplicationService_PlanIteration verifies pick order, skipped tasks and capacity
+func TestIterationPlanApplicationService_PlanIteration(t *testing.T) {
+	service, _ := setupIterationPlanTest(t)
+
+	plan, err := service.PlanIteration(context.Background(), dto.PlanIterationDTO{Number: 1, Capacity: 4})
+	require.NoError(t, err)
+
+	require.Len(t, plan.Existing, 1)
+	assert.Equal(t, "TM-task-9", plan.Existing[0].ID)
+	// Docs and Core are free to start (Docs ranks higher); API waits for Core
+	assert.Equal(t, []string{"TM-task-5", "TM-task-3", "TM-task-2"}, iterationPlanIDs(plan.Proposed))
+	require.Len(t, plan.Skipped, 1)
+	assert.Equal(t, "TM-task-4", plan.Skipped[0].ID)
+	assert.Equal(t, "track TM-track-3 is blocked", plan.Skipped[0].Reason)
+	assert.Equal(t, 1, plan.Unscheduled, "API's task does not fit; the cancelled task is ignored")
+	assert.Zero(t, plan.Remaining)
+	assert.False(t, plan.Applied)
+
+	plan, err = service.PlanIteration(context.Background(), dto.PlanIterationDTO{Number: 1, Capacity: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TM-task-5", "TM-task-3", "TM-task-2", "TM-task-1"}, iterationPlanIDs(plan.Proposed))
+	assert.Equal(t, 5, plan.Remaining)
+}
+
+// TestIterationPlanApplicationService_Apply verifies that the proposal is written in one update
+func TestIterationPlanApplicationService_Apply(t *testing.T) {
+	service, iterationRepo := setupIterationPlanTest(t)
+	var updates []*entities.IterationEntity
+	iterationRepo.UpdateIterationFunc = func(ctx context.Context, iteration *entities.IterationEntity) error {
+		updates = append(updates, iteration)
+		return nil
+	}
+	iterationRepo.AddTaskToIterationFunc = func(ctx context.Context, iterationNum int, taskID string) error {
+		t.Errorf("tasks should not be added one at a time")
+		return nil
+	}
+
+	plan, err := service.PlanIteration(context.Background(), dto.PlanIterationDTO{Number: 1, Capacity: 3, Apply: true})
+	require.NoError(t, err)
+
+	assert.True(t, plan.Applied)
+	require.Len(t, updates, 1)
+	assert.Equal(t, []string{"TM-task-9", "TM-task-5", "TM-task-3"}, updates[0].TaskIDs)
+}
+
+// TestIterationPlanApplicationService_Errors verifies the rejected inputs
+func TestIterationPlanApplicationService_Errors(t *testing.T) {
+	service, iterationRepo := setupIterationPlanTest(t)
+	ctx := context.Background()
+
+	_, err := service.PlanIteration(ctx, dto.PlanIterationDTO{Number: 1, Capacity: 0})
+	assert.True(t, errors.Is(err, tmerrors.ErrInvalidArgument), "capacity must be positive")
+
+	iteration, _ := iterationRepo.GetIteration(ctx, 1)
+	iteration.Status = string(entities.IterationStatusComplete)
+	_, err = service.PlanIteration(ctx, dto.PlanIterationDTO{Number: 1, Capacity: 5})
+	assert.True(t, errors.Is(err, tmerrors.ErrInvalidArgument), "complete iterations cannot be planned")
+}
+
+// iterationPlanIDs returns the IDs of plan tasks in order
+func iterationPlanIDs(tasks []*dto.IterationPlanTaskDTO) []string {
+	ids := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
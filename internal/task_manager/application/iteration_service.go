@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
 	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
 )
@@ -18,25 +20,47 @@ import (
 type IterationApplicationService struct {
 	iterationRepo     repositories.IterationRepository
 	taskRepo          repositories.TaskRepository
+	acRepo            repositories.AcceptanceCriteriaRepository
+	documentRepo      repositories.DocumentRepository
 	aggregateRepo     repositories.AggregateRepository
 	iterationService  *services.IterationService
 	validationService *services.ValidationService
+	policy            entities.IterationCompletionPolicy
+	eventBus          events.EventBus
+	guard             events.TransitionGuard
+	snapshots         *SnapshotApplicationService
 }
 
 // NewIterationApplicationService creates a new iteration application service.
+// eventBus may be nil, in which case no domain events are published.
+// policy may be nil, in which case the default completion policy applies.
+// guard may be nil, in which case lifecycle transitions are never vetoed.
+// snapshots may be nil, in which case no snapshot is taken before an iteration is deleted.
 func NewIterationApplicationService(
 	iterationRepo repositories.IterationRepository,
 	taskRepo repositories.TaskRepository,
+	acRepo repositories.AcceptanceCriteriaRepository,
+	documentRepo repositories.DocumentRepository,
 	aggregateRepo repositories.AggregateRepository,
 	iterationService *services.IterationService,
 	validationService *services.ValidationService,
+	policy entities.IterationCompletionPolicy,
+	eventBus events.EventBus,
+	guard events.TransitionGuard,
+	snapshots *SnapshotApplicationService,
 ) *IterationApplicationService {
 	return &IterationApplicationService{
 		iterationRepo:     iterationRepo,
 		taskRepo:          taskRepo,
+		acRepo:            acRepo,
+		documentRepo:      documentRepo,
 		aggregateRepo:     aggregateRepo,
 		iterationService:  iterationService,
 		validationService: validationService,
+		policy:            policy,
+		eventBus:          eventBus,
+		guard:             guard,
+		snapshots:         snapshots,
 	}
 }
 
@@ -112,12 +136,17 @@ func (s *IterationApplicationService) CreateIteration(ctx context.Context, input
 	if err != nil {
 		return nil, fmt.Errorf("failed to create iteration entity: %w", err)
 	}
+	if err := iteration.SetCapacity(input.Capacity); err != nil {
+		return nil, err
+	}
 
 	// Persist iteration
 	if err := s.iterationRepo.SaveIteration(ctx, iteration); err != nil {
 		return nil, fmt.Errorf("failed to save iteration: %w", err)
 	}
 
+	s.publish(ctx, events.EventIterationCreated, iteration, nil)
+
 	return iteration, nil
 }
 
@@ -134,6 +163,10 @@ func (s *IterationApplicationService) UpdateIteration(ctx context.Context, input
 	if err != nil {
 		return nil, fmt.Errorf("failed to get iteration: %w", err)
 	}
+	if err := checkVersion("iteration", strconv.Itoa(iteration.Number), iteration.Version, input.Version); err != nil {
+		return nil, err
+	}
+	previous := cloneIteration(iteration)
 
 	// Apply updates
 	if input.Name != nil {
@@ -151,6 +184,20 @@ func (s *IterationApplicationService) UpdateIteration(ctx context.Context, input
 		iteration.Deliverable = *input.Deliverable
 	}
 
+	if input.Capacity != nil {
+		capacity := input.Capacity
+		if *capacity == 0 {
+			capacity = nil
+		}
+		if err := iteration.SetCapacity(capacity); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Rank != nil {
+		iteration.Rank = *input.Rank
+	}
+
 	iteration.UpdatedAt = time.Now().UTC()
 
 	// Persist changes
@@ -158,28 +205,45 @@ func (s *IterationApplicationService) UpdateIteration(ctx context.Context, input
 		return nil, fmt.Errorf("failed to update iteration: %w", err)
 	}
 
+	s.publish(ctx, events.EventIterationUpdated, iteration, previous)
+
 	return iteration, nil
 }
 
-// DeleteIteration removes an iteration from storage.
-func (s *IterationApplicationService) DeleteIteration(ctx context.Context, iterationNum int) error {
+// DeleteIteration removes an iteration from storage, snapshotting the project first.
+// Returns the snapshot, or nil if automatic snapshots are disabled.
+func (s *IterationApplicationService) DeleteIteration(ctx context.Context, iterationNum int) (*entities.ProjectSnapshot, error) {
 	// Validate iteration number
 	if err := s.validationService.ValidateIterationNumber(iterationNum); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Verify iteration exists
-	_, err := s.iterationRepo.GetIteration(ctx, iterationNum)
+	iteration, err := s.iterationRepo.GetIteration(ctx, iterationNum)
 	if err != nil {
-		return fmt.Errorf("failed to get iteration: %w", err)
+		return nil, fmt.Errorf("failed to get iteration: %w", err)
+	}
+
+	// The database deletes the documents attached to the iteration with it
+	cascade, err := iterationCascade(ctx, s.documentRepo, iterationNum)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := snapshotBeforeDelete(ctx, s.snapshots, entities.SnapshotReasonIterationDelete)
+	if err != nil {
+		return nil, err
 	}
 
 	// Delete iteration
 	if err := s.iterationRepo.DeleteIteration(ctx, iterationNum); err != nil {
-		return fmt.Errorf("failed to delete iteration: %w", err)
+		return snapshot, fmt.Errorf("failed to delete iteration: %w", err)
 	}
 
-	return nil
+	publishCascade(ctx, s.eventBus, cascade)
+	s.publish(ctx, events.EventIterationDeleted, iteration, iteration)
+
+	return snapshot, nil
 }
 
 // ============================================================================
@@ -204,22 +268,29 @@ func (s *IterationApplicationService) StartIteration(ctx context.Context, iterat
 	if err := s.iterationService.CanStartIteration(ctx, iteration, s.iterationRepo.GetCurrentIteration); err != nil {
 		return err
 	}
+	previous := cloneIteration(iteration)
 
 	// Transition to current status
 	if err := iteration.TransitionTo(string(entities.IterationStatusCurrent)); err != nil {
 		return fmt.Errorf("failed to transition iteration: %w", err)
 	}
+	if err := s.guardTransition(ctx, events.EventIterationStarted, iteration, previous); err != nil {
+		return err
+	}
 
 	// Persist changes
 	if err := s.iterationRepo.UpdateIteration(ctx, iteration); err != nil {
 		return fmt.Errorf("failed to update iteration: %w", err)
 	}
 
+	s.publish(ctx, events.EventIterationStarted, iteration, previous)
+
 	return nil
 }
 
 // CompleteIteration transitions an iteration from "current" to "complete".
-func (s *IterationApplicationService) CompleteIteration(ctx context.Context, iterationNum int) error {
+// Unless force is set, completion is rejected while validation reports errors under the completion policy.
+func (s *IterationApplicationService) CompleteIteration(ctx context.Context, iterationNum int, force bool) error {
 	// Validate iteration number
 	if err := s.validationService.ValidateIterationNumber(iterationNum); err != nil {
 		return err
@@ -236,16 +307,34 @@ func (s *IterationApplicationService) CompleteIteration(ctx context.Context, ite
 		return err
 	}
 
+	// Check the completion policy
+	if !force {
+		result, err := s.validateIteration(ctx, iteration)
+		if err != nil {
+			return err
+		}
+		if problems := result.Errors(); len(problems) > 0 {
+			return fmt.Errorf("%w: iteration %d has %d blocking problem(s) (first: %s %s); run 'tm iteration validate %d' for details or complete with --force",
+				tmerrors.ErrRejected, iteration.Number, len(problems), problems[0].EntityID, problems[0].Message, iteration.Number)
+		}
+	}
+	previous := cloneIteration(iteration)
+
 	// Transition to complete status
 	if err := iteration.TransitionTo(string(entities.IterationStatusComplete)); err != nil {
 		return fmt.Errorf("failed to transition iteration: %w", err)
 	}
+	if err := s.guardTransition(ctx, events.EventIterationCompleted, iteration, previous); err != nil {
+		return err
+	}
 
 	// Persist changes
 	if err := s.iterationRepo.UpdateIteration(ctx, iteration); err != nil {
 		return fmt.Errorf("failed to update iteration: %w", err)
 	}
 
+	s.publish(ctx, events.EventIterationCompleted, iteration, previous)
+
 	return nil
 }
 
@@ -264,6 +353,7 @@ func (s *IterationApplicationService) RevertIteration(ctx context.Context, itera
 	}
 
 	// Revert to planned status
+	previous := cloneIteration(iteration)
 	if err := iteration.Revert(); err != nil {
 		return fmt.Errorf("failed to revert iteration: %w", err)
 	}
@@ -273,6 +363,8 @@ func (s *IterationApplicationService) RevertIteration(ctx context.Context, itera
 		return fmt.Errorf("failed to update iteration: %w", err)
 	}
 
+	s.publish(ctx, events.EventIterationReverted, iteration, previous)
+
 	return nil
 }
 
@@ -288,7 +380,7 @@ func (s *IterationApplicationService) AddTask(ctx context.Context, iterationNum
 	}
 
 	// Verify iteration exists
-	_, err := s.iterationRepo.GetIteration(ctx, iterationNum)
+	iteration, err := s.iterationRepo.GetIteration(ctx, iterationNum)
 	if err != nil {
 		return fmt.Errorf("failed to get iteration: %w", err)
 	}
@@ -307,6 +399,50 @@ func (s *IterationApplicationService) AddTask(ctx context.Context, iterationNum
 		return fmt.Errorf("failed to add task to iteration: %w", err)
 	}
 
+	updated := cloneIteration(iteration)
+	_ = updated.AddTask(taskID)
+	s.publish(ctx, events.EventIterationUpdated, updated, iteration)
+
+	return nil
+}
+
+// AddTasks adds several tasks to an iteration in a single update: either all of them are added or none.
+func (s *IterationApplicationService) AddTasks(ctx context.Context, iterationNum int, taskIDs []string) error {
+	// Validate iteration number
+	if err := s.validationService.ValidateIterationNumber(iterationNum); err != nil {
+		return err
+	}
+
+	// Verify iteration exists
+	iteration, err := s.iterationRepo.GetIteration(ctx, iterationNum)
+	if err != nil {
+		return fmt.Errorf("failed to get iteration: %w", err)
+	}
+
+	updated := cloneIteration(iteration)
+	for _, taskID := range taskIDs {
+		// Verify task exists
+		if _, err := s.taskRepo.GetTask(ctx, taskID); err != nil {
+			if errors.Is(err, tmerrors.ErrNotFound) {
+				return fmt.Errorf("%w: task %s not found", tmerrors.ErrNotFound, taskID)
+			}
+			return fmt.Errorf("failed to get task: %w", err)
+		}
+		if err := updated.AddTask(taskID); err != nil {
+			return fmt.Errorf("failed to add task %s to iteration: %w", taskID, err)
+		}
+	}
+	if len(updated.TaskIDs) == len(iteration.TaskIDs) {
+		return nil
+	}
+
+	// The iteration and its task list are written in one transaction
+	updated.UpdatedAt = time.Now().UTC()
+	if err := s.iterationRepo.UpdateIteration(ctx, updated); err != nil {
+		return fmt.Errorf("failed to add tasks to iteration: %w", err)
+	}
+	s.publish(ctx, events.EventIterationUpdated, updated, iteration)
+
 	return nil
 }
 
@@ -318,7 +454,7 @@ func (s *IterationApplicationService) RemoveTask(ctx context.Context, iterationN
 	}
 
 	// Verify iteration exists
-	_, err := s.iterationRepo.GetIteration(ctx, iterationNum)
+	iteration, err := s.iterationRepo.GetIteration(ctx, iterationNum)
 	if err != nil {
 		return fmt.Errorf("failed to get iteration: %w", err)
 	}
@@ -328,13 +464,56 @@ func (s *IterationApplicationService) RemoveTask(ctx context.Context, iterationN
 		return fmt.Errorf("failed to remove task from iteration: %w", err)
 	}
 
+	updated := cloneIteration(iteration)
+	_ = updated.RemoveTask(taskID)
+	s.publish(ctx, events.EventIterationUpdated, updated, iteration)
+
 	return nil
 }
 
+// publish announces an iteration event keyed by the iteration number.
+func (s *IterationApplicationService) publish(ctx context.Context, eventType string, iteration, previous *entities.IterationEntity) {
+	var prev interface{}
+	if previous != nil {
+		prev = previous
+	}
+	publishEvent(ctx, s.eventBus, eventType, events.EntityTypeIteration, strconv.Itoa(iteration.Number), iteration, prev)
+}
+
+// guardTransition asks the transition guard to approve an iteration lifecycle change.
+func (s *IterationApplicationService) guardTransition(ctx context.Context, eventType string, iteration, previous *entities.IterationEntity) error {
+	return guardTransition(ctx, s.guard, eventType, events.EntityTypeIteration, strconv.Itoa(iteration.Number), iteration, previous)
+}
+
 // ============================================================================
 // Read Operations
 // ============================================================================
 
+// ValidateIteration checks an iteration against the completion policy without changing it.
+func (s *IterationApplicationService) ValidateIteration(ctx context.Context, iterationNum int) (*entities.IterationValidationResult, error) {
+	iteration, err := s.GetIteration(ctx, iterationNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.validateIteration(ctx, iteration)
+}
+
+// validateIteration loads an iteration's tasks and ACs and runs the completion checks on them.
+func (s *IterationApplicationService) validateIteration(ctx context.Context, iteration *entities.IterationEntity) (*entities.IterationValidationResult, error) {
+	tasks, missingTaskIDs, err := s.iterationRepo.GetIterationTasksWithWarnings(ctx, iteration.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iteration tasks: %w", err)
+	}
+
+	acs, err := s.acRepo.ListACByIteration(ctx, iteration.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list acceptance criteria: %w", err)
+	}
+
+	return s.iterationService.ValidateCompletion(iteration, tasks, missingTaskIDs, acs, s.policy), nil
+}
+
 // GetIteration retrieves an iteration by its number.
 func (s *IterationApplicationService) GetIteration(ctx context.Context, iterationNum int) (*entities.IterationEntity, error) {
 	// Validate iteration number
@@ -2,6 +2,7 @@ package application_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -22,7 +23,7 @@ func setupIterationTestService(t *testing.T) (*application.IterationApplicationS
 	iterationService := services.NewIterationService()
 	validationService := services.NewValidationService()
 
-	service := application.NewIterationApplicationService(mockIterationRepo, mockTaskRepo, mockAggregateRepo, iterationService, validationService)
+	service := application.NewIterationApplicationService(mockIterationRepo, mockTaskRepo, &mocks.MockAcceptanceCriteriaRepository{}, &mocks.MockDocumentRepository{}, mockAggregateRepo, iterationService, validationService, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	return service, ctx, mockIterationRepo, mockTaskRepo, mockAggregateRepo, iterationService
@@ -370,7 +371,7 @@ func TestIterationService_DeleteIteration_Success(t *testing.T) {
 	}
 
 	// Delete iteration
-	err := service.DeleteIteration(ctx, 1)
+	_, err := service.DeleteIteration(ctx, 1)
 	if err != nil {
 		t.Fatalf("DeleteIteration() failed: %v", err)
 	}
@@ -393,7 +394,7 @@ func TestIterationService_DeleteIteration_NotFound(t *testing.T) {
 		return tmerrors.ErrNotFound
 	}
 
-	err := service.DeleteIteration(ctx, 999)
+	_, err := service.DeleteIteration(ctx, 999)
 	if err == nil {
 		t.Fatal("DeleteIteration() should fail for non-existent iteration")
 	}
@@ -563,7 +564,7 @@ func TestIterationService_CompleteIteration_Success(t *testing.T) {
 	}
 
 	// Complete iteration
-	err := service.CompleteIteration(ctx, 1)
+	err := service.CompleteIteration(ctx, 1, false)
 	if err != nil {
 		t.Fatalf("CompleteIteration() failed: %v", err)
 	}
@@ -589,7 +590,7 @@ func TestIterationService_CompleteIteration_NotFound(t *testing.T) {
 		return nil, tmerrors.ErrNotFound
 	}
 
-	err := service.CompleteIteration(ctx, 999)
+	err := service.CompleteIteration(ctx, 999, false)
 	if err == nil {
 		t.Fatal("CompleteIteration() should fail for non-existent iteration")
 	}
@@ -608,12 +609,89 @@ func TestIterationService_CompleteIteration_NotStarted(t *testing.T) {
 	}
 
 	// Try to complete without starting
-	err := service.CompleteIteration(ctx, 1)
+	err := service.CompleteIteration(ctx, 1, false)
 	if err == nil {
 		t.Fatal("CompleteIteration() should fail for non-started iteration")
 	}
 }
 
+func TestIterationService_CompleteIteration_RejectedByPolicy(t *testing.T) {
+	service, ctx, mockIterationRepo, _, _, _ := setupIterationTestService(t)
+
+	iteration := createTestIterationEntity(t, 1, "current")
+	task := createTestTaskEntity(t, "TM-task-1")
+
+	mockIterationRepo.GetIterationFunc = func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+		return iteration, nil
+	}
+	mockIterationRepo.GetIterationTasksWithWarningsFunc = func(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, []string, error) {
+		return []*entities.TaskEntity{task}, []string{}, nil
+	}
+	updated := false
+	mockIterationRepo.UpdateIterationFunc = func(ctx context.Context, iter *entities.IterationEntity) error {
+		updated = true
+		return nil
+	}
+
+	// A todo task blocks completion under the default policy
+	err := service.CompleteIteration(ctx, 1, false)
+	if !errors.Is(err, tmerrors.ErrRejected) {
+		t.Fatalf("CompleteIteration() error = %v, want ErrRejected", err)
+	}
+	if updated {
+		t.Error("rejected iteration should not be persisted")
+	}
+
+	// --force skips the policy
+	if err := service.CompleteIteration(ctx, 1, true); err != nil {
+		t.Fatalf("CompleteIteration(force) failed: %v", err)
+	}
+	if !updated {
+		t.Error("forced completion should persist the iteration")
+	}
+}
+
+func TestIterationService_ValidateIteration(t *testing.T) {
+	mockIterationRepo := &mocks.MockIterationRepository{}
+	mockACRepo := &mocks.MockAcceptanceCriteriaRepository{}
+	policy := entities.IterationCompletionPolicy{
+		entities.IterationCheckUnfinishedTasks: entities.CheckSeverityWarning,
+	}
+	service := application.NewIterationApplicationService(mockIterationRepo, &mocks.MockTaskRepository{}, mockACRepo, &mocks.MockDocumentRepository{}, &mocks.MockAggregateRepository{},
+		services.NewIterationService(), services.NewValidationService(), policy, nil, nil, nil)
+	ctx := context.Background()
+
+	iteration := createTestIterationEntity(t, 1, "current")
+	task := createTestTaskEntity(t, "TM-task-1")
+	now := time.Now().UTC()
+	ac := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "works", entities.VerificationTypeManual, "", now, now)
+	ac.Status = entities.ACStatusFailed
+
+	mockIterationRepo.GetIterationFunc = func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+		return iteration, nil
+	}
+	mockIterationRepo.GetIterationTasksWithWarningsFunc = func(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, []string, error) {
+		return []*entities.TaskEntity{task}, []string{"TM-task-gone"}, nil
+	}
+	mockACRepo.ListACByIterationFunc = func(ctx context.Context, iterationNum int) ([]*entities.AcceptanceCriteriaEntity, error) {
+		return []*entities.AcceptanceCriteriaEntity{ac}, nil
+	}
+
+	result, err := service.ValidateIteration(ctx, 1)
+	if err != nil {
+		t.Fatalf("ValidateIteration() failed: %v", err)
+	}
+	if len(result.Errors()) != 1 || result.Errors()[0].EntityID != "TM-ac-1" {
+		t.Errorf("Errors() = %+v, want only the failed AC", result.Errors())
+	}
+	if len(result.Warnings()) != 2 {
+		t.Errorf("Warnings() = %+v, want unfinished task and missing task", result.Warnings())
+	}
+	if result.CanComplete() {
+		t.Error("CanComplete() should be false with a failed AC")
+	}
+}
+
 // ============================================================================
 // Task Management Tests
 // ============================================================================
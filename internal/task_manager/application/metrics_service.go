@@ -0,0 +1,142 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// DefaultMetricsWeeks is the number of weeks throughput covers when none is given
+const DefaultMetricsWeeks = 8
+
+// MetricsApplicationService computes velocity, burndown, cycle time and throughput metrics
+// from iterations, tasks and the task status history.
+type MetricsApplicationService struct {
+	roadmapRepo    repositories.RoadmapRepository
+	trackRepo      repositories.TrackRepository
+	taskRepo       repositories.TaskRepository
+	iterationRepo  repositories.IterationRepository
+	metricsService *services.MetricsService
+}
+
+// NewMetricsApplicationService creates a new metrics application service
+func NewMetricsApplicationService(
+	roadmapRepo repositories.RoadmapRepository,
+	trackRepo repositories.TrackRepository,
+	taskRepo repositories.TaskRepository,
+	iterationRepo repositories.IterationRepository,
+	metricsService *services.MetricsService,
+) *MetricsApplicationService {
+	return &MetricsApplicationService{
+		roadmapRepo:    roadmapRepo,
+		trackRepo:      trackRepo,
+		taskRepo:       taskRepo,
+		iterationRepo:  iterationRepo,
+		metricsService: metricsService,
+	}
+}
+
+// GetProjectMetrics computes velocity per started iteration, cycle time per done task, throughput per track
+// over the last weeks weeks, and the burndown of iteration iterationNum (the current iteration when 0).
+// Burndown is nil when iterationNum is 0 and no iteration is current.
+func (s *MetricsApplicationService) GetProjectMetrics(ctx context.Context, iterationNum int, weeks int) (*entities.ProjectMetrics, error) {
+	if iterationNum < 0 {
+		return nil, fmt.Errorf("%w: iteration number must be positive", tmerrors.ErrInvalidArgument)
+	}
+	if weeks < 1 {
+		weeks = DefaultMetricsWeeks
+	}
+	now := time.Now().UTC()
+
+	changes, err := s.taskRepo.ListTaskStatusChanges(ctx, entities.TaskStatusChangeFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task status history: %w", err)
+	}
+	history := services.GroupStatusChanges(changes)
+
+	// Velocity
+	iterations, err := s.iterationRepo.ListIterations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list iterations: %w", err)
+	}
+	tasksByIteration := make(map[int][]*entities.TaskEntity)
+	for _, iteration := range iterations {
+		if iteration.StartedAt == nil {
+			continue
+		}
+		tasks, err := s.iterationRepo.GetIterationTasks(ctx, iteration.Number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tasks of iteration %d: %w", iteration.Number, err)
+		}
+		tasksByIteration[iteration.Number] = tasks
+	}
+
+	// Burndown
+	var burndownIteration *entities.IterationEntity
+	if iterationNum > 0 {
+		burndownIteration, err = s.iterationRepo.GetIteration(ctx, iterationNum)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get iteration: %w", err)
+		}
+	} else {
+		burndownIteration, err = s.iterationRepo.GetCurrentIteration(ctx)
+		if err != nil && !errors.Is(err, tmerrors.ErrNotFound) {
+			return nil, fmt.Errorf("failed to get current iteration: %w", err)
+		}
+	}
+	var burndown *entities.IterationBurndown
+	if burndownIteration != nil {
+		tasks, ok := tasksByIteration[burndownIteration.Number]
+		if !ok {
+			tasks, err = s.iterationRepo.GetIterationTasks(ctx, burndownIteration.Number)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get tasks of iteration %d: %w", burndownIteration.Number, err)
+			}
+		}
+		burndown = s.metricsService.Burndown(burndownIteration, tasks, history, now)
+	}
+
+	// Cycle time and throughput
+	tasks, err := s.taskRepo.ListTasks(ctx, entities.TaskFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	tracks, err := s.listTracks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cycleTimes := s.metricsService.CycleTimes(tasks, history)
+
+	return &entities.ProjectMetrics{
+		GeneratedAt:      now,
+		Velocity:         s.metricsService.Velocity(iterations, tasksByIteration, history, now),
+		Burndown:         burndown,
+		CycleTimes:       cycleTimes,
+		CycleTimeSummary: s.metricsService.SummarizeCycleTimes(cycleTimes),
+		Throughput:       s.metricsService.Throughput(tracks, tasks, history, weeks, now),
+		Weeks:            weeks,
+	}, nil
+}
+
+// listTracks returns the tracks of the active roadmap, or none if no roadmap exists yet
+func (s *MetricsApplicationService) listTracks(ctx context.Context) ([]*entities.TrackEntity, error) {
+	roadmap, err := s.roadmapRepo.GetActiveRoadmap(ctx)
+	if err != nil {
+		if errors.Is(err, tmerrors.ErrNotFound) {
+			return []*entities.TrackEntity{}, nil
+		}
+		return nil, fmt.Errorf("failed to get active roadmap: %w", err)
+	}
+
+	tracks, err := s.trackRepo.ListTracks(ctx, roadmap.ID, entities.TrackFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracks: %w", err)
+	}
+	return tracks, nil
+}
@@ -0,0 +1,132 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// setupMetricsTestService builds a metrics service over a current iteration with one done and one todo task
+func setupMetricsTestService(t *testing.T) (*application.MetricsApplicationService, *mocks.MockIterationRepository, *mocks.MockRoadmapRepository) {
+	t.Helper()
+	start := time.Now().UTC().Add(-30 * time.Hour)
+
+	iteration := createTestIterationEntity(t, 1, "current")
+	iteration.StartedAt = &start
+
+	done, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Schema", "", "done", 100, "", start, start.Add(20*time.Hour))
+	todo, _ := entities.NewTaskEntity("TM-task-2", "TM-track-1", "API", "", "todo", 100, "", start, start)
+	track, _ := entities.NewTrackEntity("TM-track-1", "roadmap-1", "Core", "", "in-progress", 100, nil, start, start)
+
+	taskRepo := &mocks.MockTaskRepository{
+		ListTasksFunc: func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+			return []*entities.TaskEntity{done, todo}, nil
+		},
+		ListTaskStatusChangesFunc: func(ctx context.Context, filters entities.TaskStatusChangeFilters) ([]*entities.TaskStatusChange, error) {
+			return []*entities.TaskStatusChange{
+				{TaskID: "TM-task-1", ToStatus: "todo", ChangedAt: start},
+				{TaskID: "TM-task-2", ToStatus: "todo", ChangedAt: start},
+				{TaskID: "TM-task-1", FromStatus: "todo", ToStatus: "in-progress", ChangedAt: start.Add(8 * time.Hour)},
+				{TaskID: "TM-task-1", FromStatus: "in-progress", ToStatus: "done", ChangedAt: start.Add(20 * time.Hour)},
+			}, nil
+		},
+	}
+	iterationRepo := &mocks.MockIterationRepository{
+		ListIterationsFunc: func(ctx context.Context) ([]*entities.IterationEntity, error) {
+			return []*entities.IterationEntity{iteration}, nil
+		},
+		GetCurrentIterationFunc: func(ctx context.Context) (*entities.IterationEntity, error) {
+			return iteration, nil
+		},
+		GetIterationTasksFunc: func(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, error) {
+			return []*entities.TaskEntity{done, todo}, nil
+		},
+	}
+	roadmapRepo := &mocks.MockRoadmapRepository{
+		GetActiveRoadmapFunc: func(ctx context.Context) (*entities.RoadmapEntity, error) {
+			return &entities.RoadmapEntity{ID: "roadmap-1"}, nil
+		},
+	}
+	trackRepo := &mocks.MockTrackRepository{
+		ListTracksFunc: func(ctx context.Context, roadmapID string, filters entities.TrackFilters) ([]*entities.TrackEntity, error) {
+			return []*entities.TrackEntity{track}, nil
+		},
+	}
+
+	service := application.NewMetricsApplicationService(roadmapRepo, trackRepo, taskRepo, iterationRepo, services.NewMetricsService())
+	return service, iterationRepo, roadmapRepo
+}
+
+// TestMetricsService_GetProjectMetrics verifies metrics are computed for the current iteration by default
+func TestMetricsService_GetProjectMetrics(t *testing.T) {
+	service, _, _ := setupMetricsTestService(t)
+
+	metrics, err := service.GetProjectMetrics(context.Background(), 0, 0)
+	if err != nil {
+		t.Fatalf("GetProjectMetrics failed: %v", err)
+	}
+
+	if len(metrics.Velocity) != 1 || metrics.Velocity[0].CompletedTasks != 1 || metrics.Velocity[0].PlannedTasks != 2 {
+		t.Errorf("unexpected velocity: %+v", metrics.Velocity)
+	}
+	if metrics.Burndown == nil || metrics.Burndown.IterationNumber != 1 {
+		t.Fatalf("expected burndown of iteration 1, got %+v", metrics.Burndown)
+	}
+	last := metrics.Burndown.Points[len(metrics.Burndown.Points)-1]
+	if last.Remaining != 1 || last.Completed != 1 {
+		t.Errorf("unexpected last burndown point: %+v", last)
+	}
+	if len(metrics.CycleTimes) != 1 || metrics.CycleTimes[0].Hours != 12 {
+		t.Errorf("expected one 12h cycle time, got %+v", metrics.CycleTimes)
+	}
+	if metrics.Weeks != application.DefaultMetricsWeeks {
+		t.Errorf("Weeks = %d, want default %d", metrics.Weeks, application.DefaultMetricsWeeks)
+	}
+	if len(metrics.Throughput) != 1 || metrics.Throughput[0].Total != 1 || len(metrics.Throughput[0].Weekly) != application.DefaultMetricsWeeks {
+		t.Errorf("unexpected throughput: %+v", metrics.Throughput)
+	}
+}
+
+// TestMetricsService_GetProjectMetrics_NoCurrentIteration verifies metrics without a current iteration or roadmap
+func TestMetricsService_GetProjectMetrics_NoCurrentIteration(t *testing.T) {
+	service, iterationRepo, roadmapRepo := setupMetricsTestService(t)
+	iterationRepo.GetCurrentIterationFunc = func(ctx context.Context) (*entities.IterationEntity, error) {
+		return nil, tmerrors.ErrNotFound
+	}
+	roadmapRepo.GetActiveRoadmapFunc = nil
+
+	metrics, err := service.GetProjectMetrics(context.Background(), 0, 4)
+	if err != nil {
+		t.Fatalf("GetProjectMetrics failed: %v", err)
+	}
+	if metrics.Burndown != nil {
+		t.Errorf("expected no burndown, got %+v", metrics.Burndown)
+	}
+	if len(metrics.Throughput) != 0 || metrics.Weeks != 4 {
+		t.Errorf("expected no throughput over 4 weeks, got %+v (weeks %d)", metrics.Throughput, metrics.Weeks)
+	}
+}
+
+// TestMetricsService_GetProjectMetrics_InvalidIteration verifies errors for bad and unknown iteration numbers
+func TestMetricsService_GetProjectMetrics_InvalidIteration(t *testing.T) {
+	service, iterationRepo, _ := setupMetricsTestService(t)
+	ctx := context.Background()
+
+	if _, err := service.GetProjectMetrics(ctx, -1, 0); !errors.Is(err, tmerrors.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument, got %v", err)
+	}
+
+	iterationRepo.GetIterationFunc = func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+		return nil, tmerrors.ErrNotFound
+	}
+	if _, err := service.GetProjectMetrics(ctx, 7, 0); !errors.Is(err, tmerrors.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
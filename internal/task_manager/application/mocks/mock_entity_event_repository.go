@@ -0,0 +1,29 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// MockEntityEventRepository is a mock implementation of EntityEventRepository for testing
+type MockEntityEventRepository struct {
+	AppendEntityEventFunc func(ctx context.Context, event *entities.EntityEvent) error
+	ListEntityEventsFunc  func(ctx context.Context, filters entities.EntityEventFilters) ([]*entities.EntityEvent, error)
+}
+
+// AppendEntityEvent implements EntityEventRepository.AppendEntityEvent
+func (m *MockEntityEventRepository) AppendEntityEvent(ctx context.Context, event *entities.EntityEvent) error {
+	if m.AppendEntityEventFunc != nil {
+		return m.AppendEntityEventFunc(ctx, event)
+	}
+	return nil
+}
+
+// ListEntityEvents implements EntityEventRepository.ListEntityEvents
+func (m *MockEntityEventRepository) ListEntityEvents(ctx context.Context, filters entities.EntityEventFilters) ([]*entities.EntityEvent, error) {
+	if m.ListEntityEventsFunc != nil {
+		return m.ListEntityEventsFunc(ctx, filters)
+	}
+	return nil, nil
+}
@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// MockIntegrityRepository is a mock implementation of IntegrityRepository for testing
+type MockIntegrityRepository struct {
+	CheckIntegrityFunc func(ctx context.Context, repair bool) ([]entities.IntegrityIssue, error)
+}
+
+// CheckIntegrity implements IntegrityRepository.CheckIntegrity
+func (m *MockIntegrityRepository) CheckIntegrity(ctx context.Context, repair bool) ([]entities.IntegrityIssue, error) {
+	if m.CheckIntegrityFunc != nil {
+		return m.CheckIntegrityFunc(ctx, repair)
+	}
+	return nil, nil
+}
@@ -1,9 +1,17 @@
 package mocks
 
+import (
+	"fmt"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+)
+
 // MockProjectManagementRepository is a mock implementation of repositories.ProjectManagementRepository for testing.
 type MockProjectManagementRepository struct {
 	// In-memory storage for testing
 	projects      map[string]map[string]string // project name → project info
+	projectData   map[string]*entities.ProjectData
 	activeProject string
 
 	// CreateProjectFunc is called by CreateProject. If nil, uses default implementation.
@@ -26,12 +34,22 @@ type MockProjectManagementRepository struct {
 
 	// ProjectExistsFunc is called by ProjectExists. If nil, checks projects map.
 	ProjectExistsFunc func(projectName string) (bool, error)
+
+	// GetProjectCodeFunc is called by GetProjectCode. If nil, returns the code from storage.
+	GetProjectCodeFunc func(projectName string) (string, error)
+
+	// ExportProjectDataFunc is called by ExportProjectData. If nil, returns the data stored for the project.
+	ExportProjectDataFunc func(projectName string) (*entities.ProjectData, error)
+
+	// ImportProjectDataFunc is called by ImportProjectData. If nil, creates the project and stores the data.
+	ImportProjectDataFunc func(projectName string, data *entities.ProjectData) error
 }
 
 // NewMockProjectManagementRepository creates a new mock project management repository
 func NewMockProjectManagementRepository() *MockProjectManagementRepository {
 	return &MockProjectManagementRepository{
 		projects:      make(map[string]map[string]string),
+		projectData:   make(map[string]*entities.ProjectData),
 		activeProject: "",
 	}
 }
@@ -92,6 +110,7 @@ func (m *MockProjectManagementRepository) DeleteProject(projectName string) erro
 	}
 	// Default implementation: delete from memory
 	delete(m.projects, projectName)
+	delete(m.projectData, projectName)
 	// Clear active project if it was deleted
 	if m.activeProject == projectName {
 		m.activeProject = ""
@@ -121,3 +140,50 @@ func (m *MockProjectManagementRepository) ProjectExists(projectName string) (boo
 	_, exists := m.projects[projectName]
 	return exists, nil
 }
+
+// GetProjectCode implements repositories.ProjectManagementRepository.
+func (m *MockProjectManagementRepository) GetProjectCode(projectName string) (string, error) {
+	if m.GetProjectCodeFunc != nil {
+		return m.GetProjectCodeFunc(projectName)
+	}
+	// Default implementation: return code from storage
+	info, exists := m.projects[projectName]
+	if !exists {
+		return "", fmt.Errorf("%w: project '%s' does not exist", tmerrors.ErrNotFound, projectName)
+	}
+	return info["code"], nil
+}
+
+// ExportProjectData implements repositories.ProjectManagementRepository.
+func (m *MockProjectManagementRepository) ExportProjectData(projectName string) (*entities.ProjectData, error) {
+	if m.ExportProjectDataFunc != nil {
+		return m.ExportProjectDataFunc(projectName)
+	}
+	// Default implementation: return stored data, or an empty project
+	info, exists := m.projects[projectName]
+	if !exists {
+		return nil, fmt.Errorf("%w: project '%s' does not exist", tmerrors.ErrNotFound, projectName)
+	}
+	if data, ok := m.projectData[projectName]; ok {
+		return data, nil
+	}
+	return &entities.ProjectData{Code: info["code"]}, nil
+}
+
+// ImportProjectData implements repositories.ProjectManagementRepository.
+func (m *MockProjectManagementRepository) ImportProjectData(projectName string, data *entities.ProjectData) error {
+	if m.ImportProjectDataFunc != nil {
+		return m.ImportProjectDataFunc(projectName, data)
+	}
+	// Default implementation: create project and store data in memory
+	if err := m.CreateProject(projectName, data.Code); err != nil {
+		return err
+	}
+	m.projectData[projectName] = data
+	return nil
+}
+
+// SetProjectData stores the data ExportProjectData returns for an existing project.
+func (m *MockProjectManagementRepository) SetProjectData(projectName string, data *entities.ProjectData) {
+	m.projectData[projectName] = data
+}
@@ -0,0 +1,29 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// MockSchemaRepository is a mock implementation of SchemaRepository for testing
+type MockSchemaRepository struct {
+	GetSchemaStatusFunc func(ctx context.Context) (*entities.SchemaStatus, error)
+	MigrateSchemaFunc   func(ctx context.Context, targetVersion int) (*entities.SchemaChange, error)
+}
+
+// GetSchemaStatus implements SchemaRepository.GetSchemaStatus
+func (m *MockSchemaRepository) GetSchemaStatus(ctx context.Context) (*entities.SchemaStatus, error) {
+	if m.GetSchemaStatusFunc != nil {
+		return m.GetSchemaStatusFunc(ctx)
+	}
+	return &entities.SchemaStatus{}, nil
+}
+
+// MigrateSchema implements SchemaRepository.MigrateSchema
+func (m *MockSchemaRepository) MigrateSchema(ctx context.Context, targetVersion int) (*entities.SchemaChange, error) {
+	if m.MigrateSchemaFunc != nil {
+		return m.MigrateSchemaFunc(ctx, targetVersion)
+	}
+	return &entities.SchemaChange{ToVersion: targetVersion}, nil
+}
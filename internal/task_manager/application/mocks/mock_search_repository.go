@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// MockSearchRepository is a mock implementation of SearchRepository for testing
+type MockSearchRepository struct {
+	SearchFunc func(ctx context.Context, filters entities.SearchFilters) ([]*entities.SearchHit, error)
+}
+
+// Search implements SearchRepository.Search
+func (m *MockSearchRepository) Search(ctx context.Context, filters entities.SearchFilters) ([]*entities.SearchHit, error) {
+	if m.SearchFunc != nil {
+		return m.SearchFunc(ctx, filters)
+	}
+	return nil, nil
+}
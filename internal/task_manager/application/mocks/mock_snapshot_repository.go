@@ -0,0 +1,56 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// MockSnapshotRepository is a mock implementation of SnapshotRepository for testing
+type MockSnapshotRepository struct {
+	CreateSnapshotFunc  func(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error)
+	ListSnapshotsFunc   func(ctx context.Context, projectName string) ([]*entities.ProjectSnapshot, error)
+	GetSnapshotFunc     func(ctx context.Context, id string) (*entities.ProjectSnapshot, error)
+	RestoreSnapshotFunc func(ctx context.Context, id string) error
+	DeleteSnapshotFunc  func(ctx context.Context, id string) error
+}
+
+// CreateSnapshot implements SnapshotRepository.CreateSnapshot
+func (m *MockSnapshotRepository) CreateSnapshot(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error) {
+	if m.CreateSnapshotFunc != nil {
+		return m.CreateSnapshotFunc(ctx, projectName, reason)
+	}
+	return &entities.ProjectSnapshot{Project: projectName, Reason: reason}, nil
+}
+
+// ListSnapshots implements SnapshotRepository.ListSnapshots
+func (m *MockSnapshotRepository) ListSnapshots(ctx context.Context, projectName string) ([]*entities.ProjectSnapshot, error) {
+	if m.ListSnapshotsFunc != nil {
+		return m.ListSnapshotsFunc(ctx, projectName)
+	}
+	return []*entities.ProjectSnapshot{}, nil
+}
+
+// GetSnapshot implements SnapshotRepository.GetSnapshot
+func (m *MockSnapshotRepository) GetSnapshot(ctx context.Context, id string) (*entities.ProjectSnapshot, error) {
+	if m.GetSnapshotFunc != nil {
+		return m.GetSnapshotFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// RestoreSnapshot implements SnapshotRepository.RestoreSnapshot
+func (m *MockSnapshotRepository) RestoreSnapshot(ctx context.Context, id string) error {
+	if m.RestoreSnapshotFunc != nil {
+		return m.RestoreSnapshotFunc(ctx, id)
+	}
+	return nil
+}
+
+// DeleteSnapshot implements SnapshotRepository.DeleteSnapshot
+func (m *MockSnapshotRepository) DeleteSnapshot(ctx context.Context, id string) error {
+	if m.DeleteSnapshotFunc != nil {
+		return m.DeleteSnapshotFunc(ctx, id)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// MockSyncStateRepository is a mock implementation of SyncStateRepository for testing
+type MockSyncStateRepository struct {
+	ListSyncStatesFunc  func(ctx context.Context, directory string) ([]*entities.SyncState, error)
+	SaveSyncStateFunc   func(ctx context.Context, state *entities.SyncState) error
+	DeleteSyncStateFunc func(ctx context.Context, directory, entityType, entityID string) error
+}
+
+// ListSyncStates implements SyncStateRepository.ListSyncStates
+func (m *MockSyncStateRepository) ListSyncStates(ctx context.Context, directory string) ([]*entities.SyncState, error) {
+	if m.ListSyncStatesFunc != nil {
+		return m.ListSyncStatesFunc(ctx, directory)
+	}
+	return nil, nil
+}
+
+// SaveSyncState implements SyncStateRepository.SaveSyncState
+func (m *MockSyncStateRepository) SaveSyncState(ctx context.Context, state *entities.SyncState) error {
+	if m.SaveSyncStateFunc != nil {
+		return m.SaveSyncStateFunc(ctx, state)
+	}
+	return nil
+}
+
+// DeleteSyncState implements SyncStateRepository.DeleteSyncState
+func (m *MockSyncStateRepository) DeleteSyncState(ctx context.Context, directory, entityType, entityID string) error {
+	if m.DeleteSyncStateFunc != nil {
+		return m.DeleteSyncStateFunc(ctx, directory, entityType, entityID)
+	}
+	return nil
+}
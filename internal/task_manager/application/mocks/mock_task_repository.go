@@ -34,6 +34,21 @@ type MockTaskRepository struct {
 
 	// GetIterationsForTaskFunc is called by GetIterationsForTask. If nil, returns empty slice, nil.
 	GetIterationsForTaskFunc func(ctx context.Context, taskID string) ([]*entities.IterationEntity, error)
+
+	// AddTaskDependencyFunc is called by AddTaskDependency. If nil, returns nil.
+	AddTaskDependencyFunc func(ctx context.Context, taskID, blockedByID string) error
+
+	// RemoveTaskDependencyFunc is called by RemoveTaskDependency. If nil, returns nil.
+	RemoveTaskDependencyFunc func(ctx context.Context, taskID, blockedByID string) error
+
+	// GetTaskDependenciesFunc is called by GetTaskDependencies. If nil, returns empty slice, nil.
+	GetTaskDependenciesFunc func(ctx context.Context, taskID string) ([]string, error)
+
+	// GetBlockedTasksFunc is called by GetBlockedTasks. If nil, returns empty slice, nil.
+	GetBlockedTasksFunc func(ctx context.Context, taskID string) ([]string, error)
+
+	// ListTaskStatusChangesFunc is called by ListTaskStatusChanges. If nil, returns empty slice, nil.
+	ListTaskStatusChangesFunc func(ctx context.Context, filters entities.TaskStatusChangeFilters) ([]*entities.TaskStatusChange, error)
 }
 
 // NewMockTaskRepository creates a new mock task repository with in-memory storage
@@ -119,6 +134,46 @@ func (m *MockTaskRepository) GetIterationsForTask(ctx context.Context, taskID st
 	return []*entities.IterationEntity{}, nil
 }
 
+// AddTaskDependency implements repositories.TaskRepository.
+func (m *MockTaskRepository) AddTaskDependency(ctx context.Context, taskID, blockedByID string) error {
+	if m.AddTaskDependencyFunc != nil {
+		return m.AddTaskDependencyFunc(ctx, taskID, blockedByID)
+	}
+	return nil
+}
+
+// RemoveTaskDependency implements repositories.TaskRepository.
+func (m *MockTaskRepository) RemoveTaskDependency(ctx context.Context, taskID, blockedByID string) error {
+	if m.RemoveTaskDependencyFunc != nil {
+		return m.RemoveTaskDependencyFunc(ctx, taskID, blockedByID)
+	}
+	return nil
+}
+
+// GetTaskDependencies implements repositories.TaskRepository.
+func (m *MockTaskRepository) GetTaskDependencies(ctx context.Context, taskID string) ([]string, error) {
+	if m.GetTaskDependenciesFunc != nil {
+		return m.GetTaskDependenciesFunc(ctx, taskID)
+	}
+	return []string{}, nil
+}
+
+// GetBlockedTasks implements repositories.TaskRepository.
+func (m *MockTaskRepository) GetBlockedTasks(ctx context.Context, taskID string) ([]string, error) {
+	if m.GetBlockedTasksFunc != nil {
+		return m.GetBlockedTasksFunc(ctx, taskID)
+	}
+	return []string{}, nil
+}
+
+// ListTaskStatusChanges implements repositories.TaskRepository.
+func (m *MockTaskRepository) ListTaskStatusChanges(ctx context.Context, filters entities.TaskStatusChangeFilters) ([]*entities.TaskStatusChange, error) {
+	if m.ListTaskStatusChangesFunc != nil {
+		return m.ListTaskStatusChangesFunc(ctx, filters)
+	}
+	return []*entities.TaskStatusChange{}, nil
+}
+
 // Reset clears all configured behavior.
 func (m *MockTaskRepository) Reset() {
 	m.SaveTaskFunc = nil
@@ -129,6 +184,11 @@ func (m *MockTaskRepository) Reset() {
 	m.MoveTaskToTrackFunc = nil
 	m.GetBacklogTasksFunc = nil
 	m.GetIterationsForTaskFunc = nil
+	m.AddTaskDependencyFunc = nil
+	m.RemoveTaskDependencyFunc = nil
+	m.GetTaskDependenciesFunc = nil
+	m.GetBlockedTasksFunc = nil
+	m.ListTaskStatusChangesFunc = nil
 }
 
 // WithError configures the mock to return the specified error for all methods.
@@ -145,5 +205,12 @@ func (m *MockTaskRepository) WithError(err error) *MockTaskRepository {
 	m.GetIterationsForTaskFunc = func(ctx context.Context, taskID string) ([]*entities.IterationEntity, error) {
 		return nil, err
 	}
+	m.AddTaskDependencyFunc = func(ctx context.Context, taskID, blockedByID string) error { return err }
+	m.RemoveTaskDependencyFunc = func(ctx context.Context, taskID, blockedByID string) error { return err }
+	m.GetTaskDependenciesFunc = func(ctx context.Context, taskID string) ([]string, error) { return nil, err }
+	m.GetBlockedTasksFunc = func(ctx context.Context, taskID string) ([]string, error) { return nil, err }
+	m.ListTaskStatusChangesFunc = func(ctx context.Context, filters entities.TaskStatusChangeFilters) ([]*entities.TaskStatusChange, error) {
+		return nil, err
+	}
 	return m
 }
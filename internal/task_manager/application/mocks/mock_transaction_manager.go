@@ -0,0 +1,27 @@
+package mocks
+
+import (
+	"context"
+)
+
+// MockTransactionManager is a mock implementation of TransactionManager for testing.
+// Without InTransactionFunc it runs fn directly and reports how many transactions ran and failed.
+type MockTransactionManager struct {
+	InTransactionFunc func(ctx context.Context, fn func(ctx context.Context) error) error
+
+	Transactions int // Calls to InTransaction
+	RolledBack   int // Calls whose fn returned an error
+}
+
+// InTransaction implements TransactionManager.InTransaction
+func (m *MockTransactionManager) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.Transactions++
+	if m.InTransactionFunc != nil {
+		return m.InTransactionFunc(ctx, fn)
+	}
+	err := fn(ctx)
+	if err != nil {
+		m.RolledBack++
+	}
+	return err
+}
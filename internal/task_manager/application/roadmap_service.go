@@ -9,6 +9,7 @@ import (
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
 	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
 )
@@ -21,6 +22,7 @@ type RoadmapApplicationService struct {
 	taskRepo      repositories.TaskRepository
 	iterationRepo repositories.IterationRepository
 	validationSvc *services.ValidationService
+	eventBus      events.EventBus
 }
 
 // NewRoadmapApplicationService creates a new roadmap application service
@@ -30,6 +32,7 @@ func NewRoadmapApplicationService(
 	taskRepo repositories.TaskRepository,
 	iterationRepo repositories.IterationRepository,
 	validationSvc *services.ValidationService,
+	eventBus events.EventBus,
 ) *RoadmapApplicationService {
 	return &RoadmapApplicationService{
 		roadmapRepo:   roadmapRepo,
@@ -37,6 +40,7 @@ func NewRoadmapApplicationService(
 		taskRepo:      taskRepo,
 		iterationRepo: iterationRepo,
 		validationSvc: validationSvc,
+		eventBus:      eventBus,
 	}
 }
 
@@ -74,6 +78,7 @@ func (s *RoadmapApplicationService) InitRoadmap(ctx context.Context, input dto.C
 		return nil, fmt.Errorf("failed to save roadmap: %w", err)
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventRoadmapCreated, events.EntityTypeRoadmap, roadmap.ID, roadmap, nil)
 	return roadmap, nil
 }
 
@@ -95,6 +100,8 @@ func (s *RoadmapApplicationService) UpdateRoadmap(ctx context.Context, input dto
 		return nil, err
 	}
 
+	previous := *roadmap
+
 	// Apply updates
 	if input.Vision != nil {
 		if err := s.validationSvc.ValidateNonEmpty("vision", *input.Vision); err != nil {
@@ -118,6 +125,7 @@ func (s *RoadmapApplicationService) UpdateRoadmap(ctx context.Context, input dto
 		return nil, fmt.Errorf("failed to update roadmap: %w", err)
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventRoadmapUpdated, events.EntityTypeRoadmap, roadmap.ID, roadmap, &previous)
 	return roadmap, nil
 }
 
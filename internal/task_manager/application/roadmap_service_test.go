@@ -33,6 +33,7 @@ func TestRoadmapApplicationService_InitRoadmap_Success(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -76,6 +77,7 @@ func TestRoadmapApplicationService_InitRoadmap_EmptyVision(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -110,6 +112,7 @@ func TestRoadmapApplicationService_InitRoadmap_EmptySuccessCriteria(t *testing.T
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -154,6 +157,7 @@ func TestRoadmapApplicationService_InitRoadmap_RoadmapAlreadyExists(t *testing.T
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -202,6 +206,7 @@ func TestRoadmapApplicationService_GetRoadmap_Success(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -234,6 +239,7 @@ func TestRoadmapApplicationService_GetRoadmap_NotFound(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -277,6 +283,7 @@ func TestRoadmapApplicationService_UpdateRoadmap_VisionOnly(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -324,6 +331,7 @@ func TestRoadmapApplicationService_UpdateRoadmap_SuccessCriteriaOnly(t *testing.
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -371,6 +379,7 @@ func TestRoadmapApplicationService_UpdateRoadmap_BothFields(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -410,6 +419,7 @@ func TestRoadmapApplicationService_UpdateRoadmap_NoFields(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -448,6 +458,7 @@ func TestRoadmapApplicationService_UpdateRoadmap_EmptyVision(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -482,6 +493,7 @@ func TestRoadmapApplicationService_UpdateRoadmap_NotFound(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -576,6 +588,7 @@ func TestRoadmapApplicationService_GetFullOverview_Success(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -622,6 +635,7 @@ func TestRoadmapApplicationService_GetFullOverview_RoadmapNotFound(t *testing.T)
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
@@ -666,6 +680,7 @@ func TestRoadmapApplicationService_GetFullOverview_EmptyData(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	// Test
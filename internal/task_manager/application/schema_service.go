@@ -0,0 +1,62 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+)
+
+// SchemaApplicationService reports and moves the schema version of the project database.
+// Opening a project migrates it to the latest version; this service lets a database be
+// migrated step by step, or rolled back before it is handed to an older tm.
+type SchemaApplicationService struct {
+	schemaRepo repositories.SchemaRepository
+}
+
+// NewSchemaApplicationService creates a new schema application service.
+func NewSchemaApplicationService(schemaRepo repositories.SchemaRepository) *SchemaApplicationService {
+	return &SchemaApplicationService{
+		schemaRepo: schemaRepo,
+	}
+}
+
+// Status returns the schema version of the project database and the known migrations.
+func (s *SchemaApplicationService) Status(ctx context.Context) (*entities.SchemaStatus, error) {
+	return s.schemaRepo.GetSchemaStatus(ctx)
+}
+
+// Migrate applies the pending migrations up to targetVersion, or all of them when targetVersion is 0.
+func (s *SchemaApplicationService) Migrate(ctx context.Context, targetVersion int) (*entities.SchemaChange, error) {
+	status, err := s.schemaRepo.GetSchemaStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if targetVersion == 0 {
+		targetVersion = status.LatestVersion
+	}
+	if targetVersion < status.CurrentVersion {
+		return nil, fmt.Errorf("%w: schema is already at v%d; use rollback to go back to v%d", tmerrors.ErrInvalidArgument, status.CurrentVersion, targetVersion)
+	}
+	return s.schemaRepo.MigrateSchema(ctx, targetVersion)
+}
+
+// Rollback reverts the applied migrations newer than targetVersion, or the last one when targetVersion is 0.
+func (s *SchemaApplicationService) Rollback(ctx context.Context, targetVersion int) (*entities.SchemaChange, error) {
+	status, err := s.schemaRepo.GetSchemaStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if status.CurrentVersion == 0 {
+		return nil, fmt.Errorf("%w: the project database does not exist yet", tmerrors.ErrNotFound)
+	}
+	if targetVersion == 0 {
+		targetVersion = status.CurrentVersion - 1
+	}
+	if targetVersion >= status.CurrentVersion {
+		return nil, fmt.Errorf("%w: schema is at v%d; use migrate to go forward to v%d", tmerrors.ErrInvalidArgument, status.CurrentVersion, targetVersion)
+	}
+	return s.schemaRepo.MigrateSchema(ctx, targetVersion)
+}
@@ -0,0 +1,67 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+)
+
+// newSchemaServiceAt returns a schema service over a database at currentVersion of 16,
+// recording the version it is asked to migrate to
+func newSchemaServiceAt(currentVersion int, target *int) *application.SchemaApplicationService {
+	return application.NewSchemaApplicationService(&mocks.MockSchemaRepository{
+		GetSchemaStatusFunc: func(ctx context.Context) (*entities.SchemaStatus, error) {
+			return &entities.SchemaStatus{CurrentVersion: currentVersion, LatestVersion: 16}, nil
+		},
+		MigrateSchemaFunc: func(ctx context.Context, targetVersion int) (*entities.SchemaChange, error) {
+			*target = targetVersion
+			return &entities.SchemaChange{FromVersion: currentVersion, ToVersion: targetVersion}, nil
+		},
+	})
+}
+
+// TestSchemaService_Migrate verifies the default target and that migrate never goes backwards
+func TestSchemaService_Migrate(t *testing.T) {
+	ctx := context.Background()
+	var target int
+	service := newSchemaServiceAt(12, &target)
+
+	if _, err := service.Migrate(ctx, 0); err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	if target != 16 {
+		t.Errorf("expected migration to the latest version, got v%d", target)
+	}
+	if _, err := service.Migrate(ctx, 14); err != nil || target != 14 {
+		t.Errorf("expected migration to v14, got v%d (%v)", target, err)
+	}
+	if _, err := service.Migrate(ctx, 10); !errors.Is(err, tmerrors.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument migrating backwards, got %v", err)
+	}
+}
+
+// TestSchemaService_Rollback verifies the default target and that rollback never goes forwards
+func TestSchemaService_Rollback(t *testing.T) {
+	ctx := context.Background()
+	var target int
+	service := newSchemaServiceAt(16, &target)
+
+	if _, err := service.Rollback(ctx, 0); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+	if target != 15 {
+		t.Errorf("expected rollback of the last migration, got v%d", target)
+	}
+	if _, err := service.Rollback(ctx, 16); !errors.Is(err, tmerrors.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument rolling back to the current version, got %v", err)
+	}
+
+	if _, err := newSchemaServiceAt(0, &target).Rollback(ctx, 0); !errors.Is(err, tmerrors.ErrNotFound) {
+		t.Errorf("expected ErrNotFound rolling back a missing database, got %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+)
+
+// DefaultSearchLimit is the number of hits returned when no limit is given
+const DefaultSearchLimit = 20
+
+// SearchApplicationService runs full-text searches across tasks, acceptance criteria, ADRs and documents.
+type SearchApplicationService struct {
+	searchRepo repositories.SearchRepository
+}
+
+// NewSearchApplicationService creates a new search application service.
+func NewSearchApplicationService(searchRepo repositories.SearchRepository) *SearchApplicationService {
+	return &SearchApplicationService{
+		searchRepo: searchRepo,
+	}
+}
+
+// Search returns the hits matching the query, most relevant first.
+// Every word of the query must match; a word ending in * matches as a prefix.
+// A limit of zero applies DefaultSearchLimit.
+func (s *SearchApplicationService) Search(ctx context.Context, filters entities.SearchFilters) ([]*entities.SearchHit, error) {
+	filters.Query = strings.TrimSpace(filters.Query)
+	if filters.Query == "" {
+		return nil, fmt.Errorf("%w: search query must not be empty", tmerrors.ErrInvalidArgument)
+	}
+	for _, entityType := range filters.EntityTypes {
+		if !entities.IsValidSearchEntityType(entityType) {
+			return nil, fmt.Errorf("%w: invalid search type %q (expected one of: %s)",
+				tmerrors.ErrInvalidArgument, entityType, strings.Join(entities.SearchEntityTypes, ", "))
+		}
+	}
+	if filters.Limit < 0 {
+		return nil, fmt.Errorf("%w: search limit must not be negative", tmerrors.ErrInvalidArgument)
+	}
+	if filters.Limit == 0 {
+		filters.Limit = DefaultSearchLimit
+	}
+
+	hits, err := s.searchRepo.Search(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query search index: %w", err)
+	}
+	return hits, nil
+}
@@ -0,0 +1,63 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+)
+
+// TestSearchService_Search verifies the query is trimmed and the default limit applied
+func TestSearchService_Search(t *testing.T) {
+	var received entities.SearchFilters
+	repo := &mocks.MockSearchRepository{
+		SearchFunc: func(ctx context.Context, filters entities.SearchFilters) ([]*entities.SearchHit, error) {
+			received = filters
+			return []*entities.SearchHit{{EntityType: entities.SearchEntityTask, EntityID: "TM-task-1"}}, nil
+		},
+	}
+	service := application.NewSearchApplicationService(repo)
+
+	hits, err := service.Search(context.Background(), entities.SearchFilters{Query: "  login  ", EntityTypes: []string{"task", "adr"}})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(hits) != 1 || hits[0].EntityID != "TM-task-1" {
+		t.Errorf("unexpected hits: %+v", hits)
+	}
+	if received.Query != "login" || received.Limit != application.DefaultSearchLimit || len(received.EntityTypes) != 2 {
+		t.Errorf("unexpected filters passed to repository: %+v", received)
+	}
+}
+
+// TestSearchService_Search_InvalidInput verifies bad queries are rejected before reaching the repository
+func TestSearchService_Search_InvalidInput(t *testing.T) {
+	repo := &mocks.MockSearchRepository{
+		SearchFunc: func(ctx context.Context, filters entities.SearchFilters) ([]*entities.SearchHit, error) {
+			t.Fatalf("repository must not be called for %+v", filters)
+			return nil, nil
+		},
+	}
+	service := application.NewSearchApplicationService(repo)
+
+	tests := []struct {
+		name    string
+		filters entities.SearchFilters
+	}{
+		{"empty query", entities.SearchFilters{Query: "   "}},
+		{"unknown type", entities.SearchFilters{Query: "login", EntityTypes: []string{"iteration"}}},
+		{"negative limit", entities.SearchFilters{Query: "login", Limit: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Search(context.Background(), tt.filters)
+			if !errors.Is(err, tmerrors.ErrInvalidArgument) {
+				t.Errorf("expected ErrInvalidArgument, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+)
+
+// SnapshotApplicationService takes, lists and restores point-in-time snapshots of project
+// databases. Every new snapshot prunes the project's snapshots the retention policy no longer keeps.
+type SnapshotApplicationService struct {
+	snapshotRepo  repositories.SnapshotRepository
+	policy        entities.SnapshotPolicy
+	activeProject string
+}
+
+// NewSnapshotApplicationService creates a new snapshot application service.
+// Methods given an empty project name use activeProject.
+func NewSnapshotApplicationService(
+	snapshotRepo repositories.SnapshotRepository,
+	policy entities.SnapshotPolicy,
+	activeProject string,
+) *SnapshotApplicationService {
+	return &SnapshotApplicationService{
+		snapshotRepo:  snapshotRepo,
+		policy:        policy,
+		activeProject: activeProject,
+	}
+}
+
+// CreateSnapshot takes a snapshot of a project on request.
+func (s *SnapshotApplicationService) CreateSnapshot(ctx context.Context, projectName string) (*entities.ProjectSnapshot, error) {
+	return s.snapshot(ctx, s.project(projectName), entities.SnapshotReasonManual)
+}
+
+// ListSnapshots returns the snapshots of a project, newest first.
+func (s *SnapshotApplicationService) ListSnapshots(ctx context.Context, projectName string) ([]*entities.ProjectSnapshot, error) {
+	return s.snapshotRepo.ListSnapshots(ctx, s.project(projectName))
+}
+
+// SnapshotBefore takes the automatic snapshot of a project before a destructive command.
+// Returns nil when automatic snapshots are disabled or the project has no database yet.
+func (s *SnapshotApplicationService) SnapshotBefore(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error) {
+	if !s.policy.BeforeDestructive {
+		return nil, nil
+	}
+	snapshot, err := s.snapshot(ctx, s.project(projectName), reason)
+	if errors.Is(err, tmerrors.ErrNotFound) {
+		return nil, nil
+	}
+	return snapshot, err
+}
+
+// SnapshotsBeforeDestructive reports whether destructive commands snapshot the project first.
+func (s *SnapshotApplicationService) SnapshotsBeforeDestructive() bool {
+	return s.policy.BeforeDestructive
+}
+
+// snapshotBeforeDelete takes the automatic snapshot of the active project before a service
+// deletes an entity, so every entry point (CLI, API) can be undone the same way.
+// snapshots may be nil, in which case no snapshot is taken.
+func snapshotBeforeDelete(ctx context.Context, snapshots *SnapshotApplicationService, reason string) (*entities.ProjectSnapshot, error) {
+	if snapshots == nil {
+		return nil, nil
+	}
+	snapshot, err := snapshots.SnapshotBefore(ctx, "", reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot project (disable with backups.before_destructive: false): %w", err)
+	}
+	return snapshot, nil
+}
+
+// RestoreSnapshot replaces a project's database with a snapshot, recreating the project
+// if it was deleted. The database being replaced is snapshotted first, so a restore can be
+// undone. Returns the restored snapshot and the snapshot of the replaced database, which is
+// nil if the project had no database.
+func (s *SnapshotApplicationService) RestoreSnapshot(ctx context.Context, id string) (*entities.ProjectSnapshot, *entities.ProjectSnapshot, error) {
+	snapshot, err := s.snapshotRepo.GetSnapshot(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	previous, err := s.snapshot(ctx, snapshot.Project, entities.SnapshotReasonRestore)
+	if err != nil && !errors.Is(err, tmerrors.ErrNotFound) {
+		return nil, nil, fmt.Errorf("failed to snapshot project before restoring: %w", err)
+	}
+
+	if err := s.snapshotRepo.RestoreSnapshot(ctx, id); err != nil {
+		return nil, nil, err
+	}
+	return snapshot, previous, nil
+}
+
+// snapshot takes a snapshot and applies the retention policy to the project's snapshots.
+func (s *SnapshotApplicationService) snapshot(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error) {
+	snapshot, err := s.snapshotRepo.CreateSnapshot(ctx, projectName, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := s.snapshotRepo.ListSnapshots(ctx, projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots for retention: %w", err)
+	}
+	for _, expired := range s.policy.Expired(snapshots, time.Now()) {
+		if expired.ID == snapshot.ID {
+			continue
+		}
+		if err := s.snapshotRepo.DeleteSnapshot(ctx, expired.ID); err != nil {
+			return nil, fmt.Errorf("failed to prune snapshot %s: %w", expired.ID, err)
+		}
+	}
+	return snapshot, nil
+}
+
+// project resolves an empty project name to the active project
+func (s *SnapshotApplicationService) project(projectName string) string {
+	if projectName == "" {
+		return s.activeProject
+	}
+	return projectName
+}
@@ -0,0 +1,156 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSnapshotRepo returns a snapshot repository mock keeping snapshots in memory, newest first
+func newSnapshotRepo(existing ...string) (*mocks.MockSnapshotRepository, *[]*entities.ProjectSnapshot) {
+	now := time.Now()
+	snapshots := []*entities.ProjectSnapshot{}
+	for i, id := range existing {
+		snapshots = append(snapshots, &entities.ProjectSnapshot{ID: id, Project: "alpha", CreatedAt: now.Add(-time.Duration(i+1) * time.Hour)})
+	}
+	repo := &mocks.MockSnapshotRepository{
+		CreateSnapshotFunc: func(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error) {
+			if projectName != "alpha" {
+				return nil, fmt.Errorf("%w: project '%s' has no database", tmerrors.ErrNotFound, projectName)
+			}
+			snapshot := &entities.ProjectSnapshot{ID: projectName + "/" + reason, Project: projectName, Reason: reason, CreatedAt: now}
+			snapshots = append([]*entities.ProjectSnapshot{snapshot}, snapshots...)
+			return snapshot, nil
+		},
+		ListSnapshotsFunc: func(ctx context.Context, projectName string) ([]*entities.ProjectSnapshot, error) {
+			return snapshots, nil
+		},
+		DeleteSnapshotFunc: func(ctx context.Context, id string) error {
+			for i, snapshot := range snapshots {
+				if snapshot.ID == id {
+					snapshots = append(snapshots[:i], snapshots[i+1:]...)
+					return nil
+				}
+			}
+			return fmt.Errorf("%w: snapshot %s", tmerrors.ErrNotFound, id)
+		},
+	}
+	return repo, &snapshots
+}
+
+// TestSnapshotService_CreateSnapshot verifies the active project default and retention
+func TestSnapshotService_CreateSnapshot(t *testing.T) {
+	repo, snapshots := newSnapshotRepo("alpha/old-1", "alpha/old-2", "alpha/old-3")
+	service := application.NewSnapshotApplicationService(repo, entities.SnapshotPolicy{Keep: 2}, "alpha")
+
+	snapshot, err := service.CreateSnapshot(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "alpha", snapshot.Project)
+	assert.Equal(t, entities.SnapshotReasonManual, snapshot.Reason)
+
+	ids := []string{}
+	for _, kept := range *snapshots {
+		ids = append(ids, kept.ID)
+	}
+	assert.Equal(t, []string{"alpha/manual", "alpha/old-1"}, ids, "only the newest snapshots are kept")
+}
+
+// TestSnapshotService_SnapshotBefore verifies automatic snapshots honor the policy
+func TestSnapshotService_SnapshotBefore(t *testing.T) {
+	ctx := context.Background()
+
+	repo, _ := newSnapshotRepo()
+	snapshot, err := application.NewSnapshotApplicationService(repo, entities.DefaultSnapshotPolicy(), "alpha").
+		SnapshotBefore(ctx, "", entities.SnapshotReasonTaskDelete)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, entities.SnapshotReasonTaskDelete, snapshot.Reason)
+
+	snapshot, err = application.NewSnapshotApplicationService(repo, entities.SnapshotPolicy{}, "alpha").
+		SnapshotBefore(ctx, "", entities.SnapshotReasonTaskDelete)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot, "disabled automatic snapshots take none")
+
+	snapshot, err = application.NewSnapshotApplicationService(repo, entities.DefaultSnapshotPolicy(), "alpha").
+		SnapshotBefore(ctx, "empty", entities.SnapshotReasonProjectDelete)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot, "a project without a database has nothing to snapshot")
+}
+
+// TestSnapshotService_RestoreSnapshot verifies the replaced database is snapshotted before restoring
+func TestSnapshotService_RestoreSnapshot(t *testing.T) {
+	repo, _ := newSnapshotRepo("alpha/old-1")
+	repo.GetSnapshotFunc = func(ctx context.Context, id string) (*entities.ProjectSnapshot, error) {
+		return &entities.ProjectSnapshot{ID: id, Project: "alpha"}, nil
+	}
+	var restored string
+	repo.RestoreSnapshotFunc = func(ctx context.Context, id string) error {
+		restored = id
+		return nil
+	}
+
+	snapshot, previous, err := application.NewSnapshotApplicationService(repo, entities.SnapshotPolicy{}, "beta").
+		RestoreSnapshot(context.Background(), "alpha/old-1")
+	require.NoError(t, err)
+	assert.Equal(t, "alpha/old-1", restored)
+	assert.Equal(t, "alpha/old-1", snapshot.ID)
+	require.NotNil(t, previous, "the replaced database is snapshotted even with automatic snapshots disabled")
+	assert.Equal(t, entities.SnapshotReasonRestore, previous.Reason)
+	assert.Equal(t, "alpha", previous.Project, "the snapshot's project is restored, not the active one")
+}
+
+// TestTrackService_DeleteSnapshotsFirst verifies a failed automatic snapshot stops the deletion
+func TestTrackService_DeleteSnapshotsFirst(t *testing.T) {
+	repo := &mocks.MockSnapshotRepository{
+		CreateSnapshotFunc: func(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error) {
+			assert.Equal(t, entities.SnapshotReasonTrackDelete, reason)
+			return nil, errors.New("disk full")
+		},
+	}
+	snapshotService := application.NewSnapshotApplicationService(repo, entities.DefaultSnapshotPolicy(), "alpha")
+	trackRepo := &mocks.MockTrackRepository{
+		GetTrackFunc: func(ctx context.Context, id string) (*entities.TrackEntity, error) {
+			return &entities.TrackEntity{ID: id}, nil
+		},
+		DeleteTrackFunc: func(ctx context.Context, id string) error {
+			t.Fatalf("track %s should not be deleted without a snapshot", id)
+			return nil
+		},
+	}
+	service := application.NewTrackApplicationService(trackRepo, nil, &mocks.MockTaskRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, &mocks.MockADRRepository{}, &mocks.MockDocumentRepository{}, nil, nil, nil, snapshotService)
+
+	_, err := service.DeleteTrack(context.Background(), "AL-track-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "disk full")
+}
+
+// TestTaskService_DeleteMissingTaskTakesNoSnapshot verifies a mistyped ID neither snapshots nor prunes
+func TestTaskService_DeleteMissingTaskTakesNoSnapshot(t *testing.T) {
+	repo := &mocks.MockSnapshotRepository{
+		CreateSnapshotFunc: func(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error) {
+			t.Fatalf("no snapshot should be taken for a missing task")
+			return nil, nil
+		},
+	}
+	snapshotService := application.NewSnapshotApplicationService(repo, entities.DefaultSnapshotPolicy(), "alpha")
+	taskRepo := &mocks.MockTaskRepository{
+		GetTaskFunc: func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+			return nil, fmt.Errorf("%w: task %s not found", tmerrors.ErrNotFound, id)
+		},
+	}
+	service := application.NewTaskApplicationService(taskRepo, nil, nil, nil, nil, nil, nil, snapshotService)
+
+	snapshot, err := service.DeleteTask(context.Background(), "AL-task-99")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, tmerrors.ErrNotFound))
+	assert.Nil(t, snapshot)
+}
@@ -0,0 +1,1017 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// syncEntityTypes lists the synced entity types in dependency order: an entity only references
+// entities of earlier types. Creates and updates are applied in this order, deletes in reverse.
+var syncEntityTypes = []string{"roadmap", "track", "task", "iteration", "acceptance_criteria", "adr", "document"}
+
+// syncKey identifies an entity across the sync directory, the database and the baselines
+type syncKey struct {
+	entityType string
+	entityID   string
+}
+
+// syncItem gathers both sides and the baseline of one entity
+type syncItem struct {
+	key    syncKey
+	file   *services.SyncFile
+	db     services.SyncEntity
+	dbHash string
+	state  *entities.SyncState
+	change *dto.SyncChangeDTO // nil when file and database agree
+}
+
+// syncCreatedEvents and syncDeletedEvents announce imported creations and deletions by entity type
+var (
+	syncCreatedEvents = map[string]string{
+		"roadmap":             events.EventRoadmapCreated,
+		"track":               events.EventTrackCreated,
+		"task":                events.EventTaskCreated,
+		"iteration":           events.EventIterationCreated,
+		"acceptance_criteria": events.EventACCreated,
+		"adr":                 events.EventADRCreated,
+		"document":            events.EventDocumentCreated,
+	}
+	syncDeletedEvents = map[string]string{
+		"track":               events.EventTrackDeleted,
+		"task":                events.EventTaskDeleted,
+		"iteration":           events.EventIterationDeleted,
+		"acceptance_criteria": events.EventACDeleted,
+		"document":            events.EventDocumentDeleted,
+	}
+)
+
+// syncGuardedEvents are the transitions pre-hooks may veto, as the services guard them
+var syncGuardedEvents = map[string]bool{
+	events.EventTaskStatusChanged:       true,
+	events.EventTaskCompleted:           true,
+	events.EventIterationStarted:        true,
+	events.EventIterationCompleted:      true,
+	events.EventACVerified:              true,
+	events.EventACAutomaticallyVerified: true,
+	events.EventACFailed:                true,
+	events.EventACSkipped:               true,
+}
+
+// SyncApplicationService mirrors a project to a directory of plain-text files and back.
+// Each entity's file is compared with its database row and with the baseline recorded when both
+// last agreed, so a side that changed since is copied over the other, and a change on both sides
+// is reported as a conflict instead of being overwritten.
+//
+// Imports are applied in a single transaction and announced with the events the services
+// publish for the same changes, so history and hooks see them too.
+type SyncApplicationService struct {
+	roadmapRepo   repositories.RoadmapRepository
+	trackRepo     repositories.TrackRepository
+	taskRepo      repositories.TaskRepository
+	iterationRepo repositories.IterationRepository
+	acRepo        repositories.AcceptanceCriteriaRepository
+	adrRepo       repositories.ADRRepository
+	documentRepo  repositories.DocumentRepository
+	syncStateRepo repositories.SyncStateRepository
+	store         services.SyncStore
+	txManager     repositories.TransactionManager
+	eventBus      events.EventBus
+	guard         events.TransitionGuard
+	snapshots     *SnapshotApplicationService
+}
+
+// NewSyncApplicationService creates a new sync application service.
+// guard may be nil, in which case imported transitions are never vetoed, and snapshots may be nil,
+// in which case no snapshot is taken before an import deletes entities.
+func NewSyncApplicationService(
+	roadmapRepo repositories.RoadmapRepository,
+	trackRepo repositories.TrackRepository,
+	taskRepo repositories.TaskRepository,
+	iterationRepo repositories.IterationRepository,
+	acRepo repositories.AcceptanceCriteriaRepository,
+	adrRepo repositories.ADRRepository,
+	documentRepo repositories.DocumentRepository,
+	syncStateRepo repositories.SyncStateRepository,
+	store services.SyncStore,
+	txManager repositories.TransactionManager,
+	eventBus events.EventBus,
+	guard events.TransitionGuard,
+	snapshots *SnapshotApplicationService,
+) *SyncApplicationService {
+	return &SyncApplicationService{
+		roadmapRepo:   roadmapRepo,
+		trackRepo:     trackRepo,
+		taskRepo:      taskRepo,
+		iterationRepo: iterationRepo,
+		acRepo:        acRepo,
+		adrRepo:       adrRepo,
+		documentRepo:  documentRepo,
+		syncStateRepo: syncStateRepo,
+		store:         store,
+		txManager:     txManager,
+		eventBus:      eventBus,
+		guard:         guard,
+		snapshots:     snapshots,
+	}
+}
+
+// Sync compares the sync directory with the database and applies the changes of the enabled directions.
+// Imported files are rewritten in canonical form. Changes of a disabled direction and unresolved
+// conflicts are reported without being applied, and their baselines are left as they were.
+// An import that deletes entities snapshots the project first.
+func (s *SyncApplicationService) Sync(ctx context.Context, input dto.SyncDTO) (*dto.SyncReportDTO, error) {
+	if input.Directory == "" {
+		return nil, fmt.Errorf("%w: sync directory is required", tmerrors.ErrInvalidArgument)
+	}
+	if input.Prefer != dto.SyncPreferNone && input.Prefer != dto.SyncPreferFiles && input.Prefer != dto.SyncPreferDatabase {
+		return nil, fmt.Errorf("%w: invalid conflict preference %q: must be %s or %s",
+			tmerrors.ErrInvalidArgument, input.Prefer, dto.SyncPreferFiles, dto.SyncPreferDatabase)
+	}
+
+	items, err := s.plan(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dto.SyncReportDTO{
+		Directory: input.Directory,
+		DryRun:    input.DryRun,
+		Changes:   []*dto.SyncChangeDTO{},
+	}
+	for _, item := range items {
+		if item.change == nil {
+			report.Unchanged++
+			continue
+		}
+		report.Changes = append(report.Changes, item.change)
+		if item.change.Action == dto.SyncActionConflict {
+			report.Conflicts++
+		}
+	}
+
+	if input.Import {
+		if err := s.validateImport(items); err != nil {
+			return nil, err
+		}
+	}
+	if input.DryRun {
+		return report, nil
+	}
+
+	if input.Import && !input.AllowCheckCommands {
+		if err := rejectCheckCommands(report.Changes); err != nil {
+			return nil, err
+		}
+	}
+	if input.Import {
+		snapshot, err := s.snapshotBeforeDeletes(ctx, items)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot != nil {
+			report.Snapshot = snapshot.ID
+		}
+		if err := s.applyImports(ctx, items); err != nil {
+			return nil, err
+		}
+	}
+	if input.Export {
+		if err := s.applyExports(ctx, input.Directory, items); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.recordStates(ctx, input, items); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// ============================================================================
+// Planning
+// ============================================================================
+
+// plan reads both sides and the baselines and decides the change of every entity, ordered by path
+func (s *SyncApplicationService) plan(ctx context.Context, input dto.SyncDTO) ([]*syncItem, error) {
+	files, err := s.store.ReadFiles(ctx, input.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sync directory: %w", err)
+	}
+	dbEntities, err := s.loadDatabase(ctx)
+	if err != nil {
+		return nil, err
+	}
+	states, err := s.syncStateRepo.ListSyncStates(ctx, input.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sync baselines: %w", err)
+	}
+
+	byKey := map[syncKey]*syncItem{}
+	item := func(key syncKey) *syncItem {
+		if byKey[key] == nil {
+			byKey[key] = &syncItem{key: key}
+		}
+		return byKey[key]
+	}
+	var fileRoadmap, dbRoadmap string
+	for i := range files {
+		file := &files[i]
+		if file.Entity.GetType() == "roadmap" {
+			fileRoadmap = file.Entity.GetID()
+		}
+		item(syncKey{file.Entity.GetType(), file.Entity.GetID()}).file = file
+	}
+	for _, entity := range dbEntities {
+		if entity.GetType() == "roadmap" {
+			dbRoadmap = entity.GetID()
+		}
+		hash, err := s.store.Hash(entity)
+		if err != nil {
+			return nil, err
+		}
+		it := item(syncKey{entity.GetType(), entity.GetID()})
+		it.db = entity
+		it.dbHash = hash
+	}
+	for _, state := range states {
+		item(syncKey{state.EntityType, state.EntityID}).state = state
+	}
+	if fileRoadmap != "" && dbRoadmap != "" && fileRoadmap != dbRoadmap {
+		return nil, fmt.Errorf("%w: %s holds roadmap %s but the project roadmap is %s",
+			tmerrors.ErrInvalidArgument, s.store.FilePath("roadmap", fileRoadmap), fileRoadmap, dbRoadmap)
+	}
+
+	items := make([]*syncItem, 0, len(byKey))
+	for _, it := range byKey {
+		it.change = s.planItem(it, input.Prefer)
+		if it.change != nil && it.change.Action == dto.SyncActionImport {
+			it.change.CheckCommand = importedCheckCommand(it)
+		}
+		items = append(items, it)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return s.store.FilePath(items[i].key.entityType, items[i].key.entityID) < s.store.FilePath(items[j].key.entityType, items[j].key.entityID)
+	})
+
+	return items, nil
+}
+
+// planItem decides what to do with one entity, resolving conflicts by prefer when given
+func (s *SyncApplicationService) planItem(it *syncItem, prefer string) *dto.SyncChangeDTO {
+	change := &dto.SyncChangeDTO{
+		EntityType: it.key.entityType,
+		EntityID:   it.key.entityID,
+		Path:       s.store.FilePath(it.key.entityType, it.key.entityID),
+	}
+	set := func(action, operation, reason string) *dto.SyncChangeDTO {
+		change.Action, change.Operation, change.Reason = action, operation, reason
+		return change
+	}
+
+	fileChanged := it.file != nil && (it.state == nil || it.file.Hash != it.state.ContentHash)
+	dbChanged := it.db != nil && (it.state == nil || it.dbHash != it.state.ContentHash || !syncUpdatedAt(it.db).Equal(it.state.UpdatedAt))
+
+	switch {
+	case it.file != nil && it.db != nil:
+		if it.file.Hash == it.dbHash {
+			return nil
+		}
+		if it.state == nil {
+			fileUpdated, dbUpdated := syncUpdatedAt(it.file.Entity), syncUpdatedAt(it.db)
+			switch {
+			case fileUpdated.After(dbUpdated):
+				return set(dto.SyncActionImport, dto.SyncOperationUpdate, "file is newer than the database")
+			case dbUpdated.After(fileUpdated):
+				return set(dto.SyncActionExport, dto.SyncOperationUpdate, "database is newer than the file")
+			}
+			return s.resolve(it, set(dto.SyncActionConflict, "", "file and database differ with the same updated_at"), prefer)
+		}
+		switch {
+		case fileChanged && !dbChanged:
+			return set(dto.SyncActionImport, dto.SyncOperationUpdate, "file edited")
+		case dbChanged && !fileChanged:
+			return set(dto.SyncActionExport, dto.SyncOperationUpdate, "changed in the database")
+		}
+		return s.resolve(it, set(dto.SyncActionConflict, "", "file and database both changed since the last sync"), prefer)
+	case it.file != nil:
+		if it.state == nil {
+			return set(dto.SyncActionImport, dto.SyncOperationCreate, "new file")
+		}
+		if !fileChanged {
+			return set(dto.SyncActionExport, dto.SyncOperationDelete, "deleted in the database")
+		}
+		return s.resolve(it, set(dto.SyncActionConflict, "", "file edited but deleted in the database"), prefer)
+	case it.db != nil:
+		if it.state == nil {
+			return set(dto.SyncActionExport, dto.SyncOperationCreate, "not exported yet")
+		}
+		if dbChanged {
+			return s.resolve(it, set(dto.SyncActionConflict, "", "file deleted but changed in the database"), prefer)
+		}
+		if !isDeletableSyncType(it.key.entityType) {
+			return s.resolve(it, set(dto.SyncActionConflict, "", fmt.Sprintf("file deleted but %s entities cannot be deleted", it.key.entityType)), prefer)
+		}
+		return set(dto.SyncActionImport, dto.SyncOperationDelete, "file deleted")
+	}
+
+	// Only a baseline is left: both sides are gone
+	return nil
+}
+
+// resolve turns a conflict into an import or export following prefer.
+// A conflict whose preferred side is a deletion that cannot be applied stays a conflict.
+func (s *SyncApplicationService) resolve(it *syncItem, conflict *dto.SyncChangeDTO, prefer string) *dto.SyncChangeDTO {
+	switch prefer {
+	case dto.SyncPreferFiles:
+		switch {
+		case it.file != nil && it.db != nil:
+			conflict.Action, conflict.Operation = dto.SyncActionImport, dto.SyncOperationUpdate
+		case it.file != nil:
+			conflict.Action, conflict.Operation = dto.SyncActionImport, dto.SyncOperationCreate
+		case isDeletableSyncType(it.key.entityType):
+			conflict.Action, conflict.Operation = dto.SyncActionImport, dto.SyncOperationDelete
+		default:
+			return conflict
+		}
+	case dto.SyncPreferDatabase:
+		switch {
+		case it.file != nil && it.db != nil:
+			conflict.Action, conflict.Operation = dto.SyncActionExport, dto.SyncOperationUpdate
+		case it.db != nil:
+			conflict.Action, conflict.Operation = dto.SyncActionExport, dto.SyncOperationCreate
+		default:
+			conflict.Action, conflict.Operation = dto.SyncActionExport, dto.SyncOperationDelete
+		}
+	default:
+		return conflict
+	}
+	conflict.Reason += fmt.Sprintf(" (resolved in favour of the %s)", prefer)
+	return conflict
+}
+
+// validateImport checks that the database stays consistent once the imports are applied:
+// imported entities only reference entities that will exist, and no remaining entity
+// references a deleted one. Nothing is applied when a reference is broken.
+func (s *SyncApplicationService) validateImport(items []*syncItem) error {
+	final := map[syncKey]services.SyncEntity{}
+	var imported []services.SyncEntity
+	var deleted []syncKey
+	for _, it := range items {
+		switch {
+		case it.change != nil && it.change.Action == dto.SyncActionImport && it.change.Operation == dto.SyncOperationDelete:
+			deleted = append(deleted, it.key)
+		case it.change != nil && it.change.Action == dto.SyncActionImport:
+			final[it.key] = it.file.Entity
+			imported = append(imported, it.file.Entity)
+		case it.db != nil:
+			final[it.key] = it.db
+		}
+	}
+
+	for _, entity := range imported {
+		for _, ref := range syncReferences(entity) {
+			if final[ref] == nil {
+				return fmt.Errorf("%w: %s references %s %s, which does not exist",
+					tmerrors.ErrInvalidArgument, s.store.FilePath(entity.GetType(), entity.GetID()), ref.entityType, ref.entityID)
+			}
+		}
+	}
+	for _, key := range deleted {
+		for _, entity := range final {
+			for _, ref := range syncReferences(entity) {
+				if ref == key {
+					return fmt.Errorf("%w: cannot delete %s %s: %s %s still references it",
+						tmerrors.ErrInvalidArgument, key.entityType, key.entityID, entity.GetType(), entity.GetID())
+				}
+			}
+		}
+	}
+
+	hasRoadmap := false
+	for key := range final {
+		hasRoadmap = hasRoadmap || key.entityType == "roadmap"
+	}
+	for _, entity := range imported {
+		if entity.GetType() == "track" && !hasRoadmap {
+			return fmt.Errorf("%w: cannot import track %s without a roadmap", tmerrors.ErrInvalidArgument, entity.GetID())
+		}
+	}
+
+	return nil
+}
+
+// importedCheckCommand returns the check command an imported acceptance criterion file sets,
+// or "" when it keeps or removes the command in the database
+func importedCheckCommand(it *syncItem) string {
+	if it.file == nil {
+		return ""
+	}
+	imported, ok := it.file.Entity.(*entities.AcceptanceCriteriaEntity)
+	if !ok || imported.CheckCommand == "" {
+		return ""
+	}
+	if current, ok := it.db.(*entities.AcceptanceCriteriaEntity); ok && current.CheckCommand == imported.CheckCommand {
+		return ""
+	}
+	return imported.CheckCommand
+}
+
+// rejectCheckCommands refuses an import that sets check commands. tm ac run runs them in a
+// shell, so a command arriving in a file, e.g. from a merged branch, needs explicit approval.
+func rejectCheckCommands(changes []*dto.SyncChangeDTO) error {
+	var paths []string
+	for _, change := range changes {
+		if change.Action == dto.SyncActionImport && change.CheckCommand != "" {
+			paths = append(paths, fmt.Sprintf("%s (%q)", change.Path, change.CheckCommand))
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: the import sets check commands, which tm ac run runs in a shell: %s; review them and import again with --allow-check-commands",
+		tmerrors.ErrRejected, strings.Join(paths, ", "))
+}
+
+// ============================================================================
+// Applying
+// ============================================================================
+
+// snapshotBeforeDeletes snapshots the project when the import deletes entities.
+// Returns nil when nothing is deleted or snapshots are disabled.
+func (s *SyncApplicationService) snapshotBeforeDeletes(ctx context.Context, items []*syncItem) (*entities.ProjectSnapshot, error) {
+	if s.snapshots == nil {
+		return nil, nil
+	}
+	for _, it := range items {
+		if it.change != nil && it.change.Action == dto.SyncActionImport && it.change.Operation == dto.SyncOperationDelete {
+			snapshot, err := s.snapshots.SnapshotBefore(ctx, "", entities.SnapshotReasonSyncImport)
+			if err != nil {
+				return nil, fmt.Errorf("failed to snapshot project before deleting entities: %w", err)
+			}
+			return snapshot, nil
+		}
+	}
+	return nil, nil
+}
+
+// applyImports writes the imported files to the database in one transaction, then announces them.
+// Pre-hooks may veto an imported transition before anything is written.
+func (s *SyncApplicationService) applyImports(ctx context.Context, items []*syncItem) error {
+	now := time.Now().UTC()
+	var imports []*syncItem
+	for _, it := range items {
+		if it.change != nil && it.change.Action == dto.SyncActionImport {
+			if it.change.Operation != dto.SyncOperationDelete {
+				prepareSyncImport(it, now)
+			}
+			imports = append(imports, it)
+		}
+	}
+
+	var announced []events.Event
+	for _, it := range imports {
+		announced = append(announced, importEvents(it)...)
+	}
+	if s.guard != nil {
+		for _, event := range announced {
+			if !syncGuardedEvents[event.Type] {
+				continue
+			}
+			if err := s.guard.BeforeTransition(ctx, event); err != nil {
+				return fmt.Errorf("failed to import %s: %w", s.store.FilePath(event.EntityType, event.EntityID), err)
+			}
+		}
+	}
+
+	err := s.txManager.InTransaction(ctx, func(ctx context.Context) error {
+		return s.writeImports(ctx, items, imports)
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.eventBus != nil {
+		for _, event := range announced {
+			s.eventBus.Publish(ctx, event)
+		}
+	}
+	return nil
+}
+
+// writeImports writes the imported files to the database: creates and updates in dependency
+// order, then deletes in reverse order
+func (s *SyncApplicationService) writeImports(ctx context.Context, items, imports []*syncItem) error {
+	byType := map[string][]*syncItem{}
+	for _, it := range imports {
+		byType[it.key.entityType] = append(byType[it.key.entityType], it)
+	}
+
+	roadmapID := ""
+	for _, it := range items {
+		if it.key.entityType == "roadmap" && (it.db != nil || it.file != nil) {
+			roadmapID = it.key.entityID
+		}
+	}
+
+	for _, entityType := range syncEntityTypes {
+		for _, it := range byType[entityType] {
+			if it.change.Operation == dto.SyncOperationDelete {
+				continue
+			}
+			if err := s.saveEntity(ctx, it, roadmapID); err != nil {
+				return fmt.Errorf("failed to import %s: %w", it.change.Path, err)
+			}
+			it.change.Applied = true
+		}
+
+		// Links between entities of one type are applied once all of them exist
+		switch entityType {
+		case "track":
+			for _, it := range byType[entityType] {
+				if it.change.Operation == dto.SyncOperationCreate && len(it.file.Entity.(*entities.TrackEntity).Dependencies) > 0 {
+					if err := s.trackRepo.UpdateTrack(ctx, it.file.Entity.(*entities.TrackEntity)); err != nil {
+						return fmt.Errorf("failed to import dependencies of %s: %w", it.change.Path, err)
+					}
+				}
+			}
+		case "task":
+			for _, it := range byType[entityType] {
+				if it.change.Operation == dto.SyncOperationDelete {
+					continue
+				}
+				if err := s.syncTaskBlockers(ctx, it); err != nil {
+					return fmt.Errorf("failed to import blockers of %s: %w", it.change.Path, err)
+				}
+			}
+		}
+	}
+
+	for i := len(syncEntityTypes) - 1; i >= 0; i-- {
+		for _, it := range byType[syncEntityTypes[i]] {
+			if it.change.Operation != dto.SyncOperationDelete {
+				continue
+			}
+			if err := s.deleteEntity(ctx, it.key); err != nil {
+				return fmt.Errorf("failed to delete %s %s: %w", it.key.entityType, it.key.entityID, err)
+			}
+			it.change.Applied = true
+		}
+	}
+
+	return nil
+}
+
+// saveEntity creates or updates the database row of an imported file
+func (s *SyncApplicationService) saveEntity(ctx context.Context, it *syncItem, roadmapID string) error {
+	create := it.change.Operation == dto.SyncOperationCreate
+	switch e := it.file.Entity.(type) {
+	case *entities.RoadmapEntity:
+		if create {
+			return s.roadmapRepo.SaveRoadmap(ctx, e)
+		}
+		return s.roadmapRepo.UpdateRoadmap(ctx, e)
+	case *entities.TrackEntity:
+		e.RoadmapID = roadmapID
+		if create {
+			// Dependencies are linked once every imported track exists
+			withoutDeps := *e
+			withoutDeps.Dependencies = []string{}
+			return s.trackRepo.SaveTrack(ctx, &withoutDeps)
+		}
+		return s.trackRepo.UpdateTrack(ctx, e)
+	case *entities.TaskEntity:
+		if create {
+			return s.taskRepo.SaveTask(ctx, e)
+		}
+		return s.taskRepo.UpdateTask(ctx, e)
+	case *entities.IterationEntity:
+		if create {
+			return s.iterationRepo.SaveIteration(ctx, e)
+		}
+		return s.iterationRepo.UpdateIteration(ctx, e)
+	case *entities.AcceptanceCriteriaEntity:
+		if create {
+			return s.acRepo.SaveAC(ctx, e)
+		}
+		return s.acRepo.UpdateAC(ctx, e)
+	case *entities.ADREntity:
+		if create {
+			return s.adrRepo.SaveADR(ctx, e)
+		}
+		return s.adrRepo.UpdateADR(ctx, e)
+	case *entities.DocumentEntity:
+		if create {
+			return s.documentRepo.SaveDocument(ctx, e)
+		}
+		return s.documentRepo.UpdateDocument(ctx, e)
+	default:
+		return fmt.Errorf("%w: cannot sync entity of type %s", tmerrors.ErrInvalidArgument, it.key.entityType)
+	}
+}
+
+// syncTaskBlockers links and unlinks blockers so the task's blockers match its file
+func (s *SyncApplicationService) syncTaskBlockers(ctx context.Context, it *syncItem) error {
+	wanted := map[string]bool{}
+	for _, id := range it.file.Entity.(*entities.TaskEntity).BlockedBy {
+		wanted[id] = true
+	}
+	current := map[string]bool{}
+	if it.db != nil {
+		for _, id := range it.db.(*entities.TaskEntity).BlockedBy {
+			current[id] = true
+		}
+	}
+
+	for id := range current {
+		if !wanted[id] {
+			if err := s.taskRepo.RemoveTaskDependency(ctx, it.key.entityID, id); err != nil {
+				return err
+			}
+		}
+	}
+	for _, id := range it.file.Entity.(*entities.TaskEntity).BlockedBy {
+		if !current[id] {
+			if err := s.taskRepo.AddTaskDependency(ctx, it.key.entityID, id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteEntity removes an entity whose file was deleted
+func (s *SyncApplicationService) deleteEntity(ctx context.Context, key syncKey) error {
+	switch key.entityType {
+	case "track":
+		return s.trackRepo.DeleteTrack(ctx, key.entityID)
+	case "task":
+		return s.taskRepo.DeleteTask(ctx, key.entityID)
+	case "iteration":
+		number, err := strconv.Atoi(key.entityID)
+		if err != nil {
+			return fmt.Errorf("%w: invalid iteration number %q", tmerrors.ErrInvalidArgument, key.entityID)
+		}
+		return s.iterationRepo.DeleteIteration(ctx, number)
+	case "acceptance_criteria":
+		return s.acRepo.DeleteAC(ctx, key.entityID)
+	case "document":
+		return s.documentRepo.DeleteDocument(ctx, key.entityID)
+	default:
+		return fmt.Errorf("%w: %s entities cannot be deleted", tmerrors.ErrInvalidArgument, key.entityType)
+	}
+}
+
+// applyExports writes the database side of the exported entities to the sync directory
+func (s *SyncApplicationService) applyExports(ctx context.Context, dir string, items []*syncItem) error {
+	for _, it := range items {
+		if it.change == nil || it.change.Action != dto.SyncActionExport {
+			continue
+		}
+		if it.change.Operation == dto.SyncOperationDelete {
+			if err := s.store.RemoveFile(ctx, dir, it.key.entityType, it.key.entityID); err != nil {
+				return err
+			}
+		} else if _, err := s.store.WriteFile(ctx, dir, it.db); err != nil {
+			return err
+		}
+		it.change.Applied = true
+	}
+	return nil
+}
+
+// recordStates records a new baseline for every entity whose file and database row now agree.
+// Imported files are rewritten from the database, so they hold the canonical form and the
+// timestamps the database assigned.
+func (s *SyncApplicationService) recordStates(ctx context.Context, input dto.SyncDTO, items []*syncItem) error {
+	current := map[syncKey]services.SyncEntity{}
+	dbEntities, err := s.loadDatabase(ctx)
+	if err != nil {
+		return err
+	}
+	for _, entity := range dbEntities {
+		current[syncKey{entity.GetType(), entity.GetID()}] = entity
+	}
+
+	syncedAt := time.Now().UTC()
+	for _, it := range items {
+		if it.change != nil && !it.change.Applied {
+			continue
+		}
+		entity := current[it.key]
+		if entity == nil {
+			if it.state != nil {
+				if err := s.syncStateRepo.DeleteSyncState(ctx, input.Directory, it.key.entityType, it.key.entityID); err != nil {
+					return fmt.Errorf("failed to delete sync baseline: %w", err)
+				}
+			}
+			continue
+		}
+
+		var hash string
+		if it.change != nil && it.change.Action == dto.SyncActionImport {
+			file, err := s.store.WriteFile(ctx, input.Directory, entity)
+			if err != nil {
+				return err
+			}
+			hash = file.Hash
+		} else if hash, err = s.store.Hash(entity); err != nil {
+			return err
+		}
+
+		updatedAt := syncUpdatedAt(entity)
+		if it.state != nil && it.state.ContentHash == hash && it.state.UpdatedAt.Equal(updatedAt) {
+			continue
+		}
+		if err := s.syncStateRepo.SaveSyncState(ctx, &entities.SyncState{
+			Directory:   input.Directory,
+			EntityType:  it.key.entityType,
+			EntityID:    it.key.entityID,
+			ContentHash: hash,
+			UpdatedAt:   updatedAt,
+			SyncedAt:    syncedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to save sync baseline: %w", err)
+		}
+	}
+	return nil
+}
+
+// loadDatabase returns every synced entity of the project
+func (s *SyncApplicationService) loadDatabase(ctx context.Context) ([]services.SyncEntity, error) {
+	result := []services.SyncEntity{}
+
+	roadmap, err := s.roadmapRepo.GetActiveRoadmap(ctx)
+	if err != nil && !errors.Is(err, tmerrors.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+	if roadmap != nil {
+		result = append(result, roadmap)
+		tracks, err := s.trackRepo.ListTracks(ctx, roadmap.ID, entities.TrackFilters{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tracks: %w", err)
+		}
+		for _, track := range tracks {
+			result = append(result, track)
+		}
+	}
+
+	tasks, err := s.taskRepo.ListTasks(ctx, entities.TaskFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	for _, task := range tasks {
+		result = append(result, task)
+		acs, err := s.acRepo.ListAC(ctx, task.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list acceptance criteria of %s: %w", task.ID, err)
+		}
+		for _, ac := range acs {
+			result = append(result, ac)
+		}
+	}
+
+	iterations, err := s.iterationRepo.ListIterations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list iterations: %w", err)
+	}
+	for _, iteration := range iterations {
+		result = append(result, iteration)
+	}
+
+	adrs, err := s.adrRepo.ListADRs(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ADRs: %w", err)
+	}
+	for _, adr := range adrs {
+		result = append(result, adr)
+	}
+
+	documents, err := s.documentRepo.FindAllDocuments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+	for _, doc := range documents {
+		result = append(result, doc)
+	}
+
+	return result, nil
+}
+
+// ============================================================================
+// Events
+// ============================================================================
+
+// importEvents returns the events announcing an imported change, as the services announce the
+// same change: the mutation itself, followed by derived events for a track or task status change
+func importEvents(it *syncItem) []events.Event {
+	entityType := syncEventEntityType(it.key.entityType)
+	event := func(eventType string, payload, previous interface{}) events.Event {
+		return events.NewEvent(eventType, entityType, it.key.entityID, payload, previous)
+	}
+	derived := func(eventType string, payload, previous interface{}) events.Event {
+		e := event(eventType, payload, previous)
+		e.Derived = true
+		return e
+	}
+
+	if it.change.Operation == dto.SyncOperationDelete {
+		return []events.Event{event(syncDeletedEvents[it.key.entityType], it.db, it.db)}
+	}
+	if it.change.Operation == dto.SyncOperationCreate {
+		return []events.Event{event(syncCreatedEvents[it.key.entityType], it.file.Entity, nil)}
+	}
+
+	switch e := it.file.Entity.(type) {
+	case *entities.TrackEntity:
+		previous := it.db.(*entities.TrackEntity)
+		result := []events.Event{event(events.EventTrackUpdated, e, previous)}
+		if e.Status != previous.Status {
+			result = append(result, derived(events.EventTrackStatusChanged, e, previous))
+			switch e.Status {
+			case string(entities.TrackStatusComplete):
+				result = append(result, derived(events.EventTrackCompleted, e, previous))
+			case string(entities.TrackStatusBlocked):
+				result = append(result, derived(events.EventTrackBlocked, e, previous))
+			}
+		}
+		return result
+	case *entities.TaskEntity:
+		previous := it.db.(*entities.TaskEntity)
+		result := []events.Event{event(events.EventTaskUpdated, e, previous)}
+		if e.Status != previous.Status {
+			result = append(result, derived(events.EventTaskStatusChanged, e, previous))
+			if e.Status == string(entities.TaskStatusDone) {
+				result = append(result, derived(events.EventTaskCompleted, e, previous))
+			}
+		}
+		return result
+	case *entities.IterationEntity:
+		previous := it.db.(*entities.IterationEntity)
+		eventType := events.EventIterationUpdated
+		if e.Status != previous.Status {
+			switch e.Status {
+			case string(entities.IterationStatusCurrent):
+				eventType = events.EventIterationStarted
+			case string(entities.IterationStatusComplete):
+				eventType = events.EventIterationCompleted
+			case string(entities.IterationStatusPlanned):
+				eventType = events.EventIterationReverted
+			}
+		}
+		return []events.Event{event(eventType, e, previous)}
+	case *entities.AcceptanceCriteriaEntity:
+		previous := it.db.(*entities.AcceptanceCriteriaEntity)
+		eventType := events.EventACUpdated
+		if e.Status != previous.Status {
+			switch e.Status {
+			case entities.ACStatusVerified:
+				eventType = events.EventACVerified
+			case entities.ACStatusAutomaticallyVerified:
+				eventType = events.EventACAutomaticallyVerified
+			case entities.ACStatusFailed:
+				eventType = events.EventACFailed
+			case entities.ACStatusSkipped:
+				eventType = events.EventACSkipped
+			}
+		}
+		return []events.Event{event(eventType, e, previous)}
+	case *entities.ADREntity:
+		previous := it.db.(*entities.ADREntity)
+		eventType := events.EventADRUpdated
+		if e.Status != previous.Status {
+			switch e.Status {
+			case string(entities.ADRStatusSuperseded):
+				eventType = events.EventADRSuperseded
+			case string(entities.ADRStatusDeprecated):
+				eventType = events.EventADRDeprecated
+			}
+		}
+		return []events.Event{event(eventType, e, previous)}
+	case *entities.RoadmapEntity:
+		return []events.Event{event(events.EventRoadmapUpdated, e, it.db)}
+	default:
+		return []events.Event{event(events.EventDocumentUpdated, it.file.Entity, it.db)}
+	}
+}
+
+// syncEventEntityType maps a sync entity type to the entity type of its events
+func syncEventEntityType(entityType string) string {
+	if entityType == "acceptance_criteria" {
+		return events.EntityTypeAC
+	}
+	return entityType
+}
+
+// ============================================================================
+// Entity helpers
+// ============================================================================
+
+// prepareSyncImport fills in the timestamps of an imported file. A hand edit that left
+// updated_at untouched gets the import time, so the database records that it changed.
+func prepareSyncImport(it *syncItem, now time.Time) {
+	createdAt, updatedAt := syncTimestamps(it.file.Entity)
+	if createdAt.IsZero() {
+		createdAt = now
+	}
+	if updatedAt.IsZero() || (it.db != nil && !updatedAt.After(syncUpdatedAt(it.db))) {
+		updatedAt = now
+	}
+
+	switch e := it.file.Entity.(type) {
+	case *entities.RoadmapEntity:
+		e.CreatedAt, e.UpdatedAt = createdAt, updatedAt
+	case *entities.TrackEntity:
+		e.CreatedAt, e.UpdatedAt = createdAt, updatedAt
+	case *entities.TaskEntity:
+		e.CreatedAt, e.UpdatedAt = createdAt, updatedAt
+	case *entities.IterationEntity:
+		e.CreatedAt, e.UpdatedAt = createdAt, updatedAt
+	case *entities.AcceptanceCriteriaEntity:
+		e.CreatedAt, e.UpdatedAt = createdAt, updatedAt
+	case *entities.ADREntity:
+		e.CreatedAt, e.UpdatedAt = createdAt, updatedAt
+	case *entities.DocumentEntity:
+		e.CreatedAt, e.UpdatedAt = createdAt, updatedAt
+	}
+}
+
+// syncTimestamps returns an entity's created_at and updated_at
+func syncTimestamps(entity services.SyncEntity) (time.Time, time.Time) {
+	switch e := entity.(type) {
+	case *entities.RoadmapEntity:
+		return e.CreatedAt, e.UpdatedAt
+	case *entities.TrackEntity:
+		return e.CreatedAt, e.UpdatedAt
+	case *entities.TaskEntity:
+		return e.CreatedAt, e.UpdatedAt
+	case *entities.IterationEntity:
+		return e.CreatedAt, e.UpdatedAt
+	case *entities.AcceptanceCriteriaEntity:
+		return e.CreatedAt, e.UpdatedAt
+	case *entities.ADREntity:
+		return e.CreatedAt, e.UpdatedAt
+	case *entities.DocumentEntity:
+		return e.CreatedAt, e.UpdatedAt
+	default:
+		return time.Time{}, time.Time{}
+	}
+}
+
+// syncUpdatedAt returns an entity's updated_at
+func syncUpdatedAt(entity services.SyncEntity) time.Time {
+	_, updatedAt := syncTimestamps(entity)
+	return updatedAt
+}
+
+// syncReferences returns the entities an entity links to
+func syncReferences(entity services.SyncEntity) []syncKey {
+	var refs []syncKey
+	switch e := entity.(type) {
+	case *entities.TrackEntity:
+		for _, id := range e.Dependencies {
+			refs = append(refs, syncKey{"track", id})
+		}
+	case *entities.TaskEntity:
+		refs = append(refs, syncKey{"track", e.TrackID})
+		for _, id := range e.BlockedBy {
+			refs = append(refs, syncKey{"task", id})
+		}
+	case *entities.IterationEntity:
+		for _, id := range e.TaskIDs {
+			refs = append(refs, syncKey{"task", id})
+		}
+	case *entities.AcceptanceCriteriaEntity:
+		refs = append(refs, syncKey{"task", e.TaskID})
+	case *entities.ADREntity:
+		refs = append(refs, syncKey{"track", e.TrackID})
+		if e.SupersededBy != nil {
+			refs = append(refs, syncKey{"adr", *e.SupersededBy})
+		}
+	case *entities.DocumentEntity:
+		if e.TrackID != nil {
+			refs = append(refs, syncKey{"track", *e.TrackID})
+		}
+		if e.IterationNumber != nil {
+			refs = append(refs, syncKey{"iteration", strconv.Itoa(*e.IterationNumber)})
+		}
+	}
+	return refs
+}
+
+// isDeletableSyncType reports whether deleting a file may delete its entity.
+// The roadmap and ADRs have no delete operation; ADRs are deprecated or superseded instead.
+func isDeletableSyncType(entityType string) bool {
+	return entityType != "roadmap" && entityType != "adr"
+}
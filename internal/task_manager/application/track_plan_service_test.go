@@ -0,0 +1,155 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type planTrack struct {
+	id, status string
+	rank       int
+	deps       []string
+}
+
+// setupTrackPlanTest returns a track plan service over the given tracks of one roadmap
+func setupTrackPlanTest(t *testing.T, tracks []planTrack) (*application.TrackPlanApplicationService, map[string]*entities.TrackEntity) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Date(2025, 8, 1, 9, 0, 0, 0, time.UTC)
+
+	roadmapRepo := mocks.NewMockRoadmapRepository()
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "Plan the work", "Tracks ship in order", now, now)
+	require.NoError(t, roadmapRepo.SaveRoadmap(ctx, roadmap))
+
+	trackRepo := mocks.NewMockTrackRepository()
+	stored := make(map[string]*entities.TrackEntity)
+	for _, track := range tracks {
+		entity, err := entities.NewTrackEntity(track.id, roadmap.ID, "Track "+track.id, "", track.status, track.rank, track.deps, now, now)
+		require.NoError(t, err)
+		require.NoError(t, trackRepo.SaveTrack(ctx, entity))
+		stored[track.id] = entity
+	}
+	trackRepo.GetTrackFunc = func(ctx context.Context, id string) (*entities.TrackEntity, error) {
+		if track, ok := stored[id]; ok {
+			return track, nil
+		}
+		return nil, tmerrors.ErrNotFound
+	}
+	trackRepo.GetTrackDependenciesFunc = func(ctx context.Context, id string) ([]string, error) {
+		return stored[id].Dependencies, nil
+	}
+
+	trackService := application.NewTrackApplicationService(trackRepo, roadmapRepo, &mocks.MockTaskRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, &mocks.MockADRRepository{}, &mocks.MockDocumentRepository{}, &mocks.MockAggregateRepository{}, services.NewValidationService(), nil, nil)
+	return application.NewTrackPlanApplicationService(roadmapRepo, trackRepo, services.NewDependencyService(), trackService), stored
+}
+
+// TestTrackPlanApplicationService_PlanTracks verifies waves, violations and suggestions
+func TestTrackPlanApplicationService_PlanTracks(t *testing.T) {
+	// Core (complete) <- API (in-progress) <- UI (in-progress), API <- Docs (not-started);
+	// Infra (blocked) <- Deploy (waiting)
+	service, _ := setupTrackPlanTest(t, []planTrack{
+		{"TM-track-1", "complete", 100, nil},
+		{"TM-track-2", "in-progress", 200, []string{"TM-track-1"}},
+		{"TM-track-3", "in-progress", 300, []string{"TM-track-2"}},
+		{"TM-track-4", "not-started", 100, []string{"TM-track-2"}},
+		{"TM-track-5", "blocked", 500, nil},
+		{"TM-track-6", "waiting", 100, []string{"TM-track-5"}},
+	})
+
+	plan, err := service.PlanTracks(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"TM-track-1"}, plan.Completed)
+	require.Len(t, plan.Waves, 2)
+	assert.Equal(t, []string{"TM-track-2", "TM-track-5"}, planWaveIDs(plan.Waves[0].Tracks))
+	assert.Equal(t, []string{"TM-track-4", "TM-track-6", "TM-track-3"}, planWaveIDs(plan.Waves[1].Tracks), "tracks within a wave are ordered by rank")
+	assert.Empty(t, plan.Waves[0].Tracks[0].OpenDependencies, "complete dependencies are not open")
+
+	require.Len(t, plan.Violations, 1)
+	assert.Equal(t, "TM-track-3", plan.Violations[0].TrackID)
+	assert.Equal(t, []string{"TM-track-2"}, plan.Violations[0].IncompleteDependencies)
+
+	suggested := make(map[string]string)
+	for _, suggestion := range plan.Suggestions {
+		suggested[suggestion.TrackID] = suggestion.To
+	}
+	assert.Equal(t, map[string]string{
+		"TM-track-3": "waiting",
+		"TM-track-4": "waiting",
+		"TM-track-6": "blocked",
+	}, suggested)
+	assert.False(t, plan.Applied)
+}
+
+// TestTrackPlanApplicationService_SuggestionsFollowUpstream verifies that suggestions take upstream suggestions into account
+func TestTrackPlanApplicationService_SuggestionsFollowUpstream(t *testing.T) {
+	// Infra (blocked) <- API (not-started) <- UI (not-started); Core (complete) <- Docs (waiting)
+	service, _ := setupTrackPlanTest(t, []planTrack{
+		{"TM-track-1", "blocked", 100, nil},
+		{"TM-track-2", "not-started", 100, []string{"TM-track-1"}},
+		{"TM-track-3", "not-started", 100, []string{"TM-track-2"}},
+		{"TM-track-4", "complete", 100, nil},
+		{"TM-track-5", "waiting", 100, []string{"TM-track-4"}},
+	})
+
+	plan, err := service.PlanTracks(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, plan.Suggestions, 3, "suggestions follow the plan order")
+	assert.Equal(t, "TM-track-5", plan.Suggestions[0].TrackID)
+	assert.Equal(t, "not-started", plan.Suggestions[0].To, "a waiting track is released once its dependencies complete")
+	assert.Equal(t, "TM-track-2", plan.Suggestions[1].TrackID)
+	assert.Equal(t, "blocked", plan.Suggestions[1].To)
+	assert.Equal(t, "TM-track-3", plan.Suggestions[2].TrackID)
+	assert.Equal(t, "blocked", plan.Suggestions[2].To, "UI is blocked because API will be")
+	assert.Equal(t, "blocked by TM-track-2", plan.Suggestions[2].Reason)
+}
+
+// TestTrackPlanApplicationService_ApplySuggestions verifies that applied suggestions leave nothing to correct
+func TestTrackPlanApplicationService_ApplySuggestions(t *testing.T) {
+	service, stored := setupTrackPlanTest(t, []planTrack{
+		{"TM-track-1", "in-progress", 100, nil},
+		{"TM-track-2", "in-progress", 100, []string{"TM-track-1"}},
+	})
+
+	plan, err := service.ApplySuggestions(context.Background())
+	require.NoError(t, err)
+
+	assert.True(t, plan.Applied)
+	require.Len(t, plan.Suggestions, 1)
+	assert.Equal(t, "waiting", stored["TM-track-2"].Status)
+	assert.Empty(t, plan.Violations)
+
+	again, err := service.PlanTracks(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, again.Suggestions, "applying the suggestions should settle the statuses")
+}
+
+// TestTrackPlanApplicationService_NoRoadmap verifies that planning needs a roadmap
+func TestTrackPlanApplicationService_NoRoadmap(t *testing.T) {
+	service := application.NewTrackPlanApplicationService(mocks.NewMockRoadmapRepository(), mocks.NewMockTrackRepository(), services.NewDependencyService(), nil)
+
+	_, err := service.PlanTracks(context.Background())
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, tmerrors.ErrNotFound))
+}
+
+// planWaveIDs returns the track IDs of a wave in order
+func planWaveIDs(items []*dto.TrackPlanItemDTO) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
 	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
 )
@@ -17,22 +18,43 @@ import (
 type TrackApplicationService struct {
 	trackRepo     repositories.TrackRepository
 	roadmapRepo   repositories.RoadmapRepository
+	taskRepo      repositories.TaskRepository
+	acRepo        repositories.AcceptanceCriteriaRepository
+	adrRepo       repositories.ADRRepository
+	documentRepo  repositories.DocumentRepository
 	aggregateRepo repositories.AggregateRepository
 	validationSvc *services.ValidationService
+	eventBus      events.EventBus
+	snapshots     *SnapshotApplicationService
 }
 
-// NewTrackApplicationService creates a new track application service
+// NewTrackApplicationService creates a new track application service.
+// The task, AC, ADR and document repositories list what deleting a track removes with it.
+// eventBus may be nil, in which case no domain events are published.
+// snapshots may be nil, in which case no snapshot is taken before a track is deleted.
 func NewTrackApplicationService(
 	trackRepo repositories.TrackRepository,
 	roadmapRepo repositories.RoadmapRepository,
+	taskRepo repositories.TaskRepository,
+	acRepo repositories.AcceptanceCriteriaRepository,
+	adrRepo repositories.ADRRepository,
+	documentRepo repositories.DocumentRepository,
 	aggregateRepo repositories.AggregateRepository,
 	validationSvc *services.ValidationService,
+	eventBus events.EventBus,
+	snapshots *SnapshotApplicationService,
 ) *TrackApplicationService {
 	return &TrackApplicationService{
 		trackRepo:     trackRepo,
 		roadmapRepo:   roadmapRepo,
+		taskRepo:      taskRepo,
+		acRepo:        acRepo,
+		adrRepo:       adrRepo,
+		documentRepo:  documentRepo,
 		aggregateRepo: aggregateRepo,
 		validationSvc: validationSvc,
+		eventBus:      eventBus,
+		snapshots:     snapshots,
 	}
 }
 
@@ -95,6 +117,8 @@ func (s *TrackApplicationService) CreateTrack(ctx context.Context, input dto.Cre
 		return nil, err
 	}
 
+	publishEvent(ctx, s.eventBus, events.EventTrackCreated, events.EntityTypeTrack, track.ID, track, nil)
+
 	return track, nil
 }
 
@@ -105,6 +129,7 @@ func (s *TrackApplicationService) UpdateTrack(ctx context.Context, input dto.Upd
 	if err != nil {
 		return nil, err
 	}
+	previous := cloneTrack(track)
 
 	// Apply updates
 	if input.Title != nil {
@@ -139,18 +164,49 @@ func (s *TrackApplicationService) UpdateTrack(ctx context.Context, input dto.Upd
 		return nil, err
 	}
 
+	// Announce the change, with status-specific events when the status moved
+	publishEvent(ctx, s.eventBus, events.EventTrackUpdated, events.EntityTypeTrack, track.ID, track, previous)
+	if track.Status != previous.Status {
+		publishDerivedEvent(ctx, s.eventBus, events.EventTrackStatusChanged, events.EntityTypeTrack, track.ID, track, previous)
+		switch track.Status {
+		case string(entities.TrackStatusComplete):
+			publishDerivedEvent(ctx, s.eventBus, events.EventTrackCompleted, events.EntityTypeTrack, track.ID, track, previous)
+		case string(entities.TrackStatusBlocked):
+			publishDerivedEvent(ctx, s.eventBus, events.EventTrackBlocked, events.EntityTypeTrack, track.ID, track, previous)
+		}
+	}
+
 	return track, nil
 }
 
-// DeleteTrack removes a track
-func (s *TrackApplicationService) DeleteTrack(ctx context.Context, trackID string) error {
+// DeleteTrack removes a track, snapshotting the project first.
+// Returns the snapshot, or nil if automatic snapshots are disabled.
+func (s *TrackApplicationService) DeleteTrack(ctx context.Context, trackID string) (*entities.ProjectSnapshot, error) {
 	// Verify track exists before deleting
-	_, err := s.trackRepo.GetTrack(ctx, trackID)
+	track, err := s.trackRepo.GetTrack(ctx, trackID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// The database deletes the track's tasks, ACs, ADRs and documents with it
+	cascade, err := trackCascade(ctx, s.taskRepo, s.acRepo, s.adrRepo, s.documentRepo, trackID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := snapshotBeforeDelete(ctx, s.snapshots, entities.SnapshotReasonTrackDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.trackRepo.DeleteTrack(ctx, trackID); err != nil {
+		return snapshot, err
 	}
 
-	return s.trackRepo.DeleteTrack(ctx, trackID)
+	publishCascade(ctx, s.eventBus, cascade)
+	publishEvent(ctx, s.eventBus, events.EventTrackDeleted, events.EntityTypeTrack, trackID, track, track)
+
+	return snapshot, nil
 }
 
 // GetTrack retrieves a track by ID
@@ -177,7 +233,7 @@ func (s *TrackApplicationService) GetTrackWithTasks(ctx context.Context, trackID
 // AddDependency adds a dependency from trackID to dependsOnID
 func (s *TrackApplicationService) AddDependency(ctx context.Context, trackID, dependsOnID string) error {
 	// Validate both tracks exist
-	_, err := s.trackRepo.GetTrack(ctx, trackID)
+	track, err := s.trackRepo.GetTrack(ctx, trackID)
 	if err != nil {
 		return fmt.Errorf("track not found: %w", err)
 	}
@@ -204,12 +260,31 @@ func (s *TrackApplicationService) AddDependency(ctx context.Context, trackID, de
 		return fmt.Errorf("circular dependency detected: %w", err)
 	}
 
+	if track != nil {
+		updated := cloneTrack(track)
+		_ = updated.AddDependency(dependsOnID)
+		publishEvent(ctx, s.eventBus, events.EventTrackUpdated, events.EntityTypeTrack, trackID, updated, track)
+	}
+
 	return nil
 }
 
 // RemoveDependency removes a dependency from trackID to dependsOnID
 func (s *TrackApplicationService) RemoveDependency(ctx context.Context, trackID, dependsOnID string) error {
-	return s.trackRepo.RemoveTrackDependency(ctx, trackID, dependsOnID)
+	if err := s.trackRepo.RemoveTrackDependency(ctx, trackID, dependsOnID); err != nil {
+		return err
+	}
+
+	// Announce the change when the track can be reloaded
+	if track, err := s.trackRepo.GetTrack(ctx, trackID); err == nil && track != nil {
+		previous := cloneTrack(track)
+		if !previous.HasDependency(dependsOnID) {
+			previous.Dependencies = append(previous.Dependencies, dependsOnID)
+		}
+		publishEvent(ctx, s.eventBus, events.EventTrackUpdated, events.EntityTypeTrack, track.ID, track, previous)
+	}
+
+	return nil
 }
 
 // GetDependencies returns the IDs of all tracks that trackID depends on
@@ -21,7 +21,7 @@ func setupTrackTestService(t *testing.T) (*application.TrackApplicationService,
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 	validationService := services.NewValidationService()
 
-	service := application.NewTrackApplicationService(mockTrackRepo, mockRoadmapRepo, mockAggregateRepo, validationService)
+	service := application.NewTrackApplicationService(mockTrackRepo, mockRoadmapRepo, &mocks.MockTaskRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, &mocks.MockADRRepository{}, &mocks.MockDocumentRepository{}, mockAggregateRepo, validationService, nil, nil)
 	ctx := context.Background()
 
 	return service, ctx, mockTrackRepo, mockRoadmapRepo, mockAggregateRepo
@@ -341,7 +341,7 @@ func TestTrackService_DeleteTrack_Success(t *testing.T) {
 	}
 
 	// Delete track
-	err := service.DeleteTrack(ctx, "TM-track-1")
+	_, err := service.DeleteTrack(ctx, "TM-track-1")
 	if err != nil {
 		t.Fatalf("DeleteTrack() failed: %v", err)
 	}
@@ -361,7 +361,7 @@ func TestTrackService_DeleteTrack_NotFound(t *testing.T) {
 		return tmerrors.ErrNotFound
 	}
 
-	err := service.DeleteTrack(ctx, "nonexistent")
+	_, err := service.DeleteTrack(ctx, "nonexistent")
 	if err == nil {
 		t.Fatal("DeleteTrack() should fail for non-existent track")
 	}
@@ -0,0 +1,137 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// vetoingGuard records consulted events and rejects the configured event type
+type vetoingGuard struct {
+	reject    string
+	consulted []events.Event
+}
+
+func (g *vetoingGuard) BeforeTransition(ctx context.Context, event events.Event) error {
+	g.consulted = append(g.consulted, event)
+	if event.Type == g.reject {
+		return fmt.Errorf("%w: vetoed by test", tmerrors.ErrRejected)
+	}
+	return nil
+}
+
+// TestTaskService_GuardVetoesStatusChange verifies a vetoed transition is neither persisted nor published
+func TestTaskService_GuardVetoesStatusChange(t *testing.T) {
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "todo", 100, "", now, now)
+
+	persisted := false
+	mockTaskRepo := &mocks.MockTaskRepository{
+		GetTaskFunc: func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+			return task, nil
+		},
+		UpdateTaskFunc: func(ctx context.Context, task *entities.TaskEntity) error {
+			persisted = true
+			return nil
+		},
+	}
+	bus := &recordingEventBus{}
+	guard := &vetoingGuard{reject: events.EventTaskStatusChanged}
+	service := application.NewTaskApplicationService(mockTaskRepo, &mocks.MockTrackRepository{}, &mocks.MockAggregateRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, services.NewValidationService(), bus, guard, nil)
+
+	_, err := service.UpdateTask(context.Background(), dto.UpdateTaskDTO{ID: "TM-task-1", Status: dto.StringPtr("in-progress")})
+	if !errors.Is(err, tmerrors.ErrRejected) {
+		t.Fatalf("UpdateTask() error = %v, want ErrRejected", err)
+	}
+	if persisted {
+		t.Error("vetoed transition should not be persisted")
+	}
+	assertEventTypes(t, bus)
+
+	consulted := guard.consulted[0]
+	if consulted.Payload.(*entities.TaskEntity).Status != "in-progress" || consulted.Previous.(*entities.TaskEntity).Status != "todo" {
+		t.Errorf("guard should see the proposed and current state")
+	}
+}
+
+// TestTaskService_GuardSkipsNonStatusUpdates verifies the guard is only consulted on transitions
+func TestTaskService_GuardSkipsNonStatusUpdates(t *testing.T) {
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "todo", 100, "", now, now)
+
+	mockTaskRepo := &mocks.MockTaskRepository{
+		GetTaskFunc: func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+			return task, nil
+		},
+	}
+	guard := &vetoingGuard{}
+	service := application.NewTaskApplicationService(mockTaskRepo, &mocks.MockTrackRepository{}, &mocks.MockAggregateRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, services.NewValidationService(), nil, guard, nil)
+
+	if _, err := service.UpdateTask(context.Background(), dto.UpdateTaskDTO{ID: "TM-task-1", Title: dto.StringPtr("Renamed")}); err != nil {
+		t.Fatalf("UpdateTask() failed: %v", err)
+	}
+	if len(guard.consulted) != 0 {
+		t.Errorf("guard consulted %d times, want 0", len(guard.consulted))
+	}
+}
+
+// TestIterationService_GuardVetoesCompletion verifies CompleteIteration honors the guard
+func TestIterationService_GuardVetoesCompletion(t *testing.T) {
+	now := time.Now().UTC()
+	iteration, _ := entities.NewIterationEntity(1, "Iteration", "Goal", "", []string{}, "current", 100, now, time.Time{}, now, now)
+
+	persisted := false
+	mockIterationRepo := &mocks.MockIterationRepository{
+		GetIterationFunc: func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+			return iteration, nil
+		},
+		UpdateIterationFunc: func(ctx context.Context, iteration *entities.IterationEntity) error {
+			persisted = true
+			return nil
+		},
+	}
+	guard := &vetoingGuard{reject: events.EventIterationCompleted}
+	service := application.NewIterationApplicationService(mockIterationRepo, &mocks.MockTaskRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, &mocks.MockDocumentRepository{}, &mocks.MockAggregateRepository{}, services.NewIterationService(), services.NewValidationService(), nil, nil, guard, nil)
+
+	err := service.CompleteIteration(context.Background(), 1, false)
+	if !errors.Is(err, tmerrors.ErrRejected) {
+		t.Fatalf("CompleteIteration() error = %v, want ErrRejected", err)
+	}
+	if persisted {
+		t.Error("vetoed completion should not be persisted")
+	}
+	if guard.consulted[0].EntityID != "1" {
+		t.Errorf("EntityID = %q, want %q", guard.consulted[0].EntityID, "1")
+	}
+}
+
+// TestACService_GuardVetoesFailure verifies FailAC honors the guard
+func TestACService_GuardVetoesFailure(t *testing.T) {
+	now := time.Now().UTC()
+	ac := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "Works", entities.VerificationTypeManual, "", now, now)
+
+	mockACRepo := &mocks.MockAcceptanceCriteriaRepository{
+		GetACFunc: func(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
+			return ac, nil
+		},
+	}
+	bus := &recordingEventBus{}
+	guard := &vetoingGuard{reject: events.EventACFailed}
+	service := application.NewACApplicationService(mockACRepo, &mocks.MockTaskRepository{}, &mocks.MockAggregateRepository{}, services.NewValidationService(), bus, guard)
+
+	err := service.FailAC(context.Background(), dto.FailACDTO{ID: "TM-ac-1", Feedback: "Broken"})
+	if !errors.Is(err, tmerrors.ErrRejected) {
+		t.Fatalf("FailAC() error = %v, want ErrRejected", err)
+	}
+	assertEventTypes(t, bus)
+}
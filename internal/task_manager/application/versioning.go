@@ -0,0 +1,17 @@
+package application
+
+import (
+	"fmt"
+
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+)
+
+// checkVersion rejects a change based on an older read of an entity: expected is the
+// version the caller last saw (nil when it doesn't care), stored is the current one.
+// The error is an ErrVersionMismatch, which RetryOnConflict does not retry.
+func checkVersion(entity, id string, stored int, expected *int) error {
+	if expected != nil && *expected != stored {
+		return fmt.Errorf("%w: %s %s is at version %d, not %d; reload it and retry", tmerrors.ErrVersionMismatch, entity, id, stored, *expected)
+	}
+	return nil
+}
@@ -13,8 +13,10 @@ type AcceptanceCriteriaEntity struct {
 	Status              AcceptanceCriteriaStatus           `json:"status"`               // Current verification status
 	Notes               string                             `json:"notes"`                // Additional notes (reason, feedback, etc.)
 	TestingInstructions string                             `json:"testing_instructions"` // Step-by-step testing guidance
+	CheckCommand        string                             `json:"check_command"`        // Shell command run by `tm ac run`; exit 0 means verified
 	CreatedAt           time.Time                          `json:"created_at"`
 	UpdatedAt           time.Time                          `json:"updated_at"`
+	Version             int                                `json:"version"` // Bumped on every stored update; 0 until the criterion is stored
 }
 
 // NewAcceptanceCriteriaEntity creates a new acceptance criterion entity
@@ -55,6 +57,22 @@ func (ac *AcceptanceCriteriaEntity) IsVerified() bool {
 	return ac.Status == ACStatusVerified || ac.Status == ACStatusAutomaticallyVerified
 }
 
+// HasCheck returns true if the AC has an executable check that `tm ac run` can execute
+func (ac *AcceptanceCriteriaEntity) HasCheck() bool {
+	return ac.CheckCommand != ""
+}
+
+// SetCheckCommand attaches (or clears, when empty) the executable check.
+// An AC with a check is automated; clearing the check makes it manual again.
+func (ac *AcceptanceCriteriaEntity) SetCheckCommand(command string) {
+	ac.CheckCommand = command
+	if command == "" {
+		ac.VerificationType = VerificationTypeManual
+	} else {
+		ac.VerificationType = VerificationTypeAutomated
+	}
+}
+
 // IsFailed returns true if the AC has failed verification
 func (ac *AcceptanceCriteriaEntity) IsFailed() bool {
 	return ac.Status == ACStatusFailed
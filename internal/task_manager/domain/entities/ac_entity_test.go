@@ -114,6 +114,21 @@ func TestAcceptanceCriteriaEntity_IsVerified(t *testing.T) {
 	}
 }
 
+func TestAcceptanceCriteriaEntity_SetCheckCommand(t *testing.T) {
+	now := time.Now()
+	ac := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "Tests pass", entities.VerificationTypeManual, "", now, now)
+	assert.False(t, ac.HasCheck())
+
+	ac.SetCheckCommand("go test ./... -run TestLogin")
+	assert.True(t, ac.HasCheck())
+	assert.Equal(t, "go test ./... -run TestLogin", ac.CheckCommand)
+	assert.Equal(t, entities.VerificationTypeAutomated, ac.VerificationType)
+
+	ac.SetCheckCommand("")
+	assert.False(t, ac.HasCheck())
+	assert.Equal(t, entities.VerificationTypeManual, ac.VerificationType)
+}
+
 func TestAcceptanceCriteriaEntity_IsFailed(t *testing.T) {
 	now := time.Now()
 
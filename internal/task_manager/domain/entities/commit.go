@@ -0,0 +1,107 @@
+package entities
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// taskIDPattern matches task IDs such as "TM-task-12"
+var taskIDPattern = regexp.MustCompile(`[A-Za-z0-9]+-task-[0-9]+`)
+
+// scissorsLine marks the start of the diff that `git commit --verbose` appends to the message file
+const scissorsLine = "# ------------------------ >8 ------------------------"
+
+// ParseCommitMessage builds a commit from a raw message as git passes it to the commit-msg hook.
+// Comment lines and everything below the scissors line are dropped, as git does before committing.
+func ParseCommitMessage(message string) Commit {
+	lines := []string{}
+	for _, line := range strings.Split(message, "\n") {
+		if strings.HasPrefix(line, scissorsLine) {
+			break
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	text := strings.TrimSpace(strings.Join(lines, "\n"))
+	subject, body, _ := strings.Cut(text, "\n")
+	return Commit{Subject: strings.TrimSpace(subject), Body: strings.TrimSpace(body)}
+}
+
+// Commit is a git commit linked to a task by mentioning its ID in the message.
+// Commits live in the code repository and are never persisted by the task manager.
+type Commit struct {
+	Hash    string    `json:"hash"`
+	Author  string    `json:"author"`
+	Date    time.Time `json:"date"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body,omitempty"`
+}
+
+// ShortHash returns the abbreviated commit hash
+func (c Commit) ShortHash() string {
+	if len(c.Hash) > 7 {
+		return c.Hash[:7]
+	}
+	return c.Hash
+}
+
+// References returns true if the commit message mentions the entity ID as a whole word,
+// so "TM-task-1" does not match a message that only mentions "TM-task-12".
+func (c Commit) References(id string) bool {
+	if id == "" {
+		return false
+	}
+	message := c.Subject + "\n" + c.Body
+	for offset := 0; ; {
+		i := strings.Index(message[offset:], id)
+		if i < 0 {
+			return false
+		}
+		start := offset + i
+		end := start + len(id)
+		if (start == 0 || !isIDChar(message[start-1])) && (end == len(message) || !isIDChar(message[end])) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+// isIDChar reports whether a byte can continue an entity ID such as "TM-task-12".
+func isIDChar(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+}
+
+// TaskIDs returns the task IDs mentioned in the commit message, in order of first appearance
+func (c Commit) TaskIDs() []string {
+	return uniqueTaskIDs(c.Subject + "\n" + c.Body)
+}
+
+// TrailerTaskIDs returns the task IDs listed in trailer lines with the given key,
+// e.g. "Closes: TM-task-12, TM-task-13" for key "Closes". Keys match case-insensitively.
+func (c Commit) TrailerTaskIDs(key string) []string {
+	values := []string{}
+	for _, line := range strings.Split(c.Body, "\n") {
+		name, value, found := strings.Cut(line, ":")
+		if found && strings.EqualFold(strings.TrimSpace(name), key) {
+			values = append(values, value)
+		}
+	}
+	return uniqueTaskIDs(strings.Join(values, "\n"))
+}
+
+// uniqueTaskIDs returns the task IDs found in text without duplicates
+func uniqueTaskIDs(text string) []string {
+	ids := []string{}
+	seen := map[string]bool{}
+	for _, id := range taskIDPattern.FindAllString(text, -1) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,80 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+func TestCommit_ShortHash(t *testing.T) {
+	commit := entities.Commit{Hash: "0123456789abcdef"}
+	if got := commit.ShortHash(); got != "0123456" {
+		t.Errorf("ShortHash() = %q, want %q", got, "0123456")
+	}
+
+	short := entities.Commit{Hash: "abc"}
+	if got := short.ShortHash(); got != "abc" {
+		t.Errorf("ShortHash() = %q, want %q", got, "abc")
+	}
+}
+
+func TestCommit_References(t *testing.T) {
+	tests := []struct {
+		name     string
+		subject  string
+		body     string
+		expected bool
+	}{
+		{"subject prefix", "TM-task-1: add handler", "", true},
+		{"in body trailer", "Add handler", "Closes: TM-task-1", true},
+		{"branch name", "Merge branch 'TM-task-1-login'", "", true},
+		{"in brackets", "[TM-task-1] add handler", "", true},
+		{"longer ID only", "TM-task-12: add handler", "", false},
+		{"prefixed ID only", "XTM-task-1: add handler", "", false},
+		{"longer then exact", "TM-task-12 and TM-task-1", "", true},
+		{"not mentioned", "Add handler", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commit := entities.Commit{Subject: tt.subject, Body: tt.body}
+			if got := commit.References("TM-task-1"); got != tt.expected {
+				t.Errorf("References() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseCommitMessage(t *testing.T) {
+	message := "TM-task-1: add handler\n\nLonger explanation.\n# On branch TM-task-2-login\n\nCloses: TM-task-1\n" +
+		"# ------------------------ >8 ------------------------\ndiff --git a/TM-task-3 b/TM-task-3\n"
+
+	commit := entities.ParseCommitMessage(message)
+	if commit.Subject != "TM-task-1: add handler" {
+		t.Errorf("Subject = %q", commit.Subject)
+	}
+	if commit.Body != "Longer explanation.\n\nCloses: TM-task-1" {
+		t.Errorf("Body = %q", commit.Body)
+	}
+	if ids := commit.TaskIDs(); len(ids) != 1 || ids[0] != "TM-task-1" {
+		t.Errorf("TaskIDs() = %v, want [TM-task-1]", ids)
+	}
+}
+
+func TestCommit_TrailerTaskIDs(t *testing.T) {
+	commit := entities.Commit{
+		Subject: "Closes: TM-task-9",
+		Body:    "Closes: TM-task-1, TM-task-2\ncloses: TM-task-1 TM-task-3\nReview: TM-task-4\nSee TM-task-5",
+	}
+
+	closes := commit.TrailerTaskIDs("Closes")
+	if len(closes) != 3 || closes[0] != "TM-task-1" || closes[1] != "TM-task-2" || closes[2] != "TM-task-3" {
+		t.Errorf("TrailerTaskIDs(Closes) = %v, want [TM-task-1 TM-task-2 TM-task-3]", closes)
+	}
+	if review := commit.TrailerTaskIDs("Review"); len(review) != 1 || review[0] != "TM-task-4" {
+		t.Errorf("TrailerTaskIDs(Review) = %v, want [TM-task-4]", review)
+	}
+	if none := commit.TrailerTaskIDs("Refs"); len(none) != 0 {
+		t.Errorf("TrailerTaskIDs(Refs) = %v, want none", none)
+	}
+}
@@ -20,6 +20,7 @@ type DocumentEntity struct {
 	IterationNumber *int                   `json:"iteration_number"` // Optional, validates >= 1
 	CreatedAt       time.Time              `json:"created_at"`
 	UpdatedAt       time.Time              `json:"updated_at"`
+	Version         int                    `json:"version"`  // Bumped on every stored update; 0 until the document is stored
 	Metadata        map[string]interface{} `json:"metadata"` // For SDK.IExtensible
 }
 
@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"time"
+)
+
+// EntityEvent is an append-only audit record of a single mutation.
+// Records are written once and never updated or deleted.
+type EntityEvent struct {
+	ID         int64         `json:"id"`
+	EntityType string        `json:"entity_type"` // task, track, iteration, ac, adr
+	EntityID   string        `json:"entity_id"`   // Iteration number for iterations
+	EventType  string        `json:"event_type"`  // One of the domain/events Event* constants
+	Changes    []FieldChange `json:"changes"`
+	Actor      string        `json:"actor"`
+	OccurredAt time.Time     `json:"occurred_at"`
+}
+
+// FieldChange describes the before/after value of a single entity field.
+// Before is nil for creations; After is nil for deletions.
+type FieldChange struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
@@ -0,0 +1,67 @@
+package entities
+
+// Consistency checks run over a project database, in reporting order
+const (
+	IntegrityCheckDatabase          = "database"           // SQLite's own integrity check
+	IntegrityCheckOrphans           = "orphans"            // Rows referencing entities that no longer exist
+	IntegrityCheckStatuses          = "invalid_statuses"   // Statuses outside the allowed values
+	IntegrityCheckRanks             = "ranks"              // Ranks outside 1-1000
+	IntegrityCheckCurrentIterations = "current_iterations" // More than one iteration marked current
+	IntegrityCheckDependencyCycles  = "dependency_cycles"  // Tracks or tasks depending on themselves
+	IntegrityCheckSequences         = "sequences"          // ID counters behind the IDs already used
+)
+
+// IntegrityChecks returns all consistency checks in reporting order
+func IntegrityChecks() []string {
+	return []string{
+		IntegrityCheckDatabase,
+		IntegrityCheckOrphans,
+		IntegrityCheckStatuses,
+		IntegrityCheckRanks,
+		IntegrityCheckCurrentIterations,
+		IntegrityCheckDependencyCycles,
+		IntegrityCheckSequences,
+	}
+}
+
+// IntegrityIssue is a single inconsistency found in a project database
+type IntegrityIssue struct {
+	Check      string `json:"check"`
+	EntityType string `json:"entity_type"` // Kind of entity or table the problem is in
+	EntityID   string `json:"entity_id"`
+	Message    string `json:"message"`
+	Repair     string `json:"repair,omitempty"` // Safe repair available for the problem; empty if it needs a human
+	Fixed      bool   `json:"fixed"`            // Whether the repair was applied
+}
+
+// IntegrityReport lists the inconsistencies found in a project database
+type IntegrityReport struct {
+	Issues []IntegrityIssue `json:"issues"`
+}
+
+// Fixed returns the issues that were repaired
+func (r *IntegrityReport) Fixed() []IntegrityIssue {
+	issues := []IntegrityIssue{}
+	for _, issue := range r.Issues {
+		if issue.Fixed {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// Unresolved returns the issues that are still present
+func (r *IntegrityReport) Unresolved() []IntegrityIssue {
+	issues := []IntegrityIssue{}
+	for _, issue := range r.Issues {
+		if !issue.Fixed {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// Healthy returns true if no issue is left unresolved
+func (r *IntegrityReport) Healthy() bool {
+	return len(r.Unresolved()) == 0
+}
@@ -0,0 +1,33 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+func TestIntegrityReport(t *testing.T) {
+	report := entities.IntegrityReport{Issues: []entities.IntegrityIssue{
+		{Check: entities.IntegrityCheckOrphans, EntityID: "3", Repair: "remove the link", Fixed: true},
+		{Check: entities.IntegrityCheckDependencyCycles, EntityID: "DW-task-1"},
+	}}
+
+	if got := len(report.Fixed()); got != 1 {
+		t.Errorf("len(Fixed()) = %d, want 1", got)
+	}
+	unresolved := report.Unresolved()
+	if len(unresolved) != 1 || unresolved[0].EntityID != "DW-task-1" {
+		t.Errorf("Unresolved() = %v, want only the dependency cycle", unresolved)
+	}
+	if report.Healthy() {
+		t.Error("Healthy() = true with an unresolved issue")
+	}
+
+	report.Issues = report.Issues[:1]
+	if !report.Healthy() {
+		t.Error("Healthy() = false with every issue fixed")
+	}
+	if !(&entities.IntegrityReport{}).Healthy() {
+		t.Error("Healthy() = false for an empty report")
+	}
+}
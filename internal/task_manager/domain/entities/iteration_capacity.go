@@ -0,0 +1,34 @@
+package entities
+
+// IterationCapacity sums the estimates of an iteration's tasks against its capacity.
+// Cancelled tasks are not counted; done tasks count as committed but not remaining.
+type IterationCapacity struct {
+	Capacity    *float64 `json:"capacity,omitempty"` // Nil when the iteration has no capacity set
+	Committed   float64  `json:"committed"`          // Estimates of all tasks in the iteration
+	Remaining   float64  `json:"remaining"`          // Estimates of the tasks not done yet
+	Unestimated int      `json:"unestimated"`        // Tasks without an estimate
+}
+
+// NewIterationCapacity computes the capacity totals of an iteration from its tasks
+func NewIterationCapacity(iteration *IterationEntity, tasks []*TaskEntity) *IterationCapacity {
+	capacity := &IterationCapacity{Capacity: iteration.Capacity}
+	for _, task := range tasks {
+		if task.Status == string(TaskStatusCancelled) {
+			continue
+		}
+		if task.Estimate == nil {
+			capacity.Unestimated++
+			continue
+		}
+		capacity.Committed += *task.Estimate
+		if task.Status != string(TaskStatusDone) {
+			capacity.Remaining += *task.Estimate
+		}
+	}
+	return capacity
+}
+
+// IsOvercommitted returns true if the committed estimates exceed the capacity
+func (c *IterationCapacity) IsOvercommitted() bool {
+	return c.Capacity != nil && c.Committed > *c.Capacity
+}
@@ -0,0 +1,64 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+func TestIterationEntity_SetCapacity(t *testing.T) {
+	iteration := &entities.IterationEntity{Number: 1}
+
+	capacity := 20.0
+	if err := iteration.SetCapacity(&capacity); err != nil {
+		t.Fatalf("unexpected error setting capacity: %v", err)
+	}
+	if iteration.Capacity == nil || *iteration.Capacity != 20 {
+		t.Errorf("Capacity = %v, want 20", iteration.Capacity)
+	}
+
+	zero := 0.0
+	if err := iteration.SetCapacity(&zero); err == nil {
+		t.Error("expected error for zero capacity, got nil")
+	}
+
+	if err := iteration.SetCapacity(nil); err != nil {
+		t.Fatalf("unexpected error clearing capacity: %v", err)
+	}
+	if iteration.Capacity != nil {
+		t.Errorf("expected capacity to be cleared, got %v", *iteration.Capacity)
+	}
+}
+
+func TestNewIterationCapacity(t *testing.T) {
+	estimate := func(value float64) *float64 { return &value }
+	capacity := 8.0
+	iteration := &entities.IterationEntity{Number: 1, Capacity: &capacity}
+	tasks := []*entities.TaskEntity{
+		{ID: "DW-task-1", Status: "done", Estimate: estimate(3)},
+		{ID: "DW-task-2", Status: "in-progress", Estimate: estimate(5)},
+		{ID: "DW-task-3", Status: "todo", Estimate: estimate(2)},
+		{ID: "DW-task-4", Status: "todo"},
+		{ID: "DW-task-5", Status: "cancelled", Estimate: estimate(13)},
+	}
+
+	result := entities.NewIterationCapacity(iteration, tasks)
+
+	if result.Committed != 10 {
+		t.Errorf("Committed = %v, want 10", result.Committed)
+	}
+	if result.Remaining != 7 {
+		t.Errorf("Remaining = %v, want 7", result.Remaining)
+	}
+	if result.Unestimated != 1 {
+		t.Errorf("Unestimated = %d, want 1", result.Unestimated)
+	}
+	if !result.IsOvercommitted() {
+		t.Error("expected iteration to be overcommitted")
+	}
+
+	iteration.Capacity = nil
+	if entities.NewIterationCapacity(iteration, tasks).IsOvercommitted() {
+		t.Error("an iteration without capacity cannot be overcommitted")
+	}
+}
@@ -18,10 +18,12 @@ type IterationEntity struct {
 	Status      string     `json:"status"` // planned, current, complete
 	Rank        float64    `json:"rank"`   // 1-1000 (lower = higher priority, supports fractional values)
 	Deliverable string     `json:"deliverable"`
+	Capacity    *float64   `json:"capacity,omitempty"` // Estimate total the iteration can take on (optional)
 	StartedAt   *time.Time `json:"started_at"`
 	CompletedAt *time.Time `json:"completed_at"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
+	Version     int        `json:"version"` // Bumped on every stored update; 0 until the iteration is stored
 }
 
 // NewIterationEntity creates a new iteration entity with validation
@@ -160,6 +162,7 @@ func (i *IterationEntity) GetAllFields() map[string]interface{} {
 		"status":       i.Status,
 		"rank":         i.Rank,
 		"deliverable":  i.Deliverable,
+		"capacity":     i.Capacity,
 		"started_at":   i.StartedAt,
 		"completed_at": i.CompletedAt,
 		"created_at":   i.CreatedAt,
@@ -167,6 +170,15 @@ func (i *IterationEntity) GetAllFields() map[string]interface{} {
 	}
 }
 
+// SetCapacity sets the estimate total the iteration can take on; nil clears it
+func (i *IterationEntity) SetCapacity(capacity *float64) error {
+	if capacity != nil && *capacity <= 0 {
+		return fmt.Errorf("%w: iteration capacity must be positive", errors.ErrInvalidArgument)
+	}
+	i.Capacity = capacity
+	return nil
+}
+
 // AddTask adds a task ID to this iteration
 func (i *IterationEntity) AddTask(taskID string) error {
 	// Check if task already exists
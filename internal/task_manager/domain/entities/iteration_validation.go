@@ -0,0 +1,135 @@
+package entities
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+)
+
+// Iteration completion checks. A project policy decides how severe each one is.
+const (
+	IterationCheckUnfinishedTasks = "unfinished_tasks" // Tasks not yet done
+	IterationCheckFailedACs       = "failed_acs"       // ACs that failed verification
+	IterationCheckNotStartedACs   = "not_started_acs"  // ACs nobody has verified yet
+	IterationCheckPendingReviews  = "pending_reviews"  // ACs waiting for human review
+	IterationCheckMissingTasks    = "missing_tasks"    // Iteration references tasks that no longer exist
+)
+
+// Severities a completion check can have
+const (
+	CheckSeverityError   = "error"   // Blocks completion unless forced
+	CheckSeverityWarning = "warning" // Reported, but does not block completion
+	CheckSeverityOff     = "off"     // Not checked
+)
+
+// IterationChecks returns all completion checks in reporting order
+func IterationChecks() []string {
+	return []string{
+		IterationCheckUnfinishedTasks,
+		IterationCheckFailedACs,
+		IterationCheckNotStartedACs,
+		IterationCheckPendingReviews,
+		IterationCheckMissingTasks,
+	}
+}
+
+// IsValidIterationCheck checks if a check name is valid
+func IsValidIterationCheck(check string) bool {
+	for _, known := range IterationChecks() {
+		if check == known {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidCheckSeverity checks if a severity is valid
+func IsValidCheckSeverity(severity string) bool {
+	return severity == CheckSeverityError || severity == CheckSeverityWarning || severity == CheckSeverityOff
+}
+
+// IterationCompletionPolicy maps completion checks to their severity.
+// Checks missing from the policy use the default severity.
+type IterationCompletionPolicy map[string]string
+
+// DefaultIterationCompletionPolicy returns the policy used when a project configures none:
+// unfinished work blocks completion, dangling task references are only reported.
+func DefaultIterationCompletionPolicy() IterationCompletionPolicy {
+	return IterationCompletionPolicy{
+		IterationCheckUnfinishedTasks: CheckSeverityError,
+		IterationCheckFailedACs:       CheckSeverityError,
+		IterationCheckNotStartedACs:   CheckSeverityError,
+		IterationCheckPendingReviews:  CheckSeverityError,
+		IterationCheckMissingTasks:    CheckSeverityWarning,
+	}
+}
+
+// Severity returns the configured severity of a check, falling back to the default policy
+func (p IterationCompletionPolicy) Severity(check string) string {
+	if severity, ok := p[check]; ok {
+		return severity
+	}
+	return DefaultIterationCompletionPolicy()[check]
+}
+
+// Validate checks that the policy only names known checks and severities
+func (p IterationCompletionPolicy) Validate() error {
+	checks := make([]string, 0, len(p))
+	for check := range p {
+		checks = append(checks, check)
+	}
+	sort.Strings(checks)
+
+	for _, check := range checks {
+		if !IsValidIterationCheck(check) {
+			return fmt.Errorf("%w: unknown iteration check %q: must be one of %s",
+				errors.ErrInvalidArgument, check, strings.Join(IterationChecks(), ", "))
+		}
+		if !IsValidCheckSeverity(p[check]) {
+			return fmt.Errorf("%w: invalid severity %q for %s: must be one of error, warning, off",
+				errors.ErrInvalidArgument, p[check], check)
+		}
+	}
+	return nil
+}
+
+// IterationIssue is a single problem found while validating an iteration
+type IterationIssue struct {
+	Check    string `json:"check"`
+	Severity string `json:"severity"`
+	EntityID string `json:"entity_id"` // Task or AC the problem is about
+	Message  string `json:"message"`
+}
+
+// IterationValidationResult lists the problems standing between an iteration and completion
+type IterationValidationResult struct {
+	IterationNumber int              `json:"iteration_number"`
+	Issues          []IterationIssue `json:"issues"`
+}
+
+// Errors returns the issues that block completion
+func (r *IterationValidationResult) Errors() []IterationIssue {
+	return r.withSeverity(CheckSeverityError)
+}
+
+// Warnings returns the issues that are reported but do not block completion
+func (r *IterationValidationResult) Warnings() []IterationIssue {
+	return r.withSeverity(CheckSeverityWarning)
+}
+
+// CanComplete returns true if no issue blocks completion
+func (r *IterationValidationResult) CanComplete() bool {
+	return len(r.Errors()) == 0
+}
+
+func (r *IterationValidationResult) withSeverity(severity string) []IterationIssue {
+	issues := []IterationIssue{}
+	for _, issue := range r.Issues {
+		if issue.Severity == severity {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
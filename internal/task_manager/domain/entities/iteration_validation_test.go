@@ -0,0 +1,55 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+)
+
+func TestIterationCompletionPolicy_Severity(t *testing.T) {
+	policy := entities.IterationCompletionPolicy{
+		entities.IterationCheckUnfinishedTasks: entities.CheckSeverityOff,
+	}
+
+	if got := policy.Severity(entities.IterationCheckUnfinishedTasks); got != entities.CheckSeverityOff {
+		t.Errorf("Severity(unfinished_tasks) = %q, want %q", got, entities.CheckSeverityOff)
+	}
+	// Unconfigured checks fall back to the default policy
+	if got := policy.Severity(entities.IterationCheckFailedACs); got != entities.CheckSeverityError {
+		t.Errorf("Severity(failed_acs) = %q, want %q", got, entities.CheckSeverityError)
+	}
+	if got := policy.Severity(entities.IterationCheckMissingTasks); got != entities.CheckSeverityWarning {
+		t.Errorf("Severity(missing_tasks) = %q, want %q", got, entities.CheckSeverityWarning)
+	}
+
+	var empty entities.IterationCompletionPolicy
+	if got := empty.Severity(entities.IterationCheckPendingReviews); got != entities.CheckSeverityError {
+		t.Errorf("nil policy Severity(pending_reviews) = %q, want %q", got, entities.CheckSeverityError)
+	}
+}
+
+func TestIterationCompletionPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  entities.IterationCompletionPolicy
+		wantErr bool
+	}{
+		{"default policy", entities.DefaultIterationCompletionPolicy(), false},
+		{"empty policy", entities.IterationCompletionPolicy{}, false},
+		{"unknown check", entities.IterationCompletionPolicy{"coverage": entities.CheckSeverityError}, true},
+		{"invalid severity", entities.IterationCompletionPolicy{entities.IterationCheckFailedACs: "fatal"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !isErrorType(err, errors.ErrInvalidArgument) {
+				t.Errorf("Validate() error = %v, want ErrInvalidArgument", err)
+			}
+		})
+	}
+}
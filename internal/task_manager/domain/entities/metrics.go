@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"time"
+)
+
+// ProjectMetrics is the throughput of a project over time
+type ProjectMetrics struct {
+	GeneratedAt      time.Time           `json:"generated_at"`
+	Velocity         []IterationVelocity `json:"velocity"`    // Started iterations, by number
+	Burndown         *IterationBurndown  `json:"burndown"`    // Nil when no iteration is in progress
+	CycleTimes       []TaskCycleTime     `json:"cycle_times"` // Done tasks, by completion time
+	CycleTimeSummary CycleTimeSummary    `json:"cycle_time_summary"`
+	Throughput       []TrackThroughput   `json:"throughput"` // Tasks done per track and week
+	Weeks            int                 `json:"weeks"`      // Number of weeks covered by Throughput
+}
+
+// IterationVelocity is the work an iteration delivered
+type IterationVelocity struct {
+	IterationNumber int     `json:"iteration_number"`
+	Name            string  `json:"name"`
+	Status          string  `json:"status"`
+	PlannedTasks    int     `json:"planned_tasks"`   // Tasks in the iteration
+	CompletedTasks  int     `json:"completed_tasks"` // Tasks done by the end of the iteration (or now, while current)
+	Days            float64 `json:"days"`            // Time from start to completion (or now, while current)
+}
+
+// IterationBurndown is the day-by-day progress of an iteration
+type IterationBurndown struct {
+	IterationNumber int             `json:"iteration_number"`
+	Name            string          `json:"name"`
+	Points          []BurndownPoint `json:"points"` // One per day since the start
+}
+
+// BurndownPoint is the state of an iteration at the end of a day
+type BurndownPoint struct {
+	Date      time.Time `json:"date"`
+	Scope     int       `json:"scope"`     // Tasks in the iteration
+	Completed int       `json:"completed"` // Tasks done by the end of the day (burnup)
+	Remaining int       `json:"remaining"` // Scope minus completed (burndown)
+}
+
+// TaskCycleTime is how long a done task took from starting work to done
+type TaskCycleTime struct {
+	TaskID    string    `json:"task_id"`
+	TrackID   string    `json:"track_id"`
+	Title     string    `json:"title"`
+	StartedAt time.Time `json:"started_at"` // First move to in-progress; creation if work never formally started
+	DoneAt    time.Time `json:"done_at"`    // Last move to done
+	Hours     float64   `json:"hours"`
+}
+
+// CycleTimeSummary aggregates task cycle times
+type CycleTimeSummary struct {
+	Count        int     `json:"count"`
+	AverageHours float64 `json:"average_hours"`
+	MedianHours  float64 `json:"median_hours"`
+	MaxHours     float64 `json:"max_hours"`
+}
+
+// TrackThroughput is the number of tasks a track completed per week
+type TrackThroughput struct {
+	TrackID string `json:"track_id"`
+	Title   string `json:"title"`
+	Weekly  []int  `json:"weekly"` // Oldest week first; the last entry is the week ending now
+	Total   int    `json:"total"`
+}
@@ -0,0 +1,15 @@
+package entities
+
+// ProjectData is the complete content of one project's database, used to export and import projects.
+// Track dependencies, task blockers and iteration membership travel inside their entities.
+type ProjectData struct {
+	Code          string // Project code the entity IDs are prefixed with (e.g., "DW")
+	Roadmap       *RoadmapEntity
+	Tracks        []*TrackEntity
+	Tasks         []*TaskEntity
+	Iterations    []*IterationEntity
+	ACs           []*AcceptanceCriteriaEntity
+	ADRs          []*ADREntity
+	Documents     []*DocumentEntity
+	StatusHistory []*TaskStatusChange // Oldest first
+}
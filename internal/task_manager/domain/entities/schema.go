@@ -0,0 +1,51 @@
+package entities
+
+// SchemaMigration is one step of the schema history of a project database
+type SchemaMigration struct {
+	Version     int    `json:"version"` // Schema version the migration brings the database to
+	Description string `json:"description"`
+	Reversible  bool   `json:"reversible"` // Whether the migration can be rolled back
+	Applied     bool   `json:"applied"`
+}
+
+// SchemaStatus describes the schema version of a project database relative to the
+// versions this tm knows
+type SchemaStatus struct {
+	CurrentVersion int               `json:"current_version"` // 0 if the database does not exist yet
+	LatestVersion  int               `json:"latest_version"`
+	Migrations     []SchemaMigration `json:"migrations"`
+}
+
+// Pending returns the migrations not applied yet, oldest first
+func (s *SchemaStatus) Pending() []SchemaMigration {
+	pending := []SchemaMigration{}
+	for _, migration := range s.Migrations {
+		if !migration.Applied {
+			pending = append(pending, migration)
+		}
+	}
+	return pending
+}
+
+// UpToDate returns true if the database is at the latest schema version
+func (s *SchemaStatus) UpToDate() bool {
+	return s.CurrentVersion == s.LatestVersion
+}
+
+// TooNew returns true if the database was written by a newer tm
+func (s *SchemaStatus) TooNew() bool {
+	return s.CurrentVersion > s.LatestVersion
+}
+
+// SchemaChange is the outcome of migrating or rolling back a project database
+type SchemaChange struct {
+	FromVersion int               `json:"from_version"`
+	ToVersion   int               `json:"to_version"`
+	BackupPath  string            `json:"backup_path,omitempty"` // Copy of the database taken before any migration ran
+	Migrations  []SchemaMigration `json:"migrations"`            // Migrations run, in the order they ran
+}
+
+// RolledBack returns true if the change went back to an older schema
+func (c *SchemaChange) RolledBack() bool {
+	return c.ToVersion < c.FromVersion
+}
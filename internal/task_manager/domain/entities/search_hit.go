@@ -0,0 +1,38 @@
+package entities
+
+// Entity types covered by full-text search
+const (
+	SearchEntityTask     = "task"
+	SearchEntityAC       = "ac"
+	SearchEntityADR      = "adr"
+	SearchEntityDocument = "document"
+)
+
+// SearchEntityTypes lists every searchable entity type
+var SearchEntityTypes = []string{SearchEntityTask, SearchEntityAC, SearchEntityADR, SearchEntityDocument}
+
+// Markers wrapped around matched terms in SearchHit.Snippet
+const (
+	SearchHighlightStart = "**"
+	SearchHighlightEnd   = "**"
+)
+
+// SearchHit is a full-text search match in a task, acceptance criterion, ADR or document.
+// Hits are read from the search index and never persisted on their own.
+type SearchHit struct {
+	EntityType string  `json:"entity_type"` // One of the SearchEntity* constants
+	EntityID   string  `json:"entity_id"`
+	Title      string  `json:"title"`   // Task/ADR/document title, AC description
+	Snippet    string  `json:"snippet"` // Matching excerpt with terms wrapped in highlight markers
+	Score      float64 `json:"score"`   // Relevance, higher is better
+}
+
+// IsValidSearchEntityType checks if an entity type is covered by search
+func IsValidSearchEntityType(entityType string) bool {
+	for _, t := range SearchEntityTypes {
+		if t == entityType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"time"
+)
+
+// Reasons a project snapshot is taken
+const (
+	SnapshotReasonManual          = "manual"               // tm project backup
+	SnapshotReasonRestore         = "pre-restore"          // The state a restore replaced
+	SnapshotReasonTaskDelete      = "pre-task-delete"      // Before a task is deleted
+	SnapshotReasonTrackDelete     = "pre-track-delete"     // Before a track is deleted
+	SnapshotReasonIterationDelete = "pre-iteration-delete" // Before an iteration is deleted
+	SnapshotReasonDocumentDelete  = "pre-document-delete"  // Before a document is deleted
+	SnapshotReasonProjectDelete   = "pre-project-delete"   // Before tm project delete
+	SnapshotReasonSyncImport      = "pre-sync-import"      // Before tm sync deletes the entities of deleted files
+	SnapshotReasonMigrationPrefix = "pre-migrate-v"        // Before schema migrations, followed by the schema version
+)
+
+// ProjectSnapshot is a copy of a project database taken at a point in time
+type ProjectSnapshot struct {
+	ID        string    `json:"id"` // "<project>/<name>", as accepted by tm project restore
+	Project   string    `json:"project"`
+	Reason    string    `json:"reason"` // SnapshotReason* value
+	Path      string    `json:"path"`
+	Size      int64     `json:"size"` // Bytes
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SnapshotPolicy controls automatic snapshots and how long snapshots are kept
+type SnapshotPolicy struct {
+	BeforeDestructive bool          // Snapshot a project before deleting tasks, tracks, iterations or the project, or importing deleted sync files
+	Keep              int           // Snapshots kept per project, newest first; 0 keeps all
+	MaxAge            time.Duration // Snapshots older than this are removed; 0 keeps them regardless of age
+}
+
+// DefaultSnapshotPolicy snapshots before destructive commands and keeps the 20 newest snapshots
+func DefaultSnapshotPolicy() SnapshotPolicy {
+	return SnapshotPolicy{BeforeDestructive: true, Keep: 20}
+}
+
+// Expired returns the snapshots the policy no longer keeps at now.
+// snapshots must be ordered newest first.
+func (p SnapshotPolicy) Expired(snapshots []*ProjectSnapshot, now time.Time) []*ProjectSnapshot {
+	expired := []*ProjectSnapshot{}
+	for i, snapshot := range snapshots {
+		tooMany := p.Keep > 0 && i >= p.Keep
+		tooOld := p.MaxAge > 0 && now.Sub(snapshot.CreatedAt) > p.MaxAge
+		if tooMany || tooOld {
+			expired = append(expired, snapshot)
+		}
+	}
+	return expired
+}
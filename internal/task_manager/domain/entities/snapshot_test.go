@@ -0,0 +1,42 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotPolicy_Expired(t *testing.T) {
+	now := time.Date(2025, 9, 10, 12, 0, 0, 0, time.UTC)
+	snapshots := []*entities.ProjectSnapshot{
+		{ID: "p/4", CreatedAt: now.Add(-time.Hour)},
+		{ID: "p/3", CreatedAt: now.Add(-24 * time.Hour)},
+		{ID: "p/2", CreatedAt: now.Add(-72 * time.Hour)},
+		{ID: "p/1", CreatedAt: now.Add(-240 * time.Hour)},
+	}
+	ids := func(expired []*entities.ProjectSnapshot) []string {
+		result := []string{}
+		for _, snapshot := range expired {
+			result = append(result, snapshot.ID)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name   string
+		policy entities.SnapshotPolicy
+		want   []string
+	}{
+		{"keep all", entities.SnapshotPolicy{}, []string{}},
+		{"keep newest", entities.SnapshotPolicy{Keep: 2}, []string{"p/2", "p/1"}},
+		{"max age", entities.SnapshotPolicy{MaxAge: 48 * time.Hour}, []string{"p/2", "p/1"}},
+		{"both", entities.SnapshotPolicy{Keep: 3, MaxAge: 100 * time.Hour}, []string{"p/1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ids(tt.policy.Expired(snapshots, now)))
+		})
+	}
+}
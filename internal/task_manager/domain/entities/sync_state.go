@@ -0,0 +1,16 @@
+package entities
+
+import (
+	"time"
+)
+
+// SyncState records an entity as it was when its sync file and database row last agreed.
+// It is the baseline sync compares both sides against to tell which one changed.
+type SyncState struct {
+	Directory   string    `json:"directory"`    // Absolute path of the sync directory
+	EntityType  string    `json:"entity_type"`  // As returned by the entity's GetType
+	EntityID    string    `json:"entity_id"`    // Iteration number for iterations
+	ContentHash string    `json:"content_hash"` // Hash of the file content both sides agreed on
+	UpdatedAt   time.Time `json:"updated_at"`   // Entity's updated_at in the database at that point
+	SyncedAt    time.Time `json:"synced_at"`
+}
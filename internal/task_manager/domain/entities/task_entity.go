@@ -3,6 +3,7 @@ package entities
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
@@ -20,6 +21,12 @@ type TaskEntity struct {
 	Branch      string    `json:"branch"` // Git branch name (optional)
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	Version     int       `json:"version"` // Bumped on every stored update; 0 until the task is stored
+
+	Estimate *float64 `json:"estimate,omitempty"` // Size in the project's unit, e.g. story points or ideal hours (optional)
+
+	BlockedBy          []string `json:"blocked_by"`                    // Task IDs that must be done first
+	UnfinishedBlockers []string `json:"unfinished_blockers,omitempty"` // Subset of BlockedBy not yet done (computed on load)
 }
 
 // NewTaskEntity creates a new task entity with validation
@@ -49,6 +56,7 @@ func NewTaskEntity(id, trackID, title, description, status string, rank int, bra
 		Branch:      branch,
 		CreatedAt:   createdAt,
 		UpdatedAt:   updatedAt,
+		BlockedBy:   []string{},
 	}, nil
 }
 
@@ -66,6 +74,36 @@ func (t *TaskEntity) TransitionTo(newStatus string) error {
 	return nil
 }
 
+// maxBranchSlugLength caps the title part of a generated branch name
+const maxBranchSlugLength = 40
+
+// DefaultBranchName derives a git branch name from the task ID and title,
+// e.g. "TM-task-12-implement-login-form".
+func (t *TaskEntity) DefaultBranchName() string {
+	var slug strings.Builder
+	pendingDash := false
+	for _, r := range strings.ToLower(t.Title) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingDash && slug.Len() > 0 {
+				slug.WriteByte('-')
+			}
+			slug.WriteRune(r)
+			pendingDash = false
+			continue
+		}
+		pendingDash = true
+	}
+
+	title := slug.String()
+	if len(title) > maxBranchSlugLength {
+		title = strings.TrimRight(title[:maxBranchSlugLength], "-")
+	}
+	if title == "" {
+		return t.ID
+	}
+	return t.ID + "-" + title
+}
+
 // IExtensible implementation
 
 // GetID returns the unique identifier for this entity
@@ -99,8 +137,10 @@ func (t *TaskEntity) GetAllFields() map[string]interface{} {
 		"status":      t.Status,
 		"rank":        t.Rank,
 		"branch":      t.Branch,
+		"estimate":    t.Estimate,
 		"created_at":  t.CreatedAt,
 		"updated_at":  t.UpdatedAt,
+		"blocked_by":  t.BlockedBy,
 		"progress":    t.GetProgress(),
 		"is_blocked":  t.IsBlocked(),
 	}
@@ -127,15 +167,34 @@ func (t *TaskEntity) GetProgress() float64 {
 	}
 }
 
-// IsBlocked returns true if the entity is blocked from progressing
+// IsBlocked returns true if any task blocking this one is not done yet
 func (t *TaskEntity) IsBlocked() bool {
-	// Tasks are never blocked in this implementation
-	return false
+	return len(t.UnfinishedBlockers) > 0
 }
 
 // GetBlockReason returns the reason for blocking, or empty string if not blocked
 func (t *TaskEntity) GetBlockReason() string {
-	return ""
+	if !t.IsBlocked() {
+		return ""
+	}
+	return fmt.Sprintf("Task %s is blocked by unfinished tasks: %s", t.ID, strings.Join(t.UnfinishedBlockers, ", "))
+}
+
+// SetEstimate sets the task's size; nil clears it
+func (t *TaskEntity) SetEstimate(estimate *float64) error {
+	if estimate != nil && *estimate <= 0 {
+		return fmt.Errorf("%w: task estimate must be positive", errors.ErrInvalidArgument)
+	}
+	t.Estimate = estimate
+	return nil
+}
+
+// EstimateValue returns the task's estimate, or 0 when it has none
+func (t *TaskEntity) EstimateValue() float64 {
+	if t.Estimate == nil {
+		return 0
+	}
+	return *t.Estimate
 }
 
 // MarshalTask serializes a task to JSON bytes with indentation
@@ -229,18 +229,80 @@ func TestTaskEntity_GetProgress(t *testing.T) {
 	}
 }
 
+func TestTaskEntity_DefaultBranchName(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		expected string
+	}{
+		{"simple", "Implement login", "TM-task-12-implement-login"},
+		{"punctuation collapsed", "Fix: crash on  empty input!", "TM-task-12-fix-crash-on-empty-input"},
+		{"non-ascii dropped", "Über café", "TM-task-12-ber-caf"},
+		{"long title truncated", "A very long task title that keeps going well past the limit", "TM-task-12-a-very-long-task-title-that-keeps-going"},
+		{"no usable characters", "!!!", "TM-task-12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &entities.TaskEntity{ID: "TM-task-12", Title: tt.title}
+			if got := task.DefaultBranchName(); got != tt.expected {
+				t.Errorf("DefaultBranchName() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestTaskEntity_IsBlocked(t *testing.T) {
-	task := &entities.TaskEntity{}
+	task := &entities.TaskEntity{ID: "DW-task-1", Status: "todo", BlockedBy: []string{"DW-task-2"}}
 	if task.IsBlocked() {
-		t.Error("expected IsBlocked() to return false for tasks")
+		t.Error("expected task with only finished blockers not to be blocked")
+	}
+
+	task.UnfinishedBlockers = []string{"DW-task-2"}
+	if !task.IsBlocked() {
+		t.Error("expected task with an unfinished blocker to be blocked")
 	}
 }
 
 func TestTaskEntity_GetBlockReason(t *testing.T) {
-	task := &entities.TaskEntity{}
+	task := &entities.TaskEntity{ID: "DW-task-1"}
 	if task.GetBlockReason() != "" {
 		t.Errorf("expected empty block reason, got %q", task.GetBlockReason())
 	}
+
+	task.UnfinishedBlockers = []string{"DW-task-2", "DW-task-3"}
+	if reason := task.GetBlockReason(); !contains(reason, "DW-task-2, DW-task-3") {
+		t.Errorf("expected block reason to list unfinished blockers, got %q", reason)
+	}
+}
+
+func TestTaskEntity_SetEstimate(t *testing.T) {
+	task := &entities.TaskEntity{ID: "DW-task-1"}
+	if task.EstimateValue() != 0 {
+		t.Errorf("EstimateValue() without estimate = %v, want 0", task.EstimateValue())
+	}
+
+	estimate := 3.5
+	if err := task.SetEstimate(&estimate); err != nil {
+		t.Fatalf("unexpected error setting estimate: %v", err)
+	}
+	if task.EstimateValue() != 3.5 {
+		t.Errorf("EstimateValue() = %v, want 3.5", task.EstimateValue())
+	}
+
+	negative := -1.0
+	if err := task.SetEstimate(&negative); err == nil {
+		t.Error("expected error for negative estimate, got nil")
+	} else if !contains(err.Error(), "must be positive") {
+		t.Errorf("expected error about positive estimate, got %q", err.Error())
+	}
+
+	if err := task.SetEstimate(nil); err != nil {
+		t.Fatalf("unexpected error clearing estimate: %v", err)
+	}
+	if task.Estimate != nil {
+		t.Errorf("expected estimate to be cleared, got %v", *task.Estimate)
+	}
 }
 
 // SDK Interface Tests
@@ -331,7 +393,7 @@ func TestTaskEntity_GetAllFields(t *testing.T) {
 	// Verify all expected fields are present
 	expectedFields := []string{
 		"id", "track_id", "title", "description",
-		"status", "rank", "branch",
+		"status", "rank", "branch", "estimate",
 		"created_at", "updated_at", "progress", "is_blocked",
 	}
 
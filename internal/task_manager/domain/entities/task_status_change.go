@@ -0,0 +1,15 @@
+package entities
+
+import (
+	"time"
+)
+
+// TaskStatusChange records a single task status transition.
+// Records are written by the task repository whenever a task's status is stored.
+type TaskStatusChange struct {
+	ID         int64     `json:"id"`
+	TaskID     string    `json:"task_id"`
+	FromStatus string    `json:"from_status"` // Empty for the status a task was created with
+	ToStatus   string    `json:"to_status"`
+	ChangedAt  time.Time `json:"changed_at"`
+}
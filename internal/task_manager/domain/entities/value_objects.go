@@ -2,6 +2,7 @@ package entities
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
 )
@@ -118,6 +119,21 @@ const (
 	ACStatusSkipped AcceptanceCriteriaStatus = "skipped"
 )
 
+// Valid status values for acceptance criteria
+var validACStatuses = map[string]bool{
+	string(ACStatusNotStarted):            true,
+	string(ACStatusAutomaticallyVerified): true,
+	string(ACStatusPendingHumanReview):    true,
+	string(ACStatusVerified):              true,
+	string(ACStatusFailed):                true,
+	string(ACStatusSkipped):               true,
+}
+
+// IsValidACStatus validates an acceptance criterion status string
+func IsValidACStatus(status string) bool {
+	return validACStatuses[status]
+}
+
 // AcceptanceCriteriaVerificationType indicates who should verify this AC
 type AcceptanceCriteriaVerificationType string
 
@@ -141,6 +157,7 @@ type TaskFilters struct {
 	TrackID  string   // Filter by parent track ID
 	Status   []string // Filter by status values (e.g., "todo", "in-progress", "review", "done")
 	Priority []string // Legacy - not used
+	Ready    bool     // Only tasks that are not done and not blocked by unfinished tasks
 }
 
 // ACFilters represents filter criteria for acceptance criteria queries
@@ -150,6 +167,26 @@ type ACFilters struct {
 	TaskID       string // Filter by task ID
 }
 
+// EntityEventFilters represents filter criteria for entity history queries
+type EntityEventFilters struct {
+	EntityID   string     // Filter by affected entity ID
+	Since      *time.Time // Only events at or after this time
+	EventTypes []string   // Filter by event type (e.g., "task-manager.task.created")
+}
+
+// TaskStatusChangeFilters represents filter criteria for task status history queries
+type TaskStatusChangeFilters struct {
+	TaskIDs []string   // Filter by task IDs; empty returns all tasks
+	Since   *time.Time // Only changes at or after this time
+}
+
+// SearchFilters represents criteria for full-text search
+type SearchFilters struct {
+	Query       string   // Search terms; all must match, a trailing * matches a prefix
+	EntityTypes []string // Filter by entity type (SearchEntity* constants); empty searches all
+	Limit       int      // Maximum number of hits; 0 or less returns all
+}
+
 // DocumentType represents valid document type values
 type DocumentType string
 
@@ -104,3 +104,30 @@ func TestIsValidADRStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestIsValidACStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		want   bool
+	}{
+		{"valid not_started", "not_started", true},
+		{"valid automatically_verified", "automatically_verified", true},
+		{"valid pending_human_review", "pending_human_review", true},
+		{"valid verified", "verified", true},
+		{"valid failed", "failed", true},
+		{"valid skipped", "skipped", true},
+		{"invalid empty", "", false},
+		{"invalid unknown", "unknown", false},
+		{"invalid dash", "not-started", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := entities.IsValidACStatus(tt.status)
+			if got != tt.want {
+				t.Errorf("IsValidACStatus(%q) = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,10 @@
 // Package errors defines standard error types for task manager.
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrNotFound indicates that a requested resource was not found.
@@ -15,4 +18,31 @@ var (
 
 	// ErrInternal indicates an internal error occurred.
 	ErrInternal = errors.New("internal error")
+
+	// ErrRejected indicates that an operation was refused by a configured policy, such as a pre-hook.
+	ErrRejected = errors.New("rejected")
+
+	// ErrConflict indicates that an entity was modified by someone else since it was read.
+	// The operation can be retried on a fresh copy, see RetryOnConflict.
+	ErrConflict = errors.New("conflict")
+
+	// ErrVersionMismatch is the conflict of a change based on a version the caller supplied and that
+	// is no longer current. Retrying cannot resolve it: the caller has to reload the entity first.
+	ErrVersionMismatch = fmt.Errorf("%w: version mismatch", ErrConflict)
 )
+
+// DefaultConflictRetries is how many times RetryOnConflict runs an operation by default.
+const DefaultConflictRetries = 3
+
+// RetryOnConflict runs a read-modify-write operation, running it again while it fails
+// with ErrConflict, up to attempts times in total. It returns the last error.
+// An ErrVersionMismatch is returned at once: the operation would fail the same way again.
+func RetryOnConflict(attempts int, operation func() error) error {
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if err = operation(); !errors.Is(err, ErrConflict) || errors.Is(err, ErrVersionMismatch) {
+			return err
+		}
+	}
+	return err
+}
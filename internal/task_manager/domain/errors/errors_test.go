@@ -0,0 +1,57 @@
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryOnConflict(t *testing.T) {
+	t.Run("retries conflicts until the operation succeeds", func(t *testing.T) {
+		calls := 0
+		err := tmerrors.RetryOnConflict(3, func() error {
+			calls++
+			if calls < 3 {
+				return fmt.Errorf("failed to update task: %w", tmerrors.ErrConflict)
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("gives up after the last attempt", func(t *testing.T) {
+		calls := 0
+		err := tmerrors.RetryOnConflict(2, func() error {
+			calls++
+			return tmerrors.ErrConflict
+		})
+		assert.ErrorIs(t, err, tmerrors.ErrConflict)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		calls := 0
+		boom := errors.New("boom")
+		err := tmerrors.RetryOnConflict(3, func() error {
+			calls++
+			return boom
+		})
+		assert.Equal(t, boom, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("does not retry a mismatch with the version the caller supplied", func(t *testing.T) {
+		calls := 0
+		err := tmerrors.RetryOnConflict(3, func() error {
+			calls++
+			return fmt.Errorf("%w: task TM-task-1 is at version 3, not 2", tmerrors.ErrVersionMismatch)
+		})
+		assert.ErrorIs(t, err, tmerrors.ErrVersionMismatch)
+		assert.ErrorIs(t, err, tmerrors.ErrConflict)
+		assert.Equal(t, 1, calls)
+	})
+}
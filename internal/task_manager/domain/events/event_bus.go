@@ -0,0 +1,63 @@
+package events
+
+import (
+	"context"
+	"time"
+)
+
+// Entity types carried by domain events
+const (
+	EntityTypeRoadmap   = "roadmap"
+	EntityTypeTrack     = "track"
+	EntityTypeTask      = "task"
+	EntityTypeIteration = "iteration"
+	EntityTypeAC        = "ac"
+	EntityTypeADR       = "adr"
+	EntityTypeDocument  = "document"
+)
+
+// EventAll subscribes a handler to every published event.
+const EventAll = "*"
+
+// Event is a domain event published after a successful mutation.
+type Event struct {
+	Type       string      // One of the Event* constants
+	Source     string      // Always PluginSourceName
+	EntityType string      // One of the EntityType* constants
+	EntityID   string      // ID of the affected entity (iteration number for iterations)
+	Payload    interface{} // Entity state after the mutation (last known state for deletions)
+	Previous   interface{} // Entity state before the mutation (nil for creations)
+	OccurredAt time.Time
+
+	// Derived events repeat a mutation already announced by another event, naming one kind of
+	// change (such as task.completed after task.updated). Subscribers that record each mutation
+	// once, like the entity history, skip them.
+	Derived bool
+}
+
+// NewEvent creates an event stamped with the current time.
+func NewEvent(eventType, entityType, entityID string, payload, previous interface{}) Event {
+	return Event{
+		Type:       eventType,
+		Source:     PluginSourceName,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Payload:    payload,
+		Previous:   previous,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+// Handler reacts to a published event.
+// Errors are reported by the bus but never undo the mutation that produced the event.
+type Handler func(ctx context.Context, event Event) error
+
+// EventBus is the port application services use to announce domain events.
+// Implementations live in the infrastructure layer.
+type EventBus interface {
+	// Publish delivers the event to every handler subscribed to its type or to EventAll.
+	Publish(ctx context.Context, event Event)
+
+	// Subscribe registers a handler for an event type, or EventAll for every event.
+	Subscribe(eventType string, handler Handler)
+}
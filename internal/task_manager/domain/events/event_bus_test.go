@@ -0,0 +1,30 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+)
+
+// TestNewEvent verifies events are stamped with source and time
+func TestNewEvent(t *testing.T) {
+	before := time.Now().UTC()
+	event := events.NewEvent(events.EventTaskStatusChanged, events.EntityTypeTask, "TM-task-1", "after", "before")
+
+	if event.Type != events.EventTaskStatusChanged {
+		t.Errorf("Type = %q, want %q", event.Type, events.EventTaskStatusChanged)
+	}
+	if event.Source != events.PluginSourceName {
+		t.Errorf("Source = %q, want %q", event.Source, events.PluginSourceName)
+	}
+	if event.EntityType != events.EntityTypeTask || event.EntityID != "TM-task-1" {
+		t.Errorf("unexpected entity: %s %s", event.EntityType, event.EntityID)
+	}
+	if event.Payload != "after" || event.Previous != "before" {
+		t.Errorf("unexpected payloads: %v %v", event.Payload, event.Previous)
+	}
+	if event.OccurredAt.Before(before) {
+		t.Errorf("OccurredAt %v is before %v", event.OccurredAt, before)
+	}
+}
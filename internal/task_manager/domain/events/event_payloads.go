@@ -32,6 +32,9 @@ type (
 
 	// TrackBlockedPayload contains the blocked track entity
 	TrackBlockedPayload = entities.TrackEntity
+
+	// TrackDeletedPayload contains the deleted track entity
+	TrackDeletedPayload = entities.TrackEntity
 )
 
 // Task event payloads
@@ -47,6 +50,9 @@ type (
 
 	// TaskCompletedPayload contains the completed task entity
 	TaskCompletedPayload = entities.TaskEntity
+
+	// TaskDeletedPayload contains the deleted task entity
+	TaskDeletedPayload = entities.TaskEntity
 )
 
 // Iteration event payloads
@@ -62,6 +68,12 @@ type (
 
 	// IterationUpdatedPayload contains the updated iteration entity
 	IterationUpdatedPayload = entities.IterationEntity
+
+	// IterationRevertedPayload contains the iteration reverted to planned
+	IterationRevertedPayload = entities.IterationEntity
+
+	// IterationDeletedPayload contains the deleted iteration entity
+	IterationDeletedPayload = entities.IterationEntity
 )
 
 // Acceptance Criteria event payloads
@@ -84,6 +96,9 @@ type (
 	// ACFailedPayload contains the failed acceptance criteria entity
 	ACFailedPayload = entities.AcceptanceCriteriaEntity
 
+	// ACSkippedPayload contains the skipped acceptance criteria entity
+	ACSkippedPayload = entities.AcceptanceCriteriaEntity
+
 	// ACDeletedPayload contains the deleted acceptance criteria entity
 	ACDeletedPayload = entities.AcceptanceCriteriaEntity
 )
@@ -102,3 +117,15 @@ type (
 	// ADRDeprecatedPayload contains the deprecated ADR entity
 	ADRDeprecatedPayload = entities.ADREntity
 )
+
+// Document event payloads
+type (
+	// DocumentCreatedPayload contains the created document entity
+	DocumentCreatedPayload = entities.DocumentEntity
+
+	// DocumentUpdatedPayload contains the updated document entity
+	DocumentUpdatedPayload = entities.DocumentEntity
+
+	// DocumentDeletedPayload contains the deleted document entity
+	DocumentDeletedPayload = entities.DocumentEntity
+)
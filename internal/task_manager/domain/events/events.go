@@ -9,35 +9,39 @@ const (
 	EventRoadmapUpdated = "task-manager.roadmap.updated"
 )
 
-// Track Events (5 events)
+// Track Events (6 events)
 const (
 	EventTrackCreated       = "task-manager.track.created"
 	EventTrackUpdated       = "task-manager.track.updated"
 	EventTrackStatusChanged = "task-manager.track.status_changed"
 	EventTrackCompleted     = "task-manager.track.completed"
 	EventTrackBlocked       = "task-manager.track.blocked"
+	EventTrackDeleted       = "task-manager.track.deleted"
 )
 
-// Task Events (5 events)
+// Task Events (6 events)
 const (
 	EventTaskCreated       = "task-manager.task.created"
 	EventTaskUpdated       = "task-manager.task.updated"
 	EventTaskStatusChanged = "task-manager.task.status_changed"
 	EventTaskCompleted     = "task-manager.task.completed"
+	EventTaskDeleted       = "task-manager.task.deleted"
 
 	// Deprecated: use EventTaskDeleted with proper prefix
 	EventTaskDeletedLegacy = "task.deleted"
 )
 
-// Iteration Events (4 events)
+// Iteration Events (6 events)
 const (
 	EventIterationCreated   = "task-manager.iteration.created"
 	EventIterationStarted   = "task-manager.iteration.started"
 	EventIterationCompleted = "task-manager.iteration.completed"
 	EventIterationUpdated   = "task-manager.iteration.updated"
+	EventIterationReverted  = "task-manager.iteration.reverted"
+	EventIterationDeleted   = "task-manager.iteration.deleted"
 )
 
-// Acceptance Criteria Events (7 events)
+// Acceptance Criteria Events (8 events)
 const (
 	EventACCreated               = "task-manager.ac.created"
 	EventACUpdated               = "task-manager.ac.updated"
@@ -45,15 +49,26 @@ const (
 	EventACAutomaticallyVerified = "task-manager.ac.automatically_verified"
 	EventACPendingReview         = "task-manager.ac.pending_review"
 	EventACFailed                = "task-manager.ac.failed"
+	EventACSkipped               = "task-manager.ac.skipped"
 	EventACDeleted               = "task-manager.ac.deleted"
 )
 
-// ADR Events (4 events)
+// ADR Events (5 events)
 const (
 	EventADRCreated    = "task-manager.adr.created"
 	EventADRUpdated    = "task-manager.adr.updated"
 	EventADRSuperseded = "task-manager.adr.superseded"
 	EventADRDeprecated = "task-manager.adr.deprecated"
+
+	// EventADRDeleted is only published when deleting a track removes its ADRs
+	EventADRDeleted = "task-manager.adr.deleted"
+)
+
+// Document Events (3 events)
+const (
+	EventDocumentCreated = "task-manager.document.created"
+	EventDocumentUpdated = "task-manager.document.updated"
+	EventDocumentDeleted = "task-manager.document.deleted"
 )
 
 // Plugin source name
@@ -28,18 +28,22 @@ func TestEventConstantsNaming(t *testing.T) {
 		{"TrackStatusChanged", events.EventTrackStatusChanged, "task-manager.", "task-manager.track.status_changed"},
 		{"TrackCompleted", events.EventTrackCompleted, "task-manager.", "task-manager.track.completed"},
 		{"TrackBlocked", events.EventTrackBlocked, "task-manager.", "task-manager.track.blocked"},
+		{"TrackDeleted", events.EventTrackDeleted, "task-manager.", "task-manager.track.deleted"},
 
 		// Task events
 		{"TaskCreated", events.EventTaskCreated, "task-manager.", "task-manager.task.created"},
 		{"TaskUpdated", events.EventTaskUpdated, "task-manager.", "task-manager.task.updated"},
 		{"TaskStatusChanged", events.EventTaskStatusChanged, "task-manager.", "task-manager.task.status_changed"},
 		{"TaskCompleted", events.EventTaskCompleted, "task-manager.", "task-manager.task.completed"},
+		{"TaskDeleted", events.EventTaskDeleted, "task-manager.", "task-manager.task.deleted"},
 
 		// Iteration events
 		{"IterationCreated", events.EventIterationCreated, "task-manager.", "task-manager.iteration.created"},
 		{"IterationStarted", events.EventIterationStarted, "task-manager.", "task-manager.iteration.started"},
 		{"IterationCompleted", events.EventIterationCompleted, "task-manager.", "task-manager.iteration.completed"},
 		{"IterationUpdated", events.EventIterationUpdated, "task-manager.", "task-manager.iteration.updated"},
+		{"IterationReverted", events.EventIterationReverted, "task-manager.", "task-manager.iteration.reverted"},
+		{"IterationDeleted", events.EventIterationDeleted, "task-manager.", "task-manager.iteration.deleted"},
 
 		// Acceptance Criteria events
 		{"ACCreated", events.EventACCreated, "task-manager.", "task-manager.ac.created"},
@@ -48,6 +52,7 @@ func TestEventConstantsNaming(t *testing.T) {
 		{"ACAutomaticallyVerified", events.EventACAutomaticallyVerified, "task-manager.", "task-manager.ac.automatically_verified"},
 		{"ACPendingReview", events.EventACPendingReview, "task-manager.", "task-manager.ac.pending_review"},
 		{"ACFailed", events.EventACFailed, "task-manager.", "task-manager.ac.failed"},
+		{"ACSkipped", events.EventACSkipped, "task-manager.", "task-manager.ac.skipped"},
 		{"ACDeleted", events.EventACDeleted, "task-manager.", "task-manager.ac.deleted"},
 
 		// ADR events
@@ -55,6 +60,11 @@ func TestEventConstantsNaming(t *testing.T) {
 		{"ADRUpdated", events.EventADRUpdated, "task-manager.", "task-manager.adr.updated"},
 		{"ADRSuperseded", events.EventADRSuperseded, "task-manager.", "task-manager.adr.superseded"},
 		{"ADRDeprecated", events.EventADRDeprecated, "task-manager.", "task-manager.adr.deprecated"},
+
+		// Document events
+		{"DocumentCreated", events.EventDocumentCreated, "task-manager.", "task-manager.document.created"},
+		{"DocumentUpdated", events.EventDocumentUpdated, "task-manager.", "task-manager.document.updated"},
+		{"DocumentDeleted", events.EventDocumentDeleted, "task-manager.", "task-manager.document.deleted"},
 	}
 
 	for _, tc := range testCases {
@@ -114,18 +124,22 @@ func TestEventTypesUniqueness(t *testing.T) {
 		events.EventTrackStatusChanged,
 		events.EventTrackCompleted,
 		events.EventTrackBlocked,
+		events.EventTrackDeleted,
 
 		// Task
 		events.EventTaskCreated,
 		events.EventTaskUpdated,
 		events.EventTaskStatusChanged,
 		events.EventTaskCompleted,
+		events.EventTaskDeleted,
 
 		// Iteration
 		events.EventIterationCreated,
 		events.EventIterationStarted,
 		events.EventIterationCompleted,
 		events.EventIterationUpdated,
+		events.EventIterationReverted,
+		events.EventIterationDeleted,
 
 		// AC
 		events.EventACCreated,
@@ -134,6 +148,7 @@ func TestEventTypesUniqueness(t *testing.T) {
 		events.EventACAutomaticallyVerified,
 		events.EventACPendingReview,
 		events.EventACFailed,
+		events.EventACSkipped,
 		events.EventACDeleted,
 
 		// ADR
@@ -159,10 +174,10 @@ func TestEventCounts(t *testing.T) {
 		count  int
 	}{
 		{"roadmap", 2},
-		{"track", 5},
-		{"task", 4}, // Not counting deprecated EventTaskDeletedLegacy
-		{"iteration", 4},
-		{"ac", 7},
+		{"track", 6},
+		{"task", 5}, // Not counting deprecated EventTaskDeletedLegacy
+		{"iteration", 6},
+		{"ac", 8},
 		{"adr", 4},
 	}
 
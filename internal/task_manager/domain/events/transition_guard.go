@@ -0,0 +1,12 @@
+package events
+
+import "context"
+
+// TransitionGuard is the port application services consult before persisting a state transition.
+// Unlike EventBus subscribers, a guard can veto: a non-nil error aborts the transition.
+// Implementations live in the infrastructure layer (e.g. pre-hook scripts).
+type TransitionGuard interface {
+	// BeforeTransition receives the event that would be published if the transition succeeds.
+	// Payload holds the proposed state and Previous the current state.
+	BeforeTransition(ctx context.Context, event Event) error
+}
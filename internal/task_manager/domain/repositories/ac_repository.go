@@ -23,6 +23,8 @@ type AcceptanceCriteriaRepository interface {
 
 	// UpdateAC updates an existing acceptance criterion.
 	// Returns ErrNotFound if the AC doesn't exist.
+	// If the AC was read from storage (Version > 0) and has been updated since,
+	// returns ErrConflict and leaves the stored AC unchanged; on success Version is bumped.
 	UpdateAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error
 
 	// DeleteAC removes an acceptance criterion from storage.
@@ -35,6 +35,8 @@ type DocumentRepository interface {
 
 	// UpdateDocument updates an existing document.
 	// Returns ErrNotFound if the document doesn't exist.
+	// If the document was read from storage (Version > 0) and has been updated since,
+	// returns ErrConflict and leaves the stored document unchanged; on success Version is bumped.
 	UpdateDocument(ctx context.Context, doc *entities.DocumentEntity) error
 
 	// DeleteDocument removes a document from storage.
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// EntityEventRepository defines the contract for the append-only entity history log.
+// Events can only be appended and queried; there are no update or delete operations.
+type EntityEventRepository interface {
+	// AppendEntityEvent records a new event and assigns its ID.
+	AppendEntityEvent(ctx context.Context, event *entities.EntityEvent) error
+
+	// ListEntityEvents returns events matching the filters, oldest first.
+	// Returns empty slice if no events match.
+	ListEntityEvents(ctx context.Context, filters entities.EntityEventFilters) ([]*entities.EntityEvent, error)
+}
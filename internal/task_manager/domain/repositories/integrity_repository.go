@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// IntegrityRepository defines the contract for checking storage for inconsistencies it
+// tolerates: corruption, rows referencing deleted entities, invalid statuses and ranks,
+// and ID counters behind the IDs already in use.
+type IntegrityRepository interface {
+	// CheckIntegrity returns the problems found in storage.
+	// With repair set, the safe repairs are applied in a single transaction and the
+	// repaired issues are marked Fixed; if any repair fails, nothing is changed.
+	CheckIntegrity(ctx context.Context, repair bool) ([]entities.IntegrityIssue, error)
+}
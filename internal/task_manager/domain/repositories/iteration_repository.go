@@ -26,6 +26,8 @@ type IterationRepository interface {
 
 	// UpdateIteration updates an existing iteration.
 	// Returns ErrNotFound if the iteration doesn't exist.
+	// If the iteration was read from storage (Version > 0) and has been updated since,
+	// returns ErrConflict and leaves the stored iteration unchanged; on success Version is bumped.
 	UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error
 
 	// DeleteIteration removes an iteration from storage.
@@ -1,5 +1,9 @@
 package repositories
 
+import (
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
 // ProjectManagementRepository handles CRUD operations for project management
 type ProjectManagementRepository interface {
 	// CreateProject creates a new project with its own database
@@ -22,4 +26,14 @@ type ProjectManagementRepository interface {
 
 	// ProjectExists checks if a project exists
 	ProjectExists(projectName string) (bool, error)
+
+	// GetProjectCode returns the code a project's entity IDs are prefixed with
+	GetProjectCode(projectName string) (string, error)
+
+	// ExportProjectData reads the complete content of a project
+	ExportProjectData(projectName string) (*entities.ProjectData, error)
+
+	// ImportProjectData creates a new project holding data, using data.Code for its IDs.
+	// A project that cannot be fully imported is not created.
+	ImportProjectData(projectName string, data *entities.ProjectData) error
 }
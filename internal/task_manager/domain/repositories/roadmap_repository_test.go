@@ -124,6 +124,26 @@ func (m *mockTaskRepository) GetIterationsForTask(ctx context.Context, taskID st
 	return nil, nil
 }
 
+func (m *mockTaskRepository) AddTaskDependency(ctx context.Context, taskID, blockedByID string) error {
+	return nil
+}
+
+func (m *mockTaskRepository) RemoveTaskDependency(ctx context.Context, taskID, blockedByID string) error {
+	return nil
+}
+
+func (m *mockTaskRepository) GetTaskDependencies(ctx context.Context, taskID string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *mockTaskRepository) GetBlockedTasks(ctx context.Context, taskID string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *mockTaskRepository) ListTaskStatusChanges(ctx context.Context, filters entities.TaskStatusChangeFilters) ([]*entities.TaskStatusChange, error) {
+	return nil, nil
+}
+
 type mockIterationRepository struct{}
 
 func (m *mockIterationRepository) SaveIteration(ctx context.Context, iteration *entities.IterationEntity) error {
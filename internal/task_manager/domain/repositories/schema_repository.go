@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// SchemaRepository defines the contract for inspecting and moving the schema version of a
+// project's storage, so an upgrade can be undone before the storage is handed to an older tm.
+type SchemaRepository interface {
+	// GetSchemaStatus returns the schema version of the storage and the known migrations.
+	// The storage is not migrated or created.
+	GetSchemaStatus(ctx context.Context) (*entities.SchemaStatus, error)
+
+	// MigrateSchema moves the schema to targetVersion, upgrading or rolling back, after
+	// backing up the storage. Returns ErrInvalidArgument for an unknown version, and
+	// ErrRejected if a migration on the way cannot be rolled back or the storage was
+	// written by a newer tm.
+	MigrateSchema(ctx context.Context, targetVersion int) (*entities.SchemaChange, error)
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// SearchRepository defines the contract for the full-text index over tasks,
+// acceptance criteria, ADRs and documents. The index is kept up to date by storage itself.
+type SearchRepository interface {
+	// Search returns hits matching the filters, most relevant first.
+	// Returns ErrInvalidArgument if the query has no searchable terms.
+	// Returns empty slice if nothing matches.
+	Search(ctx context.Context, filters entities.SearchFilters) ([]*entities.SearchHit, error)
+}
@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// SnapshotRepository defines the contract for point-in-time copies of project storage.
+// Snapshots are kept outside the projects, so they survive a project's deletion.
+type SnapshotRepository interface {
+	// CreateSnapshot copies a project's storage as it is, without migrating it.
+	// Returns ErrNotFound if the project has no storage.
+	CreateSnapshot(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error)
+
+	// ListSnapshots returns the snapshots of a project, newest first.
+	ListSnapshots(ctx context.Context, projectName string) ([]*entities.ProjectSnapshot, error)
+
+	// GetSnapshot returns the snapshot with the given ID.
+	// Returns ErrNotFound if it doesn't exist.
+	GetSnapshot(ctx context.Context, id string) (*entities.ProjectSnapshot, error)
+
+	// RestoreSnapshot replaces the storage of the snapshot's project with the snapshot,
+	// recreating the project if it was deleted. Returns ErrRejected if the snapshot was
+	// written by a newer tm.
+	RestoreSnapshot(ctx context.Context, id string) error
+
+	// DeleteSnapshot removes a snapshot.
+	DeleteSnapshot(ctx context.Context, id string) error
+}
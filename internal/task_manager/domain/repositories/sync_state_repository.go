@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// SyncStateRepository defines the contract for the per-directory baselines of `tm sync`.
+type SyncStateRepository interface {
+	// ListSyncStates returns the baselines recorded for a sync directory.
+	// Returns empty slice if the directory was never synced.
+	ListSyncStates(ctx context.Context, directory string) ([]*entities.SyncState, error)
+
+	// SaveSyncState creates or replaces the baseline of an entity in a sync directory.
+	SaveSyncState(ctx context.Context, state *entities.SyncState) error
+
+	// DeleteSyncState removes the baseline of an entity in a sync directory.
+	// Deleting a missing baseline is not an error.
+	DeleteSyncState(ctx context.Context, directory, entityType, entityID string) error
+}
@@ -23,6 +23,8 @@ type TaskRepository interface {
 
 	// UpdateTask updates an existing task.
 	// Returns ErrNotFound if the task doesn't exist.
+	// If the task was read from storage (Version > 0) and has been updated since,
+	// returns ErrConflict and leaves the stored task unchanged; on success Version is bumped.
 	UpdateTask(ctx context.Context, task *entities.TaskEntity) error
 
 	// DeleteTask removes a task from storage.
@@ -42,4 +44,25 @@ type TaskRepository interface {
 	// Returns empty slice if the task is not in any iterations.
 	// Ordered by iteration number ascending.
 	GetIterationsForTask(ctx context.Context, taskID string) ([]*entities.IterationEntity, error)
+
+	// AddTaskDependency records that taskID is blocked by blockedByID.
+	// Returns ErrNotFound if either task doesn't exist.
+	// Returns ErrAlreadyExists if the link already exists.
+	AddTaskDependency(ctx context.Context, taskID, blockedByID string) error
+
+	// RemoveTaskDependency removes the link from taskID to blockedByID.
+	// Returns ErrNotFound if the link doesn't exist.
+	RemoveTaskDependency(ctx context.Context, taskID, blockedByID string) error
+
+	// GetTaskDependencies returns the IDs of all tasks that block taskID.
+	// Returns empty slice if the task has no blockers.
+	GetTaskDependencies(ctx context.Context, taskID string) ([]string, error)
+
+	// GetBlockedTasks returns the IDs of all tasks that taskID blocks.
+	// Returns empty slice if no task is blocked by it.
+	GetBlockedTasks(ctx context.Context, taskID string) ([]string, error)
+
+	// ListTaskStatusChanges returns recorded task status transitions matching the filters.
+	// Ordered by change time ascending. Returns empty slice if none match.
+	ListTaskStatusChanges(ctx context.Context, filters entities.TaskStatusChangeFilters) ([]*entities.TaskStatusChange, error)
 }
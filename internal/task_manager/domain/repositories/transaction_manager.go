@@ -0,0 +1,10 @@
+package repositories
+
+import "context"
+
+// TransactionManager defines the contract for applying several repository changes atomically.
+type TransactionManager interface {
+	// InTransaction runs fn in a transaction. The repository calls fn makes with the context it
+	// receives are committed together when fn returns nil, and rolled back together otherwise.
+	InTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -50,6 +50,11 @@ type RoadmapRepository interface {
 	MoveTaskToTrack(ctx context.Context, taskID, newTrackID string) error
 	GetBacklogTasks(ctx context.Context) ([]*entities.TaskEntity, error)
 	GetIterationsForTask(ctx context.Context, taskID string) ([]*entities.IterationEntity, error)
+	AddTaskDependency(ctx context.Context, taskID, blockedByID string) error
+	RemoveTaskDependency(ctx context.Context, taskID, blockedByID string) error
+	GetTaskDependencies(ctx context.Context, taskID string) ([]string, error)
+	GetBlockedTasks(ctx context.Context, taskID string) ([]string, error)
+	ListTaskStatusChanges(ctx context.Context, filters entities.TaskStatusChangeFilters) ([]*entities.TaskStatusChange, error)
 
 	// Iteration operations
 	SaveIteration(ctx context.Context, iteration *entities.IterationEntity) error
@@ -101,6 +106,9 @@ type RoadmapRepository interface {
 	UpdateDocument(ctx context.Context, doc *entities.DocumentEntity) error
 	DeleteDocument(ctx context.Context, id string) error
 
+	// Search operations
+	Search(ctx context.Context, filters entities.SearchFilters) ([]*entities.SearchHit, error)
+
 	// Aggregate queries
 	GetRoadmapWithTracks(ctx context.Context, roadmapID string) (*entities.RoadmapEntity, error)
 	GetProjectMetadata(ctx context.Context, key string) (string, error)
@@ -0,0 +1,23 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// CheckResult is the outcome of executing an acceptance criterion's check
+type CheckResult struct {
+	Passed   bool          // Check exited with status 0 within the timeout
+	ExitCode int           // Process exit code, -1 if the check timed out
+	TimedOut bool          // Check was killed after exceeding its timeout
+	Duration time.Duration // Wall-clock run time
+	Output   string        // Combined stdout and stderr
+}
+
+// CheckRunner executes the check command attached to an acceptance criterion.
+// A failing check is reported through CheckResult; an error means the check could not be run at all.
+type CheckRunner interface {
+	RunCheck(ctx context.Context, ac *entities.AcceptanceCriteriaEntity, timeout time.Duration) (CheckResult, error)
+}
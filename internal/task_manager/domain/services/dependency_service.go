@@ -3,11 +3,14 @@ package services
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
 )
 
-// DependencyService handles circular dependency detection for tracks
+// DependencyService handles circular dependency detection and critical path analysis for tracks and tasks
 type DependencyService struct{}
 
 // NewDependencyService creates a new dependency service
@@ -15,7 +18,7 @@ func NewDependencyService() *DependencyService {
 	return &DependencyService{}
 }
 
-// ValidateNoCycles checks if the given track has any circular dependencies
+// ValidateNoCycles checks if the given track or task has any circular dependencies
 // Uses depth-first search algorithm to detect cycles
 // Returns ErrInvalidArgument if a cycle is detected
 func (s *DependencyService) ValidateNoCycles(
@@ -39,7 +42,7 @@ func (s *DependencyService) detectCycleDFS(
 ) error {
 	// If we're revisiting a node that's in the current path, we have a cycle
 	if visited[trackID] {
-		return fmt.Errorf("%w: circular dependency detected for track %s", errors.ErrInvalidArgument, trackID)
+		return fmt.Errorf("%w: circular dependency detected for %s", errors.ErrInvalidArgument, trackID)
 	}
 
 	// Mark node as in the current path
@@ -48,7 +51,7 @@ func (s *DependencyService) detectCycleDFS(
 	// Get dependencies for this track
 	deps, err := getDependencies(ctx, trackID)
 	if err != nil {
-		return fmt.Errorf("failed to get dependencies for track %s: %w", trackID, err)
+		return fmt.Errorf("failed to get dependencies for %s: %w", trackID, err)
 	}
 
 	// Recursively check all dependencies
@@ -62,3 +65,220 @@ func (s *DependencyService) detectCycleDFS(
 	visited[trackID] = false
 	return nil
 }
+
+// CriticalPath returns the longest chain of unfinished tracks through the dependency DAG,
+// prerequisites first. Each unfinished track weighs weights[trackID], or 1 when it has no
+// weight; complete tracks and dependencies on unknown tracks are ignored. Ties are broken
+// by track ID so the result is stable. Returns the path with its total weight, or nil and 0
+// when every track is complete.
+func (s *DependencyService) CriticalPath(tracks []*entities.TrackEntity, weights map[string]int) ([]string, int) {
+	unfinished := make(map[string]*entities.TrackEntity)
+	ids := make([]string, 0, len(tracks))
+	for _, track := range tracks {
+		if track.Status != string(entities.TrackStatusComplete) {
+			unfinished[track.ID] = track
+			ids = append(ids, track.ID)
+		}
+	}
+	sort.Strings(ids)
+
+	// best[id] is the heaviest chain ending at id; next[id] is the prerequisite it goes through
+	best := make(map[string]int)
+	next := make(map[string]string)
+	visiting := make(map[string]bool)
+	var walk func(id string) int
+	walk = func(id string) int {
+		if total, ok := best[id]; ok {
+			return total
+		}
+		if visiting[id] {
+			return 0 // Cycles are rejected on write; never loop on a corrupt graph
+		}
+		visiting[id] = true
+
+		deps := append([]string(nil), unfinished[id].Dependencies...)
+		sort.Strings(deps)
+		heaviest, via := 0, ""
+		for _, dep := range deps {
+			if unfinished[dep] == nil {
+				continue
+			}
+			if total := walk(dep); total > heaviest {
+				heaviest, via = total, dep
+			}
+		}
+
+		weight := weights[id]
+		if weight < 1 {
+			weight = 1
+		}
+		visiting[id] = false
+		best[id] = heaviest + weight
+		next[id] = via
+		return best[id]
+	}
+
+	end, total := "", 0
+	for _, id := range ids {
+		if weight := walk(id); weight > total {
+			end, total = id, weight
+		}
+	}
+	if end == "" {
+		return nil, 0
+	}
+
+	var path []string
+	for id := end; id != ""; id = next[id] {
+		path = append([]string{id}, path...)
+	}
+	return path, total
+}
+
+// TopologicalWaves orders ids so that every item comes after its dependencies, grouped into
+// waves: each wave only depends on earlier waves, so its items can be worked on in parallel.
+// Dependencies outside ids are treated as satisfied. Items within a wave are sorted by ID.
+// Returns ErrInvalidArgument if the dependencies among ids contain a cycle.
+func (s *DependencyService) TopologicalWaves(
+	ctx context.Context,
+	ids []string,
+	getDependencies func(context.Context, string) ([]string, error),
+) ([][]string, error) {
+	included := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		included[id] = true
+	}
+
+	// Kahn's algorithm, one layer at a time
+	pending := make(map[string]int, len(ids))
+	dependents := make(map[string][]string)
+	for id := range included {
+		deps, err := getDependencies(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dependencies for %s: %w", id, err)
+		}
+		for _, dep := range deps {
+			if !included[dep] || dep == id {
+				continue
+			}
+			pending[id]++
+			dependents[dep] = append(dependents[dep], id)
+		}
+	}
+
+	var wave []string
+	for id := range included {
+		if pending[id] == 0 {
+			wave = append(wave, id)
+		}
+	}
+
+	var waves [][]string
+	placed := 0
+	for len(wave) > 0 {
+		sort.Strings(wave)
+		waves = append(waves, wave)
+		placed += len(wave)
+
+		var next []string
+		for _, id := range wave {
+			for _, dependent := range dependents[id] {
+				pending[dependent]--
+				if pending[dependent] == 0 {
+					next = append(next, dependent)
+				}
+			}
+		}
+		wave = next
+	}
+
+	if placed < len(included) {
+		var cyclic []string
+		for id := range included {
+			if pending[id] > 0 {
+				cyclic = append(cyclic, id)
+			}
+		}
+		sort.Strings(cyclic)
+		return nil, fmt.Errorf("%w: circular dependency detected: cannot order %v", errors.ErrInvalidArgument, cyclic)
+	}
+	return waves, nil
+}
+
+// FindCycles returns the circular dependencies among ids, each as the IDs around the loop
+// starting from its smallest ID; a self-dependency is a cycle of one. Dependencies outside
+// ids are ignored. Every loop closed during a depth-first walk is reported once, so cycles
+// sharing items may not all be listed; the result is stable for the same graph.
+func (s *DependencyService) FindCycles(
+	ctx context.Context,
+	ids []string,
+	getDependencies func(context.Context, string) ([]string, error),
+) ([][]string, error) {
+	included := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		included[id] = true
+	}
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+
+	// onPath holds the position of items in the current walk; done marks finished items
+	onPath := make(map[string]int)
+	done := make(map[string]bool)
+	reported := make(map[string]bool)
+	var path []string
+	var cycles [][]string
+
+	var walk func(id string) error
+	walk = func(id string) error {
+		onPath[id] = len(path)
+		path = append(path, id)
+
+		deps, err := getDependencies(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get dependencies for %s: %w", id, err)
+		}
+		deps = append([]string(nil), deps...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if !included[dep] || done[dep] {
+				continue
+			}
+			if start, ok := onPath[dep]; ok {
+				cycle := rotateToSmallest(path[start:])
+				if key := strings.Join(cycle, "\x00"); !reported[key] {
+					reported[key] = true
+					cycles = append(cycles, cycle)
+				}
+				continue
+			}
+			if err := walk(dep); err != nil {
+				return err
+			}
+		}
+
+		path = path[:len(path)-1]
+		delete(onPath, id)
+		done[id] = true
+		return nil
+	}
+
+	for _, id := range sorted {
+		if !done[id] {
+			if err := walk(id); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return cycles, nil
+}
+
+// rotateToSmallest returns a copy of the loop starting from its smallest ID
+func rotateToSmallest(loop []string) []string {
+	smallest := 0
+	for i, id := range loop {
+		if id < loop[smallest] {
+			smallest = i
+		}
+	}
+	return append(append([]string{}, loop[smallest:]...), loop[:smallest]...)
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestDependencyService_ValidateNoCycles(t *testing.T) {
@@ -122,3 +125,110 @@ func TestDependencyService_ValidateNoCycles(t *testing.T) {
 		}
 	})
 }
+
+func TestDependencyService_CriticalPath(t *testing.T) {
+	service := services.NewDependencyService()
+	track := func(id, status string, deps ...string) *entities.TrackEntity {
+		return &entities.TrackEntity{ID: id, Status: status, Dependencies: deps}
+	}
+
+	t.Run("heaviest chain wins", func(t *testing.T) {
+		// A <- B <- D and A <- C <- D; C carries more open work than B
+		tracks := []*entities.TrackEntity{
+			track("A", "in-progress"),
+			track("B", "not-started", "A"),
+			track("C", "not-started", "A"),
+			track("D", "not-started", "B", "C"),
+		}
+		path, total := service.CriticalPath(tracks, map[string]int{"A": 2, "B": 1, "C": 4, "D": 1})
+		assert.Equal(t, []string{"A", "C", "D"}, path)
+		assert.Equal(t, 7, total)
+	})
+
+	t.Run("complete tracks are skipped", func(t *testing.T) {
+		tracks := []*entities.TrackEntity{
+			track("A", "complete"),
+			track("B", "in-progress", "A"),
+			track("C", "not-started", "B"),
+		}
+		path, total := service.CriticalPath(tracks, nil)
+		assert.Equal(t, []string{"B", "C"}, path)
+		assert.Equal(t, 2, total, "tracks without a weight count as 1")
+	})
+
+	t.Run("ties are broken by track ID", func(t *testing.T) {
+		tracks := []*entities.TrackEntity{track("B", "not-started"), track("A", "not-started")}
+		path, _ := service.CriticalPath(tracks, nil)
+		assert.Equal(t, []string{"A"}, path)
+	})
+
+	t.Run("all complete", func(t *testing.T) {
+		path, total := service.CriticalPath([]*entities.TrackEntity{track("A", "complete")}, nil)
+		assert.Nil(t, path)
+		assert.Zero(t, total)
+	})
+}
+
+func TestDependencyService_TopologicalWaves(t *testing.T) {
+	ctx := context.Background()
+	service := services.NewDependencyService()
+	graph := func(deps map[string][]string) func(context.Context, string) ([]string, error) {
+		return func(ctx context.Context, id string) ([]string, error) {
+			return deps[id], nil
+		}
+	}
+
+	t.Run("independent items share a wave", func(t *testing.T) {
+		// A <- B, A <- C, B and C <- D; X depends on a track outside the plan
+		deps := map[string][]string{"B": {"A"}, "C": {"A"}, "D": {"C", "B"}, "X": {"done"}}
+		waves, err := service.TopologicalWaves(ctx, []string{"D", "C", "B", "A", "X"}, graph(deps))
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"A", "X"}, {"B", "C"}, {"D"}}, waves)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		waves, err := service.TopologicalWaves(ctx, nil, graph(nil))
+		assert.NoError(t, err)
+		assert.Empty(t, waves)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		deps := map[string][]string{"A": {"B"}, "B": {"A"}, "C": {"A"}}
+		_, err := service.TopologicalWaves(ctx, []string{"A", "B", "C"}, graph(deps))
+		assert.ErrorIs(t, err, errors.ErrInvalidArgument)
+		assert.Contains(t, err.Error(), "[A B C]")
+	})
+}
+
+func TestDependencyService_FindCycles(t *testing.T) {
+	ctx := context.Background()
+	service := services.NewDependencyService()
+	graph := func(deps map[string][]string) func(context.Context, string) ([]string, error) {
+		return func(ctx context.Context, id string) ([]string, error) {
+			return deps[id], nil
+		}
+	}
+
+	t.Run("acyclic", func(t *testing.T) {
+		deps := map[string][]string{"B": {"A"}, "C": {"A", "B"}, "D": {"outside"}}
+		cycles, err := service.FindCycles(ctx, []string{"A", "B", "C", "D"}, graph(deps))
+		assert.NoError(t, err)
+		assert.Empty(t, cycles)
+	})
+
+	t.Run("loops", func(t *testing.T) {
+		// C -> B -> D -> C, E depends on itself, F depends on the loop without being in it
+		deps := map[string][]string{"C": {"B"}, "B": {"D"}, "D": {"C"}, "E": {"E"}, "F": {"C"}}
+		cycles, err := service.FindCycles(ctx, []string{"F", "E", "D", "C", "B"}, graph(deps))
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"B", "D", "C"}, {"E"}}, cycles)
+	})
+
+	t.Run("dependency error", func(t *testing.T) {
+		failing := func(ctx context.Context, id string) ([]string, error) {
+			return nil, errors.ErrInternal
+		}
+		_, err := service.FindCycles(ctx, []string{"A"}, failing)
+		assert.ErrorIs(t, err, errors.ErrInternal)
+	})
+}
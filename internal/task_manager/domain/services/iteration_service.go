@@ -58,3 +58,54 @@ func (s *IterationService) CanCompleteIteration(iteration *entities.IterationEnt
 
 	return nil
 }
+
+// ValidateCompletion reports what stands between an iteration and completion:
+// unfinished tasks, failed, not-started or pending-review ACs, and task IDs that no longer exist.
+// The policy sets each check's severity; checks that are off are skipped.
+func (s *IterationService) ValidateCompletion(
+	iteration *entities.IterationEntity,
+	tasks []*entities.TaskEntity,
+	missingTaskIDs []string,
+	acs []*entities.AcceptanceCriteriaEntity,
+	policy entities.IterationCompletionPolicy,
+) *entities.IterationValidationResult {
+	result := &entities.IterationValidationResult{
+		IterationNumber: iteration.Number,
+		Issues:          []entities.IterationIssue{},
+	}
+	add := func(check, entityID, message string) {
+		severity := policy.Severity(check)
+		if severity == entities.CheckSeverityOff {
+			return
+		}
+		result.Issues = append(result.Issues, entities.IterationIssue{
+			Check:    check,
+			Severity: severity,
+			EntityID: entityID,
+			Message:  message,
+		})
+	}
+
+	for _, task := range tasks {
+		if task.Status != string(entities.TaskStatusDone) {
+			add(entities.IterationCheckUnfinishedTasks, task.ID, fmt.Sprintf("task is %s: %s", task.Status, task.Title))
+		}
+	}
+
+	for _, ac := range acs {
+		switch {
+		case ac.IsFailed():
+			add(entities.IterationCheckFailedACs, ac.ID, fmt.Sprintf("AC of %s failed: %s", ac.TaskID, ac.Description))
+		case ac.IsPendingReview():
+			add(entities.IterationCheckPendingReviews, ac.ID, fmt.Sprintf("AC of %s awaits human review: %s", ac.TaskID, ac.Description))
+		case ac.Status == entities.ACStatusNotStarted:
+			add(entities.IterationCheckNotStartedACs, ac.ID, fmt.Sprintf("AC of %s is not verified: %s", ac.TaskID, ac.Description))
+		}
+	}
+
+	for _, taskID := range missingTaskIDs {
+		add(entities.IterationCheckMissingTasks, taskID, "task is referenced by the iteration but no longer exists")
+	}
+
+	return result
+}
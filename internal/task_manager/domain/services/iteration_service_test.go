@@ -126,6 +126,66 @@ func TestIterationService_CanStartIteration(t *testing.T) {
 	})
 }
 
+func TestIterationService_ValidateCompletion(t *testing.T) {
+	svc := services.NewIterationService()
+	iter := createTestIteration(1, "Test", string(entities.IterationStatusCurrent))
+	now := time.Now()
+
+	doneTask := &entities.TaskEntity{ID: "TM-task-1", Title: "Done", Status: string(entities.TaskStatusDone)}
+	todoTask := &entities.TaskEntity{ID: "TM-task-2", Title: "Open", Status: string(entities.TaskStatusTodo)}
+
+	verified := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "verified", entities.VerificationTypeManual, "", now, now)
+	verified.Status = entities.ACStatusVerified
+	failed := entities.NewAcceptanceCriteriaEntity("TM-ac-2", "TM-task-1", "failed", entities.VerificationTypeManual, "", now, now)
+	failed.Status = entities.ACStatusFailed
+	pending := entities.NewAcceptanceCriteriaEntity("TM-ac-3", "TM-task-1", "pending", entities.VerificationTypeManual, "", now, now)
+	pending.Status = entities.ACStatusPendingHumanReview
+	notStarted := entities.NewAcceptanceCriteriaEntity("TM-ac-4", "TM-task-2", "not started", entities.VerificationTypeManual, "", now, now)
+
+	acs := []*entities.AcceptanceCriteriaEntity{verified, failed, pending, notStarted}
+	tasks := []*entities.TaskEntity{doneTask, todoTask}
+
+	t.Run("clean iteration can complete", func(t *testing.T) {
+		result := svc.ValidateCompletion(iter, []*entities.TaskEntity{doneTask}, nil, []*entities.AcceptanceCriteriaEntity{verified}, nil)
+		assert.Empty(t, result.Issues)
+		assert.True(t, result.CanComplete())
+		assert.Equal(t, 1, result.IterationNumber)
+	})
+
+	t.Run("default policy reports every problem", func(t *testing.T) {
+		result := svc.ValidateCompletion(iter, tasks, []string{"TM-task-9"}, acs, entities.DefaultIterationCompletionPolicy())
+
+		checks := map[string]string{}
+		for _, issue := range result.Issues {
+			checks[issue.EntityID] = issue.Check
+		}
+		assert.Equal(t, map[string]string{
+			"TM-task-2": entities.IterationCheckUnfinishedTasks,
+			"TM-ac-2":   entities.IterationCheckFailedACs,
+			"TM-ac-3":   entities.IterationCheckPendingReviews,
+			"TM-ac-4":   entities.IterationCheckNotStartedACs,
+			"TM-task-9": entities.IterationCheckMissingTasks,
+		}, checks)
+		assert.Len(t, result.Errors(), 4)
+		assert.Len(t, result.Warnings(), 1)
+		assert.False(t, result.CanComplete())
+	})
+
+	t.Run("policy downgrades and disables checks", func(t *testing.T) {
+		policy := entities.IterationCompletionPolicy{
+			entities.IterationCheckUnfinishedTasks: entities.CheckSeverityWarning,
+			entities.IterationCheckFailedACs:       entities.CheckSeverityOff,
+			entities.IterationCheckNotStartedACs:   entities.CheckSeverityOff,
+			entities.IterationCheckPendingReviews:  entities.CheckSeverityOff,
+		}
+		result := svc.ValidateCompletion(iter, tasks, []string{"TM-task-9"}, acs, policy)
+
+		assert.Len(t, result.Issues, 2)
+		assert.Len(t, result.Warnings(), 2)
+		assert.True(t, result.CanComplete())
+	})
+}
+
 // Helper function to create test iterations
 func createTestIteration(number int, name, status string) *entities.IterationEntity {
 	now := time.Now()
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+// MetricsService computes throughput metrics from tasks, iterations and the task status history.
+// History is passed grouped by task ID (see GroupStatusChanges), each task's changes oldest first.
+type MetricsService struct{}
+
+// NewMetricsService creates a new metrics service
+func NewMetricsService() *MetricsService {
+	return &MetricsService{}
+}
+
+// GroupStatusChanges groups status changes by task ID, keeping their order
+func GroupStatusChanges(changes []*entities.TaskStatusChange) map[string][]*entities.TaskStatusChange {
+	grouped := make(map[string][]*entities.TaskStatusChange)
+	for _, change := range changes {
+		grouped[change.TaskID] = append(grouped[change.TaskID], change)
+	}
+	return grouped
+}
+
+// Velocity returns the tasks each started iteration planned and completed, ordered by iteration number.
+// A task counts as completed if it was done when the iteration ended; current iterations are measured up to now.
+func (s *MetricsService) Velocity(
+	iterations []*entities.IterationEntity,
+	tasksByIteration map[int][]*entities.TaskEntity,
+	history map[string][]*entities.TaskStatusChange,
+	now time.Time,
+) []entities.IterationVelocity {
+	velocity := []entities.IterationVelocity{}
+	for _, iteration := range iterations {
+		if iteration.StartedAt == nil {
+			continue
+		}
+		end := iterationEnd(iteration, now)
+		tasks := tasksByIteration[iteration.Number]
+
+		completed := 0
+		for _, task := range tasks {
+			if statusAt(task, history[task.ID], end) == string(entities.TaskStatusDone) {
+				completed++
+			}
+		}
+		velocity = append(velocity, entities.IterationVelocity{
+			IterationNumber: iteration.Number,
+			Name:            iteration.Name,
+			Status:          iteration.Status,
+			PlannedTasks:    len(tasks),
+			CompletedTasks:  completed,
+			Days:            end.Sub(*iteration.StartedAt).Hours() / 24,
+		})
+	}
+
+	sort.Slice(velocity, func(i, j int) bool {
+		return velocity[i].IterationNumber < velocity[j].IterationNumber
+	})
+	return velocity
+}
+
+// Burndown returns the iteration's progress at the end of each day from its start until it completed
+// (or now, while current). Returns nil for iterations that have not started.
+func (s *MetricsService) Burndown(
+	iteration *entities.IterationEntity,
+	tasks []*entities.TaskEntity,
+	history map[string][]*entities.TaskStatusChange,
+	now time.Time,
+) *entities.IterationBurndown {
+	if iteration.StartedAt == nil {
+		return nil
+	}
+
+	burndown := &entities.IterationBurndown{
+		IterationNumber: iteration.Number,
+		Name:            iteration.Name,
+		Points:          []entities.BurndownPoint{},
+	}
+	end := iterationEnd(iteration, now)
+	start := *iteration.StartedAt
+	for date := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location()); !date.After(end); date = date.Add(day) {
+		cutoff := date.Add(day)
+		if cutoff.After(end) {
+			cutoff = end
+		}
+
+		completed := 0
+		for _, task := range tasks {
+			if statusAt(task, history[task.ID], cutoff) == string(entities.TaskStatusDone) {
+				completed++
+			}
+		}
+		burndown.Points = append(burndown.Points, entities.BurndownPoint{
+			Date:      date,
+			Scope:     len(tasks),
+			Completed: completed,
+			Remaining: len(tasks) - completed,
+		})
+	}
+	return burndown
+}
+
+// CycleTimes returns how long each done task took from its first move to in-progress to its last move to done,
+// ordered by completion time. Tasks that never went through in-progress are measured from their creation.
+func (s *MetricsService) CycleTimes(
+	tasks []*entities.TaskEntity,
+	history map[string][]*entities.TaskStatusChange,
+) []entities.TaskCycleTime {
+	cycleTimes := []entities.TaskCycleTime{}
+	for _, task := range tasks {
+		if task.Status != string(entities.TaskStatusDone) {
+			continue
+		}
+		changes := history[task.ID]
+		doneAt := lastDoneAt(task, changes)
+
+		startedAt := task.CreatedAt
+		for _, change := range changes {
+			if change.ToStatus == string(entities.TaskStatusInProgress) && !change.ChangedAt.After(doneAt) {
+				startedAt = change.ChangedAt
+				break
+			}
+		}
+
+		cycleTimes = append(cycleTimes, entities.TaskCycleTime{
+			TaskID:    task.ID,
+			TrackID:   task.TrackID,
+			Title:     task.Title,
+			StartedAt: startedAt,
+			DoneAt:    doneAt,
+			Hours:     doneAt.Sub(startedAt).Hours(),
+		})
+	}
+
+	sort.Slice(cycleTimes, func(i, j int) bool {
+		if !cycleTimes[i].DoneAt.Equal(cycleTimes[j].DoneAt) {
+			return cycleTimes[i].DoneAt.Before(cycleTimes[j].DoneAt)
+		}
+		return cycleTimes[i].TaskID < cycleTimes[j].TaskID
+	})
+	return cycleTimes
+}
+
+// SummarizeCycleTimes returns the count, average, median and maximum of the cycle times
+func (s *MetricsService) SummarizeCycleTimes(cycleTimes []entities.TaskCycleTime) entities.CycleTimeSummary {
+	summary := entities.CycleTimeSummary{Count: len(cycleTimes)}
+	if len(cycleTimes) == 0 {
+		return summary
+	}
+
+	hours := make([]float64, 0, len(cycleTimes))
+	var total float64
+	for _, cycleTime := range cycleTimes {
+		hours = append(hours, cycleTime.Hours)
+		total += cycleTime.Hours
+	}
+	sort.Float64s(hours)
+
+	summary.AverageHours = total / float64(len(hours))
+	summary.MaxHours = hours[len(hours)-1]
+	if middle := len(hours) / 2; len(hours)%2 == 0 {
+		summary.MedianHours = (hours[middle-1] + hours[middle]) / 2
+	} else {
+		summary.MedianHours = hours[middle]
+	}
+	return summary
+}
+
+// Throughput returns the tasks each track completed in each of the last weeks weeks, in track order.
+// The last week ends now. Weeks below 1 are treated as 1.
+func (s *MetricsService) Throughput(
+	tracks []*entities.TrackEntity,
+	tasks []*entities.TaskEntity,
+	history map[string][]*entities.TaskStatusChange,
+	weeks int,
+	now time.Time,
+) []entities.TrackThroughput {
+	if weeks < 1 {
+		weeks = 1
+	}
+	windowStart := now.Add(-time.Duration(weeks) * week)
+
+	throughput := make([]entities.TrackThroughput, 0, len(tracks))
+	byTrack := make(map[string]*entities.TrackThroughput, len(tracks))
+	for _, track := range tracks {
+		throughput = append(throughput, entities.TrackThroughput{
+			TrackID: track.ID,
+			Title:   track.Title,
+			Weekly:  make([]int, weeks),
+		})
+	}
+	for i := range throughput {
+		byTrack[throughput[i].TrackID] = &throughput[i]
+	}
+
+	for _, task := range tasks {
+		entry, ok := byTrack[task.TrackID]
+		if !ok || task.Status != string(entities.TaskStatusDone) {
+			continue
+		}
+		doneAt := lastDoneAt(task, history[task.ID])
+		if !doneAt.After(windowStart) || doneAt.After(now) {
+			continue
+		}
+		index := int(doneAt.Sub(windowStart) / week)
+		if index >= weeks {
+			index = weeks - 1
+		}
+		entry.Weekly[index]++
+		entry.Total++
+	}
+	return throughput
+}
+
+// iterationEnd returns when an iteration completed, or now while it is still open
+func iterationEnd(iteration *entities.IterationEntity, now time.Time) time.Time {
+	if iteration.CompletedAt != nil {
+		return *iteration.CompletedAt
+	}
+	return now
+}
+
+// statusAt returns a task's status at a point in time according to its history.
+// Returns empty if the task did not exist yet, and the current status if no history was recorded.
+func statusAt(task *entities.TaskEntity, changes []*entities.TaskStatusChange, at time.Time) string {
+	if len(changes) == 0 {
+		return task.Status
+	}
+	status := ""
+	for _, change := range changes {
+		if change.ChangedAt.After(at) {
+			break
+		}
+		status = change.ToStatus
+	}
+	return status
+}
+
+// lastDoneAt returns when a task last moved to done, falling back to its last update
+func lastDoneAt(task *entities.TaskEntity, changes []*entities.TaskStatusChange) time.Time {
+	doneAt := task.UpdatedAt
+	for _, change := range changes {
+		if change.ToStatus == string(entities.TaskStatusDone) {
+			doneAt = change.ChangedAt
+		}
+	}
+	return doneAt
+}
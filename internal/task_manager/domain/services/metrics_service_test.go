@@ -0,0 +1,159 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var metricsStart = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+func metricsTask(id, trackID, status string) *entities.TaskEntity {
+	return &entities.TaskEntity{
+		ID:        id,
+		TrackID:   trackID,
+		Title:     "Task " + id,
+		Status:    status,
+		CreatedAt: metricsStart,
+		UpdatedAt: metricsStart,
+	}
+}
+
+func statusChange(taskID, from, to string, after time.Duration) *entities.TaskStatusChange {
+	return &entities.TaskStatusChange{TaskID: taskID, FromStatus: from, ToStatus: to, ChangedAt: metricsStart.Add(after)}
+}
+
+// metricsHistory: task-1 is worked on from +2h and done at +26h, task-2 goes straight to done at +50h,
+// task-3 is still in progress.
+func metricsHistory() map[string][]*entities.TaskStatusChange {
+	return services.GroupStatusChanges([]*entities.TaskStatusChange{
+		statusChange("task-1", "", "todo", 0),
+		statusChange("task-2", "", "todo", 0),
+		statusChange("task-3", "", "todo", 0),
+		statusChange("task-1", "todo", "in-progress", 2*time.Hour),
+		statusChange("task-3", "todo", "in-progress", 3*time.Hour),
+		statusChange("task-1", "in-progress", "done", 26*time.Hour),
+		statusChange("task-2", "todo", "done", 50*time.Hour),
+	})
+}
+
+func TestGroupStatusChanges(t *testing.T) {
+	history := metricsHistory()
+
+	require.Len(t, history["task-1"], 3)
+	assert.Equal(t, "todo", history["task-1"][0].ToStatus)
+	assert.Equal(t, "done", history["task-1"][2].ToStatus)
+	assert.Len(t, history["task-2"], 2)
+}
+
+func TestMetricsService_Velocity(t *testing.T) {
+	svc := services.NewMetricsService()
+	completedAt := metricsStart.Add(30 * time.Hour)
+	secondStart := completedAt
+
+	iterations := []*entities.IterationEntity{
+		{Number: 2, Name: "Sprint 2", Status: "current", StartedAt: &secondStart},
+		{Number: 1, Name: "Sprint 1", Status: "complete", StartedAt: &metricsStart, CompletedAt: &completedAt},
+		{Number: 3, Name: "Sprint 3", Status: "planned"},
+	}
+	tasksByIteration := map[int][]*entities.TaskEntity{
+		1: {metricsTask("task-1", "track-1", "done"), metricsTask("task-2", "track-1", "done")},
+		2: {metricsTask("task-2", "track-1", "done"), metricsTask("task-3", "track-1", "in-progress")},
+	}
+
+	velocity := svc.Velocity(iterations, tasksByIteration, metricsHistory(), metricsStart.Add(60*time.Hour))
+
+	require.Len(t, velocity, 2, "planned iterations are skipped")
+	assert.Equal(t, 1, velocity[0].IterationNumber)
+	assert.Equal(t, 2, velocity[0].PlannedTasks)
+	assert.Equal(t, 1, velocity[0].CompletedTasks, "task-2 was done after the iteration completed")
+	assert.InDelta(t, 1.25, velocity[0].Days, 0.001)
+	assert.Equal(t, 2, velocity[1].IterationNumber)
+	assert.Equal(t, 1, velocity[1].CompletedTasks)
+}
+
+func TestMetricsService_Burndown(t *testing.T) {
+	svc := services.NewMetricsService()
+	iteration := &entities.IterationEntity{Number: 1, Name: "Sprint 1", Status: "current", StartedAt: &metricsStart}
+	tasks := []*entities.TaskEntity{
+		metricsTask("task-1", "track-1", "done"),
+		metricsTask("task-2", "track-1", "done"),
+		metricsTask("task-3", "track-1", "in-progress"),
+	}
+
+	burndown := svc.Burndown(iteration, tasks, metricsHistory(), metricsStart.Add(60*time.Hour))
+
+	require.NotNil(t, burndown)
+	require.Len(t, burndown.Points, 3, "one point per day since the start")
+	remaining := []int{}
+	for _, point := range burndown.Points {
+		assert.Equal(t, 3, point.Scope)
+		assert.Equal(t, point.Scope-point.Completed, point.Remaining)
+		remaining = append(remaining, point.Remaining)
+	}
+	assert.Equal(t, []int{3, 2, 1}, remaining)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), burndown.Points[0].Date)
+
+	planned := &entities.IterationEntity{Number: 2, Status: "planned"}
+	assert.Nil(t, svc.Burndown(planned, tasks, metricsHistory(), metricsStart), "iterations that have not started have no burndown")
+}
+
+func TestMetricsService_CycleTimes(t *testing.T) {
+	svc := services.NewMetricsService()
+	noHistory := metricsTask("task-4", "track-2", "done")
+	noHistory.UpdatedAt = metricsStart.Add(5 * time.Hour)
+	tasks := []*entities.TaskEntity{
+		metricsTask("task-2", "track-1", "done"),
+		metricsTask("task-1", "track-1", "done"),
+		metricsTask("task-3", "track-1", "in-progress"),
+		noHistory,
+	}
+
+	cycleTimes := svc.CycleTimes(tasks, metricsHistory())
+
+	require.Len(t, cycleTimes, 3, "only done tasks have a cycle time")
+	assert.Equal(t, "task-4", cycleTimes[0].TaskID, "ordered by completion time")
+	assert.Equal(t, 5.0, cycleTimes[0].Hours, "without history, creation to last update")
+	assert.Equal(t, "task-1", cycleTimes[1].TaskID)
+	assert.Equal(t, 24.0, cycleTimes[1].Hours, "in-progress to done")
+	assert.Equal(t, "task-2", cycleTimes[2].TaskID)
+	assert.Equal(t, 50.0, cycleTimes[2].Hours, "never in progress, measured from creation")
+
+	summary := svc.SummarizeCycleTimes(cycleTimes)
+	assert.Equal(t, 3, summary.Count)
+	assert.InDelta(t, 26.333, summary.AverageHours, 0.001)
+	assert.Equal(t, 24.0, summary.MedianHours)
+	assert.Equal(t, 50.0, summary.MaxHours)
+
+	assert.Equal(t, entities.CycleTimeSummary{}, svc.SummarizeCycleTimes(nil))
+}
+
+func TestMetricsService_Throughput(t *testing.T) {
+	svc := services.NewMetricsService()
+	tracks := []*entities.TrackEntity{
+		{ID: "track-1", Title: "Core"},
+		{ID: "track-2", Title: "Docs"},
+	}
+	old := metricsTask("task-5", "track-1", "done")
+	old.UpdatedAt = metricsStart.Add(-30 * 24 * time.Hour)
+	tasks := []*entities.TaskEntity{
+		metricsTask("task-1", "track-1", "done"),
+		metricsTask("task-2", "track-1", "done"),
+		metricsTask("task-3", "track-1", "in-progress"),
+		old,
+	}
+	now := metricsStart.Add(8 * 24 * time.Hour)
+
+	throughput := svc.Throughput(tracks, tasks, metricsHistory(), 2, now)
+
+	require.Len(t, throughput, 2)
+	assert.Equal(t, "track-1", throughput[0].TrackID)
+	assert.Equal(t, []int{0, 2}, throughput[0].Weekly, "both tasks were done in the week ending now")
+	assert.Equal(t, 2, throughput[0].Total, "tasks done before the window are not counted")
+	assert.Equal(t, []int{0, 0}, throughput[1].Weekly)
+	assert.Equal(t, 0, throughput[1].Total)
+}
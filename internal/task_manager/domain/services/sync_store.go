@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+)
+
+// SyncEntity is an entity mirrored to a sync file: a roadmap, track, task, iteration,
+// acceptance criterion, ADR or document entity.
+type SyncEntity interface {
+	GetID() string
+	GetType() string
+}
+
+// SyncFile is an entity decoded from its sync file
+type SyncFile struct {
+	Entity SyncEntity
+	Path   string // Relative to the sync directory
+	Hash   string // Hash of the file content as found on disk
+}
+
+// SyncStore is a directory of plain-text files mirroring a project, one file per entity.
+type SyncStore interface {
+	// ReadFiles decodes every entity file in dir. A missing directory holds no files.
+	// A file that does not decode into a valid entity is an error.
+	ReadFiles(ctx context.Context, dir string) ([]SyncFile, error)
+
+	// WriteFile writes an entity's file into dir and returns it.
+	WriteFile(ctx context.Context, dir string, entity SyncEntity) (SyncFile, error)
+
+	// RemoveFile deletes an entity's file from dir. Removing a missing file is not an error.
+	RemoveFile(ctx context.Context, dir, entityType, entityID string) error
+
+	// Hash returns the hash the entity's file has when written by WriteFile
+	Hash(entity SyncEntity) (string, error)
+
+	// FilePath returns where an entity's file lives, relative to the sync directory
+	FilePath(entityType, entityID string) string
+}
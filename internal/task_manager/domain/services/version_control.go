@@ -0,0 +1,27 @@
+package services
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// VersionControl is the code repository that tasks are worked on in.
+type VersionControl interface {
+	// CheckoutBranch switches to the branch, creating it from HEAD if it doesn't exist.
+	// It reports whether the branch was created.
+	CheckoutBranch(ctx context.Context, name string) (bool, error)
+
+	// FindCommits returns commits on local branches whose message contains text, newest first.
+	FindCommits(ctx context.Context, text string) ([]entities.Commit, error)
+
+	// CurrentBranch returns the checked out branch, or "" when HEAD is detached.
+	CurrentBranch(ctx context.Context) (string, error)
+
+	// MergedCommits returns the commits brought in by the last merge or pull, newest first.
+	MergedCommits(ctx context.Context) ([]entities.Commit, error)
+
+	// InstallHook writes an executable hook script and returns its path.
+	// A hook not installed by the task manager is only replaced when force is set.
+	InstallHook(ctx context.Context, name, script string, force bool) (string, error)
+}
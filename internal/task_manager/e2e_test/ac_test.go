@@ -1,6 +1,7 @@
 package task_manager_e2e_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -540,3 +541,75 @@ func (s *ACTestSuite) TestACListIterationWithTestingInstructions() {
 	s.Contains(withTestingOutput, "2. Second", "should show second of AC 3")
 	s.Contains(withTestingOutput, "3. Third", "should show third of AC 3")
 }
+
+// TestACRunChecks tests that 'ac run' executes checks and records the outcome
+func (s *ACTestSuite) TestACRunChecks() {
+	trackOutput, err := s.run("track", "create", "--title", "Test Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Test Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	passOutput, err := s.run("ac", "add", taskID, "--description", "Passing check", "--check", "echo checked $TM_AC_ID")
+	s.requireSuccess(passOutput, err, "failed to add AC with passing check")
+	s.Contains(passOutput, "Check:", "add output should show the check")
+	passID := s.parseID(passOutput, "ac")
+
+	failOutput, err := s.run("ac", "add", taskID, "--description", "Failing check", "--check", "echo boom >&2; exit 2")
+	s.requireSuccess(failOutput, err, "failed to add AC with failing check")
+	failID := s.parseID(failOutput, "ac")
+
+	manualOutput, err := s.run("ac", "add", taskID, "--description", "Manual AC")
+	s.requireSuccess(manualOutput, err, "failed to add manual AC")
+	manualID := s.parseID(manualOutput, "ac")
+
+	// A single AC without a check cannot be run
+	_, err = s.run("ac", "run", manualID)
+	s.requireError(err, "running an AC without a check should fail")
+
+	// Running the passing AC alone succeeds
+	runOutput, err := s.run("ac", "run", passID)
+	s.requireSuccess(runOutput, err, "passing check should succeed")
+	s.Contains(runOutput, "automatically_verified")
+
+	showOutput, err := s.run("ac", "show", passID)
+	s.requireSuccess(showOutput, err, "failed to show AC")
+	s.Contains(showOutput, "checked "+passID, "check output should be stored in notes")
+
+	// Running the task runs both checks and exits non-zero because one failed
+	runOutput, err = s.run("ac", "run", taskID)
+	s.requireError(err, "task run with a failing check should fail")
+	s.Contains(runOutput, "2 check(s), 1 passed, 1 failed")
+	s.Contains(runOutput, "boom", "failing check output should be shown")
+	s.NotContains(runOutput, manualID, "manual AC should not be run")
+
+	// Structured output gates the same way, with the results and the failure in one envelope
+	jsonOutput, err := s.run("ac", "run", taskID, "-o", "json")
+	s.requireError(err, "task run with a failing check should fail in JSON output too")
+	var envelope struct {
+		Kind string `json:"kind"`
+		Data []struct {
+			ACID string `json:"ac_id"`
+		} `json:"data"`
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(jsonOutput), &envelope), "output should be a single JSON envelope: %s", jsonOutput)
+	s.Equal("ac_check_results", envelope.Kind)
+	s.Len(envelope.Data, 2)
+	s.Require().NotNil(envelope.Error)
+	s.Equal("1 of 2 check(s) did not pass", envelope.Error.Message)
+
+	showOutput, err = s.run("ac", "show", failID)
+	s.requireSuccess(showOutput, err, "failed to show AC")
+	s.Contains(showOutput, "failed")
+	s.Contains(showOutput, "exit code 2")
+
+	// Removing the check makes the AC manual again
+	updateOutput, err := s.run("ac", "update", failID, "--check", "")
+	s.requireSuccess(updateOutput, err, "failed to clear check")
+	s.Contains(updateOutput, "Check: Cleared")
+}
@@ -1,6 +1,8 @@
 package task_manager_e2e_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -114,23 +116,54 @@ func (s *E2ETestSuite) TearDownTest() {
 
 // run executes a tm command and returns stdout/stderr combined
 func (s *E2ETestSuite) run(args ...string) (string, error) {
+	return s.runWithEnv(nil, args...)
+}
+
+// runWithEnv executes a tm command with extra environment variables (KEY=value)
+func (s *E2ETestSuite) runWithEnv(env []string, args ...string) (string, error) {
+	return s.runCommand("", env, args...)
+}
+
+// runInDir executes a tm command from another directory, e.g. a scratch git repository
+func (s *E2ETestSuite) runInDir(dir string, args ...string) (string, error) {
+	return s.runCommand(dir, nil, args...)
+}
+
+// runCommand executes a tm command in dir (empty for the test's directory) with extra environment variables
+func (s *E2ETestSuite) runCommand(dir string, env []string, args ...string) (string, error) {
 	// Use the arguments as-is (no prepending)
 	fullArgs := args
 
 	// Use the locally-built binary, not system PATH
 	cmd := exec.Command(tmBinaryPath, fullArgs...)
+	cmd.Dir = dir
 
 	// CRITICAL: Set TM_WORKING_DIR environment variable
 	// This ensures the binary uses a consistent working directory for all operations
 	// (active-project.txt, project databases, etc.)
 	// Without this, each command invocation would use its own os.Getwd() which may vary
 	cmd.Env = append(os.Environ(), "TM_WORKING_DIR="+s.testWorkingDir)
+	cmd.Env = append(cmd.Env, env...)
 
 	// Execute the command and capture output
 	output, err := cmd.CombinedOutput()
 	return string(output), err
 }
 
+// runJSON executes a tm command with -o json and decodes the envelope it writes to stdout
+// into envelope. Notices on stderr, such as automatic snapshots, are not decoded.
+func (s *E2ETestSuite) runJSON(envelope interface{}, args ...string) {
+	cmd := exec.Command(tmBinaryPath, append(args, "-o", "json")...)
+	cmd.Env = append(os.Environ(), "TM_WORKING_DIR="+s.testWorkingDir)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	s.Require().NoError(err, "tm %s failed\nStdout:\n%s\nStderr:\n%s", strings.Join(args, " "), stdout.String(), stderr.String())
+	s.Require().NoError(json.Unmarshal(stdout.Bytes(), envelope), "tm %s should print a JSON envelope, got: %s", strings.Join(args, " "), stdout.String())
+}
+
 // requireSuccess asserts that a command executed successfully
 func (s *E2ETestSuite) requireSuccess(output string, err error, msg string, args ...interface{}) {
 	s.Require().NoError(err, append([]interface{}{msg, "\nOutput:\n", output}, args...)...)
@@ -0,0 +1,173 @@
+package task_manager_e2e_test
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// GitTestSuite tests task branches and commit links end-to-end against a scratch repository
+type GitTestSuite struct {
+	E2ETestSuite
+}
+
+func TestGitSuite(t *testing.T) {
+	suite.Run(t, new(GitTestSuite))
+}
+
+// git runs a git command in dir and returns its trimmed output
+func (s *GitTestSuite) git(dir string, args ...string) string {
+	output, err := s.tryGit(dir, args...)
+	s.Require().NoError(err, "git %v failed: %s", args, output)
+	return output
+}
+
+// tryGit runs a git command in dir that may fail, e.g. because a hook rejects it
+func (s *GitTestSuite) tryGit(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	return strings.TrimSpace(string(output)), err
+}
+
+// newRepo creates a git repository with one initial commit
+func (s *GitTestSuite) newRepo() string {
+	dir := s.T().TempDir()
+	s.git(dir, "init", "--quiet", "--initial-branch=main")
+	s.git(dir, "config", "user.name", "E2E Tester")
+	s.git(dir, "config", "user.email", "e2e@example.com")
+	s.git(dir, "commit", "--quiet", "--allow-empty", "-m", "Initial commit")
+	return dir
+}
+
+// TestTaskCreateWithBranch tests that --branch is persisted on the task
+func (s *GitTestSuite) TestTaskCreateWithBranch() {
+	trackOutput, err := s.run("track", "create", "--title", "Git Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+
+	taskOutput, err := s.run("task", "create", "--track", s.parseID(trackOutput, "track"), "--title", "Branch Task", "--branch", "feature/login")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	s.Contains(taskOutput, "Branch:      feature/login")
+	taskID := s.parseID(taskOutput, "task")
+
+	showOutput, err := s.run("task", "show", taskID)
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "feature/login")
+
+	updateOutput, err := s.run("task", "update", taskID, "--branch", "feature/signin")
+	s.requireSuccess(updateOutput, err, "failed to update branch")
+	s.Contains(updateOutput, "feature/signin")
+}
+
+// TestTaskStartAndCommits tests branch creation and commit scanning in a real repository
+func (s *GitTestSuite) TestTaskStartAndCommits() {
+	repo := s.newRepo()
+
+	trackOutput, err := s.run("track", "create", "--title", "Git Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	taskOutput, err := s.run("task", "create", "--track", s.parseID(trackOutput, "track"), "--title", "Implement Login Form!")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	startOutput, err := s.runInDir(repo, "task", "start", taskID)
+	s.requireSuccess(startOutput, err, "failed to start task")
+	branch := taskID + "-implement-login-form"
+	s.Contains(startOutput, "Created and checked out branch "+branch)
+	s.Contains(startOutput, "in-progress")
+	s.Equal(branch, s.git(repo, "rev-parse", "--abbrev-ref", "HEAD"))
+
+	// Starting again checks out the recorded branch
+	s.git(repo, "checkout", "--quiet", "main")
+	startOutput, err = s.runInDir(repo, "task", "start", taskID)
+	s.requireSuccess(startOutput, err, "failed to restart task")
+	s.Contains(startOutput, "Checked out existing branch "+branch)
+
+	s.git(repo, "commit", "--quiet", "--allow-empty", "-m", taskID+": add login form")
+	s.git(repo, "commit", "--quiet", "--allow-empty", "-m", "Unrelated change", "-m", "Refs: "+taskID+"0")
+
+	commitsOutput, err := s.runInDir(repo, "task", "commits", taskID)
+	s.requireSuccess(commitsOutput, err, "failed to list commits")
+	s.Contains(commitsOutput, "add login form")
+	s.NotContains(commitsOutput, "Unrelated change", "a longer task ID must not match")
+	s.Contains(commitsOutput, "Total: 1 commit(s)")
+
+	showOutput, err := s.runInDir(repo, "task", "show", taskID)
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "Commits")
+	s.Contains(showOutput, "add login form")
+}
+
+// TestTaskStartOutsideRepository tests that start fails cleanly without a git repository
+func (s *GitTestSuite) TestTaskStartOutsideRepository() {
+	trackOutput, err := s.run("track", "create", "--title", "Git Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	taskOutput, err := s.run("task", "create", "--track", s.parseID(trackOutput, "track"), "--title", "No Repo")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	output, err := s.runInDir(s.T().TempDir(), "task", "start", taskID)
+	s.requireError(err, "start outside a repository should fail")
+	s.Contains(output, "not a git repository")
+
+	showOutput, err := s.run("task", "show", taskID)
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "todo", "failed start must not change the task")
+}
+
+// TestGitHooks tests that installed hooks enforce task references and apply merge trailers
+func (s *GitTestSuite) TestGitHooks() {
+	repo := s.newRepo()
+
+	trackOutput, err := s.run("track", "create", "--title", "Hook Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	planned, err := s.run("task", "create", "--track", trackID, "--title", "Planned Task")
+	s.requireSuccess(planned, err, "failed to create task")
+	plannedID := s.parseID(planned, "task")
+	unplanned, err := s.run("task", "create", "--track", trackID, "--title", "Unplanned Task")
+	s.requireSuccess(unplanned, err, "failed to create task")
+	unplannedID := s.parseID(unplanned, "task")
+
+	iterOutput, err := s.run("iteration", "create", "--name", "Hook Iteration", "--goal", "Hooks", "--deliverable", "Hooks")
+	s.requireSuccess(iterOutput, err, "failed to create iteration")
+	iterNumber := s.parseIterationNumber(iterOutput)
+	output, err := s.run("iteration", "add-task", iterNumber, plannedID)
+	s.requireSuccess(output, err, "failed to add task to iteration")
+	output, err = s.run("iteration", "start", iterNumber)
+	s.requireSuccess(output, err, "failed to start iteration")
+
+	output, err = s.runInDir(repo, "git", "install-hooks")
+	s.requireSuccess(output, err, "failed to install hooks")
+	s.Contains(output, "commit-msg")
+	s.Contains(output, "post-merge")
+
+	// commit-msg rejects commits without a task of the current iteration
+	output, err = s.tryGit(repo, "commit", "--allow-empty", "-m", "No task here")
+	s.requireError(err, "commit without a task ID should be rejected")
+	s.Contains(output, "commit rejected")
+	s.Contains(output, plannedID)
+	_, err = s.tryGit(repo, "commit", "--allow-empty", "-m", unplannedID+": not planned")
+	s.requireError(err, "commit for a task outside the iteration should be rejected")
+
+	// Trailers are applied once the work is merged into main
+	s.git(repo, "checkout", "--quiet", "-b", "feature")
+	s.git(repo, "commit", "--quiet", "--allow-empty", "-m", plannedID+": finish", "-m", "Closes: "+plannedID+"\nReview: "+unplannedID)
+	s.git(repo, "checkout", "--quiet", "main")
+
+	showOutput, err := s.run("task", "show", plannedID)
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "todo", "trailers must not apply before the merge")
+
+	mergeOutput := s.git(repo, "merge", "--no-ff", "-m", "Merge feature", "feature")
+	s.Contains(mergeOutput, plannedID+": todo → done")
+
+	showOutput, err = s.run("task", "show", plannedID)
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "done")
+	showOutput, err = s.run("task", "show", unplannedID)
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "review")
+}
@@ -0,0 +1,90 @@
+package task_manager_e2e_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// HistoryTestSuite tests the entity history command end-to-end
+type HistoryTestSuite struct {
+	E2ETestSuite
+}
+
+func TestHistorySuite(t *testing.T) {
+	suite.Run(t, new(HistoryTestSuite))
+}
+
+// TestHistoryRecordsTaskMutations tests that task changes are recorded with field diffs
+func (s *HistoryTestSuite) TestHistoryRecordsTaskMutations() {
+	trackOutput, err := s.run("track", "create", "--title", "History Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "History Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	updateOutput, err := s.run("task", "update", taskID, "--status", "in-progress")
+	s.requireSuccess(updateOutput, err, "failed to update task")
+
+	historyOutput, err := s.run("history", taskID)
+	s.requireSuccess(historyOutput, err, "failed to show history")
+	s.Contains(historyOutput, "task-manager.task.created")
+	s.Contains(historyOutput, "task-manager.task.updated")
+	s.Contains(historyOutput, `status: "todo" → "in-progress"`)
+	s.NotContains(historyOutput, "task-manager.task.status_changed", "a status change should be recorded once, as the update")
+
+	// Filter by event type with structured output
+	jsonOutput, err := s.run("history", taskID, "--type", "task.updated", "-o", "json")
+	s.requireSuccess(jsonOutput, err, "failed to show filtered history")
+
+	var envelope struct {
+		Kind string `json:"kind"`
+		Data []struct {
+			EntityID  string `json:"entity_id"`
+			EventType string `json:"event_type"`
+			Changes   []struct {
+				Field string `json:"field"`
+				After string `json:"after"`
+			} `json:"changes"`
+		} `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(jsonOutput), &envelope), "output should be valid JSON: %s", jsonOutput)
+	s.Equal("history", envelope.Kind)
+	s.Require().Len(envelope.Data, 1)
+	s.Equal(taskID, envelope.Data[0].EntityID)
+	s.Require().Len(envelope.Data[0].Changes, 1)
+	s.Equal("in-progress", envelope.Data[0].Changes[0].After)
+
+	// Derived event types select the updates that changed the status
+	statusOutput, err := s.run("history", taskID, "--type", "task.status_changed", "-o", "json")
+	s.requireSuccess(statusOutput, err, "failed to filter history by task.status_changed")
+	s.Require().NoError(json.Unmarshal([]byte(statusOutput), &envelope), "output should be valid JSON: %s", statusOutput)
+	s.Require().Len(envelope.Data, 1, "the status change should match task.status_changed")
+	s.Equal("task-manager.task.updated", envelope.Data[0].EventType)
+
+	completedOutput, err := s.run("history", taskID, "--type", "task.completed", "-o", "json")
+	s.requireSuccess(completedOutput, err, "failed to filter history by task.completed")
+	s.Require().NoError(json.Unmarshal([]byte(completedOutput), &envelope), "output should be valid JSON: %s", completedOutput)
+	s.Empty(envelope.Data, "the task has not been completed")
+}
+
+// TestHistorySince tests filtering history by date
+func (s *HistoryTestSuite) TestHistorySince() {
+	trackOutput, err := s.run("track", "create", "--title", "Since Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	output, err := s.run("history", "--since", "2000-01-01")
+	s.requireSuccess(output, err, "failed to show history since date")
+	s.Contains(output, trackID)
+
+	output, err = s.run("history", "--since", "2999-01-01")
+	s.requireSuccess(output, err, "failed to show history for future date")
+	s.Contains(output, "No history found")
+
+	output, err = s.run("history", "--since", "yesterday")
+	s.requireError(err, "invalid --since should fail")
+}
@@ -0,0 +1,69 @@
+package task_manager_e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// HooksTestSuite tests project hook scripts end-to-end
+type HooksTestSuite struct {
+	E2ETestSuite
+}
+
+func TestHooksSuite(t *testing.T) {
+	suite.Run(t, new(HooksTestSuite))
+}
+
+// writeHook installs an executable script in the suite project's hooks directory
+func (s *HooksTestSuite) writeHook(name, script string) {
+	hooksDir := filepath.Join(s.testWorkingDir, "projects", s.projectName, "hooks")
+	s.Require().NoError(os.MkdirAll(hooksDir, 0755))
+	path := filepath.Join(hooksDir, name)
+	s.Require().NoError(os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0755))
+	s.T().Cleanup(func() { os.Remove(path) })
+}
+
+// TestPreHookVetoesACFailure tests that a failing pre-hook blocks the transition
+func (s *HooksTestSuite) TestPreHookVetoesACFailure() {
+	trackOutput, err := s.run("track", "create", "--title", "Hook Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	taskOutput, err := s.run("task", "create", "--track", s.parseID(trackOutput, "track"), "--title", "Hook Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	acOutput, err := s.run("ac", "add", s.parseID(taskOutput, "task"), "--description", "Hooked AC", "--testing-instructions", "Test")
+	s.requireSuccess(acOutput, err, "failed to add AC")
+	acID := s.parseID(acOutput, "ac")
+
+	s.writeHook("pre-ac.failed.sh", `echo "failures are frozen" >&2; exit 1`)
+
+	output, err := s.run("ac", "fail", acID, "--feedback", "Broken")
+	s.requireError(err, "pre-hook should veto the failure")
+	s.Contains(output, "failures are frozen")
+
+	showOutput, err := s.run("ac", "show", acID)
+	s.requireSuccess(showOutput, err, "failed to show AC")
+	s.NotContains(showOutput, "Broken", "vetoed transition must not be persisted")
+}
+
+// TestPostHookReceivesEntityJSON tests that post-hooks observe transitions with the entity on stdin
+func (s *HooksTestSuite) TestPostHookReceivesEntityJSON() {
+	trackOutput, err := s.run("track", "create", "--title", "Post Hook Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	taskOutput, err := s.run("task", "create", "--track", s.parseID(trackOutput, "track"), "--title", "Post Hook Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	captured := filepath.Join(s.T().TempDir(), "captured.json")
+	s.writeHook("post-task.status_changed", `cat > "`+captured+`"; exit 1`)
+
+	// A failing post-hook does not undo the transition
+	output, err := s.run("task", "update", taskID, "--status", "in-progress")
+	s.requireSuccess(output, err, "post-hook failures must not fail the command")
+
+	data, err := os.ReadFile(captured)
+	s.Require().NoError(err, "post-hook should have run")
+	s.Contains(string(data), `"id":"`+taskID+`"`)
+	s.Contains(string(data), `"status":"in-progress"`)
+}
@@ -0,0 +1,70 @@
+package task_manager_e2e_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// IterationPlanTestSuite tests proposing iteration scope end-to-end
+// This suite runs in its own project because the proposal draws on the whole backlog
+type IterationPlanTestSuite struct {
+	E2ETestSuite
+}
+
+func TestIterationPlanSuite(t *testing.T) {
+	suite.Run(t, new(IterationPlanTestSuite))
+}
+
+// TestIterationPlan tests the proposal diff, skipped tasks and applying the proposal
+func (s *IterationPlanTestSuite) TestIterationPlan() {
+	output, err := s.run("track", "create", "--title", "Scope Track")
+	s.requireSuccess(output, err, "failed to create track")
+	trackID := s.parseID(output, "-track-")
+	output, err = s.run("track", "create", "--title", "Stuck Track")
+	s.requireSuccess(output, err, "failed to create blocked track")
+	blockedID := s.parseID(output, "-track-")
+	output, err = s.run("track", "update", blockedID, "--status", "blocked")
+	s.requireSuccess(output, err, "failed to block track")
+
+	var taskIDs []string
+	for _, title := range []string{"First Scope Task", "Second Scope Task", "Third Scope Task"} {
+		output, err = s.run("task", "create", "--track", trackID, "--title", title)
+		s.requireSuccess(output, err, "failed to create task")
+		taskIDs = append(taskIDs, s.parseID(output, "-task-"))
+	}
+	output, err = s.run("task", "create", "--track", blockedID, "--title", "Stuck Task")
+	s.requireSuccess(output, err, "failed to create task on blocked track")
+	stuckID := s.parseID(output, "-task-")
+
+	output, err = s.run("iteration", "create", "--name", "Planned Sprint", "--goal", "Scope", "--deliverable", "Plan")
+	s.requireSuccess(output, err, "failed to create iteration")
+	output, err = s.run("iteration", "add-task", "1", taskIDs[0])
+	s.requireSuccess(output, err, "failed to add task")
+
+	output, err = s.run("iteration", "plan", "1", "--capacity", "2")
+	s.requireSuccess(output, err, "plan should succeed")
+	s.Contains(output, "  "+taskIDs[0])
+	s.Contains(output, "+ "+taskIDs[1])
+	s.NotContains(output, "+ "+taskIDs[2], "capacity counts the task already in the iteration")
+	s.Contains(output, "track "+blockedID+" is blocked")
+	s.Contains(output, "1 backlog task(s) did not fit")
+
+	output, err = s.run("iteration", "show", "1")
+	s.requireSuccess(output, err, "failed to show iteration")
+	s.NotContains(output, taskIDs[1], "planning without --apply changes nothing")
+
+	output, err = s.run("iteration", "plan", "1", "--capacity", "5", "--apply", "-o", "json")
+	s.requireSuccess(output, err, "applying the plan should succeed")
+	s.Contains(output, `"kind": "iteration_plan"`)
+	s.Contains(output, `"applied": true`)
+
+	output, err = s.run("iteration", "show", "1")
+	s.requireSuccess(output, err, "failed to show iteration")
+	s.Contains(output, taskIDs[1])
+	s.Contains(output, taskIDs[2])
+	s.NotContains(output, stuckID)
+
+	output, err = s.run("iteration", "plan", "1", "--capacity", "0")
+	s.requireError(err, "capacity must be positive")
+}
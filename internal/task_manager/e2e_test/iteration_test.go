@@ -1,6 +1,8 @@
 package task_manager_e2e_test
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -18,6 +20,12 @@ type IterationWorkflowTestSuite struct {
 	E2ETestSuite
 }
 
+// IterationValidationTestSuite tests the iteration completion gate
+// This suite runs in its own project because it needs to start an iteration
+type IterationValidationTestSuite struct {
+	E2ETestSuite
+}
+
 // TestIterationSuite runs the IterationTestSuite
 func TestIterationSuite(t *testing.T) {
 	suite.Run(t, new(IterationTestSuite))
@@ -28,6 +36,11 @@ func TestIterationWorkflowSuite(t *testing.T) {
 	suite.Run(t, new(IterationWorkflowTestSuite))
 }
 
+// TestIterationValidationSuite runs the IterationValidationTestSuite
+func TestIterationValidationSuite(t *testing.T) {
+	suite.Run(t, new(IterationValidationTestSuite))
+}
+
 // TestIterationCreate tests iteration creation with required flags
 func (s *IterationTestSuite) TestIterationCreate() {
 	output, err := s.run("iteration", "create",
@@ -196,6 +209,53 @@ func (s *IterationTestSuite) TestIterationCurrent() {
 	s.Contains(output, "Current Sprint", "output should contain current iteration name")
 }
 
+// TestIterationCapacity tests task estimates adding up against the iteration capacity
+func (s *IterationTestSuite) TestIterationCapacity() {
+	output, err := s.run("track", "create", "--title", "Capacity Track")
+	s.requireSuccess(output, err, "failed to create track")
+	trackID := s.parseID(output, "-track-")
+
+	output, err = s.run("task", "create", "--track", trackID, "--title", "Sized Task", "--estimate", "5")
+	s.requireSuccess(output, err, "task create with estimate should succeed")
+	s.Contains(output, "Estimate:    5")
+	sizedID := s.parseID(output, "-task-")
+	output, err = s.run("task", "create", "--track", trackID, "--title", "Finished Task", "--estimate", "2.5")
+	s.requireSuccess(output, err, "failed to create task")
+	finishedID := s.parseID(output, "-task-")
+	output, err = s.run("task", "update", finishedID, "--status", "done")
+	s.requireSuccess(output, err, "failed to finish task")
+	output, err = s.run("task", "create", "--track", trackID, "--title", "Unsized Task")
+	s.requireSuccess(output, err, "failed to create task")
+	unsizedID := s.parseID(output, "-task-")
+
+	output, err = s.run("iteration", "create", "--name", "Sized Sprint", "--goal", "Capacity", "--deliverable", "Totals", "--capacity", "6")
+	s.requireSuccess(output, err, "iteration create with capacity should succeed")
+	s.Contains(output, "Capacity:    6")
+	iterNumber := s.parseIterationNumber(output)
+	output, err = s.run("iteration", "add-task", iterNumber, sizedID, finishedID, unsizedID)
+	s.requireSuccess(output, err, "failed to add tasks")
+
+	output, err = s.run("iteration", "show", iterNumber)
+	s.requireSuccess(output, err, "iteration show should succeed")
+	s.Contains(output, "Committed:   7.5 (1 task(s) without estimate)")
+	s.Contains(output, "Remaining:   5")
+	s.Contains(output, "exceeds capacity by 1.5")
+
+	// Raising the capacity and clearing an estimate update the totals
+	output, err = s.run("iteration", "update", iterNumber, "--capacity", "10")
+	s.requireSuccess(output, err, "iteration update with capacity should succeed")
+	output, err = s.run("task", "update", sizedID, "--estimate", "0")
+	s.requireSuccess(output, err, "clearing the estimate should succeed")
+	output, err = s.run("iteration", "show", iterNumber, "-o", "json")
+	s.requireSuccess(output, err, "iteration show should succeed")
+	s.Contains(output, `"committed": 2.5`)
+	s.Contains(output, `"unestimated": 2`)
+	s.NotContains(output, "exceeds capacity")
+
+	output, err = s.run("task", "update", sizedID, "--estimate", "-1")
+	s.requireError(err, "negative estimates are rejected")
+}
+
 // TestIterationUpdate tests updating iteration fields
 func (s *IterationTestSuite) TestIterationUpdate() {
 	// Create an iteration
@@ -409,3 +469,76 @@ func (s *IterationWorkflowTestSuite) TestIterationCurrentWithFullShowsACs() {
 		strings.Contains(currentOutput, "○") || strings.Contains(currentOutput, "✓"),
 		"should show status indicators for ACs")
 }
+
+// TestIterationValidateAndComplete tests that open work blocks completion unless forced or allowed by policy
+func (s *IterationValidationTestSuite) TestIterationValidateAndComplete() {
+	trackOutput, err := s.run("track", "create", "--title", "Gate Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	taskOutput, err := s.run("task", "create", "--track", s.parseID(trackOutput, "track"), "--title", "Open Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	iterOutput, err := s.run("iteration", "create", "--name", "Gate Sprint", "--goal", "Gate", "--deliverable", "Gate")
+	s.requireSuccess(iterOutput, err, "failed to create iteration")
+	iterNumber := s.parseIterationNumber(iterOutput)
+
+	output, err := s.run("iteration", "add-task", iterNumber, taskID)
+	s.requireSuccess(output, err, "failed to add task to iteration")
+	output, err = s.run("iteration", "start", iterNumber)
+	s.requireSuccess(output, err, "failed to start iteration")
+
+	// The todo task blocks completion
+	output, err = s.run("iteration", "validate", iterNumber)
+	s.requireError(err, "validate should fail while a task is open")
+	s.Contains(output, "unfinished_tasks")
+	s.Contains(output, taskID)
+
+	output, err = s.run("iteration", "complete", iterNumber)
+	s.requireError(err, "complete should be refused while a task is open")
+	s.Contains(output, "blocking problem")
+
+	// A project policy can downgrade the check to a warning
+	policyPath := filepath.Join(s.testWorkingDir, "projects", s.projectName, "policy.yaml")
+	s.Require().NoError(os.WriteFile(policyPath, []byte("iteration_completion:\n  unfinished_tasks: warning\n"), 0644))
+
+	output, err = s.run("iteration", "validate", iterNumber)
+	s.requireSuccess(output, err, "validate should pass once the check is a warning")
+	s.Contains(output, "Warnings")
+	s.Contains(output, "can be completed")
+
+	// Restore the default policy; --force still completes
+	s.Require().NoError(os.Remove(policyPath))
+	output, err = s.run("iteration", "complete", iterNumber, "--force")
+	s.requireSuccess(output, err, "forced completion should succeed")
+	s.Contains(output, "completed successfully")
+}
+
+// TestIterationReport tests generating an iteration report and saving it as a retrospective
+func (s *IterationTestSuite) TestIterationReport() {
+	trackOutput, err := s.run("track", "create", "--title", "Report Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	taskOutput, err := s.run("task", "create", "--track", s.parseID(trackOutput, "track"), "--title", "Reported Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	iterOutput, err := s.run("iteration", "create", "--name", "Report Sprint", "--goal", "Report", "--deliverable", "Report")
+	s.requireSuccess(iterOutput, err, "failed to create iteration")
+	iterNumber := s.parseIterationNumber(iterOutput)
+	output, err := s.run("iteration", "add-task", iterNumber, taskID)
+	s.requireSuccess(output, err, "failed to add task to iteration")
+
+	output, err = s.run("iteration", "report", iterNumber)
+	s.requireSuccess(output, err, "report should succeed")
+	s.Contains(output, "# Iteration "+iterNumber+" Report: Report Sprint")
+	s.Contains(output, "### todo (1)")
+	s.Contains(output, taskID+": Reported Task")
+	s.NotContains(output, "Saved as retrospective document")
+
+	output, err = s.run("iteration", "report", iterNumber, "--save")
+	s.requireSuccess(output, err, "report --save should succeed")
+	s.Contains(output, "Saved as retrospective document")
+
+	output, err = s.run("doc", "list", "--iteration", iterNumber, "--type", "retrospective")
+	s.requireSuccess(output, err, "doc list should succeed")
+	s.Contains(output, "Iteration "+iterNumber+" Retrospective")
+}
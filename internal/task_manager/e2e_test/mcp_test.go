@@ -0,0 +1,120 @@
+package task_manager_e2e_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// McpTestSuite tests the MCP server run by tm mcp
+type McpTestSuite struct {
+	E2ETestSuite
+}
+
+func TestMcpSuite(t *testing.T) {
+	suite.Run(t, new(McpTestSuite))
+}
+
+// mcpResponse is a decoded JSON-RPC response
+type mcpResponse struct {
+	ID     int             `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
+
+// session pipes JSON-RPC messages through tm mcp and returns its responses by ID
+func (s *McpTestSuite) session(messages ...string) map[int]mcpResponse {
+	cmd := exec.Command(tmBinaryPath, "mcp")
+	cmd.Env = append(os.Environ(), "TM_WORKING_DIR="+s.testWorkingDir)
+	cmd.Stdin = strings.NewReader(strings.Join(messages, "\n") + "\n")
+	stdout, err := cmd.StdoutPipe()
+	s.Require().NoError(err)
+	s.Require().NoError(cmd.Start())
+
+	responses := map[int]mcpResponse{}
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var resp mcpResponse
+		s.Require().NoError(json.Unmarshal(scanner.Bytes(), &resp), "stdout must only carry protocol messages: %s", scanner.Text())
+		responses[resp.ID] = resp
+	}
+	s.Require().NoError(scanner.Err())
+	s.Require().NoError(cmd.Wait(), "server should exit when stdin closes")
+	return responses
+}
+
+// toolCall returns a tools/call request
+func toolCall(id int, name, arguments string) string {
+	return `{"jsonrpc":"2.0","id":` + strconv.Itoa(id) + `,"method":"tools/call","params":{"name":"` + name + `","arguments":` + arguments + `}}`
+}
+
+// envelope decodes the structured content of a tool result
+func (s *McpTestSuite) envelope(resp mcpResponse) (bool, map[string]interface{}) {
+	s.Require().Nil(resp.Error)
+	var result struct {
+		IsError           bool                   `json:"isError"`
+		StructuredContent map[string]interface{} `json:"structuredContent"`
+	}
+	s.Require().NoError(json.Unmarshal(resp.Result, &result))
+	return result.IsError, result.StructuredContent
+}
+
+// TestMcp tests an agent session through the MCP tools and that the CLI sees its changes
+func (s *McpTestSuite) TestMcp() {
+	output, err := s.run("track", "create", "--title", "MCP Track")
+	s.requireSuccess(output, err, "failed to create track")
+	trackID := s.parseID(output, "-track-")
+	output, err = s.run("task", "create", "--track", trackID, "--title", "MCP Task")
+	s.requireSuccess(output, err, "failed to create task")
+	taskID := s.parseID(output, "-task-")
+
+	responses := s.session(
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-06-18","capabilities":{},"clientInfo":{"name":"e2e","version":"1"}}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		toolCall(2, "task_update_status", `{"id":"`+taskID+`","status":"in-progress"}`),
+		toolCall(3, "ac_add", `{"task_id":"`+taskID+`","description":"Works over MCP"}`),
+		toolCall(4, "doc_create", `{"title":"MCP Plan","type":"plan","content":"# Plan","track_id":"`+trackID+`"}`),
+		toolCall(5, "task_show", `{"id":"MISSING-task-1"}`),
+		`{"jsonrpc":"2.0","id":6,"method":"resources/read","params":{"uri":"tm://roadmap"}}`,
+		`{"jsonrpc":"2.0","id":7,"method":"prompts/get","params":{"name":"tm_system_prompt"}}`,
+	)
+	s.Require().Len(responses, 7)
+
+	isError, envelope := s.envelope(responses[2])
+	s.False(isError)
+	s.Equal("task", envelope["kind"])
+	s.Equal("in-progress", envelope["data"].(map[string]interface{})["status"])
+
+	isError, envelope = s.envelope(responses[3])
+	s.False(isError)
+	s.Equal("ac", envelope["kind"])
+
+	isError, envelope = s.envelope(responses[4])
+	s.False(isError)
+	s.Equal("document", envelope["kind"])
+
+	isError, envelope = s.envelope(responses[5])
+	s.True(isError)
+	s.Equal("not_found", envelope["error"].(map[string]interface{})["code"])
+
+	s.Nil(responses[6].Error)
+	s.Contains(string(responses[6].Result), "tm://roadmap")
+	s.Nil(responses[7].Error)
+	s.Contains(string(responses[7].Result), "Task Manager")
+
+	output, err = s.run("task", "show", taskID)
+	s.requireSuccess(output, err, "the CLI should see changes made through MCP")
+	s.Contains(output, "in-progress")
+	output, err = s.run("ac", "list", taskID)
+	s.requireSuccess(output, err, "failed to list acceptance criteria")
+	s.Contains(output, "Works over MCP")
+}
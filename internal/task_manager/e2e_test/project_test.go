@@ -1,6 +1,7 @@
 package task_manager_e2e_test
 
 import (
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -245,6 +246,40 @@ func (s *ProjectTestSuite) TestProjectParallelCreation() {
 	}
 }
 
+// TestProjectSelectionPrecedence tests that --project beats TM_PROJECT, which beats the active project
+func (s *ProjectTestSuite) TestProjectSelectionPrecedence() {
+	for _, name := range []string{"active-proj", "env-proj", "flag-proj"} {
+		output, err := s.run("project", "create", name)
+		s.requireSuccess(output, err, "failed to create project %s", name)
+		output, err = s.run("--project", name, "roadmap", "init", "--vision", name+" vision", "--success-criteria", "done")
+		s.requireSuccess(output, err, "failed to init roadmap in %s", name)
+	}
+	output, err := s.run("project", "switch", "active-proj")
+	s.requireSuccess(output, err, "failed to switch project")
+
+	output, err = s.run("roadmap", "show")
+	s.requireSuccess(output, err, "failed to show roadmap")
+	s.Contains(output, "active-proj vision")
+
+	output, err = s.runWithEnv([]string{"TM_PROJECT=env-proj"}, "roadmap", "show")
+	s.requireSuccess(output, err, "failed to show roadmap with TM_PROJECT")
+	s.Contains(output, "env-proj vision")
+
+	output, err = s.runWithEnv([]string{"TM_PROJECT=env-proj"}, "roadmap", "show", "--project", "flag-proj")
+	s.requireSuccess(output, err, "failed to show roadmap with --project")
+	s.Contains(output, "flag-proj vision")
+
+	// Selecting a project never rewrites the active project
+	output, err = s.run("project", "list")
+	s.requireSuccess(output, err, "failed to list projects")
+	s.Contains(output, "Active: active-proj")
+
+	// Unknown projects are rejected rather than silently created
+	output, err = s.run("--project", "no-such-proj", "task", "list")
+	s.requireError(err, "unknown --project should fail")
+	s.Contains(output, "no-such-proj")
+}
+
 // TestProjectCommandFlow tests a complete workflow: create -> list -> switch -> show -> delete
 func (s *ProjectTestSuite) TestProjectCommandFlow() {
 	// Create a project
@@ -278,3 +313,52 @@ func (s *ProjectTestSuite) TestProjectCommandFlow() {
 	s.NoError(err, "project list should succeed\nOutput:\n%s", output)
 	s.NotContains(output, "workflow-test", "deleted project should not be in list")
 }
+
+// TestProjectExportImport tests exporting a project and importing it next to the original
+func (s *ProjectTestSuite) TestProjectExportImport() {
+	output, err := s.run("project", "create", "source-proj")
+	s.requireSuccess(output, err, "failed to create project")
+	s.run("project", "switch", "source-proj")
+	output, err = s.run("roadmap", "init", "--vision", "Export vision", "--success-criteria", "done")
+	s.requireSuccess(output, err, "failed to init roadmap")
+	output, err = s.run("track", "create", "--title", "Core", "--description", "core work")
+	s.requireSuccess(output, err, "failed to create track")
+	trackID := s.parseID(output, "track")
+	output, err = s.run("task", "create", "--track", trackID, "--title", "First")
+	s.requireSuccess(output, err, "failed to create task")
+	firstID := s.parseID(output, "task")
+	output, err = s.run("task", "create", "--track", trackID, "--title", "Second")
+	s.requireSuccess(output, err, "failed to create task")
+	secondID := s.parseID(output, "task")
+	output, err = s.run("task", "block", secondID, firstID)
+	s.requireSuccess(output, err, "failed to block task")
+
+	file := filepath.Join(s.testWorkingDir, "source.yaml")
+	output, err = s.run("project", "export", "source-proj", "--format", "yaml", "--file", file)
+	s.requireSuccess(output, err, "failed to export project")
+	s.Contains(output, "Tasks: 2")
+
+	// The copy would reuse the source's IDs, so its code must be remapped
+	output, err = s.run("project", "import", file, "--as", "copy-proj")
+	s.requireError(err, "import with a colliding project code should fail")
+	s.Contains(output, "is already used by project")
+
+	output, err = s.run("project", "import", file, "--as", "copy-proj", "--code", "CP", "--dry-run")
+	s.requireSuccess(output, err, "dry run should succeed")
+	s.Contains(output, "nothing was imported")
+	output, err = s.run("project", "list")
+	s.requireSuccess(output, err, "failed to list projects")
+	s.NotContains(output, "copy-proj", "dry run should not create the project")
+
+	output, err = s.run("project", "import", file, "--as", "copy-proj", "--code", "CP")
+	s.requireSuccess(output, err, "failed to import project")
+	s.Contains(output, "IDs remapped")
+
+	output, err = s.run("--project", "copy-proj", "task", "show", "CP-task-2")
+	s.requireSuccess(output, err, "remapped task should exist")
+	s.Contains(output, "Second")
+	s.Contains(output, "CP-task-1", "blocker should be remapped")
+	output, err = s.run("--project", "copy-proj", "roadmap", "show")
+	s.requireSuccess(output, err, "failed to show imported roadmap")
+	s.Contains(output, "Export vision")
+}
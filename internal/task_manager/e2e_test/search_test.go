@@ -0,0 +1,83 @@
+package task_manager_e2e_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// SearchTestSuite tests full-text search end-to-end
+type SearchTestSuite struct {
+	E2ETestSuite
+}
+
+func TestSearchSuite(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
+
+// TestSearchAcrossEntities tests that tasks, ACs, ADRs and documents are found and kept up to date
+func (s *SearchTestSuite) TestSearchAcrossEntities() {
+	trackOutput, err := s.run("track", "create", "--title", "Search Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Kerberos login", "--description", "Authenticate against the corporate realm")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	acOutput, err := s.run("ac", "add", taskID, "--description", "Ticket is renewed", "--testing-instructions", "Wait for the kerberos ticket to expire")
+	s.requireSuccess(acOutput, err, "failed to add AC")
+
+	adrOutput, err := s.run("adr", "create", trackID,
+		"--title", "Single sign-on",
+		"--context", "Users already have domain accounts",
+		"--decision", "Use Kerberos tickets",
+		"--consequences", "Requires a domain controller")
+	s.requireSuccess(adrOutput, err, "failed to create ADR")
+
+	docOutput, err := s.run("doc", "create", "--title", "Rollout", "--type", "plan", "--content", "Enable kerberos per team")
+	s.requireSuccess(docOutput, err, "failed to create document")
+
+	searchOutput, err := s.run("search", "kerberos")
+	s.requireSuccess(searchOutput, err, "failed to search")
+	s.Contains(searchOutput, taskID)
+	s.Contains(searchOutput, "Ticket is renewed")
+	s.Contains(searchOutput, "Single sign-on")
+	s.Contains(searchOutput, "Rollout")
+	s.Contains(searchOutput, "**kerberos**")
+	s.Contains(searchOutput, "Total: 4 hit(s)")
+
+	// Type filter with structured output
+	jsonOutput, err := s.run("search", "kerb*", "--type", "task,adr", "-o", "json")
+	s.requireSuccess(jsonOutput, err, "failed to search with type filter")
+	var envelope struct {
+		Kind string `json:"kind"`
+		Data []struct {
+			EntityType string `json:"entity_type"`
+			EntityID   string `json:"entity_id"`
+		} `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(jsonOutput), &envelope), "output should be valid JSON: %s", jsonOutput)
+	s.Equal("search_hit_list", envelope.Kind)
+	s.Require().Len(envelope.Data, 2)
+	s.Equal("task", envelope.Data[0].EntityType, "title match should rank first")
+	s.Equal(taskID, envelope.Data[0].EntityID)
+
+	// Updates are reflected immediately
+	updateOutput, err := s.run("task", "update", taskID, "--title", "SAML login", "--description", "Federated")
+	s.requireSuccess(updateOutput, err, "failed to update task")
+	searchOutput, err = s.run("search", "kerberos", "--type", "task")
+	s.requireSuccess(searchOutput, err, "failed to search after update")
+	s.Contains(searchOutput, "No results found")
+	searchOutput, err = s.run("search", "saml")
+	s.requireSuccess(searchOutput, err, "failed to search for new title")
+	s.Contains(searchOutput, taskID)
+}
+
+// TestSearchInvalidInput tests that unknown types are rejected
+func (s *SearchTestSuite) TestSearchInvalidInput() {
+	output, err := s.run("search", "anything", "--type", "iteration")
+	s.requireError(err, "unknown search type should fail")
+	s.Contains(output, "invalid search type")
+}
@@ -0,0 +1,140 @@
+package task_manager_e2e_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ServeTestSuite tests the REST/JSON API served by tm serve
+type ServeTestSuite struct {
+	E2ETestSuite
+	token string // Session token printed by the running server
+}
+
+func TestServeSuite(t *testing.T) {
+	suite.Run(t, new(ServeTestSuite))
+}
+
+// apiResponse is a decoded API envelope
+type apiResponse struct {
+	Kind  string                 `json:"kind"`
+	Data  map[string]interface{} `json:"data"`
+	Error *struct {
+		Code string `json:"code"`
+	} `json:"error"`
+}
+
+// startServer runs tm serve on a free port and returns the API base URL and a stop function.
+// The session token the server prints is kept for call.
+func (s *ServeTestSuite) startServer() (string, func()) {
+	cmd := exec.Command(tmBinaryPath, "serve", "--addr", "127.0.0.1:0")
+	cmd.Env = append(os.Environ(), "TM_WORKING_DIR="+s.testWorkingDir)
+	stdout, err := cmd.StdoutPipe()
+	s.Require().NoError(err)
+	s.Require().NoError(cmd.Start())
+
+	reader := bufio.NewReader(stdout)
+	line, err := reader.ReadString('\n')
+	s.Require().NoError(err, "server should announce its address")
+	s.Require().Contains(line, "Serving tm API on ")
+	baseURL := strings.TrimSpace(strings.TrimPrefix(line, "Serving tm API on "))
+
+	for !strings.HasPrefix(line, "Token: ") {
+		line, err = reader.ReadString('\n')
+		s.Require().NoError(err, "server should print its token")
+	}
+	s.token = strings.Fields(line)[1]
+
+	return baseURL, func() {
+		_ = cmd.Process.Signal(os.Interrupt)
+		s.NoError(cmd.Wait(), "server should shut down cleanly on interrupt")
+	}
+}
+
+// call sends a JSON request and decodes the response envelope
+func (s *ServeTestSuite) call(method, url, body string) (int, *apiResponse) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	s.Require().NoError(err)
+	req.Header.Set("Authorization", "Bearer "+s.token)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+	if len(data) == 0 {
+		return resp.StatusCode, nil
+	}
+	var envelope apiResponse
+	s.Require().NoError(json.Unmarshal(data, &envelope), string(data))
+	return resp.StatusCode, &envelope
+}
+
+// TestServe tests a planning session through the API and that the CLI sees its changes
+func (s *ServeTestSuite) TestServe() {
+	output, err := s.run("track", "create", "--title", "API Track")
+	s.requireSuccess(output, err, "failed to create track")
+	trackID := s.parseID(output, "-track-")
+
+	baseURL, stop := s.startServer()
+	defer stop()
+
+	code, resp := s.call(http.MethodPost, baseURL+"/tasks", `{"track_id":"`+trackID+`","title":"API Task","estimate":2}`)
+	s.Require().Equal(http.StatusCreated, code)
+	s.Equal("task", resp.Kind)
+	taskID := resp.Data["id"].(string)
+
+	code, resp = s.call(http.MethodPost, baseURL+"/acs", `{"task_id":"`+taskID+`","description":"Works over HTTP"}`)
+	s.Require().Equal(http.StatusCreated, code)
+	acID := resp.Data["id"].(string)
+
+	code, resp = s.call(http.MethodPost, baseURL+"/iterations", `{"name":"API Sprint","goal":"Serve","deliverable":"API"}`)
+	s.Require().Equal(http.StatusCreated, code)
+	s.Equal("iteration", resp.Kind)
+	number := resp.Data["iteration"].(map[string]interface{})["number"].(float64)
+	iterationURL := baseURL + "/iterations/" + strconv.Itoa(int(number))
+
+	code, _ = s.call(http.MethodPost, iterationURL+"/tasks", `{"task_ids":["`+taskID+`"]}`)
+	s.Require().Equal(http.StatusOK, code)
+	code, resp = s.call(http.MethodPost, iterationURL+"/start", "")
+	s.Require().Equal(http.StatusOK, code)
+	s.Equal("current", resp.Data["iteration"].(map[string]interface{})["status"])
+
+	code, resp = s.call(http.MethodPost, baseURL+"/acs/"+acID+"/verify", "")
+	s.Require().Equal(http.StatusOK, code)
+	s.Equal("verified", resp.Data["status"])
+	code, resp = s.call(http.MethodPatch, baseURL+"/tasks/"+taskID, `{"status":"done"}`)
+	s.Require().Equal(http.StatusOK, code)
+	s.Equal("done", resp.Data["status"])
+
+	code, resp = s.call(http.MethodPost, iterationURL+"/complete", "")
+	s.Require().Equal(http.StatusOK, code)
+	s.Equal("complete", resp.Data["iteration"].(map[string]interface{})["status"])
+
+	code, resp = s.call(http.MethodGet, baseURL+"/tasks/"+acID, "")
+	s.Equal(http.StatusNotFound, code)
+	s.Equal("not_found", resp.Error.Code)
+
+	// Requests without the session token are refused
+	unauthorized, err := http.Post(baseURL+"/tasks", "application/json", strings.NewReader(`{"title":"Anonymous"}`))
+	s.Require().NoError(err)
+	unauthorized.Body.Close()
+	s.Equal(http.StatusUnauthorized, unauthorized.StatusCode)
+
+	output, err = s.run("task", "show", taskID)
+	s.requireSuccess(output, err, "the CLI should see tasks created through the API")
+	s.Contains(output, "API Task")
+	s.Contains(output, "done")
+}
@@ -0,0 +1,106 @@
+package task_manager_e2e_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// StatsTestSuite tests the metrics command end-to-end
+// This suite runs in its own project because it needs to start an iteration
+type StatsTestSuite struct {
+	E2ETestSuite
+}
+
+func TestStatsSuite(t *testing.T) {
+	suite.Run(t, new(StatsTestSuite))
+}
+
+// TestStatsAfterTaskTransitions tests that status changes show up in velocity, burndown, cycle time and throughput
+func (s *StatsTestSuite) TestStatsAfterTaskTransitions() {
+	trackOutput, err := s.run("track", "create", "--title", "Stats Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	var taskIDs []string
+	for _, title := range []string{"Finished Task", "Open Task"} {
+		taskOutput, err := s.run("task", "create", "--track", trackID, "--title", title, "--rank", "100")
+		s.requireSuccess(taskOutput, err, "failed to create task")
+		taskIDs = append(taskIDs, s.parseID(taskOutput, "task"))
+	}
+
+	// Before any iteration starts there is nothing to burn down
+	output, err := s.run("stats")
+	s.requireSuccess(output, err, "stats should succeed on a fresh project")
+	s.Contains(output, "No started iterations")
+	s.Contains(output, "No iteration in progress")
+	s.Contains(output, "No tasks done yet")
+
+	iterOutput, err := s.run("iteration", "create", "--name", "Stats Sprint", "--goal", "Stats", "--deliverable", "Stats")
+	s.requireSuccess(iterOutput, err, "failed to create iteration")
+	iterNumber := s.parseIterationNumber(iterOutput)
+	output, err = s.run("iteration", "add-task", iterNumber, taskIDs[0], taskIDs[1])
+	s.requireSuccess(output, err, "failed to add tasks to iteration")
+	output, err = s.run("iteration", "start", iterNumber)
+	s.requireSuccess(output, err, "failed to start iteration")
+
+	for _, status := range []string{"in-progress", "done"} {
+		output, err = s.run("task", "update", taskIDs[0], "--status", status)
+		s.requireSuccess(output, err, "failed to update task")
+	}
+
+	output, err = s.run("stats")
+	s.requireSuccess(output, err, "stats should succeed")
+	s.Contains(output, "Stats Sprint")
+	s.Contains(output, "1/2")
+	s.Contains(output, "Burndown: Iteration "+iterNumber+" - Stats Sprint")
+	s.Contains(output, taskIDs[0])
+	s.Contains(output, "Stats Track")
+
+	// Structured output carries the full series
+	jsonOutput, err := s.run("stats", "--weeks", "4", "-o", "json")
+	s.requireSuccess(jsonOutput, err, "stats -o json should succeed")
+
+	var envelope struct {
+		Kind string `json:"kind"`
+		Data struct {
+			Velocity []struct {
+				CompletedTasks int `json:"completed_tasks"`
+				PlannedTasks   int `json:"planned_tasks"`
+			} `json:"velocity"`
+			Burndown struct {
+				Points []struct {
+					Remaining int `json:"remaining"`
+				} `json:"points"`
+			} `json:"burndown"`
+			CycleTimes []struct {
+				TaskID string `json:"task_id"`
+			} `json:"cycle_times"`
+			Throughput []struct {
+				TrackID string `json:"track_id"`
+				Weekly  []int  `json:"weekly"`
+				Total   int    `json:"total"`
+			} `json:"throughput"`
+			Weeks int `json:"weeks"`
+		} `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(jsonOutput), &envelope), "output should be valid JSON: %s", jsonOutput)
+	s.Equal("project_metrics", envelope.Kind)
+	s.Require().Len(envelope.Data.Velocity, 1)
+	s.Equal(1, envelope.Data.Velocity[0].CompletedTasks)
+	s.Equal(2, envelope.Data.Velocity[0].PlannedTasks)
+	s.Require().NotEmpty(envelope.Data.Burndown.Points)
+	s.Equal(1, envelope.Data.Burndown.Points[len(envelope.Data.Burndown.Points)-1].Remaining)
+	s.Require().Len(envelope.Data.CycleTimes, 1)
+	s.Equal(taskIDs[0], envelope.Data.CycleTimes[0].TaskID)
+	s.Equal(4, envelope.Data.Weeks)
+	s.Require().Len(envelope.Data.Throughput, 1)
+	s.Equal(trackID, envelope.Data.Throughput[0].TrackID)
+	s.Len(envelope.Data.Throughput[0].Weekly, 4)
+	s.Equal(1, envelope.Data.Throughput[0].Total)
+
+	// A burndown for an unknown iteration is an error
+	_, err = s.run("stats", "--iteration", "99")
+	s.requireError(err, "stats should fail for an unknown iteration")
+}
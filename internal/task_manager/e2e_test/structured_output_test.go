@@ -0,0 +1,100 @@
+package task_manager_e2e_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// StructuredOutputTestSuite tests that commands changing data report their result as an envelope
+type StructuredOutputTestSuite struct {
+	E2ETestSuite
+}
+
+func TestStructuredOutputSuite(t *testing.T) {
+	suite.Run(t, new(StructuredOutputTestSuite))
+}
+
+// entityEnvelope is the envelope of a command whose data is a single entity
+type entityEnvelope struct {
+	SchemaVersion string `json:"schema_version"`
+	Kind          string `json:"kind"`
+	Data          struct {
+		ID      string `json:"id"`
+		TrackID string `json:"track_id"`
+		Title   string `json:"title"`
+		Status  string `json:"status"`
+	} `json:"data"`
+}
+
+// TestCreateUpdateDeleteAsJSON tests create, update and delete commands with -o json
+func (s *StructuredOutputTestSuite) TestCreateUpdateDeleteAsJSON() {
+	var track struct {
+		SchemaVersion string `json:"schema_version"`
+		Kind          string `json:"kind"`
+		Data          struct {
+			Track struct {
+				ID    string `json:"id"`
+				Title string `json:"title"`
+			} `json:"track"`
+		} `json:"data"`
+	}
+	s.runJSON(&track, "track", "create", "--title", "JSON Track", "--rank", "100")
+	s.Equal("tm/v1", track.SchemaVersion)
+	s.Equal("track", track.Kind)
+	s.Equal("JSON Track", track.Data.Track.Title)
+	s.Require().NotEmpty(track.Data.Track.ID)
+
+	var created entityEnvelope
+	s.runJSON(&created, "task", "create", "--track", track.Data.Track.ID, "--title", "JSON Task", "--rank", "100")
+	s.Equal("tm/v1", created.SchemaVersion)
+	s.Equal("task", created.Kind)
+	s.Equal(track.Data.Track.ID, created.Data.TrackID)
+	s.Equal("todo", created.Data.Status)
+	taskID := created.Data.ID
+	s.Require().NotEmpty(taskID)
+
+	var updated entityEnvelope
+	s.runJSON(&updated, "task", "update", taskID, "--status", "in-progress")
+	s.Equal("task", updated.Kind)
+	s.Equal(taskID, updated.Data.ID)
+	s.Equal("in-progress", updated.Data.Status)
+
+	var deleted struct {
+		SchemaVersion string `json:"schema_version"`
+		Kind          string `json:"kind"`
+		Data          struct {
+			Entity string `json:"entity"`
+			ID     string `json:"id"`
+		} `json:"data"`
+	}
+	s.runJSON(&deleted, "task", "delete", taskID)
+	s.Equal("tm/v1", deleted.SchemaVersion)
+	s.Equal("deleted", deleted.Kind)
+	s.Equal("task", deleted.Data.Entity)
+	s.Equal(taskID, deleted.Data.ID)
+
+	output, err := s.run("task", "show", taskID)
+	s.requireError(err, "deleted task should be gone\nOutput: %s", output)
+}
+
+// TestACTransitionAsJSON tests a status transition command with -o json
+func (s *StructuredOutputTestSuite) TestACTransitionAsJSON() {
+	trackOutput, err := s.run("track", "create", "--title", "AC JSON Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	var task entityEnvelope
+	s.runJSON(&task, "task", "create", "--track", trackID, "--title", "AC JSON Task", "--rank", "100")
+
+	var ac entityEnvelope
+	s.runJSON(&ac, "ac", "add", task.Data.ID, "--description", "Works")
+	s.Equal("ac", ac.Kind)
+	s.Require().NotEmpty(ac.Data.ID)
+
+	var verified entityEnvelope
+	s.runJSON(&verified, "ac", "verify", ac.Data.ID)
+	s.Equal("ac", verified.Kind)
+	s.Equal(ac.Data.ID, verified.Data.ID)
+	s.Equal("verified", verified.Data.Status)
+}
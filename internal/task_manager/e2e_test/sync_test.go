@@ -0,0 +1,90 @@
+package task_manager_e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// SyncTestSuite tests mirroring the project to markdown files end-to-end
+// This suite runs in its own project because every entity of the project is synced
+type SyncTestSuite struct {
+	E2ETestSuite
+}
+
+func TestSyncSuite(t *testing.T) {
+	suite.Run(t, new(SyncTestSuite))
+}
+
+// TestSyncRoundTrip tests exporting entities, importing file edits and resolving a conflict
+func (s *SyncTestSuite) TestSyncRoundTrip() {
+	dir := filepath.Join(s.testWorkingDir, "sync-roadmap")
+
+	output, err := s.run("track", "create", "--title", "Sync Track", "--description", "Tracked in git")
+	s.requireSuccess(output, err, "failed to create track")
+	trackID := s.parseID(output, "-track-")
+	output, err = s.run("task", "create", "--track", trackID, "--title", "Sync Task")
+	s.requireSuccess(output, err, "failed to create task")
+	taskID := s.parseID(output, "-task-")
+	output, err = s.run("ac", "add", taskID, "--description", "Files stay in sync")
+	s.requireSuccess(output, err, "failed to add acceptance criterion")
+	output, err = s.run("adr", "create", trackID,
+		"--title", "Store the roadmap in git",
+		"--context", "Reviews happen in pull requests",
+		"--decision", "Mirror entities to markdown",
+		"--consequences", "Merges need a sync")
+	s.requireSuccess(output, err, "failed to create ADR")
+
+	// First sync exports everything
+	output, err = s.run("sync", "--dir", dir)
+	s.requireSuccess(output, err, "first sync should succeed")
+	s.Contains(output, "tracks/"+trackID+".md")
+	taskFile := filepath.Join(dir, "tasks", taskID+".md")
+	s.FileExists(taskFile)
+	s.FileExists(filepath.Join(dir, "roadmap.md"))
+
+	output, err = s.run("sync", "status", "--dir", dir)
+	s.requireSuccess(output, err, "status should succeed")
+	s.Contains(output, "Everything in sync")
+
+	// A file edit is imported into the database
+	content, err := os.ReadFile(taskFile)
+	s.Require().NoError(err)
+	edited := strings.Replace(string(content), "title: Sync Task", "title: Edited In Git", 1)
+	edited = strings.Replace(edited, "status: todo", "status: in-progress", 1)
+	s.Require().NoError(os.WriteFile(taskFile, []byte(edited+"\nDescribed in the file.\n"), 0644))
+
+	output, err = s.run("sync", "--dir", dir)
+	s.requireSuccess(output, err, "sync should import the edit")
+	s.Contains(output, "file edited")
+	output, err = s.run("task", "show", taskID)
+	s.requireSuccess(output, err, "failed to show task")
+	s.Contains(output, "Edited In Git")
+	s.Contains(output, "in-progress")
+	s.Contains(output, "Described in the file.")
+
+	// A change on both sides is a conflict until a side is preferred
+	content, err = os.ReadFile(taskFile)
+	s.Require().NoError(err)
+	s.Require().NoError(os.WriteFile(taskFile, []byte(strings.Replace(string(content), "title: Edited In Git", "title: File Side", 1)), 0644))
+	output, err = s.run("task", "update", taskID, "--title", "Database Side")
+	s.requireSuccess(output, err, "failed to update task")
+
+	output, err = s.run("sync", "--dir", dir)
+	s.requireError(err, "sync should fail while a conflict remains")
+	s.Contains(output, "conflict")
+
+	output, err = s.run("sync", "--dir", dir, "--prefer", "database")
+	s.requireSuccess(output, err, "preferring the database should resolve the conflict")
+	content, err = os.ReadFile(taskFile)
+	s.Require().NoError(err)
+	s.Contains(string(content), "title: Database Side")
+
+	output, err = s.run("sync", "status", "--dir", dir, "-o", "json")
+	s.requireSuccess(output, err, "status should succeed")
+	s.Contains(output, `"kind": "sync_report"`)
+	s.Contains(output, `"conflicts": 0`)
+}
@@ -1,6 +1,7 @@
 package task_manager_e2e_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -92,6 +93,45 @@ func (s *TaskTestSuite) TestTaskShow() {
 	s.Contains(showOutput, "Test Task", "task title should appear in show output")
 }
 
+// TestTaskShowStructuredOutput tests the global --output flag on task show
+func (s *TaskTestSuite) TestTaskShowStructuredOutput() {
+	// Create track
+	trackOutput, err := s.run("track", "create", "--title", "Test Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	// Create task
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Test Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	// Show task as JSON
+	showOutput, err := s.run("task", "show", taskID, "--output", "json")
+	s.requireSuccess(showOutput, err, "failed to show task as JSON")
+
+	var envelope struct {
+		SchemaVersion string `json:"schema_version"`
+		Kind          string `json:"kind"`
+		Data          struct {
+			ID      string `json:"id"`
+			TrackID string `json:"track_id"`
+			Title   string `json:"title"`
+		} `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(showOutput), &envelope), "output should be valid JSON: %s", showOutput)
+	s.Equal("tm/v1", envelope.SchemaVersion)
+	s.Equal("task", envelope.Kind)
+	s.Equal(taskID, envelope.Data.ID)
+	s.Equal(trackID, envelope.Data.TrackID)
+	s.Equal("Test Task", envelope.Data.Title)
+
+	// Missing task reports a structured error
+	errOutput, err := s.run("task", "show", "E2ETEST-task-99999", "-o", "yaml")
+	s.requireError(err, "showing a missing task should fail")
+	s.Contains(errOutput, "kind: error")
+	s.Contains(errOutput, "code: not_found")
+}
+
 // TestTaskUpdate tests updating task properties
 func (s *TaskTestSuite) TestTaskUpdate() {
 	// Create track
@@ -239,3 +279,58 @@ func (s *TaskTestSuite) TestTaskMultiple() {
 		s.Contains(listOutput, taskID, "task "+taskID+" should appear in list")
 	}
 }
+
+// TestTaskBlockAndReady tests blocked-by links, cycle detection and the --ready filter
+func (s *TaskTestSuite) TestTaskBlockAndReady() {
+	// Create track
+	trackOutput, err := s.run("track", "create", "--title", "Test Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	// Create two tasks: the second one needs the first
+	firstOutput, err := s.run("task", "create", "--track", trackID, "--title", "Schema", "--rank", "100")
+	s.requireSuccess(firstOutput, err, "failed to create first task")
+	firstID := s.parseID(firstOutput, "task")
+
+	secondOutput, err := s.run("task", "create", "--track", trackID, "--title", "API", "--rank", "100")
+	s.requireSuccess(secondOutput, err, "failed to create second task")
+	secondID := s.parseID(secondOutput, "task")
+
+	blockOutput, err := s.run("task", "block", secondID, firstID)
+	s.requireSuccess(blockOutput, err, "failed to block task")
+	s.Contains(blockOutput, secondID+" is blocked by "+firstID)
+
+	// The reverse link would create a cycle
+	cycleOutput, err := s.run("task", "block", firstID, secondID)
+	s.requireError(err, "circular dependency should be rejected")
+	s.Contains(cycleOutput, "circular dependency")
+
+	// Only the first task is ready; the second shows as blocked
+	readyOutput, err := s.run("task", "list", "--ready")
+	s.requireSuccess(readyOutput, err, "failed to list ready tasks")
+	s.Contains(readyOutput, firstID)
+	s.NotContains(readyOutput, secondID)
+
+	showOutput, err := s.run("task", "show", secondID)
+	s.requireSuccess(showOutput, err, "failed to show blocked task")
+	s.Contains(showOutput, "Blocked By:")
+	s.Contains(showOutput, firstID+" (not done)")
+
+	// Finishing the blocker makes the second task ready
+	updateOutput, err := s.run("task", "update", firstID, "--status", "done")
+	s.requireSuccess(updateOutput, err, "failed to finish blocker")
+
+	readyOutput, err = s.run("task", "list", "--ready")
+	s.requireSuccess(readyOutput, err, "failed to list ready tasks")
+	s.Contains(readyOutput, secondID)
+	s.NotContains(readyOutput, firstID)
+
+	// Unblocking removes the link
+	unblockOutput, err := s.run("task", "unblock", secondID, firstID)
+	s.requireSuccess(unblockOutput, err, "failed to unblock task")
+	s.Contains(unblockOutput, "no longer blocked")
+
+	showOutput, err = s.run("task", "show", secondID)
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.NotContains(showOutput, "Blocked By:")
+}
@@ -0,0 +1,58 @@
+package task_manager_e2e_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// TrackPlanTestSuite tests scheduling tracks along their dependencies end-to-end
+// This suite runs in its own project because applying the plan changes every track's status
+type TrackPlanTestSuite struct {
+	E2ETestSuite
+}
+
+func TestTrackPlanSuite(t *testing.T) {
+	suite.Run(t, new(TrackPlanTestSuite))
+}
+
+// TestTrackPlan tests waves, violations in track list and applying suggestions
+func (s *TrackPlanTestSuite) TestTrackPlan() {
+	output, err := s.run("track", "create", "--title", "Plan Core")
+	s.requireSuccess(output, err, "failed to create core track")
+	coreID := s.parseID(output, "-track-")
+	output, err = s.run("track", "create", "--title", "Plan API")
+	s.requireSuccess(output, err, "failed to create API track")
+	apiID := s.parseID(output, "-track-")
+	output, err = s.run("track", "add-dependency", apiID, coreID)
+	s.requireSuccess(output, err, "failed to set track dependency")
+	output, err = s.run("track", "update", apiID, "--status", "in-progress")
+	s.requireSuccess(output, err, "failed to start API track")
+
+	output, err = s.run("track", "list")
+	s.requireSuccess(output, err, "track list should succeed")
+	s.Contains(output, "in-progress !")
+	s.Contains(output, "! "+apiID+" is in-progress but depends on incomplete "+coreID)
+
+	output, err = s.run("track", "plan")
+	s.requireSuccess(output, err, "track plan should succeed")
+	s.Contains(output, "Wave 1\n  "+coreID)
+	s.Contains(output, "Wave 2\n  "+apiID)
+	s.Contains(output, "in-progress → waiting (waiting on "+coreID+")")
+
+	output, err = s.run("track", "plan", "--apply")
+	s.requireSuccess(output, err, "applying the plan should succeed")
+	s.Contains(output, "Applied status changes:")
+	output, err = s.run("track", "show", apiID)
+	s.requireSuccess(output, err, "failed to show API track")
+	s.Contains(output, "Status:      waiting")
+
+	// Completing the dependency releases the waiting track
+	output, err = s.run("track", "update", coreID, "--status", "complete")
+	s.requireSuccess(output, err, "failed to complete core track")
+	output, err = s.run("track", "plan", "-o", "json")
+	s.requireSuccess(output, err, "track plan should succeed")
+	s.Contains(output, `"kind": "track_plan"`)
+	s.Contains(output, `"to": "not-started"`)
+	s.Contains(output, `"violations": []`)
+}
@@ -130,6 +130,37 @@ func (s *TrackTestSuite) TestTrackSetDependency() {
 	s.Contains(showOutput, trackID1, "dependency should be visible in track details")
 }
 
+// TestTrackGraph tests rendering the track dependency graph in every format
+func (s *TrackTestSuite) TestTrackGraph() {
+	output1, err := s.run("track", "create", "--title", "Graph Base Track")
+	s.requireSuccess(output1, err, "failed to create first track")
+	trackID1 := s.parseID(output1, "-track-")
+	output2, err := s.run("track", "create", "--title", "Graph Top Track")
+	s.requireSuccess(output2, err, "failed to create second track")
+	trackID2 := s.parseID(output2, "-track-")
+	depOutput, err := s.run("track", "add-dependency", trackID2, trackID1)
+	s.requireSuccess(depOutput, err, "failed to set track dependency")
+
+	output, err := s.run("graph")
+	s.requireSuccess(output, err, "failed to render ascii graph")
+	s.Contains(output, trackID1+"  Graph Base Track [not-started]")
+	s.Contains(output, trackID1+" → "+trackID2)
+	s.Contains(output, "Critical path")
+
+	output, err = s.run("graph", "--format", "dot", "--tracks-only")
+	s.requireSuccess(output, err, "failed to render dot graph")
+	s.Contains(output, "digraph roadmap {")
+	s.Contains(output, `"`+trackID1+`" -> "`+trackID2+`"`)
+
+	output, err = s.run("graph", "--format", "mermaid", "--save", "--title", "Track Graph")
+	s.requireSuccess(output, err, "failed to save mermaid graph")
+	s.Contains(output, "flowchart LR")
+	docID := s.parseID(output, "-doc-")
+	output, err = s.run("doc", "show", docID)
+	s.requireSuccess(output, err, "saved graph should be a document")
+	s.Contains(output, "```mermaid")
+}
+
 // TestTrackRemoveDependency tests removing a dependency between tracks
 func (s *TrackTestSuite) TestTrackRemoveDependency() {
 	// Create two tracks
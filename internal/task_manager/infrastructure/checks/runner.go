@@ -0,0 +1,80 @@
+// Package checks executes the shell commands attached to acceptance criteria.
+package checks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/procgroup"
+)
+
+// Compile-time check that ShellRunner implements services.CheckRunner
+var _ services.CheckRunner = (*ShellRunner)(nil)
+
+// ShellRunner runs checks through `sh -c` in a fixed working directory.
+type ShellRunner struct {
+	workDir string
+}
+
+// NewShellRunner creates a check runner. An empty workDir runs checks in the current directory.
+func NewShellRunner(workDir string) *ShellRunner {
+	return &ShellRunner{workDir: workDir}
+}
+
+// RunCheck executes the AC's check command, killing it and everything it started once the timeout expires.
+// The AC and task IDs are exported as TM_AC_ID and TM_TASK_ID.
+func (r *ShellRunner) RunCheck(ctx context.Context, ac *entities.AcceptanceCriteriaEntity, timeout time.Duration) (services.CheckResult, error) {
+	if !ac.HasCheck() {
+		return services.CheckResult{}, fmt.Errorf("AC %s has no check command", ac.ID)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", ac.CheckCommand)
+	cmd.Dir = r.workDir
+	cmd.Env = append(os.Environ(),
+		"TM_AC_ID="+ac.ID,
+		"TM_TASK_ID="+ac.TaskID,
+	)
+
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	procgroup.KillOnCancel(cmd)
+
+	start := time.Now()
+	err := cmd.Run()
+	result := services.CheckResult{
+		Duration: time.Since(start),
+		Output:   strings.TrimSpace(output.String()),
+	}
+
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		result.ExitCode = -1
+		result.TimedOut = true
+		return result, nil
+	case context.Canceled:
+		return result, fmt.Errorf("check for AC %s interrupted: %w", ac.ID, ctx.Err())
+	}
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		result.Passed = true
+	case errors.As(err, &exitErr):
+		result.ExitCode = exitErr.ExitCode()
+	default:
+		return result, fmt.Errorf("failed to run check for AC %s: %w", ac.ID, err)
+	}
+	return result, nil
+}
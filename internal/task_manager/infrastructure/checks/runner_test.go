@@ -0,0 +1,65 @@
+package checks_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/checks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func checkedAC(command string) *entities.AcceptanceCriteriaEntity {
+	ac := &entities.AcceptanceCriteriaEntity{ID: "TM-ac-1", TaskID: "TM-task-1"}
+	ac.SetCheckCommand(command)
+	return ac
+}
+
+func TestShellRunner_PassesOnZeroExit(t *testing.T) {
+	runner := checks.NewShellRunner("")
+
+	result, err := runner.RunCheck(context.Background(), checkedAC("echo all good"), 5*time.Second)
+	require.NoError(t, err)
+	assert.True(t, result.Passed)
+	assert.Equal(t, 0, result.ExitCode)
+	assert.Equal(t, "all good", result.Output)
+}
+
+func TestShellRunner_FailsOnNonZeroExit(t *testing.T) {
+	runner := checks.NewShellRunner("")
+
+	result, err := runner.RunCheck(context.Background(), checkedAC("echo broken >&2; exit 3"), 5*time.Second)
+	require.NoError(t, err)
+	assert.False(t, result.Passed)
+	assert.Equal(t, 3, result.ExitCode)
+	assert.Equal(t, "broken", result.Output)
+}
+
+func TestShellRunner_TimesOut(t *testing.T) {
+	runner := checks.NewShellRunner("")
+
+	result, err := runner.RunCheck(context.Background(), checkedAC("sleep 5"), 100*time.Millisecond)
+	require.NoError(t, err)
+	assert.False(t, result.Passed)
+	assert.True(t, result.TimedOut)
+	assert.Equal(t, -1, result.ExitCode)
+}
+
+func TestShellRunner_WorkingDirectoryAndEnvironment(t *testing.T) {
+	dir := t.TempDir()
+	runner := checks.NewShellRunner(dir)
+
+	result, err := runner.RunCheck(context.Background(), checkedAC(`test "$(pwd -P)" = "$(cd "`+dir+`" && pwd -P)" && echo "$TM_AC_ID $TM_TASK_ID"`), 5*time.Second)
+	require.NoError(t, err)
+	assert.True(t, result.Passed, result.Output)
+	assert.Equal(t, "TM-ac-1 TM-task-1", result.Output)
+}
+
+func TestShellRunner_RequiresCheckCommand(t *testing.T) {
+	runner := checks.NewShellRunner("")
+
+	_, err := runner.RunCheck(context.Background(), &entities.AcceptanceCriteriaEntity{ID: "TM-ac-1"}, time.Second)
+	assert.Error(t, err)
+}
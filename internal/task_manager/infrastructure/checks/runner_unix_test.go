@@ -0,0 +1,47 @@
+//go:build unix
+
+package checks_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/checks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellRunner_TimeoutKillsBackgroundProcesses(t *testing.T) {
+	pidFile := filepath.Join(t.TempDir(), "pid")
+	runner := checks.NewShellRunner("")
+
+	result, err := runner.RunCheck(context.Background(), checkedAC("sleep 77 & echo $! > "+pidFile+"; wait"), 200*time.Millisecond)
+	require.NoError(t, err)
+	require.True(t, result.TimedOut)
+
+	data, err := os.ReadFile(pidFile)
+	require.NoError(t, err)
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return !processAlive(pid) }, 2*time.Second, 20*time.Millisecond,
+		"background process %d survived the timeout", pid)
+}
+
+// processAlive reports whether pid is running; an unreaped zombie counts as dead.
+func processAlive(pid int) bool {
+	if syscall.Kill(pid, 0) != nil {
+		return false
+	}
+	stat, err := os.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+	if err != nil {
+		return true
+	}
+	fields := strings.Fields(string(stat[strings.LastIndexByte(string(stat), ')')+1:]))
+	return len(fields) == 0 || fields[0] != "Z"
+}
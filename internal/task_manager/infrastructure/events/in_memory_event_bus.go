@@ -0,0 +1,50 @@
+// Package events provides event bus implementations for the task manager.
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/logger"
+)
+
+// InMemoryEventBus is a synchronous, in-process implementation of events.EventBus.
+// Handlers run in subscription order on the publishing goroutine.
+type InMemoryEventBus struct {
+	mu       sync.RWMutex
+	handlers map[string][]events.Handler
+	logger   logger.Logger
+}
+
+// NewInMemoryEventBus creates an empty event bus.
+// Handler failures are reported through the given logger.
+func NewInMemoryEventBus(log logger.Logger) *InMemoryEventBus {
+	return &InMemoryEventBus{
+		handlers: make(map[string][]events.Handler),
+		logger:   log,
+	}
+}
+
+// Subscribe registers a handler for an event type, or events.EventAll for every event.
+func (b *InMemoryEventBus) Subscribe(eventType string, handler events.Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Publish delivers the event to type-specific handlers first, then to wildcard handlers.
+// A failing handler is logged and does not prevent the remaining handlers from running.
+func (b *InMemoryEventBus) Publish(ctx context.Context, event events.Event) {
+	b.mu.RLock()
+	handlers := make([]events.Handler, 0, len(b.handlers[event.Type])+len(b.handlers[events.EventAll]))
+	handlers = append(handlers, b.handlers[event.Type]...)
+	handlers = append(handlers, b.handlers[events.EventAll]...)
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil && b.logger != nil {
+			b.logger.Warn("event handler failed", "event", event.Type, "entity", event.EntityID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/logger"
+	infraevents "github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/events"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingLogger captures warnings emitted by the bus
+type recordingLogger struct {
+	warnings []string
+}
+
+func (l *recordingLogger) Debug(msg string, keysAndValues ...interface{}) {}
+func (l *recordingLogger) Info(msg string, keysAndValues ...interface{})  {}
+func (l *recordingLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.warnings = append(l.warnings, msg)
+}
+func (l *recordingLogger) Error(msg string, keysAndValues ...interface{}) {}
+func (l *recordingLogger) SetLevel(level logger.Level)                    {}
+func (l *recordingLogger) GetLevel() logger.Level                         { return logger.LevelDebug }
+
+func TestInMemoryEventBus_DeliversToTypeAndWildcardHandlers(t *testing.T) {
+	bus := infraevents.NewInMemoryEventBus(nil)
+
+	var received []string
+	bus.Subscribe(events.EventTaskCreated, func(ctx context.Context, event events.Event) error {
+		received = append(received, "typed:"+event.EntityID)
+		return nil
+	})
+	bus.Subscribe(events.EventAll, func(ctx context.Context, event events.Event) error {
+		received = append(received, "all:"+event.Type)
+		return nil
+	})
+	bus.Subscribe(events.EventTaskDeleted, func(ctx context.Context, event events.Event) error {
+		received = append(received, "unexpected")
+		return nil
+	})
+
+	bus.Publish(context.Background(), events.NewEvent(events.EventTaskCreated, events.EntityTypeTask, "TM-task-1", nil, nil))
+
+	assert.Equal(t, []string{"typed:TM-task-1", "all:" + events.EventTaskCreated}, received)
+}
+
+func TestInMemoryEventBus_HandlerErrorDoesNotStopDelivery(t *testing.T) {
+	log := &recordingLogger{}
+	bus := infraevents.NewInMemoryEventBus(log)
+
+	calls := 0
+	bus.Subscribe(events.EventACFailed, func(ctx context.Context, event events.Event) error {
+		calls++
+		return errors.New("boom")
+	})
+	bus.Subscribe(events.EventACFailed, func(ctx context.Context, event events.Event) error {
+		calls++
+		return nil
+	})
+
+	bus.Publish(context.Background(), events.NewEvent(events.EventACFailed, events.EntityTypeAC, "TM-ac-1", nil, nil))
+
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []string{"event handler failed"}, log.warnings)
+}
+
+func TestInMemoryEventBus_NoSubscribers(t *testing.T) {
+	bus := infraevents.NewInMemoryEventBus(nil)
+	assert.NotPanics(t, func() {
+		bus.Publish(context.Background(), events.NewEvent(events.EventTrackCreated, events.EntityTypeTrack, "TM-track-1", nil, nil))
+	})
+}
@@ -0,0 +1,454 @@
+package filesync
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"gopkg.in/yaml.v3"
+)
+
+// frontMatterDelimiter opens and closes the YAML front matter of a sync file
+const frontMatterDelimiter = "---"
+
+// Body section headings of multi-field entities
+const (
+	sectionVision          = "Vision"
+	sectionSuccessCriteria = "Success Criteria"
+	sectionContext         = "Context"
+	sectionDecision        = "Decision"
+	sectionConsequences    = "Consequences"
+	sectionAlternatives    = "Alternatives"
+)
+
+// ============================================================================
+// Front matter schemas
+// ============================================================================
+
+type roadmapFrontMatter struct {
+	ID        string    `yaml:"id"`
+	CreatedAt time.Time `yaml:"created_at"`
+	UpdatedAt time.Time `yaml:"updated_at"`
+}
+
+type trackFrontMatter struct {
+	ID           string    `yaml:"id"`
+	Title        string    `yaml:"title"`
+	Status       string    `yaml:"status"`
+	Rank         int       `yaml:"rank"`
+	Dependencies []string  `yaml:"dependencies"`
+	CreatedAt    time.Time `yaml:"created_at"`
+	UpdatedAt    time.Time `yaml:"updated_at"`
+}
+
+type taskFrontMatter struct {
+	ID        string    `yaml:"id"`
+	Track     string    `yaml:"track"`
+	Title     string    `yaml:"title"`
+	Status    string    `yaml:"status"`
+	Rank      int       `yaml:"rank"`
+	Branch    string    `yaml:"branch,omitempty"`
+	Estimate  *float64  `yaml:"estimate,omitempty"`
+	BlockedBy []string  `yaml:"blocked_by"`
+	CreatedAt time.Time `yaml:"created_at"`
+	UpdatedAt time.Time `yaml:"updated_at"`
+}
+
+type iterationFrontMatter struct {
+	Number      int        `yaml:"number"`
+	Name        string     `yaml:"name"`
+	Goal        string     `yaml:"goal"`
+	Status      string     `yaml:"status"`
+	Rank        float64    `yaml:"rank"`
+	Tasks       []string   `yaml:"tasks"`
+	Capacity    *float64   `yaml:"capacity,omitempty"`
+	StartedAt   *time.Time `yaml:"started_at,omitempty"`
+	CompletedAt *time.Time `yaml:"completed_at,omitempty"`
+	CreatedAt   time.Time  `yaml:"created_at"`
+	UpdatedAt   time.Time  `yaml:"updated_at"`
+}
+
+type acceptanceCriteriaFrontMatter struct {
+	ID                  string    `yaml:"id"`
+	Task                string    `yaml:"task"`
+	VerificationType    string    `yaml:"verification_type"`
+	Status              string    `yaml:"status"`
+	Notes               string    `yaml:"notes,omitempty"`
+	CheckCommand        string    `yaml:"check_command,omitempty"`
+	TestingInstructions string    `yaml:"testing_instructions,omitempty"`
+	CreatedAt           time.Time `yaml:"created_at"`
+	UpdatedAt           time.Time `yaml:"updated_at"`
+}
+
+type adrFrontMatter struct {
+	ID           string    `yaml:"id"`
+	Track        string    `yaml:"track"`
+	Title        string    `yaml:"title"`
+	Status       string    `yaml:"status"`
+	SupersededBy *string   `yaml:"superseded_by,omitempty"`
+	CreatedAt    time.Time `yaml:"created_at"`
+	UpdatedAt    time.Time `yaml:"updated_at"`
+}
+
+type documentFrontMatter struct {
+	ID        string    `yaml:"id"`
+	Title     string    `yaml:"title"`
+	Type      string    `yaml:"type"`
+	Status    string    `yaml:"status"`
+	Track     *string   `yaml:"track,omitempty"`
+	Iteration *int      `yaml:"iteration,omitempty"`
+	CreatedAt time.Time `yaml:"created_at"`
+	UpdatedAt time.Time `yaml:"updated_at"`
+}
+
+// ============================================================================
+// Encoding
+// ============================================================================
+
+// encodeEntity renders an entity as its sync file content
+func encodeEntity(entity services.SyncEntity) ([]byte, error) {
+	switch e := entity.(type) {
+	case *entities.RoadmapEntity:
+		return renderFile(roadmapFrontMatter{
+			ID:        e.ID,
+			CreatedAt: e.CreatedAt.UTC(),
+			UpdatedAt: e.UpdatedAt.UTC(),
+		}, renderSections([]string{sectionVision, sectionSuccessCriteria}, map[string]string{
+			sectionVision:          e.Vision,
+			sectionSuccessCriteria: e.SuccessCriteria,
+		}))
+	case *entities.TrackEntity:
+		return renderFile(trackFrontMatter{
+			ID:           e.ID,
+			Title:        e.Title,
+			Status:       e.Status,
+			Rank:         e.Rank,
+			Dependencies: nonNil(e.Dependencies),
+			CreatedAt:    e.CreatedAt.UTC(),
+			UpdatedAt:    e.UpdatedAt.UTC(),
+		}, e.Description)
+	case *entities.TaskEntity:
+		return renderFile(taskFrontMatter{
+			ID:        e.ID,
+			Track:     e.TrackID,
+			Title:     e.Title,
+			Status:    e.Status,
+			Rank:      e.Rank,
+			Branch:    e.Branch,
+			Estimate:  e.Estimate,
+			BlockedBy: nonNil(e.BlockedBy),
+			CreatedAt: e.CreatedAt.UTC(),
+			UpdatedAt: e.UpdatedAt.UTC(),
+		}, e.Description)
+	case *entities.IterationEntity:
+		return renderFile(iterationFrontMatter{
+			Number:      e.Number,
+			Name:        e.Name,
+			Goal:        e.Goal,
+			Status:      e.Status,
+			Rank:        e.Rank,
+			Tasks:       nonNil(e.TaskIDs),
+			Capacity:    e.Capacity,
+			StartedAt:   utcPtr(e.StartedAt),
+			CompletedAt: utcPtr(e.CompletedAt),
+			CreatedAt:   e.CreatedAt.UTC(),
+			UpdatedAt:   e.UpdatedAt.UTC(),
+		}, e.Deliverable)
+	case *entities.AcceptanceCriteriaEntity:
+		return renderFile(acceptanceCriteriaFrontMatter{
+			ID:                  e.ID,
+			Task:                e.TaskID,
+			VerificationType:    string(e.VerificationType),
+			Status:              string(e.Status),
+			Notes:               e.Notes,
+			CheckCommand:        e.CheckCommand,
+			TestingInstructions: e.TestingInstructions,
+			CreatedAt:           e.CreatedAt.UTC(),
+			UpdatedAt:           e.UpdatedAt.UTC(),
+		}, e.Description)
+	case *entities.ADREntity:
+		headings := []string{sectionContext, sectionDecision, sectionConsequences}
+		if strings.Trim(e.Alternatives, "\n") != "" {
+			headings = append(headings, sectionAlternatives)
+		}
+		return renderFile(adrFrontMatter{
+			ID:           e.ID,
+			Track:        e.TrackID,
+			Title:        e.Title,
+			Status:       e.Status,
+			SupersededBy: e.SupersededBy,
+			CreatedAt:    e.CreatedAt.UTC(),
+			UpdatedAt:    e.UpdatedAt.UTC(),
+		}, renderSections(headings, map[string]string{
+			sectionContext:      e.Context,
+			sectionDecision:     e.Decision,
+			sectionConsequences: e.Consequences,
+			sectionAlternatives: e.Alternatives,
+		}))
+	case *entities.DocumentEntity:
+		return renderFile(documentFrontMatter{
+			ID:        e.ID,
+			Title:     e.Title,
+			Type:      string(e.Type),
+			Status:    string(e.Status),
+			Track:     e.TrackID,
+			Iteration: e.IterationNumber,
+			CreatedAt: e.CreatedAt.UTC(),
+			UpdatedAt: e.UpdatedAt.UTC(),
+		}, e.Content)
+	default:
+		return nil, fmt.Errorf("%w: cannot sync entity of type %s", tmerrors.ErrInvalidArgument, entity.GetType())
+	}
+}
+
+// renderFile joins YAML front matter and a markdown body into file content
+func renderFile(frontMatter interface{}, body string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(frontMatterDelimiter + "\n")
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(frontMatter); err != nil {
+		return nil, fmt.Errorf("failed to encode front matter: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode front matter: %w", err)
+	}
+	buf.WriteString(frontMatterDelimiter + "\n")
+	if body = strings.Trim(body, "\n"); body != "" {
+		buf.WriteString("\n" + body + "\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// renderSections renders body text as "## Heading" sections in the given order
+func renderSections(headings []string, sections map[string]string) string {
+	parts := make([]string, 0, len(headings))
+	for _, heading := range headings {
+		part := "## " + heading
+		if text := strings.Trim(sections[heading], "\n"); text != "" {
+			part += "\n\n" + text
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// ============================================================================
+// Decoding
+// ============================================================================
+
+// decodeEntity parses sync file content into an entity of the given type,
+// validating it through the entity's constructor
+func decodeEntity(entityType string, content []byte) (services.SyncEntity, error) {
+	frontMatter, body, err := splitFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	switch entityType {
+	case "roadmap":
+		var fm roadmapFrontMatter
+		if err := decodeFrontMatter(frontMatter, &fm); err != nil {
+			return nil, err
+		}
+		sections, err := parseSections(body, sectionVision, sectionSuccessCriteria)
+		if err != nil {
+			return nil, err
+		}
+		return entities.NewRoadmapEntity(fm.ID, sections[sectionVision], sections[sectionSuccessCriteria], fm.CreatedAt, fm.UpdatedAt)
+	case "track":
+		var fm trackFrontMatter
+		if err := decodeFrontMatter(frontMatter, &fm); err != nil {
+			return nil, err
+		}
+		return entities.NewTrackEntity(fm.ID, "", fm.Title, body, fm.Status, fm.Rank, fm.Dependencies, fm.CreatedAt, fm.UpdatedAt)
+	case "task":
+		var fm taskFrontMatter
+		if err := decodeFrontMatter(frontMatter, &fm); err != nil {
+			return nil, err
+		}
+		task, err := entities.NewTaskEntity(fm.ID, fm.Track, fm.Title, body, fm.Status, fm.Rank, fm.Branch, fm.CreatedAt, fm.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if err := task.SetEstimate(fm.Estimate); err != nil {
+			return nil, err
+		}
+		task.BlockedBy = nonNil(fm.BlockedBy)
+		return task, nil
+	case "iteration":
+		var fm iterationFrontMatter
+		if err := decodeFrontMatter(frontMatter, &fm); err != nil {
+			return nil, err
+		}
+		iteration, err := entities.NewIterationEntity(fm.Number, fm.Name, fm.Goal, body, fm.Tasks, fm.Status, fm.Rank,
+			timeValue(fm.StartedAt), timeValue(fm.CompletedAt), fm.CreatedAt, fm.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if err := iteration.SetCapacity(fm.Capacity); err != nil {
+			return nil, err
+		}
+		return iteration, nil
+	case "acceptance_criteria":
+		var fm acceptanceCriteriaFrontMatter
+		if err := decodeFrontMatter(frontMatter, &fm); err != nil {
+			return nil, err
+		}
+		return decodeAcceptanceCriteria(fm, body)
+	case "adr":
+		var fm adrFrontMatter
+		if err := decodeFrontMatter(frontMatter, &fm); err != nil {
+			return nil, err
+		}
+		sections, err := parseSections(body, sectionContext, sectionDecision, sectionConsequences, sectionAlternatives)
+		if err != nil {
+			return nil, err
+		}
+		return entities.NewADREntity(fm.ID, fm.Track, fm.Title, fm.Status, sections[sectionContext], sections[sectionDecision],
+			sections[sectionConsequences], sections[sectionAlternatives], fm.CreatedAt, fm.UpdatedAt, fm.SupersededBy)
+	case "document":
+		var fm documentFrontMatter
+		if err := decodeFrontMatter(frontMatter, &fm); err != nil {
+			return nil, err
+		}
+		docType, err := entities.NewDocumentType(fm.Type)
+		if err != nil {
+			return nil, err
+		}
+		status, err := entities.NewDocumentStatus(fm.Status)
+		if err != nil {
+			return nil, err
+		}
+		return entities.NewDocumentEntity(fm.ID, fm.Title, docType, status, body, fm.Track, fm.Iteration, fm.CreatedAt, fm.UpdatedAt)
+	default:
+		return nil, fmt.Errorf("%w: cannot sync entity of type %s", tmerrors.ErrInvalidArgument, entityType)
+	}
+}
+
+// decodeAcceptanceCriteria validates and builds an acceptance criterion from its front matter
+func decodeAcceptanceCriteria(fm acceptanceCriteriaFrontMatter, description string) (*entities.AcceptanceCriteriaEntity, error) {
+	if fm.ID == "" || fm.Task == "" {
+		return nil, fmt.Errorf("%w: acceptance criterion requires id and task", tmerrors.ErrInvalidArgument)
+	}
+	verificationType := entities.AcceptanceCriteriaVerificationType(fm.VerificationType)
+	if verificationType != entities.VerificationTypeManual && verificationType != entities.VerificationTypeAutomated {
+		return nil, fmt.Errorf("%w: invalid verification type %q: must be manual or automated", tmerrors.ErrInvalidArgument, fm.VerificationType)
+	}
+	status := entities.AcceptanceCriteriaStatus(fm.Status)
+	switch status {
+	case entities.ACStatusNotStarted, entities.ACStatusAutomaticallyVerified, entities.ACStatusPendingHumanReview,
+		entities.ACStatusVerified, entities.ACStatusFailed, entities.ACStatusSkipped:
+	default:
+		return nil, fmt.Errorf("%w: invalid acceptance criterion status %q", tmerrors.ErrInvalidArgument, fm.Status)
+	}
+
+	ac := entities.NewAcceptanceCriteriaEntity(fm.ID, fm.Task, description, verificationType, fm.TestingInstructions, fm.CreatedAt, fm.UpdatedAt)
+	ac.Status = status
+	ac.Notes = fm.Notes
+	ac.CheckCommand = fm.CheckCommand
+	return ac, nil
+}
+
+// splitFile separates the YAML front matter from the markdown body
+func splitFile(content []byte) ([]byte, string, error) {
+	text := strings.ReplaceAll(string(content), "\r\n", "\n")
+	if !strings.HasPrefix(text, frontMatterDelimiter+"\n") {
+		return nil, "", fmt.Errorf("%w: file must start with a %s front matter line", tmerrors.ErrInvalidArgument, frontMatterDelimiter)
+	}
+	rest := text[len(frontMatterDelimiter)+1:]
+
+	var frontMatter, body string
+	if strings.HasPrefix(rest, frontMatterDelimiter+"\n") || rest == frontMatterDelimiter {
+		body = strings.TrimPrefix(rest, frontMatterDelimiter)
+	} else {
+		end := strings.Index(rest, "\n"+frontMatterDelimiter+"\n")
+		if end < 0 {
+			if !strings.HasSuffix(rest, "\n"+frontMatterDelimiter) {
+				return nil, "", fmt.Errorf("%w: front matter is not closed with %s", tmerrors.ErrInvalidArgument, frontMatterDelimiter)
+			}
+			end = len(rest) - len(frontMatterDelimiter) - 1
+		}
+		frontMatter = rest[:end+1]
+		body = rest[end+1+len(frontMatterDelimiter):]
+	}
+
+	return []byte(frontMatter), strings.Trim(body, "\n"), nil
+}
+
+// decodeFrontMatter decodes front matter strictly, so misspelled keys are reported rather than dropped
+func decodeFrontMatter(frontMatter []byte, out interface{}) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(frontMatter))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(out); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: invalid front matter: %v", tmerrors.ErrInvalidArgument, err)
+	}
+	return nil
+}
+
+// parseSections splits a body into "## Heading" sections. Only the given headings start
+// a section, so other headings stay part of the section text.
+func parseSections(body string, headings ...string) (map[string]string, error) {
+	known := make(map[string]bool, len(headings))
+	for _, heading := range headings {
+		known[heading] = true
+	}
+
+	sections := make(map[string]string, len(headings))
+	lines := map[string][]string{}
+	current := ""
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "## ") && known[strings.TrimSpace(line[3:])] {
+			current = strings.TrimSpace(line[3:])
+			if _, seen := lines[current]; seen {
+				return nil, fmt.Errorf("%w: section %q appears twice", tmerrors.ErrInvalidArgument, current)
+			}
+			lines[current] = []string{}
+			continue
+		}
+		if current == "" {
+			if strings.TrimSpace(line) != "" {
+				return nil, fmt.Errorf("%w: text before the first section; expected one of: ## %s",
+					tmerrors.ErrInvalidArgument, strings.Join(headings, ", ## "))
+			}
+			continue
+		}
+		lines[current] = append(lines[current], line)
+	}
+
+	for heading, sectionLines := range lines {
+		sections[heading] = strings.Trim(strings.Join(sectionLines, "\n"), "\n")
+	}
+	return sections, nil
+}
+
+// nonNil returns an empty slice for nil, so empty lists render as [] rather than null
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// utcPtr returns a copy of an optional time in UTC
+func utcPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+// timeValue dereferences an optional time, returning the zero time for nil
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
@@ -0,0 +1,167 @@
+// Package filesync mirrors a project to a directory of markdown files with YAML front matter,
+// one file per entity, so the roadmap can be reviewed and merged with git.
+package filesync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// Compile-time check that MarkdownStore implements services.SyncStore
+var _ services.SyncStore = (*MarkdownStore)(nil)
+
+// RoadmapFileName is the file holding the roadmap, at the root of the sync directory
+const RoadmapFileName = "roadmap.md"
+
+// entityDirs maps entity types to the subdirectory holding their files
+var entityDirs = map[string]string{
+	"track":               "tracks",
+	"task":                "tasks",
+	"iteration":           "iterations",
+	"acceptance_criteria": "acceptance-criteria",
+	"adr":                 "adrs",
+	"document":            "documents",
+}
+
+// MarkdownStore implements services.SyncStore with one markdown file per entity:
+//
+//	roadmap.md
+//	tracks/<id>.md
+//	tasks/<id>.md
+//	iterations/<number>.md
+//	acceptance-criteria/<id>.md
+//	adrs/<id>.md
+//	documents/<id>.md
+//
+// Scalar fields live in the YAML front matter; the entity's main text is the markdown body.
+// Other files in the directory are ignored.
+type MarkdownStore struct{}
+
+// NewMarkdownStore creates a new markdown sync store
+func NewMarkdownStore() *MarkdownStore {
+	return &MarkdownStore{}
+}
+
+// ReadFiles decodes every entity file in dir. A missing directory holds no files.
+func (s *MarkdownStore) ReadFiles(ctx context.Context, dir string) ([]services.SyncFile, error) {
+	files := []services.SyncFile{}
+
+	roadmapFile, err := s.readFile(dir, "roadmap", RoadmapFileName)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		files = append(files, roadmapFile)
+	}
+
+	entityTypes := make([]string, 0, len(entityDirs))
+	for entityType := range entityDirs {
+		entityTypes = append(entityTypes, entityType)
+	}
+	sort.Strings(entityTypes)
+
+	for _, entityType := range entityTypes {
+		entries, err := os.ReadDir(filepath.Join(dir, entityDirs[entityType]))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sync directory: %w", err)
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
+				continue
+			}
+			file, err := s.readFile(dir, entityType, filepath.Join(entityDirs[entityType], entry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			if expected := s.FilePath(entityType, file.Entity.GetID()); expected != file.Path {
+				return nil, fmt.Errorf("%w: %s holds %s %s, which belongs in %s",
+					tmerrors.ErrInvalidArgument, file.Path, entityType, file.Entity.GetID(), expected)
+			}
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
+
+// WriteFile writes an entity's file into dir and returns it.
+func (s *MarkdownStore) WriteFile(ctx context.Context, dir string, entity services.SyncEntity) (services.SyncFile, error) {
+	content, err := encodeEntity(entity)
+	if err != nil {
+		return services.SyncFile{}, err
+	}
+
+	path := s.FilePath(entity.GetType(), entity.GetID())
+	fullPath := filepath.Join(dir, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return services.SyncFile{}, fmt.Errorf("failed to create sync directory: %w", err)
+	}
+	if err := os.WriteFile(fullPath, content, 0644); err != nil {
+		return services.SyncFile{}, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return services.SyncFile{Entity: entity, Path: path, Hash: hashContent(content)}, nil
+}
+
+// RemoveFile deletes an entity's file from dir. Removing a missing file is not an error.
+func (s *MarkdownStore) RemoveFile(ctx context.Context, dir, entityType, entityID string) error {
+	path := s.FilePath(entityType, entityID)
+	if err := os.Remove(filepath.Join(dir, path)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove %s: %w", path, err)
+	}
+	return nil
+}
+
+// Hash returns the hash the entity's file has when written by WriteFile
+func (s *MarkdownStore) Hash(entity services.SyncEntity) (string, error) {
+	content, err := encodeEntity(entity)
+	if err != nil {
+		return "", err
+	}
+	return hashContent(content), nil
+}
+
+// FilePath returns where an entity's file lives, relative to the sync directory
+func (s *MarkdownStore) FilePath(entityType, entityID string) string {
+	subdir, ok := entityDirs[entityType]
+	if !ok {
+		return RoadmapFileName
+	}
+	return filepath.Join(subdir, entityID+".md")
+}
+
+// readFile reads and decodes a single entity file
+func (s *MarkdownStore) readFile(dir, entityType, path string) (services.SyncFile, error) {
+	content, err := os.ReadFile(filepath.Join(dir, path))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return services.SyncFile{}, err
+		}
+		return services.SyncFile{}, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	entity, err := decodeEntity(entityType, content)
+	if err != nil {
+		return services.SyncFile{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return services.SyncFile{Entity: entity, Path: path, Hash: hashContent(content)}, nil
+}
+
+// hashContent returns the hex SHA-256 of a file's content, ignoring line ending style
+func hashContent(content []byte) string {
+	sum := sha256.Sum256([]byte(strings.ReplaceAll(string(content), "\r\n", "\n")))
+	return hex.EncodeToString(sum[:])
+}
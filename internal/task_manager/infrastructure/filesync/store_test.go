@@ -0,0 +1,141 @@
+package filesync_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/filesync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testEntities returns one entity of every synced type
+func testEntities(t *testing.T) []services.SyncEntity {
+	t.Helper()
+	now := time.Date(2025, 4, 2, 8, 15, 30, 123456789, time.UTC)
+	trackID := "TM-track-1"
+	iterationNum := 1
+	supersededBy := "TM-adr-2"
+
+	roadmap, err := entities.NewRoadmapEntity("roadmap-1", "Ship a task manager", "Teams use it daily\n\n- fast\n- scriptable", now, now)
+	require.NoError(t, err)
+	track, err := entities.NewTrackEntity(trackID, "", "Core", "Core model\nand storage", "in-progress", 100, []string{"TM-track-2"}, now, now)
+	require.NoError(t, err)
+	task, err := entities.NewTaskEntity("TM-task-1", trackID, "Model: tasks", "## Notes\n\nTricky --- part", "todo", 200, "feat/model", now, now)
+	require.NoError(t, err)
+	task.BlockedBy = []string{"TM-task-2"}
+	iteration, err := entities.NewIterationEntity(1, "Sprint 1", "Basics", "A working CLI", []string{"TM-task-1"}, "current", 1.5, now, time.Time{}, now, now)
+	require.NoError(t, err)
+	ac := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "Tasks persist", entities.VerificationTypeAutomated, "Run the tests", now, now)
+	ac.Status = entities.ACStatusFailed
+	ac.Notes = "flaky"
+	ac.CheckCommand = "go test ./..."
+	adr, err := entities.NewADREntity("TM-adr-1", trackID, "Use SQLite", "superseded", "Need storage", "SQLite", "One file", "", now, now, &supersededBy)
+	require.NoError(t, err)
+	doc, err := entities.NewDocumentEntity("TM-doc-abc123", "Plan", entities.DocumentTypePlan, entities.DocumentStatusDraft, "# Plan\n\nSteps", nil, &iterationNum, now, now)
+	require.NoError(t, err)
+
+	return []services.SyncEntity{roadmap, track, task, iteration, ac, adr, doc}
+}
+
+// TestMarkdownStore_RoundTrip verifies that every entity type reads back as written
+func TestMarkdownStore_RoundTrip(t *testing.T) {
+	store := filesync.NewMarkdownStore()
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	written := map[string]services.SyncFile{}
+	for _, entity := range testEntities(t) {
+		file, err := store.WriteFile(ctx, dir, entity)
+		require.NoError(t, err)
+		hash, err := store.Hash(entity)
+		require.NoError(t, err)
+		assert.Equal(t, hash, file.Hash, "Hash should match the written file")
+		written[file.Path] = file
+	}
+	assert.FileExists(t, filepath.Join(dir, "roadmap.md"))
+	assert.FileExists(t, filepath.Join(dir, "iterations", "1.md"))
+	assert.FileExists(t, filepath.Join(dir, "acceptance-criteria", "TM-ac-1.md"))
+
+	files, err := store.ReadFiles(ctx, dir)
+	require.NoError(t, err)
+	require.Len(t, files, len(written))
+	for _, file := range files {
+		original, ok := written[file.Path]
+		require.True(t, ok, "unexpected file %s", file.Path)
+		assert.Equal(t, original.Hash, file.Hash, file.Path)
+		assert.Equal(t, original.Entity, file.Entity, file.Path)
+	}
+}
+
+// TestMarkdownStore_ReadsHandWrittenFile verifies that a file written by hand decodes with defaults for omitted fields
+func TestMarkdownStore_ReadsHandWrittenFile(t *testing.T) {
+	store := filesync.NewMarkdownStore()
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "tasks"), 0755))
+	content := "---\nid: TM-task-7\ntrack: TM-track-1\ntitle: Written by hand\nstatus: todo\nrank: 300\n---\n\nSome description.\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tasks", "TM-task-7.md"), []byte(content), 0644))
+
+	files, err := store.ReadFiles(context.Background(), dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	task := files[0].Entity.(*entities.TaskEntity)
+	assert.Equal(t, "Written by hand", task.Title)
+	assert.Equal(t, "Some description.", task.Description)
+	assert.Empty(t, task.BlockedBy)
+	assert.True(t, task.CreatedAt.IsZero())
+}
+
+// TestMarkdownStore_RejectsInvalidFiles verifies that malformed files are reported rather than skipped
+func TestMarkdownStore_RejectsInvalidFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		content string
+	}{
+		{name: "no front matter", path: "tasks/TM-task-1.md", content: "Just text\n"},
+		{name: "unclosed front matter", path: "tasks/TM-task-1.md", content: "---\nid: TM-task-1\n"},
+		{name: "unknown key", path: "tasks/TM-task-1.md", content: "---\nid: TM-task-1\ntrack: TM-track-1\ntitel: Typo\nstatus: todo\nrank: 1\n---\n"},
+		{name: "invalid status", path: "tasks/TM-task-1.md", content: "---\nid: TM-task-1\ntrack: TM-track-1\ntitle: T\nstatus: someday\nrank: 1\n---\n"},
+		{name: "id does not match file name", path: "tasks/TM-task-2.md", content: "---\nid: TM-task-1\ntrack: TM-track-1\ntitle: T\nstatus: todo\nrank: 1\n---\n"},
+		{name: "text outside roadmap sections", path: "roadmap.md", content: "---\nid: roadmap-1\n---\n\nIntro\n\n## Vision\n\nV\n\n## Success Criteria\n\nS\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, tt.path)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0644))
+
+			_, err := filesync.NewMarkdownStore().ReadFiles(context.Background(), dir)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tmerrors.ErrInvalidArgument), "got %v", err)
+		})
+	}
+}
+
+// TestMarkdownStore_MissingDirectoryAndRemove verifies that a missing directory is empty and removal is idempotent
+func TestMarkdownStore_MissingDirectoryAndRemove(t *testing.T) {
+	store := filesync.NewMarkdownStore()
+	dir := filepath.Join(t.TempDir(), "roadmap")
+	ctx := context.Background()
+
+	files, err := store.ReadFiles(ctx, dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	entity := testEntities(t)[2]
+	_, err = store.WriteFile(ctx, dir, entity)
+	require.NoError(t, err)
+	require.NoError(t, store.RemoveFile(ctx, dir, entity.GetType(), entity.GetID()))
+	require.NoError(t, store.RemoveFile(ctx, dir, entity.GetType(), entity.GetID()))
+	assert.NoFileExists(t, filepath.Join(dir, store.FilePath(entity.GetType(), entity.GetID())))
+}
@@ -0,0 +1,203 @@
+// Package git talks to the local code repository through the git command line.
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+)
+
+// Compile-time check that Client implements services.VersionControl
+var _ services.VersionControl = (*Client)(nil)
+
+// Field and record separators used in `git log --format` output
+const (
+	fieldSeparator  = "\x1f"
+	recordSeparator = "\x1e"
+)
+
+// hookMarker identifies hook scripts written by the task manager so reinstalling can replace them
+const hookMarker = "# Installed by tm git install-hooks - reinstall to update"
+
+// Client runs git commands in a working tree.
+type Client struct {
+	workDir string
+}
+
+// NewClient creates a git client. An empty workDir uses the current directory.
+func NewClient(workDir string) *Client {
+	return &Client{workDir: workDir}
+}
+
+// CheckoutBranch switches to the branch, creating it from HEAD if it doesn't exist.
+func (c *Client) CheckoutBranch(ctx context.Context, name string) (bool, error) {
+	if _, err := c.run(ctx, "check-ref-format", "--branch", name); err != nil {
+		return false, fmt.Errorf("%w: invalid branch name %q", tmerrors.ErrInvalidArgument, name)
+	}
+
+	exists, err := c.branchExists(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		_, err = c.run(ctx, "checkout", name)
+		return false, err
+	}
+	_, err = c.run(ctx, "checkout", "-b", name)
+	return err == nil, err
+}
+
+// FindCommits returns commits on local branches whose message contains text, newest first.
+func (c *Client) FindCommits(ctx context.Context, text string) ([]entities.Commit, error) {
+	// A repository without commits has no history to search
+	if _, err := c.run(ctx, "rev-parse", "--verify", "--quiet", "HEAD"); err != nil {
+		if _, repoErr := c.run(ctx, "rev-parse", "--git-dir"); repoErr != nil {
+			return nil, repoErr
+		}
+		return []entities.Commit{}, nil
+	}
+
+	return c.log(ctx, "--branches", "--fixed-strings", "--grep="+text)
+}
+
+// CurrentBranch returns the checked out branch, or "" when HEAD is detached.
+func (c *Client) CurrentBranch(ctx context.Context) (string, error) {
+	output, err := c.run(ctx, "symbolic-ref", "--quiet", "--short", "HEAD")
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// MergedCommits returns the commits between ORIG_HEAD and HEAD, newest first.
+// Git records ORIG_HEAD before every merge and pull; without it nothing was merged.
+func (c *Client) MergedCommits(ctx context.Context) ([]entities.Commit, error) {
+	if _, err := c.run(ctx, "rev-parse", "--verify", "--quiet", "ORIG_HEAD"); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return []entities.Commit{}, nil
+		}
+		return nil, err
+	}
+	return c.log(ctx, "ORIG_HEAD..HEAD")
+}
+
+// InstallHook writes an executable script to the repository's hooks directory (honoring core.hooksPath).
+// The marker line is inserted after the shebang; hooks without it are only replaced when force is set.
+func (c *Client) InstallHook(ctx context.Context, name, script string, force bool) (string, error) {
+	output, err := c.run(ctx, "rev-parse", "--git-path", "hooks/"+name)
+	if err != nil {
+		return "", err
+	}
+	path := strings.TrimSpace(output)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(c.workDir, path)
+	}
+
+	existing, err := os.ReadFile(path)
+	if err == nil && !force && !strings.Contains(string(existing), hookMarker) {
+		return "", fmt.Errorf("%w: %s hook already exists at %s (use --force to replace it)", tmerrors.ErrAlreadyExists, name, path)
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to read existing %s hook: %w", name, err)
+	}
+
+	shebang, rest, _ := strings.Cut(script, "\n")
+	content := shebang + "\n" + hookMarker + "\n" + rest
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", fmt.Errorf("failed to create hooks directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0755); err != nil {
+		return "", fmt.Errorf("failed to write %s hook: %w", name, err)
+	}
+	// WriteFile keeps the mode of an existing file, so make sure a replaced hook is executable
+	if err := os.Chmod(path, 0755); err != nil {
+		return "", fmt.Errorf("failed to make %s hook executable: %w", name, err)
+	}
+	return path, nil
+}
+
+// branchExists checks for a local branch.
+func (c *Client) branchExists(ctx context.Context, name string) (bool, error) {
+	_, err := c.run(ctx, "rev-parse", "--verify", "--quiet", "refs/heads/"+name)
+	if err == nil {
+		return true, nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return false, nil
+	}
+	return false, err
+}
+
+// log runs `git log` with the given revision arguments and parses the commits.
+func (c *Client) log(ctx context.Context, args ...string) ([]entities.Commit, error) {
+	format := strings.Join([]string{"%H", "%an", "%aI", "%s", "%b"}, fieldSeparator) + recordSeparator
+	output, err := c.run(ctx, append([]string{"log", "--format=" + format}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	return parseLog(output)
+}
+
+// run executes a git command and returns its stdout.
+// Failures carry git's stderr so "not a git repository" and similar reach the user.
+func (c *Client) run(ctx context.Context, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = c.workDir
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return "", fmt.Errorf("git %s: %s: %w", args[0], message, err)
+	}
+	return stdout.String(), nil
+}
+
+// parseLog splits `git log` output produced with the record and field separators.
+func parseLog(output string) ([]entities.Commit, error) {
+	commits := []entities.Commit{}
+	for _, record := range strings.Split(output, recordSeparator) {
+		record = strings.TrimLeft(record, "\n")
+		if record == "" {
+			continue
+		}
+		fields := strings.SplitN(record, fieldSeparator, 5)
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("unexpected git log record: %q", record)
+		}
+		date, err := time.Parse(time.RFC3339, fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid commit date %q: %w", fields[2], err)
+		}
+		commits = append(commits, entities.Commit{
+			Hash:    fields[0],
+			Author:  fields[1],
+			Date:    date,
+			Subject: fields[3],
+			Body:    strings.TrimSpace(fields[4]),
+		})
+	}
+	return commits, nil
+}
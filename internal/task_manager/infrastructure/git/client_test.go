@@ -0,0 +1,172 @@
+package git_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runGit runs a git command in dir and returns its trimmed output
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v failed: %s", args, output)
+	return strings.TrimSpace(string(output))
+}
+
+// newRepo creates an empty git repository
+func newRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	runGit(t, dir, "init", "--quiet", "--initial-branch=main")
+	runGit(t, dir, "config", "user.name", "Test User")
+	runGit(t, dir, "config", "user.email", "test@example.com")
+	return dir
+}
+
+func TestClient_CheckoutBranch(t *testing.T) {
+	dir := newRepo(t)
+	runGit(t, dir, "commit", "--quiet", "--allow-empty", "-m", "Initial commit")
+	client := git.NewClient(dir)
+
+	created, err := client.CheckoutBranch(context.Background(), "TM-task-1-login")
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, "TM-task-1-login", runGit(t, dir, "rev-parse", "--abbrev-ref", "HEAD"))
+
+	runGit(t, dir, "checkout", "--quiet", "main")
+	created, err = client.CheckoutBranch(context.Background(), "TM-task-1-login")
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, "TM-task-1-login", runGit(t, dir, "rev-parse", "--abbrev-ref", "HEAD"))
+}
+
+func TestClient_CheckoutBranch_InvalidName(t *testing.T) {
+	client := git.NewClient(newRepo(t))
+
+	_, err := client.CheckoutBranch(context.Background(), "bad..name")
+	assert.True(t, errors.Is(err, tmerrors.ErrInvalidArgument), "got %v", err)
+}
+
+func TestClient_FindCommits(t *testing.T) {
+	dir := newRepo(t)
+	runGit(t, dir, "commit", "--quiet", "--allow-empty", "-m", "TM-task-1: first")
+	runGit(t, dir, "commit", "--quiet", "--allow-empty", "-m", "Unrelated")
+	runGit(t, dir, "commit", "--quiet", "--allow-empty", "-m", "Second", "-m", "Refs: TM-task-1")
+	client := git.NewClient(dir)
+
+	commits, err := client.FindCommits(context.Background(), "TM-task-1")
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	assert.Equal(t, "Second", commits[0].Subject)
+	assert.Equal(t, "Refs: TM-task-1", commits[0].Body)
+	assert.Equal(t, "TM-task-1: first", commits[1].Subject)
+	assert.Equal(t, "Test User", commits[1].Author)
+	assert.Len(t, commits[1].Hash, 40)
+	assert.False(t, commits[1].Date.IsZero())
+}
+
+func TestClient_FindCommits_EmptyRepository(t *testing.T) {
+	client := git.NewClient(newRepo(t))
+
+	commits, err := client.FindCommits(context.Background(), "TM-task-1")
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+}
+
+func TestClient_FindCommits_NotARepository(t *testing.T) {
+	client := git.NewClient(t.TempDir())
+
+	_, err := client.FindCommits(context.Background(), "TM-task-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a git repository")
+}
+
+func TestClient_CurrentBranch(t *testing.T) {
+	dir := newRepo(t)
+	runGit(t, dir, "commit", "--quiet", "--allow-empty", "-m", "Initial commit")
+	client := git.NewClient(dir)
+
+	branch, err := client.CurrentBranch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "main", branch)
+
+	runGit(t, dir, "checkout", "--quiet", "--detach")
+	branch, err = client.CurrentBranch(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, branch)
+}
+
+func TestClient_MergedCommits(t *testing.T) {
+	dir := newRepo(t)
+	runGit(t, dir, "commit", "--quiet", "--allow-empty", "-m", "Initial commit")
+	client := git.NewClient(dir)
+
+	commits, err := client.MergedCommits(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, commits, "no merge has happened yet")
+
+	runGit(t, dir, "checkout", "--quiet", "-b", "feature")
+	runGit(t, dir, "commit", "--quiet", "--allow-empty", "-m", "First")
+	runGit(t, dir, "commit", "--quiet", "--allow-empty", "-m", "Second", "-m", "Closes: TM-task-1")
+	runGit(t, dir, "checkout", "--quiet", "main")
+	runGit(t, dir, "merge", "--quiet", "--no-ff", "-m", "Merge feature", "feature")
+
+	commits, err = client.MergedCommits(context.Background())
+	require.NoError(t, err)
+	require.Len(t, commits, 3)
+	assert.Equal(t, "Merge feature", commits[0].Subject)
+	assert.Equal(t, "Second", commits[1].Subject)
+	assert.Equal(t, "Closes: TM-task-1", commits[1].Body)
+	assert.Equal(t, "First", commits[2].Subject)
+}
+
+func TestClient_InstallHook(t *testing.T) {
+	dir := newRepo(t)
+	client := git.NewClient(dir)
+
+	path, err := client.InstallHook(context.Background(), "commit-msg", "#!/bin/sh\nexit 0\n", false)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, ".git", "hooks", "commit-msg"), path)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.NotZero(t, info.Mode()&0100, "hook must be executable")
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(content), "#!/bin/sh\n# Installed by tm"), string(content))
+	assert.True(t, strings.HasSuffix(string(content), "\nexit 0\n"))
+
+	// Reinstalling replaces a hook tm wrote
+	_, err = client.InstallHook(context.Background(), "commit-msg", "#!/bin/sh\nexit 1\n", false)
+	require.NoError(t, err)
+}
+
+func TestClient_InstallHook_KeepsForeignHooks(t *testing.T) {
+	dir := newRepo(t)
+	client := git.NewClient(dir)
+	foreign := filepath.Join(dir, ".git", "hooks", "post-merge")
+	require.NoError(t, os.WriteFile(foreign, []byte("#!/bin/sh\necho mine\n"), 0644))
+
+	_, err := client.InstallHook(context.Background(), "post-merge", "#!/bin/sh\nexit 0\n", false)
+	assert.True(t, errors.Is(err, tmerrors.ErrAlreadyExists), "got %v", err)
+	content, _ := os.ReadFile(foreign)
+	assert.Equal(t, "#!/bin/sh\necho mine\n", string(content))
+
+	_, err = client.InstallHook(context.Background(), "post-merge", "#!/bin/sh\nexit 0\n", true)
+	require.NoError(t, err)
+	info, err := os.Stat(foreign)
+	require.NoError(t, err)
+	assert.NotZero(t, info.Mode()&0100, "replaced hook must be executable")
+}
@@ -0,0 +1,199 @@
+// Package hooks runs user-provided scripts around task manager state transitions.
+package hooks
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Phases a hook can run in.
+const (
+	// PhasePre hooks run before a transition is persisted and can veto it with a non-zero exit.
+	PhasePre = "pre"
+	// PhasePost hooks run after a transition is persisted and only observe it.
+	PhasePost = "post"
+)
+
+// AnyEvent is the hook key matching every event.
+const AnyEvent = "*"
+
+// DefaultTimeout bounds how long a single hook may run.
+const DefaultTimeout = 30 * time.Second
+
+// Hook is a single executable bound to a phase and an event.
+type Hook struct {
+	Phase   string // PhasePre or PhasePost
+	Event   string // Short event name, e.g. "ac.failed", or AnyEvent
+	Command string // Executable path (hooks directory) or shell command line (config file)
+	Shell   bool   // Run Command through `sh -c`
+}
+
+// Config is the set of hooks available to a project.
+type Config struct {
+	Hooks   []Hook
+	Timeout time.Duration
+}
+
+// fileConfig mirrors the `hooks` section of ~/.tm/config.yaml:
+//
+//	hooks:
+//	  timeout: 30s
+//	  pre:
+//	    task.status_changed:
+//	      - ~/bin/check-branch
+//	  post:
+//	    ac.failed:
+//	      - ~/bin/notify-chat
+type fileConfig struct {
+	Hooks struct {
+		Timeout string              `yaml:"timeout"`
+		Pre     map[string][]string `yaml:"pre"`
+		Post    map[string][]string `yaml:"post"`
+	} `yaml:"hooks"`
+}
+
+// LoadConfig collects hooks from the global config file and the project hooks directory.
+// Missing files and directories simply contribute no hooks.
+func LoadConfig(configPath, hooksDir string) (Config, error) {
+	cfg := Config{Timeout: DefaultTimeout}
+
+	fromFile, timeout, err := loadConfigFile(configPath)
+	if err != nil {
+		return cfg, err
+	}
+	if timeout > 0 {
+		cfg.Timeout = timeout
+	}
+	cfg.Hooks = append(cfg.Hooks, fromFile...)
+
+	fromDir, err := loadHooksDir(hooksDir)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.Hooks = append(cfg.Hooks, fromDir...)
+
+	return cfg, nil
+}
+
+// Matching returns the hooks registered for a phase and short event name, in configuration order.
+func (c Config) Matching(phase, event string) []Hook {
+	var matched []Hook
+	for _, hook := range c.Hooks {
+		if hook.Phase == phase && (hook.Event == event || hook.Event == AnyEvent) {
+			matched = append(matched, hook)
+		}
+	}
+	return matched
+}
+
+// loadConfigFile reads hooks declared in the global YAML config.
+func loadConfigFile(path string) ([]Hook, time.Duration, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, 0, nil
+		}
+		return nil, 0, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	var parsed fileConfig
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return nil, 0, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+
+	var timeout time.Duration
+	if parsed.Hooks.Timeout != "" {
+		timeout, err = time.ParseDuration(parsed.Hooks.Timeout)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid hooks.timeout in %s: %w", path, err)
+		}
+	}
+
+	var hooks []Hook
+	hooks = append(hooks, configHooks(PhasePre, parsed.Hooks.Pre)...)
+	hooks = append(hooks, configHooks(PhasePost, parsed.Hooks.Post)...)
+	return hooks, timeout, nil
+}
+
+// configHooks flattens an event -> commands map, sorted by event for deterministic order.
+func configHooks(phase string, byEvent map[string][]string) []Hook {
+	events := make([]string, 0, len(byEvent))
+	for event := range byEvent {
+		events = append(events, event)
+	}
+	sort.Strings(events)
+
+	var hooks []Hook
+	for _, event := range events {
+		for _, command := range byEvent[event] {
+			hooks = append(hooks, Hook{
+				Phase:   phase,
+				Event:   ShortEventName(event),
+				Command: command,
+				Shell:   true,
+			})
+		}
+	}
+	return hooks
+}
+
+// loadHooksDir discovers executables named <phase>-<event>[.ext] in the project hooks directory,
+// e.g. post-ac.failed.sh or pre-task.status_changed. Non-executable files are ignored.
+func loadHooksDir(dir string) ([]Hook, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read hooks directory %s: %w", dir, err)
+	}
+
+	var hooks []Hook
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.Mode()&0111 == 0 {
+			continue
+		}
+
+		phase, event, ok := parseHookFileName(entry.Name())
+		if !ok {
+			continue
+		}
+		hooks = append(hooks, Hook{
+			Phase:   phase,
+			Event:   event,
+			Command: filepath.Join(dir, entry.Name()),
+		})
+	}
+	return hooks, nil
+}
+
+// parseHookFileName splits "post-ac.failed.sh" into ("post", "ac.failed").
+// Event names are <entity>.<action>, so anything after the second dot is an extension.
+func parseHookFileName(name string) (string, string, bool) {
+	var phase string
+	switch {
+	case strings.HasPrefix(name, PhasePre+"-"):
+		phase = PhasePre
+	case strings.HasPrefix(name, PhasePost+"-"):
+		phase = PhasePost
+	default:
+		return "", "", false
+	}
+
+	event := strings.TrimPrefix(name, phase+"-")
+	parts := strings.SplitN(event, ".", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return phase, parts[0] + "." + parts[1], true
+}
@@ -0,0 +1,90 @@
+package hooks_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/hooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, path, content string, mode os.FileMode) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), mode))
+}
+
+func TestLoadConfig_MissingSources(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg, err := hooks.LoadConfig(filepath.Join(dir, "config.yaml"), filepath.Join(dir, "hooks"))
+	require.NoError(t, err)
+	assert.Empty(t, cfg.Hooks)
+	assert.Equal(t, hooks.DefaultTimeout, cfg.Timeout)
+}
+
+func TestLoadConfig_ConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	writeFile(t, configPath, `hooks:
+  timeout: 5s
+  pre:
+    task.status_changed:
+      - ./check-branch
+  post:
+    task-manager.ac.failed:
+      - notify --channel dev
+    "*":
+      - log-everything
+`, 0644)
+
+	cfg, err := hooks.LoadConfig(configPath, filepath.Join(dir, "hooks"))
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, cfg.Timeout)
+
+	pre := cfg.Matching(hooks.PhasePre, "task.status_changed")
+	require.Len(t, pre, 1)
+	assert.Equal(t, "./check-branch", pre[0].Command)
+	assert.True(t, pre[0].Shell)
+
+	// Full event names are normalized; wildcard hooks match every event
+	post := cfg.Matching(hooks.PhasePost, "ac.failed")
+	require.Len(t, post, 2)
+	assert.Equal(t, "log-everything", post[0].Command)
+	assert.Equal(t, "notify --channel dev", post[1].Command)
+
+	assert.Empty(t, cfg.Matching(hooks.PhasePre, "ac.failed"))
+}
+
+func TestLoadConfig_InvalidTimeout(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	writeFile(t, configPath, "hooks:\n  timeout: soon\n", 0644)
+
+	_, err := hooks.LoadConfig(configPath, "")
+	assert.Error(t, err)
+}
+
+func TestLoadConfig_HooksDirectory(t *testing.T) {
+	dir := t.TempDir()
+	hooksDir := filepath.Join(dir, "hooks")
+	writeFile(t, filepath.Join(hooksDir, "post-ac.failed.sh"), "#!/bin/sh\n", 0755)
+	writeFile(t, filepath.Join(hooksDir, "pre-iteration.completed"), "#!/bin/sh\n", 0755)
+	writeFile(t, filepath.Join(hooksDir, "post-task.completed.sample"), "#!/bin/sh\n", 0644) // not executable
+	writeFile(t, filepath.Join(hooksDir, "README"), "docs", 0755)                            // not a hook name
+
+	cfg, err := hooks.LoadConfig(filepath.Join(dir, "config.yaml"), hooksDir)
+	require.NoError(t, err)
+	require.Len(t, cfg.Hooks, 2)
+
+	post := cfg.Matching(hooks.PhasePost, "ac.failed")
+	require.Len(t, post, 1)
+	assert.Equal(t, filepath.Join(hooksDir, "post-ac.failed.sh"), post[0].Command)
+	assert.False(t, post[0].Shell)
+
+	assert.Len(t, cfg.Matching(hooks.PhasePre, "iteration.completed"), 1)
+	assert.Empty(t, cfg.Matching(hooks.PhasePost, "task.completed"))
+}
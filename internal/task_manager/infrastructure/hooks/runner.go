@@ -0,0 +1,136 @@
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/logger"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/procgroup"
+)
+
+// Compile-time check that Runner can guard transitions
+var _ events.TransitionGuard = (*Runner)(nil)
+
+// maxVetoMessage caps how much hook output is echoed back in a veto error.
+const maxVetoMessage = 500
+
+// Runner executes configured hooks with the entity JSON on stdin.
+// Pre-hooks act as an events.TransitionGuard; post-hooks are driven by an event bus subscription.
+type Runner struct {
+	config  Config
+	project string
+	logger  logger.Logger
+}
+
+// NewRunner creates a hook runner for a project.
+func NewRunner(config Config, project string, log logger.Logger) *Runner {
+	return &Runner{
+		config:  config,
+		project: project,
+		logger:  log,
+	}
+}
+
+// BeforeTransition runs the pre-hooks for the event in order.
+// The first hook exiting non-zero (or timing out) vetoes the transition with ErrRejected.
+func (r *Runner) BeforeTransition(ctx context.Context, event events.Event) error {
+	for _, hook := range r.config.Matching(PhasePre, ShortEventName(event.Type)) {
+		output, err := r.run(ctx, hook, PhasePre, event)
+		if err != nil {
+			return fmt.Errorf("%w: pre-hook %s vetoed %s of %s %s: %s",
+				tmerrors.ErrRejected, hookName(hook), ShortEventName(event.Type), event.EntityType, event.EntityID, vetoMessage(output, err))
+		}
+	}
+	return nil
+}
+
+// AfterEvent runs the post-hooks for a published event. It matches events.Handler.
+// Post-hooks only observe: every hook runs, and failures are returned for the bus to log.
+func (r *Runner) AfterEvent(ctx context.Context, event events.Event) error {
+	var failures []error
+	for _, hook := range r.config.Matching(PhasePost, ShortEventName(event.Type)) {
+		output, err := r.run(ctx, hook, PhasePost, event)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("post-hook %s failed: %w", hookName(hook), err))
+			continue
+		}
+		if output != "" {
+			r.logger.Debug("post-hook output", "hook", hookName(hook), "output", output)
+		}
+	}
+	return errors.Join(failures...)
+}
+
+// ShortEventName strips the plugin source prefix: "task-manager.ac.failed" becomes "ac.failed".
+func ShortEventName(eventType string) string {
+	return strings.TrimPrefix(eventType, events.PluginSourceName+".")
+}
+
+// run executes a single hook and returns its combined output.
+func (r *Runner) run(ctx context.Context, hook Hook, phase string, event events.Event) (string, error) {
+	stdin, err := json.Marshal(event.Payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode entity: %w", err)
+	}
+
+	timeout := r.config.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if hook.Shell {
+		cmd = exec.CommandContext(ctx, "sh", "-c", hook.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, hook.Command)
+	}
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Env = append(os.Environ(),
+		"TM_HOOK_PHASE="+phase,
+		"TM_HOOK_EVENT="+event.Type,
+		"TM_ENTITY_TYPE="+event.EntityType,
+		"TM_ENTITY_ID="+event.EntityID,
+		"TM_PROJECT="+r.project,
+	)
+
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	procgroup.KillOnCancel(cmd)
+
+	err = cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return output.String(), fmt.Errorf("timed out after %s", timeout)
+	}
+	return strings.TrimSpace(output.String()), err
+}
+
+// hookName is a short label for a hook in messages.
+func hookName(hook Hook) string {
+	if hook.Shell {
+		return fmt.Sprintf("%q", hook.Command)
+	}
+	return filepath.Base(hook.Command)
+}
+
+// vetoMessage prefers what the hook printed over the bare exit status.
+func vetoMessage(output string, err error) string {
+	if output == "" {
+		return err.Error()
+	}
+	if len(output) > maxVetoMessage {
+		output = output[:maxVetoMessage] + "..."
+	}
+	return output
+}
@@ -0,0 +1,96 @@
+package hooks_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/logger"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/hooks"
+	infralogger "github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRunner(hookList ...hooks.Hook) *hooks.Runner {
+	cfg := hooks.Config{Hooks: hookList, Timeout: 5 * time.Second}
+	return hooks.NewRunner(cfg, "demo", infralogger.NewStandardLogger(logger.LevelError))
+}
+
+func failedACEvent() events.Event {
+	ac := &entities.AcceptanceCriteriaEntity{ID: "TM-ac-1", TaskID: "TM-task-1", Status: entities.ACStatusFailed}
+	return events.NewEvent(events.EventACFailed, events.EntityTypeAC, ac.ID, ac, nil)
+}
+
+func TestRunner_BeforeTransition_AllowsOnZeroExit(t *testing.T) {
+	runner := newTestRunner(hooks.Hook{Phase: hooks.PhasePre, Event: "ac.failed", Command: "exit 0", Shell: true})
+
+	assert.NoError(t, runner.BeforeTransition(context.Background(), failedACEvent()))
+}
+
+func TestRunner_BeforeTransition_VetoesOnNonZeroExit(t *testing.T) {
+	runner := newTestRunner(hooks.Hook{Phase: hooks.PhasePre, Event: "ac.failed", Command: "echo 'not today' >&2; exit 1", Shell: true})
+
+	err := runner.BeforeTransition(context.Background(), failedACEvent())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, tmerrors.ErrRejected)
+	assert.Contains(t, err.Error(), "not today")
+	assert.Contains(t, err.Error(), "TM-ac-1")
+}
+
+func TestRunner_BeforeTransition_IgnoresOtherEvents(t *testing.T) {
+	runner := newTestRunner(hooks.Hook{Phase: hooks.PhasePre, Event: "task.completed", Command: "exit 1", Shell: true})
+
+	assert.NoError(t, runner.BeforeTransition(context.Background(), failedACEvent()))
+}
+
+func TestRunner_BeforeTransition_TimeoutVetoes(t *testing.T) {
+	cfg := hooks.Config{
+		Hooks:   []hooks.Hook{{Phase: hooks.PhasePre, Event: "ac.failed", Command: "sleep 5", Shell: true}},
+		Timeout: 100 * time.Millisecond,
+	}
+	runner := hooks.NewRunner(cfg, "demo", infralogger.NewStandardLogger(logger.LevelError))
+
+	err := runner.BeforeTransition(context.Background(), failedACEvent())
+	assert.ErrorIs(t, err, tmerrors.ErrRejected)
+	assert.Contains(t, err.Error(), "timed out")
+}
+
+func TestRunner_AfterEvent_PassesEntityJSONAndEnvironment(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out")
+	runner := newTestRunner(hooks.Hook{
+		Phase:   hooks.PhasePost,
+		Event:   "ac.failed",
+		Command: `cat > "` + out + `"; echo "$TM_HOOK_PHASE $TM_HOOK_EVENT $TM_ENTITY_TYPE $TM_ENTITY_ID $TM_PROJECT" >> "` + out + `"`,
+		Shell:   true,
+	})
+
+	require.NoError(t, runner.AfterEvent(context.Background(), failedACEvent()))
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"id":"TM-ac-1"`)
+	assert.Contains(t, string(data), "post task-manager.ac.failed ac TM-ac-1 demo")
+}
+
+func TestRunner_AfterEvent_RunsAllHooksAndReportsFailures(t *testing.T) {
+	marker := filepath.Join(t.TempDir(), "ran")
+	runner := newTestRunner(
+		hooks.Hook{Phase: hooks.PhasePost, Event: "ac.failed", Command: "exit 3", Shell: true},
+		hooks.Hook{Phase: hooks.PhasePost, Event: "*", Command: `touch "` + marker + `"`, Shell: true},
+	)
+
+	err := runner.AfterEvent(context.Background(), failedACEvent())
+	assert.Error(t, err)
+	assert.FileExists(t, marker, "later hooks still run after a failure")
+}
+
+func TestShortEventName(t *testing.T) {
+	assert.Equal(t, "ac.failed", hooks.ShortEventName(events.EventACFailed))
+	assert.Equal(t, "task.completed", hooks.ShortEventName("task.completed"))
+}
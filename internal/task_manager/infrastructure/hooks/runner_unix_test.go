@@ -0,0 +1,53 @@
+//go:build unix
+
+package hooks_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/logger"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/hooks"
+	infralogger "github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunner_TimeoutKillsBackgroundProcesses(t *testing.T) {
+	pidFile := filepath.Join(t.TempDir(), "pid")
+	cfg := hooks.Config{
+		Hooks:   []hooks.Hook{{Phase: hooks.PhasePre, Event: "ac.failed", Command: "sleep 77 & echo $! > " + pidFile + "; wait", Shell: true}},
+		Timeout: 200 * time.Millisecond,
+	}
+	runner := hooks.NewRunner(cfg, "demo", infralogger.NewStandardLogger(logger.LevelError))
+
+	err := runner.BeforeTransition(context.Background(), failedACEvent())
+	require.ErrorIs(t, err, tmerrors.ErrRejected)
+
+	data, err := os.ReadFile(pidFile)
+	require.NoError(t, err)
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return !processAlive(pid) }, 2*time.Second, 20*time.Millisecond,
+		"background process %d survived the timeout", pid)
+}
+
+// processAlive reports whether pid is running; an unreaped zombie counts as dead.
+func processAlive(pid int) bool {
+	if syscall.Kill(pid, 0) != nil {
+		return false
+	}
+	stat, err := os.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+	if err != nil {
+		return true
+	}
+	fields := strings.Fields(string(stat[strings.LastIndexByte(string(stat), ')')+1:]))
+	return len(fields) == 0 || fields[0] != "Z"
+}
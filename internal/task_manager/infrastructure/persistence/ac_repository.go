@@ -37,7 +37,7 @@ func NewSQLiteAcceptanceCriteriaRepository(db *sql.DB, logger logger.Logger) *SQ
 func (r *SQLiteAcceptanceCriteriaRepository) SaveAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
 	// Check if AC already exists
 	var exists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM acceptance_criteria WHERE id = ?", ac.ID).Scan(&exists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM acceptance_criteria WHERE id = ?", ac.ID).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check AC existence: %w", err)
 	}
@@ -47,7 +47,7 @@ func (r *SQLiteAcceptanceCriteriaRepository) SaveAC(ctx context.Context, ac *ent
 
 	// Verify task exists
 	var taskExists int
-	err = r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", ac.TaskID).Scan(&taskExists)
+	err = conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", ac.TaskID).Scan(&taskExists)
 	if err != nil {
 		return fmt.Errorf("failed to verify task: %w", err)
 	}
@@ -55,14 +55,15 @@ func (r *SQLiteAcceptanceCriteriaRepository) SaveAC(ctx context.Context, ac *ent
 		return fmt.Errorf("%w: task %s not found", tmerrors.ErrNotFound, ac.TaskID)
 	}
 
-	_, err = r.DB.ExecContext(
+	_, err = conn(ctx, r.DB).ExecContext(
 		ctx,
-		"INSERT INTO acceptance_criteria (id, task_id, description, verification_type, status, notes, testing_instructions, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
-		ac.ID, ac.TaskID, ac.Description, string(ac.VerificationType), string(ac.Status), ac.Notes, ac.TestingInstructions, ac.CreatedAt, ac.UpdatedAt,
+		"INSERT INTO acceptance_criteria (id, task_id, description, verification_type, status, notes, testing_instructions, check_command, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		ac.ID, ac.TaskID, ac.Description, string(ac.VerificationType), string(ac.Status), ac.Notes, ac.TestingInstructions, ac.CheckCommand, ac.CreatedAt, ac.UpdatedAt,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to insert AC: %w", err)
 	}
+	ac.Version = 1
 
 	return nil
 }
@@ -72,11 +73,11 @@ func (r *SQLiteAcceptanceCriteriaRepository) GetAC(ctx context.Context, id strin
 	var ac entities.AcceptanceCriteriaEntity
 
 	var testingInstructions sql.NullString
-	err := r.DB.QueryRowContext(
+	err := conn(ctx, r.DB).QueryRowContext(
 		ctx,
-		"SELECT id, task_id, description, verification_type, status, notes, testing_instructions, created_at, updated_at FROM acceptance_criteria WHERE id = ?",
+		"SELECT id, task_id, description, verification_type, status, notes, testing_instructions, check_command, created_at, updated_at, version FROM acceptance_criteria WHERE id = ?",
 		id,
-	).Scan(&ac.ID, &ac.TaskID, &ac.Description, (*string)(&ac.VerificationType), (*string)(&ac.Status), &ac.Notes, &testingInstructions, &ac.CreatedAt, &ac.UpdatedAt)
+	).Scan(&ac.ID, &ac.TaskID, &ac.Description, (*string)(&ac.VerificationType), (*string)(&ac.Status), &ac.Notes, &testingInstructions, &ac.CheckCommand, &ac.CreatedAt, &ac.UpdatedAt, &ac.Version)
 
 	if testingInstructions.Valid {
 		ac.TestingInstructions = testingInstructions.String
@@ -94,9 +95,9 @@ func (r *SQLiteAcceptanceCriteriaRepository) GetAC(ctx context.Context, id strin
 
 // ListAC returns all acceptance criteria for a task.
 func (r *SQLiteAcceptanceCriteriaRepository) ListAC(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
-		"SELECT id, task_id, description, verification_type, status, notes, testing_instructions, created_at, updated_at FROM acceptance_criteria WHERE task_id = ? ORDER BY created_at ASC",
+		"SELECT id, task_id, description, verification_type, status, notes, testing_instructions, check_command, created_at, updated_at, version FROM acceptance_criteria WHERE task_id = ? ORDER BY created_at ASC",
 		taskID,
 	)
 	if err != nil {
@@ -108,7 +109,7 @@ func (r *SQLiteAcceptanceCriteriaRepository) ListAC(ctx context.Context, taskID
 	for rows.Next() {
 		var ac entities.AcceptanceCriteriaEntity
 		var testingInstructions sql.NullString
-		err := rows.Scan(&ac.ID, &ac.TaskID, &ac.Description, (*string)(&ac.VerificationType), (*string)(&ac.Status), &ac.Notes, &testingInstructions, &ac.CreatedAt, &ac.UpdatedAt)
+		err := rows.Scan(&ac.ID, &ac.TaskID, &ac.Description, (*string)(&ac.VerificationType), (*string)(&ac.Status), &ac.Notes, &testingInstructions, &ac.CheckCommand, &ac.CreatedAt, &ac.UpdatedAt, &ac.Version)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan AC: %w", err)
 		}
@@ -126,23 +127,18 @@ func (r *SQLiteAcceptanceCriteriaRepository) ListAC(ctx context.Context, taskID
 }
 
 // UpdateAC updates an existing acceptance criterion.
+// A criterion read at a version that is no longer stored is rejected with ErrConflict.
 func (r *SQLiteAcceptanceCriteriaRepository) UpdateAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
-	result, err := r.DB.ExecContext(
+	err := conn(ctx, r.DB).QueryRowContext(
 		ctx,
-		"UPDATE acceptance_criteria SET task_id = ?, description = ?, verification_type = ?, status = ?, notes = ?, testing_instructions = ?, updated_at = ? WHERE id = ?",
-		ac.TaskID, ac.Description, string(ac.VerificationType), string(ac.Status), ac.Notes, ac.TestingInstructions, ac.UpdatedAt, ac.ID,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to update AC: %w", err)
+		"UPDATE acceptance_criteria SET task_id = ?, description = ?, verification_type = ?, status = ?, notes = ?, testing_instructions = ?, check_command = ?, updated_at = ?, version = version + 1 WHERE id = ?"+versionGuard+" RETURNING version",
+		append([]interface{}{ac.TaskID, ac.Description, string(ac.VerificationType), string(ac.Status), ac.Notes, ac.TestingInstructions, ac.CheckCommand, ac.UpdatedAt, ac.ID}, versionArgs(ac.Version)...)...,
+	).Scan(&ac.Version)
+	if err == sql.ErrNoRows {
+		return updateMissed(ctx, conn(ctx, r.DB), "acceptance_criteria", "id", ac.ID, "AC")
 	}
-
-	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
-	if rowsAffected == 0 {
-		return fmt.Errorf("%w: AC %s not found", tmerrors.ErrNotFound, ac.ID)
+		return fmt.Errorf("failed to update AC: %w", err)
 	}
 
 	return nil
@@ -150,7 +146,7 @@ func (r *SQLiteAcceptanceCriteriaRepository) UpdateAC(ctx context.Context, ac *e
 
 // DeleteAC removes an acceptance criterion from storage.
 func (r *SQLiteAcceptanceCriteriaRepository) DeleteAC(ctx context.Context, id string) error {
-	result, err := r.DB.ExecContext(ctx, "DELETE FROM acceptance_criteria WHERE id = ?", id)
+	result, err := conn(ctx, r.DB).ExecContext(ctx, "DELETE FROM acceptance_criteria WHERE id = ?", id)
 	if err != nil {
 		return fmt.Errorf("failed to delete AC: %w", err)
 	}
@@ -174,9 +170,9 @@ func (r *SQLiteAcceptanceCriteriaRepository) ListACByTask(ctx context.Context, t
 
 // ListACByIteration returns all acceptance criteria for all tasks in an iteration.
 func (r *SQLiteAcceptanceCriteriaRepository) ListACByIteration(ctx context.Context, iterationNum int) ([]*entities.AcceptanceCriteriaEntity, error) {
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
-		`SELECT ac.id, ac.task_id, ac.description, ac.verification_type, ac.status, ac.notes, ac.testing_instructions, ac.created_at, ac.updated_at
+		`SELECT ac.id, ac.task_id, ac.description, ac.verification_type, ac.status, ac.notes, ac.testing_instructions, ac.check_command, ac.created_at, ac.updated_at, ac.version
 		 FROM acceptance_criteria ac
 		 JOIN tasks t ON ac.task_id = t.id
 		 JOIN iteration_tasks it ON t.id = it.task_id
@@ -193,7 +189,7 @@ func (r *SQLiteAcceptanceCriteriaRepository) ListACByIteration(ctx context.Conte
 	for rows.Next() {
 		var ac entities.AcceptanceCriteriaEntity
 		var testingInstructions sql.NullString
-		err := rows.Scan(&ac.ID, &ac.TaskID, &ac.Description, (*string)(&ac.VerificationType), (*string)(&ac.Status), &ac.Notes, &testingInstructions, &ac.CreatedAt, &ac.UpdatedAt)
+		err := rows.Scan(&ac.ID, &ac.TaskID, &ac.Description, (*string)(&ac.VerificationType), (*string)(&ac.Status), &ac.Notes, &testingInstructions, &ac.CheckCommand, &ac.CreatedAt, &ac.UpdatedAt, &ac.Version)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan AC: %w", err)
 		}
@@ -212,7 +208,7 @@ func (r *SQLiteAcceptanceCriteriaRepository) ListACByIteration(ctx context.Conte
 
 // ListFailedAC returns all acceptance criteria with status "failed".
 func (r *SQLiteAcceptanceCriteriaRepository) ListFailedAC(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
-	query := `SELECT ac.id, ac.task_id, ac.description, ac.verification_type, ac.status, ac.notes, ac.testing_instructions, ac.created_at, ac.updated_at
+	query := `SELECT ac.id, ac.task_id, ac.description, ac.verification_type, ac.status, ac.notes, ac.testing_instructions, ac.check_command, ac.created_at, ac.updated_at, ac.version
 		      FROM acceptance_criteria ac`
 
 	var joins []string
@@ -252,7 +248,7 @@ func (r *SQLiteAcceptanceCriteriaRepository) ListFailedAC(ctx context.Context, f
 	}
 	query += " ORDER BY ac.created_at ASC"
 
-	rows, err := r.DB.QueryContext(ctx, query, args...)
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query failed ACs: %w", err)
 	}
@@ -262,7 +258,7 @@ func (r *SQLiteAcceptanceCriteriaRepository) ListFailedAC(ctx context.Context, f
 	for rows.Next() {
 		var ac entities.AcceptanceCriteriaEntity
 		var testingInstructions sql.NullString
-		err := rows.Scan(&ac.ID, &ac.TaskID, &ac.Description, (*string)(&ac.VerificationType), (*string)(&ac.Status), &ac.Notes, &testingInstructions, &ac.CreatedAt, &ac.UpdatedAt)
+		err := rows.Scan(&ac.ID, &ac.TaskID, &ac.Description, (*string)(&ac.VerificationType), (*string)(&ac.Status), &ac.Notes, &testingInstructions, &ac.CheckCommand, &ac.CreatedAt, &ac.UpdatedAt, &ac.Version)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan AC: %w", err)
 		}
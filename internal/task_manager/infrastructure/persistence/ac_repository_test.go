@@ -145,6 +145,98 @@ func TestUpdateAC(t *testing.T) {
 	}
 }
 
+func TestACCheckCommandRoundTrip(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	acRepo := persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	// Setup
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+
+	task, _ := entities.NewTaskEntity("task-1", "track-1", "Task", "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+	taskRepo.SaveTask(ctx, task)
+
+	ac := entities.NewAcceptanceCriteriaEntity("ac-1", "task-1", "Tests pass", entities.VerificationTypeManual, "", time.Now().UTC(), time.Now().UTC())
+	ac.SetCheckCommand("go test ./...")
+	if err := acRepo.SaveAC(ctx, ac); err != nil {
+		t.Fatalf("failed to save AC: %v", err)
+	}
+
+	retrieved, _ := acRepo.GetAC(ctx, "ac-1")
+	if retrieved.CheckCommand != "go test ./..." || retrieved.VerificationType != entities.VerificationTypeAutomated {
+		t.Errorf("expected automated AC with check, got %q (%s)", retrieved.CheckCommand, retrieved.VerificationType)
+	}
+
+	// Update check command
+	retrieved.SetCheckCommand("make check")
+	if err := acRepo.UpdateAC(ctx, retrieved); err != nil {
+		t.Fatalf("failed to update AC: %v", err)
+	}
+
+	listed, _ := acRepo.ListAC(ctx, "task-1")
+	if len(listed) != 1 || listed[0].CheckCommand != "make check" {
+		t.Errorf("expected updated check command in list, got %+v", listed)
+	}
+}
+
+func TestInitSchema_MigratesACCheckCommand(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	// Rewind to a v9 database whose acceptance_criteria table predates check commands
+	statements := []string{
+		"DROP TABLE acceptance_criteria",
+		`CREATE TABLE acceptance_criteria (
+			id TEXT PRIMARY KEY,
+			task_id TEXT NOT NULL,
+			description TEXT NOT NULL,
+			verification_type TEXT NOT NULL,
+			status TEXT NOT NULL,
+			notes TEXT,
+			testing_instructions TEXT,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		"INSERT INTO acceptance_criteria (id, task_id, description, verification_type, status, notes, testing_instructions, created_at, updated_at) VALUES ('ac-1', 'task-1', 'Old AC', 'manual', 'not_started', '', '', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)",
+		"UPDATE project_metadata SET value = '9' WHERE key = 'schema_version'",
+	}
+	for _, stmt := range statements {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("failed to prepare v9 database: %v", err)
+		}
+	}
+
+	if err := persistence.InitSchema(db); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	acRepo := persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger())
+	ac, err := acRepo.GetAC(context.Background(), "ac-1")
+	if err != nil {
+		t.Fatalf("failed to get migrated AC: %v", err)
+	}
+	if ac.CheckCommand != "" {
+		t.Errorf("expected empty check command after migration, got %q", ac.CheckCommand)
+	}
+
+	var version int
+	if err := db.QueryRow("SELECT CAST(value AS INTEGER) FROM project_metadata WHERE key = 'schema_version'").Scan(&version); err != nil {
+		t.Fatalf("failed to read schema version: %v", err)
+	}
+	if version != persistence.SchemaVersion {
+		t.Errorf("expected schema version %d, got %d", persistence.SchemaVersion, version)
+	}
+}
+
 func TestListFailedAC(t *testing.T) {
 	db := createTestDB(t)
 	defer db.Close()
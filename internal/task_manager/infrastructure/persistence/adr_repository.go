@@ -37,7 +37,7 @@ func NewSQLiteADRRepository(db *sql.DB, logger logger.Logger) *SQLiteADRReposito
 func (r *SQLiteADRRepository) SaveADR(ctx context.Context, adr *entities.ADREntity) error {
 	// Check if ADR already exists
 	var exists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", adr.ID).Scan(&exists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", adr.ID).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check ADR existence: %w", err)
 	}
@@ -46,7 +46,7 @@ func (r *SQLiteADRRepository) SaveADR(ctx context.Context, adr *entities.ADREnti
 	}
 
 	// Check if track exists
-	err = r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tracks WHERE id = ?", adr.TrackID).Scan(&exists)
+	err = conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM tracks WHERE id = ?", adr.TrackID).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check track existence: %w", err)
 	}
@@ -54,7 +54,7 @@ func (r *SQLiteADRRepository) SaveADR(ctx context.Context, adr *entities.ADREnti
 		return fmt.Errorf("%w: track %s does not exist", tmerrors.ErrNotFound, adr.TrackID)
 	}
 
-	_, err = r.DB.ExecContext(
+	_, err = conn(ctx, r.DB).ExecContext(
 		ctx,
 		"INSERT INTO adrs (id, track_id, title, status, context, decision, consequences, alternatives, created_at, updated_at, superseded_by) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
 		adr.ID, adr.TrackID, adr.Title, adr.Status, adr.Context, adr.Decision, adr.Consequences, adr.Alternatives, adr.CreatedAt, adr.UpdatedAt, adr.SupersededBy,
@@ -68,7 +68,7 @@ func (r *SQLiteADRRepository) SaveADR(ctx context.Context, adr *entities.ADREnti
 
 // GetADR retrieves an ADR by its ID.
 func (r *SQLiteADRRepository) GetADR(ctx context.Context, id string) (*entities.ADREntity, error) {
-	row := r.DB.QueryRowContext(
+	row := conn(ctx, r.DB).QueryRowContext(
 		ctx,
 		"SELECT id, track_id, title, status, context, decision, consequences, alternatives, created_at, updated_at, superseded_by FROM adrs WHERE id = ?",
 		id,
@@ -105,7 +105,7 @@ func (r *SQLiteADRRepository) ListADRs(ctx context.Context, trackID *string) ([]
 
 	query += " ORDER BY created_at DESC"
 
-	rows, err := r.DB.QueryContext(ctx, query, args...)
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query ADRs: %w", err)
 	}
@@ -140,7 +140,7 @@ func (r *SQLiteADRRepository) ListADRs(ctx context.Context, trackID *string) ([]
 func (r *SQLiteADRRepository) UpdateADR(ctx context.Context, adr *entities.ADREntity) error {
 	// Check if ADR exists
 	var exists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", adr.ID).Scan(&exists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", adr.ID).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check ADR existence: %w", err)
 	}
@@ -148,7 +148,7 @@ func (r *SQLiteADRRepository) UpdateADR(ctx context.Context, adr *entities.ADREn
 		return fmt.Errorf("%w: ADR %s not found", tmerrors.ErrNotFound, adr.ID)
 	}
 
-	_, err = r.DB.ExecContext(
+	_, err = conn(ctx, r.DB).ExecContext(
 		ctx,
 		"UPDATE adrs SET title = ?, status = ?, context = ?, decision = ?, consequences = ?, alternatives = ?, updated_at = ?, superseded_by = ? WHERE id = ?",
 		adr.Title, adr.Status, adr.Context, adr.Decision, adr.Consequences, adr.Alternatives, adr.UpdatedAt, adr.SupersededBy, adr.ID,
@@ -164,7 +164,7 @@ func (r *SQLiteADRRepository) UpdateADR(ctx context.Context, adr *entities.ADREn
 func (r *SQLiteADRRepository) SupersedeADR(ctx context.Context, adrID, supersededByID string) error {
 	// Check if both ADRs exist
 	var exists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", adrID).Scan(&exists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", adrID).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check ADR existence: %w", err)
 	}
@@ -172,7 +172,7 @@ func (r *SQLiteADRRepository) SupersedeADR(ctx context.Context, adrID, supersede
 		return fmt.Errorf("%w: ADR %s not found", tmerrors.ErrNotFound, adrID)
 	}
 
-	err = r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", supersededByID).Scan(&exists)
+	err = conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", supersededByID).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check superseding ADR existence: %w", err)
 	}
@@ -181,7 +181,7 @@ func (r *SQLiteADRRepository) SupersedeADR(ctx context.Context, adrID, supersede
 	}
 
 	now := time.Now().UTC()
-	_, err = r.DB.ExecContext(
+	_, err = conn(ctx, r.DB).ExecContext(
 		ctx,
 		"UPDATE adrs SET status = ?, superseded_by = ?, updated_at = ? WHERE id = ?",
 		string(entities.ADRStatusSuperseded), supersededByID, now, adrID,
@@ -197,7 +197,7 @@ func (r *SQLiteADRRepository) SupersedeADR(ctx context.Context, adrID, supersede
 func (r *SQLiteADRRepository) DeprecateADR(ctx context.Context, adrID string) error {
 	// Check if ADR exists
 	var exists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", adrID).Scan(&exists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", adrID).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check ADR existence: %w", err)
 	}
@@ -206,7 +206,7 @@ func (r *SQLiteADRRepository) DeprecateADR(ctx context.Context, adrID string) er
 	}
 
 	now := time.Now().UTC()
-	_, err = r.DB.ExecContext(
+	_, err = conn(ctx, r.DB).ExecContext(
 		ctx,
 		"UPDATE adrs SET status = ?, updated_at = ? WHERE id = ?",
 		string(entities.ADRStatusDeprecated), now, adrID,
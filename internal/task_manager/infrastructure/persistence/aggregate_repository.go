@@ -37,7 +37,7 @@ func NewSQLiteAggregateRepository(db *sql.DB, logger logger.Logger) *SQLiteAggre
 func (r *SQLiteAggregateRepository) GetRoadmapWithTracks(ctx context.Context, roadmapID string) (*entities.RoadmapEntity, error) {
 	// Get roadmap
 	var roadmap entities.RoadmapEntity
-	err := r.DB.QueryRowContext(
+	err := conn(ctx, r.DB).QueryRowContext(
 		ctx,
 		"SELECT id, vision, success_criteria, created_at, updated_at FROM roadmaps WHERE id = ?",
 		roadmapID,
@@ -51,7 +51,7 @@ func (r *SQLiteAggregateRepository) GetRoadmapWithTracks(ctx context.Context, ro
 	}
 
 	// Load all tracks for this roadmap
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
 		"SELECT id, roadmap_id, title, description, status, rank, created_at, updated_at FROM tracks WHERE roadmap_id = ? ORDER BY id",
 		roadmapID,
@@ -87,7 +87,7 @@ func (r *SQLiteAggregateRepository) GetRoadmapWithTracks(ctx context.Context, ro
 // GetProjectMetadata retrieves a metadata value by key.
 func (r *SQLiteAggregateRepository) GetProjectMetadata(ctx context.Context, key string) (string, error) {
 	var value string
-	err := r.DB.QueryRowContext(ctx, "SELECT value FROM project_metadata WHERE key = ?", key).Scan(&value)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT value FROM project_metadata WHERE key = ?", key).Scan(&value)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return "", fmt.Errorf("%w: metadata key %s not found", tmerrors.ErrNotFound, key)
@@ -99,7 +99,7 @@ func (r *SQLiteAggregateRepository) GetProjectMetadata(ctx context.Context, key
 
 // SetProjectMetadata sets a metadata value by key.
 func (r *SQLiteAggregateRepository) SetProjectMetadata(ctx context.Context, key, value string) error {
-	_, err := r.DB.ExecContext(
+	_, err := conn(ctx, r.DB).ExecContext(
 		ctx,
 		"INSERT OR REPLACE INTO project_metadata (key, value) VALUES (?, ?)",
 		key, value,
@@ -136,7 +136,7 @@ func (r *SQLiteAggregateRepository) GetNextSequenceNumber(ctx context.Context, e
 		query = "SELECT id FROM tracks"
 	case "iter":
 		// For iterations, use the number column directly
-		err := r.DB.QueryRowContext(ctx, "SELECT COALESCE(MAX(number), 0) FROM iterations").Scan(&maxNum)
+		err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COALESCE(MAX(number), 0) FROM iterations").Scan(&maxNum)
 		if err != nil {
 			return 0, fmt.Errorf("failed to get max iteration number: %w", err)
 		}
@@ -152,7 +152,7 @@ func (r *SQLiteAggregateRepository) GetNextSequenceNumber(ctx context.Context, e
 	}
 
 	// For tasks and tracks, we need to parse IDs
-	rows, err := r.DB.QueryContext(ctx, query)
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query)
 	if err != nil {
 		return 0, fmt.Errorf("failed to query %s IDs: %w", entityType, err)
 	}
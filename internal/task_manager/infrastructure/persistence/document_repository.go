@@ -35,7 +35,7 @@ func NewSQLiteDocumentRepository(db *sql.DB) *SQLiteDocumentRepository {
 func (r *SQLiteDocumentRepository) SaveDocument(ctx context.Context, doc *entities.DocumentEntity) error {
 	// Check if document already exists
 	var exists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM documents WHERE id = ?", doc.ID).Scan(&exists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM documents WHERE id = ?", doc.ID).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check document existence: %w", err)
 	}
@@ -50,7 +50,7 @@ func (r *SQLiteDocumentRepository) SaveDocument(ctx context.Context, doc *entiti
 	}
 
 	// Insert document with NULL handling for optional attachments
-	_, err = r.DB.ExecContext(
+	_, err = conn(ctx, r.DB).ExecContext(
 		ctx,
 		`INSERT INTO documents (id, title, type, status, content, track_id, iteration_number,
 			created_at, updated_at, metadata) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
@@ -60,6 +60,7 @@ func (r *SQLiteDocumentRepository) SaveDocument(ctx context.Context, doc *entiti
 	if err != nil {
 		return fmt.Errorf("failed to insert document: %w", err)
 	}
+	doc.Version = 1
 
 	return nil
 }
@@ -67,10 +68,10 @@ func (r *SQLiteDocumentRepository) SaveDocument(ctx context.Context, doc *entiti
 // FindDocumentByID retrieves a document by its ID.
 // Returns ErrNotFound if the document doesn't exist.
 func (r *SQLiteDocumentRepository) FindDocumentByID(ctx context.Context, id string) (*entities.DocumentEntity, error) {
-	row := r.DB.QueryRowContext(
+	row := conn(ctx, r.DB).QueryRowContext(
 		ctx,
 		`SELECT id, title, type, status, content, track_id, iteration_number,
-			created_at, updated_at, metadata FROM documents WHERE id = ?`,
+			created_at, updated_at, version, metadata FROM documents WHERE id = ?`,
 		id,
 	)
 
@@ -88,10 +89,10 @@ func (r *SQLiteDocumentRepository) FindDocumentByID(ctx context.Context, id stri
 // FindAllDocuments returns all documents in storage.
 // Returns empty slice if no documents exist.
 func (r *SQLiteDocumentRepository) FindAllDocuments(ctx context.Context) ([]*entities.DocumentEntity, error) {
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
 		`SELECT id, title, type, status, content, track_id, iteration_number,
-			created_at, updated_at, metadata FROM documents ORDER BY created_at DESC`,
+			created_at, updated_at, version, metadata FROM documents ORDER BY created_at DESC`,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query documents: %w", err)
@@ -117,10 +118,10 @@ func (r *SQLiteDocumentRepository) FindAllDocuments(ctx context.Context) ([]*ent
 // FindDocumentsByTrack returns all documents attached to a specific track.
 // Returns empty slice if no documents are attached to the track.
 func (r *SQLiteDocumentRepository) FindDocumentsByTrack(ctx context.Context, trackID string) ([]*entities.DocumentEntity, error) {
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
 		`SELECT id, title, type, status, content, track_id, iteration_number,
-			created_at, updated_at, metadata FROM documents
+			created_at, updated_at, version, metadata FROM documents
 		WHERE track_id = ? ORDER BY created_at DESC`,
 		trackID,
 	)
@@ -148,10 +149,10 @@ func (r *SQLiteDocumentRepository) FindDocumentsByTrack(ctx context.Context, tra
 // FindDocumentsByIteration returns all documents attached to a specific iteration.
 // Returns empty slice if no documents are attached to the iteration.
 func (r *SQLiteDocumentRepository) FindDocumentsByIteration(ctx context.Context, iterationNumber int) ([]*entities.DocumentEntity, error) {
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
 		`SELECT id, title, type, status, content, track_id, iteration_number,
-			created_at, updated_at, metadata FROM documents
+			created_at, updated_at, version, metadata FROM documents
 		WHERE iteration_number = ? ORDER BY created_at DESC`,
 		iterationNumber,
 	)
@@ -179,10 +180,10 @@ func (r *SQLiteDocumentRepository) FindDocumentsByIteration(ctx context.Context,
 // FindDocumentsByType returns all documents of a specific type.
 // Returns empty slice if no documents of that type exist.
 func (r *SQLiteDocumentRepository) FindDocumentsByType(ctx context.Context, docType entities.DocumentType) ([]*entities.DocumentEntity, error) {
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
 		`SELECT id, title, type, status, content, track_id, iteration_number,
-			created_at, updated_at, metadata FROM documents
+			created_at, updated_at, version, metadata FROM documents
 		WHERE type = ? ORDER BY created_at DESC`,
 		docType.String(),
 	)
@@ -208,7 +209,8 @@ func (r *SQLiteDocumentRepository) FindDocumentsByType(ctx context.Context, docT
 }
 
 // UpdateDocument updates an existing document.
-// Returns ErrNotFound if the document doesn't exist.
+// Returns ErrNotFound if the document doesn't exist, and ErrConflict if it was read
+// at a version that is no longer stored.
 func (r *SQLiteDocumentRepository) UpdateDocument(ctx context.Context, doc *entities.DocumentEntity) error {
 	// Convert metadata to JSON
 	metadataJSON, err := json.Marshal(doc.Metadata)
@@ -216,25 +218,19 @@ func (r *SQLiteDocumentRepository) UpdateDocument(ctx context.Context, doc *enti
 		return fmt.Errorf("failed to marshal metadata: %w", err)
 	}
 
-	result, err := r.DB.ExecContext(
+	err = conn(ctx, r.DB).QueryRowContext(
 		ctx,
 		`UPDATE documents SET title = ?, type = ?, status = ?, content = ?,
-			track_id = ?, iteration_number = ?, updated_at = ?, metadata = ?
-		WHERE id = ?`,
-		doc.Title, doc.Type.String(), doc.Status.String(), doc.Content,
-		doc.TrackID, doc.IterationNumber, doc.UpdatedAt, string(metadataJSON), doc.ID,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to update document: %w", err)
+			track_id = ?, iteration_number = ?, updated_at = ?, metadata = ?, version = version + 1
+		WHERE id = ?`+versionGuard+` RETURNING version`,
+		append([]interface{}{doc.Title, doc.Type.String(), doc.Status.String(), doc.Content,
+			doc.TrackID, doc.IterationNumber, doc.UpdatedAt, string(metadataJSON), doc.ID}, versionArgs(doc.Version)...)...,
+	).Scan(&doc.Version)
+	if err == sql.ErrNoRows {
+		return updateMissed(ctx, conn(ctx, r.DB), "documents", "id", doc.ID, "document")
 	}
-
-	rows, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
-	if rows == 0 {
-		return fmt.Errorf("%w: document %s not found", tmerrors.ErrNotFound, doc.ID)
+		return fmt.Errorf("failed to update document: %w", err)
 	}
 
 	return nil
@@ -243,7 +239,7 @@ func (r *SQLiteDocumentRepository) UpdateDocument(ctx context.Context, doc *enti
 // DeleteDocument removes a document from storage.
 // Returns ErrNotFound if the document doesn't exist.
 func (r *SQLiteDocumentRepository) DeleteDocument(ctx context.Context, id string) error {
-	result, err := r.DB.ExecContext(ctx, "DELETE FROM documents WHERE id = ?", id)
+	result, err := conn(ctx, r.DB).ExecContext(ctx, "DELETE FROM documents WHERE id = ?", id)
 	if err != nil {
 		return fmt.Errorf("failed to delete document: %w", err)
 	}
@@ -271,7 +267,7 @@ func (r *SQLiteDocumentRepository) scanDocument(row *sql.Row) (*entities.Documen
 
 	err := row.Scan(
 		&doc.ID, &doc.Title, &doc.Type, &doc.Status, &doc.Content,
-		&doc.TrackID, &doc.IterationNumber, &doc.CreatedAt, &doc.UpdatedAt, &metadataJSON,
+		&doc.TrackID, &doc.IterationNumber, &doc.CreatedAt, &doc.UpdatedAt, &doc.Version, &metadataJSON,
 	)
 	if err != nil {
 		return nil, err
@@ -297,7 +293,7 @@ func (r *SQLiteDocumentRepository) scanDocumentFromRows(rows *sql.Rows) (*entiti
 
 	err := rows.Scan(
 		&doc.ID, &doc.Title, &doc.Type, &doc.Status, &doc.Content,
-		&doc.TrackID, &doc.IterationNumber, &doc.CreatedAt, &doc.UpdatedAt, &metadataJSON,
+		&doc.TrackID, &doc.IterationNumber, &doc.CreatedAt, &doc.UpdatedAt, &doc.Version, &metadataJSON,
 	)
 	if err != nil {
 		return nil, err
@@ -715,7 +715,7 @@ func TestSQLiteDocumentRepository_DeleteDocument_NotFound(t *testing.T) {
 // setupTestDB creates a test database with schema initialized
 func setupTestDB(t *testing.T, tmpDir string) *sql.DB {
 	dbPath := filepath.Join(tmpDir, "test.db")
-	db, err := sql.Open("sqlite3", dbPath)
+	db, err := sql.Open(persistence.SQLiteDriverName, dbPath)
 	if err != nil {
 		t.Fatalf("failed to open test database: %v", err)
 	}
@@ -0,0 +1,117 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+)
+
+// Compile-time check that SQLiteEntityEventRepository implements repositories.EntityEventRepository
+var _ repositories.EntityEventRepository = (*SQLiteEntityEventRepository)(nil)
+
+// SQLiteEntityEventRepository implements repositories.EntityEventRepository using SQLite as the backend.
+type SQLiteEntityEventRepository struct {
+	DB *sql.DB
+}
+
+// NewSQLiteEntityEventRepository creates a new SQLite-backed entity event repository.
+func NewSQLiteEntityEventRepository(db *sql.DB) *SQLiteEntityEventRepository {
+	return &SQLiteEntityEventRepository{
+		DB: db,
+	}
+}
+
+// ============================================================================
+// Entity Event Operations
+// ============================================================================
+
+// AppendEntityEvent records a new event and assigns its ID.
+func (r *SQLiteEntityEventRepository) AppendEntityEvent(ctx context.Context, event *entities.EntityEvent) error {
+	changes := event.Changes
+	if changes == nil {
+		changes = []entities.FieldChange{}
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal changes: %w", err)
+	}
+
+	result, err := conn(ctx, r.DB).ExecContext(
+		ctx,
+		`INSERT INTO entity_events (entity_type, entity_id, event_type, changes, actor, occurred_at)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+		event.EntityType, event.EntityID, event.EventType, string(changesJSON), event.Actor, event.OccurredAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert entity event: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to read entity event ID: %w", err)
+	}
+	event.ID = id
+
+	return nil
+}
+
+// ListEntityEvents returns events matching the filters, oldest first.
+// Returns empty slice if no events match.
+func (r *SQLiteEntityEventRepository) ListEntityEvents(ctx context.Context, filters entities.EntityEventFilters) ([]*entities.EntityEvent, error) {
+	query := `SELECT id, entity_type, entity_id, event_type, changes, actor, occurred_at FROM entity_events WHERE 1=1`
+	var args []interface{}
+
+	if filters.EntityID != "" {
+		query += " AND entity_id = ?"
+		args = append(args, filters.EntityID)
+	}
+
+	if filters.Since != nil {
+		query += " AND occurred_at >= ?"
+		args = append(args, filters.Since.UTC())
+	}
+
+	if len(filters.EventTypes) > 0 {
+		placeholders := make([]string, len(filters.EventTypes))
+		for i, eventType := range filters.EventTypes {
+			placeholders[i] = "?"
+			args = append(args, eventType)
+		}
+		query += " AND event_type IN (" + strings.Join(placeholders, ", ") + ")"
+	}
+
+	query += " ORDER BY occurred_at ASC, id ASC"
+
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query entity events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*entities.EntityEvent{}
+	for rows.Next() {
+		event := &entities.EntityEvent{}
+		var changesJSON string
+		if err := rows.Scan(
+			&event.ID, &event.EntityType, &event.EntityID, &event.EventType,
+			&changesJSON, &event.Actor, &event.OccurredAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan entity event: %w", err)
+		}
+		if err := json.Unmarshal([]byte(changesJSON), &event.Changes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal changes: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating entity events: %w", err)
+	}
+
+	return events, nil
+}
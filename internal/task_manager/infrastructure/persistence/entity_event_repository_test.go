@@ -0,0 +1,99 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/persistence"
+)
+
+// TestSQLiteEntityEventRepository_AppendAndList tests that appended events round-trip with their diffs
+func TestSQLiteEntityEventRepository_AppendAndList(t *testing.T) {
+	tmpDir := t.TempDir()
+	db := setupTestDB(t, tmpDir)
+	defer db.Close()
+
+	repo := persistence.NewSQLiteEntityEventRepository(db)
+	ctx := context.Background()
+
+	event := &entities.EntityEvent{
+		EntityType: "task",
+		EntityID:   "TM-task-1",
+		EventType:  "task-manager.task.updated",
+		Changes: []entities.FieldChange{
+			{Field: "status", Before: "todo", After: "in-progress"},
+		},
+		Actor:      "alice",
+		OccurredAt: time.Now(),
+	}
+	if err := repo.AppendEntityEvent(ctx, event); err != nil {
+		t.Fatalf("failed to append event: %v", err)
+	}
+	if event.ID == 0 {
+		t.Errorf("expected event ID to be assigned")
+	}
+
+	events, err := repo.ListEntityEvents(ctx, entities.EntityEventFilters{EntityID: "TM-task-1"})
+	if err != nil {
+		t.Fatalf("failed to list events: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	got := events[0]
+	if got.Actor != "alice" || got.EventType != event.EventType {
+		t.Errorf("event mismatch: got %+v", got)
+	}
+	if len(got.Changes) != 1 || got.Changes[0].Field != "status" || got.Changes[0].After != "in-progress" {
+		t.Errorf("changes mismatch: got %+v", got.Changes)
+	}
+}
+
+// TestSQLiteEntityEventRepository_Filters tests filtering by entity, time and event type
+func TestSQLiteEntityEventRepository_Filters(t *testing.T) {
+	tmpDir := t.TempDir()
+	db := setupTestDB(t, tmpDir)
+	defer db.Close()
+
+	repo := persistence.NewSQLiteEntityEventRepository(db)
+	ctx := context.Background()
+
+	base := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	seed := []*entities.EntityEvent{
+		{EntityType: "task", EntityID: "TM-task-1", EventType: "task-manager.task.created", OccurredAt: base},
+		{EntityType: "task", EntityID: "TM-task-1", EventType: "task-manager.task.updated", OccurredAt: base.Add(24 * time.Hour)},
+		{EntityType: "track", EntityID: "TM-track-1", EventType: "task-manager.track.created", OccurredAt: base.Add(48 * time.Hour)},
+	}
+	for _, event := range seed {
+		if err := repo.AppendEntityEvent(ctx, event); err != nil {
+			t.Fatalf("failed to append event: %v", err)
+		}
+	}
+
+	since := base.Add(time.Hour)
+	tests := []struct {
+		name    string
+		filters entities.EntityEventFilters
+		want    int
+	}{
+		{"no filters", entities.EntityEventFilters{}, 3},
+		{"by entity", entities.EntityEventFilters{EntityID: "TM-task-1"}, 2},
+		{"since", entities.EntityEventFilters{Since: &since}, 2},
+		{"by type", entities.EntityEventFilters{EventTypes: []string{"task-manager.task.created", "task-manager.track.created"}}, 2},
+		{"combined", entities.EntityEventFilters{EntityID: "TM-task-1", Since: &since}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := repo.ListEntityEvents(ctx, tt.filters)
+			if err != nil {
+				t.Fatalf("failed to list events: %v", err)
+			}
+			if len(events) != tt.want {
+				t.Errorf("expected %d events, got %d", tt.want, len(events))
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package persistence
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -8,6 +10,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
 )
 
@@ -193,3 +197,199 @@ func (r *FileSystemProjectManagementRepository) ProjectExists(projectName string
 	}
 	return false, err
 }
+
+// GetProjectCode returns the code a project's entity IDs are prefixed with
+func (r *FileSystemProjectManagementRepository) GetProjectCode(projectName string) (string, error) {
+	repos, err := r.openProject(projectName)
+	if err != nil {
+		return "", err
+	}
+	defer repos.DB.Close()
+
+	return repos.GetProjectCode(context.Background()), nil
+}
+
+// ExportProjectData reads the complete content of a project
+func (r *FileSystemProjectManagementRepository) ExportProjectData(projectName string) (*entities.ProjectData, error) {
+	repos, err := r.openProject(projectName)
+	if err != nil {
+		return nil, err
+	}
+	defer repos.DB.Close()
+	ctx := context.Background()
+
+	data := &entities.ProjectData{
+		Code:       repos.GetProjectCode(ctx),
+		Tracks:     []*entities.TrackEntity{},
+		Iterations: []*entities.IterationEntity{},
+		ACs:        []*entities.AcceptanceCriteriaEntity{},
+	}
+
+	roadmap, err := repos.GetActiveRoadmap(ctx)
+	if err != nil && !errors.Is(err, tmerrors.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+	if roadmap != nil {
+		data.Roadmap = roadmap
+		if data.Tracks, err = repos.ListTracks(ctx, roadmap.ID, entities.TrackFilters{}); err != nil {
+			return nil, fmt.Errorf("failed to list tracks: %w", err)
+		}
+	}
+	if data.Tasks, err = repos.ListTasks(ctx, entities.TaskFilters{}); err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	for _, task := range data.Tasks {
+		acs, err := repos.ListAC(ctx, task.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list acceptance criteria of %s: %w", task.ID, err)
+		}
+		data.ACs = append(data.ACs, acs...)
+	}
+	if data.Iterations, err = repos.ListIterations(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list iterations: %w", err)
+	}
+	if data.ADRs, err = repos.ListADRs(ctx, nil); err != nil {
+		return nil, fmt.Errorf("failed to list ADRs: %w", err)
+	}
+	if data.Documents, err = repos.Document.FindAllDocuments(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+	if data.StatusHistory, err = repos.ListTaskStatusChanges(ctx, entities.TaskStatusChangeFilters{}); err != nil {
+		return nil, fmt.Errorf("failed to list task status history: %w", err)
+	}
+
+	return data, nil
+}
+
+// ImportProjectData creates a new project holding data, using data.Code for its IDs.
+// The project is built in a staging directory, its rows written in one transaction, and only
+// then moved into place: a project that cannot be fully imported never appears.
+func (r *FileSystemProjectManagementRepository) ImportProjectData(projectName string, data *entities.ProjectData) error {
+	exists, err := r.ProjectExists(projectName)
+	if err != nil {
+		return fmt.Errorf("failed to check project existence: %w", err)
+	}
+	if exists {
+		return fmt.Errorf("project '%s' already exists", projectName)
+	}
+
+	if err := os.MkdirAll(r.workingDir, 0755); err != nil {
+		return fmt.Errorf("failed to create working directory: %w", err)
+	}
+	stagingDir, err := os.MkdirTemp(r.workingDir, ".import-")
+	if err != nil {
+		return fmt.Errorf("failed to create import staging directory: %w", err)
+	}
+	defer os.RemoveAll(stagingDir)
+
+	staging := NewFileSystemProjectManagementRepository(stagingDir)
+	if err := staging.CreateProject(projectName, data.Code); err != nil {
+		return err
+	}
+	if err := staging.writeProjectData(projectName, data); err != nil {
+		return err
+	}
+
+	projectsDir := filepath.Join(r.workingDir, "projects")
+	if err := os.MkdirAll(projectsDir, 0755); err != nil {
+		return fmt.Errorf("failed to create projects directory: %w", err)
+	}
+	if err := os.Rename(filepath.Join(stagingDir, "projects", projectName), filepath.Join(projectsDir, projectName)); err != nil {
+		return fmt.Errorf("failed to register imported project: %w", err)
+	}
+	return nil
+}
+
+// writeProjectData writes data into an existing, empty project in a single transaction
+func (r *FileSystemProjectManagementRepository) writeProjectData(projectName string, data *entities.ProjectData) error {
+	repos, err := r.openProject(projectName)
+	if err != nil {
+		return err
+	}
+	defer repos.DB.Close()
+
+	return repos.InTransaction(context.Background(), func(ctx context.Context) error {
+		if err := repos.SetProjectMetadata(ctx, "project_code", data.Code); err != nil {
+			return err
+		}
+		if data.Roadmap != nil {
+			if err := repos.SaveRoadmap(ctx, data.Roadmap); err != nil {
+				return fmt.Errorf("failed to import roadmap: %w", err)
+			}
+		}
+		for _, track := range data.Tracks {
+			if err := repos.SaveTrack(ctx, track); err != nil {
+				return fmt.Errorf("failed to import track %s: %w", track.ID, err)
+			}
+		}
+		for _, task := range data.Tasks {
+			if err := repos.SaveTask(ctx, task); err != nil {
+				return fmt.Errorf("failed to import task %s: %w", task.ID, err)
+			}
+		}
+		// Blockers are linked once every task exists
+		for _, task := range data.Tasks {
+			for _, blockerID := range task.BlockedBy {
+				if err := repos.AddTaskDependency(ctx, task.ID, blockerID); err != nil {
+					return fmt.Errorf("failed to import blocker %s of task %s: %w", blockerID, task.ID, err)
+				}
+			}
+		}
+		for _, iteration := range data.Iterations {
+			if err := repos.SaveIteration(ctx, iteration); err != nil {
+				return fmt.Errorf("failed to import iteration %d: %w", iteration.Number, err)
+			}
+		}
+		for _, ac := range data.ACs {
+			if err := repos.SaveAC(ctx, ac); err != nil {
+				return fmt.Errorf("failed to import acceptance criterion %s: %w", ac.ID, err)
+			}
+		}
+		for _, adr := range data.ADRs {
+			if err := repos.SaveADR(ctx, adr); err != nil {
+				return fmt.Errorf("failed to import ADR %s: %w", adr.ID, err)
+			}
+		}
+		for _, doc := range data.Documents {
+			if err := repos.Document.SaveDocument(ctx, doc); err != nil {
+				return fmt.Errorf("failed to import document %s: %w", doc.ID, err)
+			}
+		}
+
+		// Saving tasks recorded their current status; an exported history replaces it
+		if len(data.StatusHistory) > 0 {
+			db := conn(ctx, repos.DB)
+			if _, err := db.ExecContext(ctx, "DELETE FROM task_status_history"); err != nil {
+				return fmt.Errorf("failed to reset task status history: %w", err)
+			}
+			for _, change := range data.StatusHistory {
+				if _, err := db.ExecContext(
+					ctx,
+					"INSERT INTO task_status_history (task_id, from_status, to_status, changed_at) VALUES (?, ?, ?, ?)",
+					change.TaskID, change.FromStatus, change.ToStatus, change.ChangedAt,
+				); err != nil {
+					return fmt.Errorf("failed to import status history of %s: %w", change.TaskID, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// openProject opens an existing project's database.
+// Repositories keep the logger only for their callers, so none is passed.
+func (r *FileSystemProjectManagementRepository) openProject(projectName string) (*SQLiteRepositoryComposite, error) {
+	exists, err := r.ProjectExists(projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check project existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: project '%s' does not exist", tmerrors.ErrNotFound, projectName)
+	}
+
+	_, db, err := OpenProjectDatabase(r.workingDir, projectName)
+	if err != nil {
+		return nil, err
+	}
+	return NewSQLiteRepositoryComposite(db, nil), nil
+}
@@ -0,0 +1,166 @@
+package persistence_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/persistence"
+)
+
+// newTestProjectData returns a project with one entity of each kind, linked together
+func newTestProjectData(t *testing.T) *entities.ProjectData {
+	t.Helper()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "Vision", "Criteria", now, now)
+	track1, _ := entities.NewTrackEntity("AL-track-1", roadmap.ID, "Core", "", "in-progress", 100, nil, now, now)
+	track2, _ := entities.NewTrackEntity("AL-track-2", roadmap.ID, "UI", "", "not-started", 200, []string{"AL-track-1"}, now, now)
+	task1, _ := entities.NewTaskEntity("AL-task-1", track1.ID, "Model", "", "done", 100, "", now, now)
+	task2, _ := entities.NewTaskEntity("AL-task-2", track2.ID, "Screen", "", "todo", 200, "", now, now)
+	task2.BlockedBy = []string{task1.ID}
+	iteration, _ := entities.NewIterationEntity(1, "Sprint 1", "Goal", "", []string{task2.ID, task1.ID}, "current", 500, now, time.Time{}, now, now)
+	ac := entities.NewAcceptanceCriteriaEntity("AL-ac-1", task1.ID, "Model works", entities.VerificationTypeAutomated, "", now, now)
+	ac.Status = entities.ACStatusFailed
+	ac.Notes = "flaky"
+	ac.CheckCommand = "go test ./..."
+	adr, _ := entities.NewADREntity("AL-adr-1", track1.ID, "Use SQLite", "accepted", "ctx", "dec", "cons", "", now, now, nil)
+	doc, _ := entities.NewDocumentEntity("TM-doc-1", "Plan", entities.DocumentTypePlan, entities.DocumentStatusDraft, "text", &track1.ID, nil, now, now)
+
+	return &entities.ProjectData{
+		Code:       "AL",
+		Roadmap:    roadmap,
+		Tracks:     []*entities.TrackEntity{track1, track2},
+		Tasks:      []*entities.TaskEntity{task1, task2},
+		Iterations: []*entities.IterationEntity{iteration},
+		ACs:        []*entities.AcceptanceCriteriaEntity{ac},
+		ADRs:       []*entities.ADREntity{adr},
+		Documents:  []*entities.DocumentEntity{doc},
+		StatusHistory: []*entities.TaskStatusChange{
+			{TaskID: task1.ID, ToStatus: "todo", ChangedAt: now},
+			{TaskID: task1.ID, FromStatus: "todo", ToStatus: "done", ChangedAt: now.Add(time.Hour)},
+			{TaskID: task2.ID, ToStatus: "todo", ChangedAt: now},
+		},
+	}
+}
+
+func TestProjectData_ImportExportRoundTrip(t *testing.T) {
+	repo := persistence.NewFileSystemProjectManagementRepository(t.TempDir())
+
+	if err := repo.ImportProjectData("alpha", newTestProjectData(t)); err != nil {
+		t.Fatalf("ImportProjectData failed: %v", err)
+	}
+
+	code, err := repo.GetProjectCode("alpha")
+	if err != nil {
+		t.Fatalf("GetProjectCode failed: %v", err)
+	}
+	if code != "AL" {
+		t.Errorf("GetProjectCode() = %s, want AL", code)
+	}
+
+	data, err := repo.ExportProjectData("alpha")
+	if err != nil {
+		t.Fatalf("ExportProjectData failed: %v", err)
+	}
+	if data.Code != "AL" || data.Roadmap == nil || data.Roadmap.Vision != "Vision" {
+		t.Errorf("unexpected project header: code=%s roadmap=%+v", data.Code, data.Roadmap)
+	}
+	if len(data.Tracks) != 2 || len(data.Tasks) != 2 || len(data.Iterations) != 1 ||
+		len(data.ACs) != 1 || len(data.ADRs) != 1 || len(data.Documents) != 1 {
+		t.Fatalf("unexpected entity counts: %d tracks, %d tasks, %d iterations, %d ACs, %d ADRs, %d documents",
+			len(data.Tracks), len(data.Tasks), len(data.Iterations), len(data.ACs), len(data.ADRs), len(data.Documents))
+	}
+
+	for _, track := range data.Tracks {
+		if track.ID == "AL-track-2" && (len(track.Dependencies) != 1 || track.Dependencies[0] != "AL-track-1") {
+			t.Errorf("track dependencies = %v, want [AL-track-1]", track.Dependencies)
+		}
+	}
+	for _, task := range data.Tasks {
+		if task.ID == "AL-task-2" && (len(task.BlockedBy) != 1 || task.BlockedBy[0] != "AL-task-1") {
+			t.Errorf("task blockers = %v, want [AL-task-1]", task.BlockedBy)
+		}
+	}
+	if ids := data.Iterations[0].TaskIDs; len(ids) != 2 {
+		t.Errorf("iteration tasks = %v, want both tasks", ids)
+	}
+	if ac := data.ACs[0]; ac.Status != entities.ACStatusFailed || ac.Notes != "flaky" || ac.CheckCommand != "go test ./..." {
+		t.Errorf("acceptance criterion not preserved: %+v", ac)
+	}
+	if doc := data.Documents[0]; doc.TrackID == nil || *doc.TrackID != "AL-track-1" {
+		t.Errorf("document attachment not preserved: %+v", doc)
+	}
+
+	// The imported history replaces the one recorded when the tasks were saved
+	if len(data.StatusHistory) != 3 {
+		t.Fatalf("status history has %d entries, want 3", len(data.StatusHistory))
+	}
+	if last := data.StatusHistory[2]; last.TaskID != "AL-task-1" || last.ToStatus != "done" {
+		t.Errorf("status history not preserved: %+v", last)
+	}
+}
+
+func TestImportProjectData_FailureCreatesNothing(t *testing.T) {
+	workingDir := t.TempDir()
+	repo := persistence.NewFileSystemProjectManagementRepository(workingDir)
+	data := newTestProjectData(t)
+	data.Tasks = append(data.Tasks, data.Tasks[0])
+
+	err := repo.ImportProjectData("alpha", data)
+	if !errors.Is(err, tmerrors.ErrAlreadyExists) {
+		t.Fatalf("ImportProjectData error = %v, want ErrAlreadyExists", err)
+	}
+
+	exists, err := repo.ProjectExists("alpha")
+	if err != nil {
+		t.Fatalf("ProjectExists failed: %v", err)
+	}
+	if exists {
+		t.Error("partially imported project was registered")
+	}
+	// The staging directory is removed with the rows written to it
+	entries, err := os.ReadDir(workingDir)
+	if err != nil {
+		t.Fatalf("ReadDir failed: %v", err)
+	}
+	for _, entry := range entries {
+		if entry.Name() != "projects" {
+			t.Errorf("import left %s behind", entry.Name())
+		}
+	}
+}
+
+func TestImportProjectData_ExistingProject(t *testing.T) {
+	repo := persistence.NewFileSystemProjectManagementRepository(t.TempDir())
+	if err := repo.CreateProject("alpha", "XY"); err != nil {
+		t.Fatalf("CreateProject failed: %v", err)
+	}
+
+	if err := repo.ImportProjectData("alpha", newTestProjectData(t)); err == nil {
+		t.Fatal("ImportProjectData into an existing project succeeded")
+	}
+
+	data, err := repo.ExportProjectData("alpha")
+	if err != nil {
+		t.Fatalf("ExportProjectData failed: %v", err)
+	}
+	if len(data.Tasks) != 0 || data.Roadmap != nil {
+		t.Errorf("existing project was written to: %d tasks, roadmap %+v", len(data.Tasks), data.Roadmap)
+	}
+}
+
+func TestExportProjectData_UnknownProject(t *testing.T) {
+	repo := persistence.NewFileSystemProjectManagementRepository(t.TempDir())
+
+	_, err := repo.ExportProjectData("missing")
+	if !errors.Is(err, tmerrors.ErrNotFound) {
+		t.Errorf("ExportProjectData error = %v, want ErrNotFound", err)
+	}
+	if exists, _ := repo.ProjectExists("missing"); exists {
+		t.Error("exporting an unknown project created it")
+	}
+}
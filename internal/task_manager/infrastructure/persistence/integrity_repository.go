@@ -0,0 +1,388 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+)
+
+// Compile-time check that SQLiteIntegrityRepository implements repositories.IntegrityRepository
+var _ repositories.IntegrityRepository = (*SQLiteIntegrityRepository)(nil)
+
+// Ranks of tracks, tasks and iterations are validated to this range by their entities
+const (
+	minRank = 1
+	maxRank = 1000
+)
+
+// referenceRule describes a column referencing another table and how dangling references are repaired
+type referenceRule struct {
+	entityType string // Kind of entity the issue is reported on
+	query      string // Selects (entity ID, dangling reference) pairs
+	message    string // Formatted with the entity ID and the dangling reference
+	repair     string // Describes the repair; empty when a human has to decide
+	repairSQL  string
+}
+
+// referenceRules lists every reference between tables. Link rows, acceptance criteria and
+// status history are deleted with their missing parent, as the foreign keys would have done;
+// documents and supersessions are detached. Tasks, tracks and ADRs are never deleted.
+var referenceRules = []referenceRule{
+	{
+		entityType: "track",
+		query:      "SELECT id, roadmap_id FROM tracks WHERE roadmap_id NOT IN (SELECT id FROM roadmaps) ORDER BY id",
+		message:    "track %s belongs to missing roadmap %s",
+	},
+	{
+		entityType: "track",
+		query:      "SELECT track_id, depends_on_id FROM track_dependencies WHERE depends_on_id NOT IN (SELECT id FROM tracks) ORDER BY track_id, depends_on_id",
+		message:    "track %s depends on missing track %s",
+		repair:     "remove the dependency",
+		repairSQL:  "DELETE FROM track_dependencies WHERE depends_on_id NOT IN (SELECT id FROM tracks)",
+	},
+	{
+		entityType: "track",
+		query:      "SELECT depends_on_id, track_id FROM track_dependencies WHERE track_id NOT IN (SELECT id FROM tracks) ORDER BY depends_on_id, track_id",
+		message:    "track %s is depended on by missing track %s",
+		repair:     "remove the dependency",
+		repairSQL:  "DELETE FROM track_dependencies WHERE track_id NOT IN (SELECT id FROM tracks)",
+	},
+	{
+		entityType: "task",
+		query:      "SELECT id, track_id FROM tasks WHERE track_id NOT IN (SELECT id FROM tracks) ORDER BY id",
+		message:    "task %s belongs to missing track %s",
+	},
+	{
+		entityType: "task",
+		query:      "SELECT task_id, blocked_by_id FROM task_dependencies WHERE blocked_by_id NOT IN (SELECT id FROM tasks) ORDER BY task_id, blocked_by_id",
+		message:    "task %s is blocked by missing task %s",
+		repair:     "remove the dependency",
+		repairSQL:  "DELETE FROM task_dependencies WHERE blocked_by_id NOT IN (SELECT id FROM tasks)",
+	},
+	{
+		entityType: "task",
+		query:      "SELECT blocked_by_id, task_id FROM task_dependencies WHERE task_id NOT IN (SELECT id FROM tasks) ORDER BY blocked_by_id, task_id",
+		message:    "task %s blocks missing task %s",
+		repair:     "remove the dependency",
+		repairSQL:  "DELETE FROM task_dependencies WHERE task_id NOT IN (SELECT id FROM tasks)",
+	},
+	{
+		entityType: "task",
+		query:      "SELECT DISTINCT task_id, task_id FROM task_status_history WHERE task_id NOT IN (SELECT id FROM tasks) ORDER BY task_id",
+		message:    "status history is kept for task %[1]s, which no longer exists",
+		repair:     "delete the history",
+		repairSQL:  "DELETE FROM task_status_history WHERE task_id NOT IN (SELECT id FROM tasks)",
+	},
+	{
+		entityType: "iteration",
+		query:      "SELECT iteration_number, task_id FROM iteration_tasks WHERE task_id NOT IN (SELECT id FROM tasks) ORDER BY iteration_number, task_id",
+		message:    "iteration %s lists missing task %s",
+		repair:     "remove the task from the iteration",
+		repairSQL:  "DELETE FROM iteration_tasks WHERE task_id NOT IN (SELECT id FROM tasks)",
+	},
+	{
+		entityType: "task",
+		query:      "SELECT task_id, iteration_number FROM iteration_tasks WHERE iteration_number NOT IN (SELECT number FROM iterations) ORDER BY task_id, iteration_number",
+		message:    "task %s is listed in missing iteration %s",
+		repair:     "remove the task from the iteration",
+		repairSQL:  "DELETE FROM iteration_tasks WHERE iteration_number NOT IN (SELECT number FROM iterations)",
+	},
+	{
+		entityType: "ac",
+		query:      "SELECT id, task_id FROM acceptance_criteria WHERE task_id NOT IN (SELECT id FROM tasks) ORDER BY id",
+		message:    "acceptance criterion %s belongs to missing task %s",
+		repair:     "delete the acceptance criterion",
+		repairSQL:  "DELETE FROM acceptance_criteria WHERE task_id NOT IN (SELECT id FROM tasks)",
+	},
+	{
+		entityType: "adr",
+		query:      "SELECT id, track_id FROM adrs WHERE track_id NOT IN (SELECT id FROM tracks) ORDER BY id",
+		message:    "ADR %s belongs to missing track %s",
+	},
+	{
+		entityType: "adr",
+		query:      "SELECT id, superseded_by FROM adrs WHERE superseded_by IS NOT NULL AND superseded_by NOT IN (SELECT id FROM adrs) ORDER BY id",
+		message:    "ADR %s is superseded by missing ADR %s",
+		repair:     "clear the supersession",
+		repairSQL:  "UPDATE adrs SET superseded_by = NULL WHERE superseded_by IS NOT NULL AND superseded_by NOT IN (SELECT id FROM adrs)",
+	},
+	{
+		entityType: "document",
+		query:      "SELECT id, track_id FROM documents WHERE track_id IS NOT NULL AND track_id NOT IN (SELECT id FROM tracks) ORDER BY id",
+		message:    "document %s is attached to missing track %s",
+		repair:     "detach the document",
+		repairSQL:  "UPDATE documents SET track_id = NULL, version = version + 1 WHERE track_id IS NOT NULL AND track_id NOT IN (SELECT id FROM tracks)",
+	},
+	{
+		entityType: "document",
+		query:      "SELECT id, iteration_number FROM documents WHERE iteration_number IS NOT NULL AND iteration_number NOT IN (SELECT number FROM iterations) ORDER BY id",
+		message:    "document %s is attached to missing iteration %s",
+		repair:     "detach the document",
+		repairSQL:  "UPDATE documents SET iteration_number = NULL, version = version + 1 WHERE iteration_number IS NOT NULL AND iteration_number NOT IN (SELECT number FROM iterations)",
+	},
+}
+
+// statusRules lists the tables with a status column and the values they allow
+var statusRules = []struct {
+	entityType string
+	query      string // Selects (entity ID, status) pairs
+	valid      func(string) bool
+}{
+	{"track", "SELECT id, status FROM tracks ORDER BY id", entities.IsValidTrackStatus},
+	{"task", "SELECT id, status FROM tasks ORDER BY id", entities.IsValidTaskStatus},
+	{"iteration", "SELECT number, status FROM iterations ORDER BY number", entities.IsValidIterationStatus},
+	{"ac", "SELECT id, status FROM acceptance_criteria ORDER BY id", entities.IsValidACStatus},
+	{"adr", "SELECT id, status FROM adrs ORDER BY id", entities.IsValidADRStatus},
+}
+
+// rankRules lists the ranked tables; versioned tables get their version bumped when a rank is repaired
+var rankRules = []struct {
+	entityType string
+	table      string
+	keyColumn  string
+	versioned  bool
+}{
+	{"track", "tracks", "id", false},
+	{"task", "tasks", "id", true},
+	{"iteration", "iterations", "number", true},
+}
+
+// SQLiteIntegrityRepository checks a project database for inconsistencies and repairs the safe ones.
+type SQLiteIntegrityRepository struct {
+	DB *sql.DB
+}
+
+// NewSQLiteIntegrityRepository creates a new SQLite integrity repository.
+func NewSQLiteIntegrityRepository(db *sql.DB) *SQLiteIntegrityRepository {
+	return &SQLiteIntegrityRepository{DB: db}
+}
+
+// CheckIntegrity returns the problems found in storage, repairing the safe ones when repair is set.
+// All checks read the same snapshot; repairs are committed together or not at all.
+func (r *SQLiteIntegrityRepository) CheckIntegrity(ctx context.Context, repair bool) ([]entities.IntegrityIssue, error) {
+	tx, err := beginTx(ctx, r.DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	issues := []entities.IntegrityIssue{}
+	for _, check := range []func(context.Context, sqlConn, bool) ([]entities.IntegrityIssue, error){
+		r.checkDatabase,
+		r.checkReferences,
+		r.checkStatuses,
+		r.checkRanks,
+		r.checkSequences,
+	} {
+		found, err := check(ctx, tx, repair)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, found...)
+	}
+
+	if repair {
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("failed to commit repairs: %w", err)
+		}
+	}
+	return issues, nil
+}
+
+// checkDatabase reports what SQLite's own integrity check finds. Corruption is never repaired.
+func (r *SQLiteIntegrityRepository) checkDatabase(ctx context.Context, tx sqlConn, repair bool) ([]entities.IntegrityIssue, error) {
+	rows, err := tx.QueryContext(ctx, "PRAGMA integrity_check")
+	if err != nil {
+		return nil, fmt.Errorf("failed to run integrity check: %w", err)
+	}
+	defer rows.Close()
+
+	issues := []entities.IntegrityIssue{}
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return nil, fmt.Errorf("failed to scan integrity check result: %w", err)
+		}
+		if result == "ok" {
+			continue
+		}
+		issues = append(issues, entities.IntegrityIssue{
+			Check:      entities.IntegrityCheckDatabase,
+			EntityType: "database",
+			Message:    result,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating integrity check results: %w", err)
+	}
+	return issues, nil
+}
+
+// checkReferences reports rows referencing entities that no longer exist
+func (r *SQLiteIntegrityRepository) checkReferences(ctx context.Context, tx sqlConn, repair bool) ([]entities.IntegrityIssue, error) {
+	issues := []entities.IntegrityIssue{}
+	for _, rule := range referenceRules {
+		pairs, err := queryPairs(ctx, tx, rule.query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s references: %w", rule.entityType, err)
+		}
+		if len(pairs) == 0 {
+			continue
+		}
+
+		fixed := repair && rule.repairSQL != ""
+		if fixed {
+			if _, err := tx.ExecContext(ctx, rule.repairSQL); err != nil {
+				return nil, fmt.Errorf("failed to repair %s references: %w", rule.entityType, err)
+			}
+		}
+		for _, pair := range pairs {
+			issues = append(issues, entities.IntegrityIssue{
+				Check:      entities.IntegrityCheckOrphans,
+				EntityType: rule.entityType,
+				EntityID:   pair[0],
+				Message:    fmt.Sprintf(rule.message, pair[0], pair[1]),
+				Repair:     rule.repair,
+				Fixed:      fixed,
+			})
+		}
+	}
+	return issues, nil
+}
+
+// checkStatuses reports statuses outside the allowed values. Which status was meant is
+// for a human to decide, so these are never repaired.
+func (r *SQLiteIntegrityRepository) checkStatuses(ctx context.Context, tx sqlConn, repair bool) ([]entities.IntegrityIssue, error) {
+	issues := []entities.IntegrityIssue{}
+	for _, rule := range statusRules {
+		pairs, err := queryPairs(ctx, tx, rule.query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s statuses: %w", rule.entityType, err)
+		}
+		for _, pair := range pairs {
+			if rule.valid(pair[1]) {
+				continue
+			}
+			issues = append(issues, entities.IntegrityIssue{
+				Check:      entities.IntegrityCheckStatuses,
+				EntityType: rule.entityType,
+				EntityID:   pair[0],
+				Message:    fmt.Sprintf("%s %s has invalid status %q", rule.entityType, pair[0], pair[1]),
+			})
+		}
+	}
+	return issues, nil
+}
+
+// checkRanks reports ranks outside minRank-maxRank; the repair clamps them into the range
+func (r *SQLiteIntegrityRepository) checkRanks(ctx context.Context, tx sqlConn, repair bool) ([]entities.IntegrityIssue, error) {
+	issues := []entities.IntegrityIssue{}
+	for _, rule := range rankRules {
+		outOfRange := "rank < ? OR rank > ?"
+		pairs, err := queryPairs(ctx, tx,
+			fmt.Sprintf("SELECT %s, rank FROM %s WHERE %s ORDER BY %s", rule.keyColumn, rule.table, outOfRange, rule.keyColumn),
+			minRank, maxRank)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s ranks: %w", rule.entityType, err)
+		}
+		if len(pairs) == 0 {
+			continue
+		}
+
+		if repair {
+			set := "rank = MIN(MAX(rank, ?), ?)"
+			if rule.versioned {
+				set += ", version = version + 1"
+			}
+			query := fmt.Sprintf("UPDATE %s SET %s WHERE %s", rule.table, set, outOfRange)
+			if _, err := tx.ExecContext(ctx, query, minRank, maxRank, minRank, maxRank); err != nil {
+				return nil, fmt.Errorf("failed to repair %s ranks: %w", rule.entityType, err)
+			}
+		}
+		for _, pair := range pairs {
+			issues = append(issues, entities.IntegrityIssue{
+				Check:      entities.IntegrityCheckRanks,
+				EntityType: rule.entityType,
+				EntityID:   pair[0],
+				Message:    fmt.Sprintf("%s %s has rank %s outside %d-%d", rule.entityType, pair[0], pair[1], minRank, maxRank),
+				Repair:     fmt.Sprintf("clamp the rank to %d-%d", minRank, maxRank),
+				Fixed:      repair,
+			})
+		}
+	}
+	return issues, nil
+}
+
+// checkSequences reports AUTOINCREMENT counters behind the highest ID of their table,
+// which would make SQLite hand out IDs that were already used; the repair advances them.
+func (r *SQLiteIntegrityRepository) checkSequences(ctx context.Context, tx sqlConn, repair bool) ([]entities.IntegrityIssue, error) {
+	var exists int
+	err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'sqlite_sequence'").Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for sequences: %w", err)
+	}
+	if exists == 0 {
+		return nil, nil
+	}
+
+	counters, err := queryPairs(ctx, tx, "SELECT name, seq FROM sqlite_sequence ORDER BY name")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sequences: %w", err)
+	}
+
+	issues := []entities.IntegrityIssue{}
+	for _, counter := range counters {
+		table := counter[0]
+		seq, err := strconv.ParseInt(counter[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sequence %q for table %s: %w", counter[1], table, err)
+		}
+		var maxID int64
+		query := fmt.Sprintf(`SELECT COALESCE(MAX(rowid), 0) FROM "%s"`, table)
+		if err := tx.QueryRowContext(ctx, query).Scan(&maxID); err != nil {
+			return nil, fmt.Errorf("failed to get highest ID of %s: %w", table, err)
+		}
+		if seq >= maxID {
+			continue
+		}
+
+		if repair {
+			if _, err := tx.ExecContext(ctx, "UPDATE sqlite_sequence SET seq = ? WHERE name = ?", maxID, table); err != nil {
+				return nil, fmt.Errorf("failed to repair sequence of %s: %w", table, err)
+			}
+		}
+		issues = append(issues, entities.IntegrityIssue{
+			Check:      entities.IntegrityCheckSequences,
+			EntityType: "table",
+			EntityID:   table,
+			Message:    fmt.Sprintf("ID counter of %s is at %d, behind the highest ID %d", table, seq, maxID),
+			Repair:     fmt.Sprintf("advance the counter to %d", maxID),
+			Fixed:      repair,
+		})
+	}
+	return issues, nil
+}
+
+// queryPairs returns the two columns of every row of a query as strings.
+// The rows are read completely so the transaction can be used again right away.
+func queryPairs(ctx context.Context, tx sqlConn, query string, args ...interface{}) ([][2]string, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pairs [][2]string
+	for rows.Next() {
+		var first, second sql.NullString
+		if err := rows.Scan(&first, &second); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, [2]string{first.String, second.String})
+	}
+	return pairs, rows.Err()
+}
@@ -0,0 +1,127 @@
+package persistence_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/persistence"
+)
+
+// TestSQLiteIntegrityRepository_HealthyDatabase tests that a consistent database reports nothing
+func TestSQLiteIntegrityRepository_HealthyDatabase(t *testing.T) {
+	db := setupTestDB(t, t.TempDir())
+	defer db.Close()
+	createTrack(t, db, "TM-track-1")
+	createIteration(t, db, 1)
+
+	issues, err := persistence.NewSQLiteIntegrityRepository(db).CheckIntegrity(context.Background(), false)
+	if err != nil {
+		t.Fatalf("CheckIntegrity failed: %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("expected no issues, got %+v", issues)
+	}
+}
+
+// TestSQLiteIntegrityRepository_CheckAndRepair tests that problems are reported, and that only
+// the safe ones are repaired
+func TestSQLiteIntegrityRepository_CheckAndRepair(t *testing.T) {
+	db := setupTestDB(t, t.TempDir())
+	defer db.Close()
+	ctx := context.Background()
+	createTrack(t, db, "TM-track-1")
+	createIteration(t, db, 1)
+
+	// The test database does not enforce foreign keys, so dangling rows can be written directly
+	now := time.Now()
+	for _, stmt := range []string{
+		"INSERT INTO tasks (id, track_id, title, status, rank, created_at, updated_at) VALUES ('TM-task-1', 'TM-track-1', 'Task', 'sleeping', 5000, ?, ?)",
+		"INSERT INTO tasks (id, track_id, title, status, rank, created_at, updated_at) VALUES ('TM-task-2', 'TM-track-9', 'Task', 'todo', 500, ?, ?)",
+		"INSERT INTO iteration_tasks (iteration_number, task_id) VALUES (1, 'TM-task-9')",
+		"INSERT INTO acceptance_criteria (id, task_id, description, verification_type, status, created_at, updated_at) VALUES ('TM-ac-1', 'TM-task-9', 'AC', 'manual', 'not_started', ?, ?)",
+		"INSERT INTO documents (id, title, type, status, content, iteration_number, created_at, updated_at) VALUES ('TM-doc-1', 'Plan', 'plan', 'draft', '', 7, ?, ?)",
+		"INSERT INTO entity_events (entity_type, entity_id, event_type, occurred_at) VALUES ('task', 'TM-task-1', 'created', ?)",
+		"INSERT INTO entity_events (entity_type, entity_id, event_type, occurred_at) VALUES ('task', 'TM-task-1', 'updated', ?)",
+	} {
+		var args []interface{}
+		for i := 0; i < strings.Count(stmt, "?"); i++ {
+			args = append(args, now)
+		}
+		if _, err := db.ExecContext(ctx, stmt, args...); err != nil {
+			t.Fatalf("failed to set up %q: %v", stmt, err)
+		}
+	}
+	if _, err := db.ExecContext(ctx, "UPDATE sqlite_sequence SET seq = 1 WHERE name = 'entity_events'"); err != nil {
+		t.Fatalf("failed to rewind sequence: %v", err)
+	}
+
+	repo := persistence.NewSQLiteIntegrityRepository(db)
+	issues, err := repo.CheckIntegrity(ctx, false)
+	if err != nil {
+		t.Fatalf("CheckIntegrity failed: %v", err)
+	}
+	found := make(map[string]entities.IntegrityIssue)
+	for _, issue := range issues {
+		if issue.Fixed {
+			t.Errorf("issue marked fixed without repair: %+v", issue)
+		}
+		found[issue.Check+" "+issue.EntityID] = issue
+	}
+	for _, key := range []string{
+		"orphans TM-task-2",
+		"orphans 1",
+		"orphans TM-ac-1",
+		"orphans TM-doc-1",
+		"invalid_statuses TM-task-1",
+		"ranks TM-task-1",
+		"sequences entity_events",
+	} {
+		if _, ok := found[key]; !ok {
+			t.Errorf("missing issue %q in %+v", key, issues)
+		}
+	}
+	if len(issues) != 7 {
+		t.Errorf("expected 7 issues, got %d: %+v", len(issues), issues)
+	}
+	if found["orphans TM-task-2"].Repair != "" {
+		t.Errorf("tasks of missing tracks should not be repaired automatically: %+v", found["orphans TM-task-2"])
+	}
+
+	issues, err = repo.CheckIntegrity(ctx, true)
+	if err != nil {
+		t.Fatalf("CheckIntegrity with repair failed: %v", err)
+	}
+	for _, issue := range issues {
+		if issue.Fixed != (issue.Repair != "") {
+			t.Errorf("issue with repair %q has Fixed = %v", issue.Repair, issue.Fixed)
+		}
+	}
+
+	// Only the problems that need a human remain
+	issues, err = repo.CheckIntegrity(ctx, false)
+	if err != nil {
+		t.Fatalf("CheckIntegrity after repair failed: %v", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("expected the orphaned task and the invalid status to remain, got %+v", issues)
+	}
+
+	var rank int
+	var iterationNumber sql.NullInt64
+	if err := db.QueryRowContext(ctx, "SELECT rank FROM tasks WHERE id = 'TM-task-1'").Scan(&rank); err != nil {
+		t.Fatalf("failed to read rank: %v", err)
+	}
+	if rank != 1000 {
+		t.Errorf("expected rank clamped to 1000, got %d", rank)
+	}
+	if err := db.QueryRowContext(ctx, "SELECT iteration_number FROM documents WHERE id = 'TM-doc-1'").Scan(&iterationNumber); err != nil {
+		t.Fatalf("document should be kept: %v", err)
+	}
+	if iterationNumber.Valid {
+		t.Errorf("expected document to be detached, got iteration %d", iterationNumber.Int64)
+	}
+}
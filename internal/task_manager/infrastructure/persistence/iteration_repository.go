@@ -42,7 +42,7 @@ func NewSQLiteIterationRepository(db *sql.DB, logger logger.Logger, acRepo repos
 func (r *SQLiteIterationRepository) SaveIteration(ctx context.Context, iteration *entities.IterationEntity) error {
 	// Check if iteration already exists
 	var exists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM iterations WHERE number = ?", iteration.Number).Scan(&exists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM iterations WHERE number = ?", iteration.Number).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check iteration existence: %w", err)
 	}
@@ -51,7 +51,7 @@ func (r *SQLiteIterationRepository) SaveIteration(ctx context.Context, iteration
 	}
 
 	// Start transaction for iteration and tasks
-	tx, err := r.DB.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.DB)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -60,8 +60,8 @@ func (r *SQLiteIterationRepository) SaveIteration(ctx context.Context, iteration
 	// Insert iteration
 	_, err = tx.ExecContext(
 		ctx,
-		"INSERT INTO iterations (number, name, goal, status, rank, deliverable, started_at, completed_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
-		iteration.Number, iteration.Name, iteration.Goal, iteration.Status, iteration.Rank, iteration.Deliverable, iteration.StartedAt, iteration.CompletedAt, iteration.CreatedAt, iteration.UpdatedAt,
+		"INSERT INTO iterations (number, name, goal, status, rank, deliverable, capacity, started_at, completed_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		iteration.Number, iteration.Name, iteration.Goal, iteration.Status, iteration.Rank, iteration.Deliverable, iteration.Capacity, iteration.StartedAt, iteration.CompletedAt, iteration.CreatedAt, iteration.UpdatedAt,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to insert iteration: %w", err)
@@ -82,6 +82,7 @@ func (r *SQLiteIterationRepository) SaveIteration(ctx context.Context, iteration
 	if err = tx.Commit(); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
+	iteration.Version = 1
 
 	return nil
 }
@@ -90,12 +91,13 @@ func (r *SQLiteIterationRepository) SaveIteration(ctx context.Context, iteration
 func (r *SQLiteIterationRepository) GetIteration(ctx context.Context, number int) (*entities.IterationEntity, error) {
 	var iteration entities.IterationEntity
 	var startedAt, completedAt sql.NullTime
+	var capacity sql.NullFloat64
 
-	err := r.DB.QueryRowContext(
+	err := conn(ctx, r.DB).QueryRowContext(
 		ctx,
-		"SELECT number, name, goal, status, rank, deliverable, started_at, completed_at, created_at, updated_at FROM iterations WHERE number = ?",
+		"SELECT number, name, goal, status, rank, deliverable, capacity, started_at, completed_at, created_at, updated_at, version FROM iterations WHERE number = ?",
 		number,
-	).Scan(&iteration.Number, &iteration.Name, &iteration.Goal, &iteration.Status, &iteration.Rank, &iteration.Deliverable, &startedAt, &completedAt, &iteration.CreatedAt, &iteration.UpdatedAt)
+	).Scan(&iteration.Number, &iteration.Name, &iteration.Goal, &iteration.Status, &iteration.Rank, &iteration.Deliverable, &capacity, &startedAt, &completedAt, &iteration.CreatedAt, &iteration.UpdatedAt, &iteration.Version)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -104,6 +106,9 @@ func (r *SQLiteIterationRepository) GetIteration(ctx context.Context, number int
 		return nil, fmt.Errorf("failed to query iteration: %w", err)
 	}
 
+	if capacity.Valid {
+		iteration.Capacity = &capacity.Float64
+	}
 	if startedAt.Valid {
 		iteration.StartedAt = &startedAt.Time
 	}
@@ -125,12 +130,13 @@ func (r *SQLiteIterationRepository) GetIteration(ctx context.Context, number int
 func (r *SQLiteIterationRepository) GetCurrentIteration(ctx context.Context) (*entities.IterationEntity, error) {
 	var iteration entities.IterationEntity
 	var startedAt, completedAt sql.NullTime
+	var capacity sql.NullFloat64
 
-	err := r.DB.QueryRowContext(
+	err := conn(ctx, r.DB).QueryRowContext(
 		ctx,
-		"SELECT number, name, goal, status, rank, deliverable, started_at, completed_at, created_at, updated_at FROM iterations WHERE status = ? LIMIT 1",
+		"SELECT number, name, goal, status, rank, deliverable, capacity, started_at, completed_at, created_at, updated_at, version FROM iterations WHERE status = ? LIMIT 1",
 		"current",
-	).Scan(&iteration.Number, &iteration.Name, &iteration.Goal, &iteration.Status, &iteration.Rank, &iteration.Deliverable, &startedAt, &completedAt, &iteration.CreatedAt, &iteration.UpdatedAt)
+	).Scan(&iteration.Number, &iteration.Name, &iteration.Goal, &iteration.Status, &iteration.Rank, &iteration.Deliverable, &capacity, &startedAt, &completedAt, &iteration.CreatedAt, &iteration.UpdatedAt, &iteration.Version)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -139,6 +145,9 @@ func (r *SQLiteIterationRepository) GetCurrentIteration(ctx context.Context) (*e
 		return nil, fmt.Errorf("failed to query current iteration: %w", err)
 	}
 
+	if capacity.Valid {
+		iteration.Capacity = &capacity.Float64
+	}
 	if startedAt.Valid {
 		iteration.StartedAt = &startedAt.Time
 	}
@@ -158,9 +167,9 @@ func (r *SQLiteIterationRepository) GetCurrentIteration(ctx context.Context) (*e
 
 // ListIterations returns all iterations, ordered by rank (then number).
 func (r *SQLiteIterationRepository) ListIterations(ctx context.Context) ([]*entities.IterationEntity, error) {
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
-		"SELECT number, name, goal, status, rank, deliverable, started_at, completed_at, created_at, updated_at FROM iterations ORDER BY rank, number",
+		"SELECT number, name, goal, status, rank, deliverable, capacity, started_at, completed_at, created_at, updated_at, version FROM iterations ORDER BY rank, number",
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query iterations: %w", err)
@@ -171,12 +180,16 @@ func (r *SQLiteIterationRepository) ListIterations(ctx context.Context) ([]*enti
 	for rows.Next() {
 		var iteration entities.IterationEntity
 		var startedAt, completedAt sql.NullTime
+		var capacity sql.NullFloat64
 
-		err := rows.Scan(&iteration.Number, &iteration.Name, &iteration.Goal, &iteration.Status, &iteration.Rank, &iteration.Deliverable, &startedAt, &completedAt, &iteration.CreatedAt, &iteration.UpdatedAt)
+		err := rows.Scan(&iteration.Number, &iteration.Name, &iteration.Goal, &iteration.Status, &iteration.Rank, &iteration.Deliverable, &capacity, &startedAt, &completedAt, &iteration.CreatedAt, &iteration.UpdatedAt, &iteration.Version)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan iteration: %w", err)
 		}
 
+		if capacity.Valid {
+			iteration.Capacity = &capacity.Float64
+		}
 		if startedAt.Valid {
 			iteration.StartedAt = &startedAt.Time
 		}
@@ -202,31 +215,27 @@ func (r *SQLiteIterationRepository) ListIterations(ctx context.Context) ([]*enti
 }
 
 // UpdateIteration updates an existing iteration.
+// An iteration read at a version that is no longer stored is rejected with ErrConflict.
 func (r *SQLiteIterationRepository) UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error {
 	// Start transaction for iteration and tasks update
-	tx, err := r.DB.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.DB)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
 	// Update iteration fields
-	result, err := tx.ExecContext(
+	var version int
+	err = tx.QueryRowContext(
 		ctx,
-		"UPDATE iterations SET name = ?, goal = ?, status = ?, rank = ?, deliverable = ?, started_at = ?, completed_at = ?, updated_at = ? WHERE number = ?",
-		iteration.Name, iteration.Goal, iteration.Status, iteration.Rank, iteration.Deliverable, iteration.StartedAt, iteration.CompletedAt, iteration.UpdatedAt, iteration.Number,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to update iteration: %w", err)
+		"UPDATE iterations SET name = ?, goal = ?, status = ?, rank = ?, deliverable = ?, capacity = ?, started_at = ?, completed_at = ?, updated_at = ?, version = version + 1 WHERE number = ?"+versionGuard+" RETURNING version",
+		append([]interface{}{iteration.Name, iteration.Goal, iteration.Status, iteration.Rank, iteration.Deliverable, iteration.Capacity, iteration.StartedAt, iteration.CompletedAt, iteration.UpdatedAt, iteration.Number}, versionArgs(iteration.Version)...)...,
+	).Scan(&version)
+	if err == sql.ErrNoRows {
+		return updateMissed(ctx, tx, "iterations", "number", iteration.Number, "iteration")
 	}
-
-	rows, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
-	if rows == 0 {
-		return fmt.Errorf("%w: iteration %d not found", tmerrors.ErrNotFound, iteration.Number)
+		return fmt.Errorf("failed to update iteration: %w", err)
 	}
 
 	// Delete existing task associations
@@ -250,13 +259,14 @@ func (r *SQLiteIterationRepository) UpdateIteration(ctx context.Context, iterati
 	if err = tx.Commit(); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
+	iteration.Version = version
 
 	return nil
 }
 
 // DeleteIteration removes an iteration from storage.
 func (r *SQLiteIterationRepository) DeleteIteration(ctx context.Context, number int) error {
-	result, err := r.DB.ExecContext(ctx, "DELETE FROM iterations WHERE number = ?", number)
+	result, err := conn(ctx, r.DB).ExecContext(ctx, "DELETE FROM iterations WHERE number = ?", number)
 	if err != nil {
 		return fmt.Errorf("failed to delete iteration: %w", err)
 	}
@@ -277,7 +287,7 @@ func (r *SQLiteIterationRepository) DeleteIteration(ctx context.Context, number
 func (r *SQLiteIterationRepository) AddTaskToIteration(ctx context.Context, iterationNum int, taskID string) error {
 	// Check if iteration exists
 	var iterExists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM iterations WHERE number = ?", iterationNum).Scan(&iterExists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM iterations WHERE number = ?", iterationNum).Scan(&iterExists)
 	if err != nil {
 		return fmt.Errorf("failed to check iteration existence: %w", err)
 	}
@@ -287,7 +297,7 @@ func (r *SQLiteIterationRepository) AddTaskToIteration(ctx context.Context, iter
 
 	// Check if task exists
 	var taskExists int
-	err = r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", taskID).Scan(&taskExists)
+	err = conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", taskID).Scan(&taskExists)
 	if err != nil {
 		return fmt.Errorf("failed to check task existence: %w", err)
 	}
@@ -297,7 +307,7 @@ func (r *SQLiteIterationRepository) AddTaskToIteration(ctx context.Context, iter
 
 	// Check if task already in iteration
 	var alreadyExists int
-	err = r.DB.QueryRowContext(
+	err = conn(ctx, r.DB).QueryRowContext(
 		ctx,
 		"SELECT COUNT(*) FROM iteration_tasks WHERE iteration_number = ? AND task_id = ?",
 		iterationNum, taskID,
@@ -310,7 +320,7 @@ func (r *SQLiteIterationRepository) AddTaskToIteration(ctx context.Context, iter
 	}
 
 	// Insert task association
-	_, err = r.DB.ExecContext(
+	_, err = conn(ctx, r.DB).ExecContext(
 		ctx,
 		"INSERT INTO iteration_tasks (iteration_number, task_id) VALUES (?, ?)",
 		iterationNum, taskID,
@@ -319,12 +329,12 @@ func (r *SQLiteIterationRepository) AddTaskToIteration(ctx context.Context, iter
 		return fmt.Errorf("failed to add task to iteration: %w", err)
 	}
 
-	return nil
+	return r.bumpIterationVersion(ctx, iterationNum)
 }
 
 // RemoveTaskFromIteration removes a task from an iteration.
 func (r *SQLiteIterationRepository) RemoveTaskFromIteration(ctx context.Context, iterationNum int, taskID string) error {
-	result, err := r.DB.ExecContext(
+	result, err := conn(ctx, r.DB).ExecContext(
 		ctx,
 		"DELETE FROM iteration_tasks WHERE iteration_number = ? AND task_id = ?",
 		iterationNum, taskID,
@@ -342,6 +352,15 @@ func (r *SQLiteIterationRepository) RemoveTaskFromIteration(ctx context.Context,
 		return fmt.Errorf("%w: task not in iteration", tmerrors.ErrNotFound)
 	}
 
+	return r.bumpIterationVersion(ctx, iterationNum)
+}
+
+// bumpIterationVersion marks copies of an iteration read before its task list changed
+// as stale, so that updating one of them cannot write back the old task list.
+func (r *SQLiteIterationRepository) bumpIterationVersion(ctx context.Context, iterationNum int) error {
+	if _, err := conn(ctx, r.DB).ExecContext(ctx, "UPDATE iterations SET version = version + 1 WHERE number = ?", iterationNum); err != nil {
+		return fmt.Errorf("failed to update iteration version: %w", err)
+	}
 	return nil
 }
 
@@ -349,7 +368,7 @@ func (r *SQLiteIterationRepository) RemoveTaskFromIteration(ctx context.Context,
 func (r *SQLiteIterationRepository) GetIterationTasks(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, error) {
 	// Check if iteration exists
 	var exists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM iterations WHERE number = ?", iterationNum).Scan(&exists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM iterations WHERE number = ?", iterationNum).Scan(&exists)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check iteration existence: %w", err)
 	}
@@ -380,7 +399,7 @@ func (r *SQLiteIterationRepository) GetIterationTasks(ctx context.Context, itera
 func (r *SQLiteIterationRepository) GetIterationTasksWithWarnings(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, []string, error) {
 	// Check if iteration exists
 	var exists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM iterations WHERE number = ?", iterationNum).Scan(&exists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM iterations WHERE number = ?", iterationNum).Scan(&exists)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to check iteration existence: %w", err)
 	}
@@ -509,12 +528,13 @@ func (r *SQLiteIterationRepository) GetIterationByNumber(ctx context.Context, nu
 func (r *SQLiteIterationRepository) GetNextPlannedIteration(ctx context.Context) (*entities.IterationEntity, error) {
 	var iteration entities.IterationEntity
 	var startedAt, completedAt sql.NullTime
+	var capacity sql.NullFloat64
 
-	err := r.DB.QueryRowContext(
+	err := conn(ctx, r.DB).QueryRowContext(
 		ctx,
-		"SELECT number, name, goal, status, rank, deliverable, started_at, completed_at, created_at, updated_at FROM iterations WHERE status = ? ORDER BY rank, number LIMIT 1",
+		"SELECT number, name, goal, status, rank, deliverable, capacity, started_at, completed_at, created_at, updated_at, version FROM iterations WHERE status = ? ORDER BY rank, number LIMIT 1",
 		"planned",
-	).Scan(&iteration.Number, &iteration.Name, &iteration.Goal, &iteration.Status, &iteration.Rank, &iteration.Deliverable, &startedAt, &completedAt, &iteration.CreatedAt, &iteration.UpdatedAt)
+	).Scan(&iteration.Number, &iteration.Name, &iteration.Goal, &iteration.Status, &iteration.Rank, &iteration.Deliverable, &capacity, &startedAt, &completedAt, &iteration.CreatedAt, &iteration.UpdatedAt, &iteration.Version)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -523,6 +543,9 @@ func (r *SQLiteIterationRepository) GetNextPlannedIteration(ctx context.Context)
 		return nil, fmt.Errorf("failed to query next planned iteration: %w", err)
 	}
 
+	if capacity.Valid {
+		iteration.Capacity = &capacity.Float64
+	}
 	if startedAt.Valid {
 		iteration.StartedAt = &startedAt.Time
 	}
@@ -546,7 +569,7 @@ func (r *SQLiteIterationRepository) GetNextPlannedIteration(ctx context.Context)
 
 // getIterationTaskIDs retrieves all task IDs for an iteration.
 func (r *SQLiteIterationRepository) getIterationTaskIDs(ctx context.Context, iterationNum int) ([]string, error) {
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
 		"SELECT task_id FROM iteration_tasks WHERE iteration_number = ? ORDER BY task_id",
 		iterationNum,
@@ -576,12 +599,13 @@ func (r *SQLiteIterationRepository) getIterationTaskIDs(ctx context.Context, ite
 func (r *SQLiteIterationRepository) getTask(ctx context.Context, id string) (*entities.TaskEntity, error) {
 	var task entities.TaskEntity
 	var branch sql.NullString
+	var estimate sql.NullFloat64
 
-	err := r.DB.QueryRowContext(
+	err := conn(ctx, r.DB).QueryRowContext(
 		ctx,
-		"SELECT id, track_id, title, description, status, rank, branch, created_at, updated_at FROM tasks WHERE id = ?",
+		"SELECT id, track_id, title, description, status, rank, branch, estimate, created_at, updated_at, version FROM tasks WHERE id = ?",
 		id,
-	).Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &task.CreatedAt, &task.UpdatedAt)
+	).Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &estimate, &task.CreatedAt, &task.UpdatedAt, &task.Version)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -593,6 +617,13 @@ func (r *SQLiteIterationRepository) getTask(ctx context.Context, id string) (*en
 	if branch.Valid {
 		task.Branch = branch.String
 	}
+	if estimate.Valid {
+		task.Estimate = &estimate.Float64
+	}
+
+	if err := loadTaskBlockers(ctx, conn(ctx, r.DB), []*entities.TaskEntity{&task}); err != nil {
+		return nil, err
+	}
 
 	return &task, nil
 }
@@ -206,11 +206,19 @@ func TestGetIterationTasksWithWarnings_MissingTask(t *testing.T) {
 	iterationRepo.AddTaskToIteration(ctx, 1, "task-2")
 	iterationRepo.AddTaskToIteration(ctx, 1, "task-3")
 
-	// Delete task-2 directly from database to simulate a missing task
-	_, err := db.ExecContext(ctx, "DELETE FROM tasks WHERE id = ?", "task-2")
+	// Delete task-2 directly from database to simulate a missing task; foreign keys are
+	// turned off on that connection, or the cascade would drop the iteration link too
+	rawConn, err := db.Conn(ctx)
 	if err != nil {
+		t.Fatalf("failed to get connection: %v", err)
+	}
+	if _, err := rawConn.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+		t.Fatalf("failed to disable foreign keys: %v", err)
+	}
+	if _, err := rawConn.ExecContext(ctx, "DELETE FROM tasks WHERE id = ?", "task-2"); err != nil {
 		t.Fatalf("failed to delete task: %v", err)
 	}
+	rawConn.Close()
 
 	// Call GetIterationTasksWithWarnings
 	tasks, missingTaskIDs, err := iterationRepo.GetIterationTasksWithWarnings(ctx, 1)
@@ -315,6 +323,40 @@ func TestUpdateIteration(t *testing.T) {
 	}
 }
 
+func TestUpdateIteration_Conflict(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	repo := persistence.NewSQLiteIterationRepository(db, createTestLogger(), persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger()))
+	ctx := context.Background()
+
+	// Create iteration
+	iteration, _ := entities.NewIterationEntity(1, "Sprint 1", "Goal", "", []string{}, "planned", 500, time.Time{}, time.Time{}, time.Now().UTC(), time.Now().UTC())
+	repo.SaveIteration(ctx, iteration)
+
+	// Two writers read the same version; the first one wins
+	first, _ := repo.GetIteration(ctx, 1)
+	second, _ := repo.GetIteration(ctx, 1)
+
+	first.Goal = "First goal"
+	if err := repo.UpdateIteration(ctx, first); err != nil {
+		t.Fatalf("failed to update iteration: %v", err)
+	}
+
+	second.Goal = "Second goal"
+	if err := repo.UpdateIteration(ctx, second); !errors.Is(err, tmerrors.ErrConflict) {
+		t.Fatalf("expected ErrConflict, got %v", err)
+	}
+	if second.Version != 1 {
+		t.Errorf("expected rejected iteration to keep version 1, got %d", second.Version)
+	}
+
+	retrieved, _ := repo.GetIteration(ctx, 1)
+	if retrieved.Goal != "First goal" || retrieved.Version != 2 {
+		t.Errorf("expected first update to be kept, got goal %q, version %d", retrieved.Goal, retrieved.Version)
+	}
+}
+
 func TestDeleteIteration(t *testing.T) {
 	db := createTestDB(t)
 	defer db.Close()
@@ -6,14 +6,16 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
 )
 
 const (
-	// SchemaVersion is the current database schema version
-	SchemaVersion = 8
+	// SchemaVersion is the current database schema version, the version of the last migration
+	SchemaVersion = 16
 	// Note: SchemaVersion is per-project database version
 	// Projects table is in the workspace-level database (.darwinflow/projects.db)
 )
@@ -63,8 +65,10 @@ CREATE TABLE IF NOT EXISTS tasks (
     status TEXT NOT NULL,
     rank INTEGER NOT NULL DEFAULT 500,
     branch TEXT,
+    estimate REAL,
     created_at TIMESTAMP NOT NULL,
     updated_at TIMESTAMP NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
     FOREIGN KEY(track_id) REFERENCES tracks(id) ON DELETE CASCADE
 )
 `
@@ -77,10 +81,12 @@ CREATE TABLE IF NOT EXISTS iterations (
     status TEXT NOT NULL,
     rank REAL NOT NULL DEFAULT 500,
     deliverable TEXT,
+    capacity REAL,
     started_at TIMESTAMP,
     completed_at TIMESTAMP,
     created_at TIMESTAMP NOT NULL,
-    updated_at TIMESTAMP NOT NULL
+    updated_at TIMESTAMP NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1
 )
 `
 
@@ -92,6 +98,51 @@ CREATE TABLE IF NOT EXISTS iteration_tasks (
     FOREIGN KEY (iteration_number) REFERENCES iterations(number) ON DELETE CASCADE,
     FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
 )
+`
+
+	createTaskDependenciesTable = `
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    task_id TEXT NOT NULL,
+    blocked_by_id TEXT NOT NULL,
+    PRIMARY KEY (task_id, blocked_by_id),
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (blocked_by_id) REFERENCES tasks(id) ON DELETE CASCADE
+)
+`
+
+	createTaskDependenciesBlockedByIndex = `
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_blocked_by_id ON task_dependencies(blocked_by_id)
+`
+
+	createTaskStatusHistoryTable = `
+CREATE TABLE IF NOT EXISTS task_status_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    task_id TEXT NOT NULL,
+    from_status TEXT NOT NULL DEFAULT '',
+    to_status TEXT NOT NULL,
+    changed_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+)
+`
+
+	createTaskStatusHistoryTaskIDIndex = `
+CREATE INDEX IF NOT EXISTS idx_task_status_history_task_id ON task_status_history(task_id)
+`
+
+	createTaskStatusHistoryChangedAtIndex = `
+CREATE INDEX IF NOT EXISTS idx_task_status_history_changed_at ON task_status_history(changed_at)
+`
+
+	createSyncStateTable = `
+CREATE TABLE IF NOT EXISTS sync_state (
+    directory TEXT NOT NULL,
+    entity_type TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    content_hash TEXT NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    synced_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (directory, entity_type, entity_id)
+)
 `
 
 	createProjectMetadataTable = `
@@ -110,8 +161,10 @@ CREATE TABLE IF NOT EXISTS acceptance_criteria (
     status TEXT NOT NULL,
     notes TEXT,
     testing_instructions TEXT,
+    check_command TEXT NOT NULL DEFAULT '',
     created_at TIMESTAMP NOT NULL,
     updated_at TIMESTAMP NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
     FOREIGN KEY(task_id) REFERENCES tasks(id) ON DELETE CASCADE
 )
 `
@@ -202,6 +255,7 @@ CREATE TABLE IF NOT EXISTS documents (
     iteration_number INTEGER NULL REFERENCES iterations(number) ON DELETE CASCADE,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 1,
     metadata TEXT DEFAULT '{}',
     CHECK(NOT (track_id IS NOT NULL AND iteration_number IS NOT NULL))
 )
@@ -217,97 +271,230 @@ CREATE INDEX IF NOT EXISTS idx_documents_iteration_number ON documents(iteration
 
 	createDocumentsTypeIndex = `
 CREATE INDEX IF NOT EXISTS idx_documents_type ON documents(type)
+`
+
+	createEntityEventsTable = `
+CREATE TABLE IF NOT EXISTS entity_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity_type TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    changes TEXT NOT NULL DEFAULT '[]',
+    actor TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP NOT NULL
+)
+`
+
+	createEntityEventsEntityIDIndex = `
+CREATE INDEX IF NOT EXISTS idx_entity_events_entity_id ON entity_events(entity_id)
+`
+
+	createEntityEventsOccurredAtIndex = `
+CREATE INDEX IF NOT EXISTS idx_entity_events_occurred_at ON entity_events(occurred_at)
 `
 )
 
-// InitSchema initializes the database schema with all required tables and indexes.
-// It's safe to call multiple times (uses IF NOT EXISTS).
+// migration is one step of the schema history: up brings a database at version-1 to version,
+// and down, if the step can be reverted, takes it back.
+type migration struct {
+	version     int
+	description string
+	up          func(tx *sql.Tx) error
+	down        func(tx *sql.Tx) error // nil if the migration cannot be rolled back
+}
+
+// migrations is the schema history in order. A schema change adds a migration here and
+// bumps SchemaVersion to its version; new databases are created at SchemaVersion directly.
+var migrations = []migration{
+	{version: 4, description: "Replace priorities with ranks", up: migrateV3ToV4},
+	{version: 5, description: "Add testing instructions to acceptance criteria", up: migrateV4ToV5},
+	{version: 6, description: "Add documents", up: migrateV5ToV6},
+	{version: 7, description: "Store iteration ranks as REAL", up: migrateV6ToV7},
+	{version: 8, description: "Normalize iteration ranks", up: migrateV7ToV8},
+	{version: 9, description: "Add entity history log", up: migrateV8ToV9, down: dropTables("entity_events")},
+	{version: 10, description: "Add acceptance criteria check commands", up: migrateV9ToV10, down: dropColumns("acceptance_criteria.check_command")},
+	{version: 11, description: "Add full-text search index", up: migrateV10ToV11, down: dropSearchIndex},
+	{version: 12, description: "Add task dependencies", up: migrateV11ToV12, down: dropTables("task_dependencies")},
+	{version: 13, description: "Add task status history", up: migrateV12ToV13, down: dropTables("task_status_history")},
+	{version: 14, description: "Add sync state", up: migrateV13ToV14, down: dropTables("sync_state")},
+	{version: 15, description: "Add task estimates and iteration capacity", up: migrateV14ToV15, down: dropColumns("tasks.estimate", "iterations.capacity")},
+	{version: 16, description: "Add versions for optimistic locking", up: migrateV15ToV16,
+		down: dropColumns("tasks.version", "acceptance_criteria.version", "iterations.version", "documents.version")},
+}
+
+// baseSchemaVersion is the oldest schema the migrations start from.
+// Databases without a known version are treated as new.
+var baseSchemaVersion = migrations[0].version - 1
+
+// InitSchema brings the database schema up to SchemaVersion, creating all tables and
+// indexes of a new database. It's safe to call multiple times.
 func InitSchema(db *sql.DB) error {
-	// First create project_metadata table if it doesn't exist
-	if _, err := db.Exec(createProjectMetadataTable); err != nil {
-		return fmt.Errorf("failed to create project_metadata table: %w", err)
+	_, err := migrateSchema(db, SchemaVersion)
+	return err
+}
+
+// readSchemaVersion returns the schema version of a database without changing it.
+// Returns 0 for a new database or one whose version is unknown.
+func readSchemaVersion(db *sql.DB) (int, error) {
+	var tables int
+	if err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'project_metadata'").Scan(&tables); err != nil {
+		return 0, fmt.Errorf("failed to check schema version: %w", err)
 	}
 
-	// Check if we need to migrate from version 3 to version 4 (priority -> rank)
 	var currentVersion int
-	err := db.QueryRow("SELECT CAST(value AS INTEGER) FROM project_metadata WHERE key = 'schema_version'").Scan(&currentVersion)
-	if err != nil && err != sql.ErrNoRows {
-		return fmt.Errorf("failed to check schema version: %w", err)
+	if tables > 0 {
+		err := db.QueryRow("SELECT CAST(value AS INTEGER) FROM project_metadata WHERE key = 'schema_version'").Scan(&currentVersion)
+		if err != nil && err != sql.ErrNoRows {
+			return 0, fmt.Errorf("failed to check schema version: %w", err)
+		}
+	}
+	if currentVersion != 0 {
+		return currentVersion, nil
 	}
 
 	// If no version found, check if we have old tables with priority column
-	if err == sql.ErrNoRows || currentVersion == 0 {
-		// Check if tracks table exists and has priority column
-		var hasPriorityColumn bool
-		rows, err := db.Query("PRAGMA table_info(tracks)")
-		if err == nil {
-			defer rows.Close()
-			for rows.Next() {
-				var cid int
-				var name, typ string
-				var notnull, pk int
-				var dfltValue sql.NullString
-				if err := rows.Scan(&cid, &name, &typ, &notnull, &dfltValue, &pk); err == nil {
-					if name == "priority" {
-						hasPriorityColumn = true
-						break
-					}
-				}
-			}
-			rows.Close()
-		}
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+	hasPriorityColumn, err := tableHasColumn(tx, "tracks", "priority")
+	if err != nil {
+		return 0, err
+	}
+	if hasPriorityColumn {
+		// A v3 database that needs migration
+		return baseSchemaVersion, nil
+	}
+	return 0, nil
+}
 
-		// If we found priority column, it's a v3 database that needs migration
-		if hasPriorityColumn {
-			currentVersion = 3
+// migrationPlan returns the migrations moving a database from currentVersion to targetVersion:
+// the newer ones oldest first when upgrading, the applied ones newest first when rolling back.
+func migrationPlan(currentVersion, targetVersion int) ([]migration, error) {
+	if currentVersion > SchemaVersion {
+		return nil, fmt.Errorf("%w: database schema v%d is newer than this tm supports (v%d); upgrade tm", tmerrors.ErrRejected, currentVersion, SchemaVersion)
+	}
+	if targetVersion < baseSchemaVersion || targetVersion > SchemaVersion {
+		return nil, fmt.Errorf("%w: unknown schema version %d (must be between %d and %d)", tmerrors.ErrInvalidArgument, targetVersion, baseSchemaVersion, SchemaVersion)
+	}
+	if currentVersion < baseSchemaVersion {
+		if targetVersion != SchemaVersion {
+			return nil, fmt.Errorf("%w: a new database can only be created at the latest schema v%d", tmerrors.ErrInvalidArgument, SchemaVersion)
 		}
+		return nil, nil
 	}
 
-	// If we have version 3, run migration
-	if currentVersion == 3 {
-		if err := migrateV3ToV4(db); err != nil {
-			return fmt.Errorf("failed to migrate from v3 to v4: %w", err)
+	plan := []migration{}
+	if targetVersion >= currentVersion {
+		for _, m := range migrations {
+			if m.version > currentVersion && m.version <= targetVersion {
+				plan = append(plan, m)
+			}
 		}
-		currentVersion = 4
+		return plan, nil
 	}
-
-	// If we have version 4, run migration
-	if currentVersion == 4 {
-		if err := migrateV4ToV5(db); err != nil {
-			return fmt.Errorf("failed to migrate from v4 to v5: %w", err)
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if m.version > currentVersion || m.version <= targetVersion {
+			continue
+		}
+		if m.down == nil {
+			return nil, fmt.Errorf("%w: migration to v%d (%s) cannot be rolled back", tmerrors.ErrRejected, m.version, m.description)
 		}
-		currentVersion = 5
+		plan = append(plan, m)
 	}
+	return plan, nil
+}
 
-	// If we have version 5, run migration
-	if currentVersion == 5 {
-		if err := migrateV5ToV6(db); err != nil {
-			return fmt.Errorf("failed to migrate from v5 to v6: %w", err)
-		}
-		currentVersion = 6
+// migrateSchema moves the database schema to targetVersion, running the up steps of newer
+// migrations or the down steps of applied ones. Every step commits together with the version
+// it reaches, so a failed migration leaves the database at the last version reached.
+// Returns the migrations run.
+func migrateSchema(db *sql.DB, targetVersion int) ([]migration, error) {
+	// First create project_metadata table if it doesn't exist
+	if _, err := db.Exec(createProjectMetadataTable); err != nil {
+		return nil, fmt.Errorf("failed to create project_metadata table: %w", err)
 	}
 
-	// If we have version 6, run migration
-	if currentVersion == 6 {
-		if err := migrateV6ToV7(db); err != nil {
-			return fmt.Errorf("failed to migrate from v6 to v7: %w", err)
+	currentVersion, err := readSchemaVersion(db)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := migrationPlan(currentVersion, targetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, m := range plan {
+		if targetVersion > currentVersion {
+			err = inSchemaTransaction(db, m.version, func(tx *sql.Tx) error {
+				if err := m.up(tx); err != nil {
+					return fmt.Errorf("failed to migrate from v%d to v%d: %w", m.version-1, m.version, err)
+				}
+				return nil
+			})
+		} else {
+			err = inSchemaTransaction(db, m.version-1, func(tx *sql.Tx) error {
+				if err := m.down(tx); err != nil {
+					return fmt.Errorf("failed to roll back from v%d to v%d: %w", m.version, m.version-1, err)
+				}
+				return nil
+			})
+		}
+		if err != nil {
+			return plan[:i], err
 		}
-		currentVersion = 7
 	}
 
-	// If we have version 7, run migration
-	if currentVersion == 7 {
-		if err := migrateV7ToV8(db); err != nil {
-			return fmt.Errorf("failed to migrate from v7 to v8: %w", err)
+	if targetVersion == SchemaVersion {
+		// Creates a new database, and anything an older schema lacked
+		if err := inSchemaTransaction(db, SchemaVersion, createSchema); err != nil {
+			return plan, err
 		}
-		currentVersion = 8
 	}
 
+	return plan, nil
+}
+
+// inSchemaTransaction runs a schema change and records the version it reaches in one transaction
+func inSchemaTransaction(db *sql.DB, version int, change func(tx *sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := change(tx); err != nil {
+		return err
+	}
+	if err := setSchemaVersion(tx, version); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit schema v%d: %w", version, err)
+	}
+	return nil
+}
+
+// setSchemaVersion records the schema version of the database
+func setSchemaVersion(tx *sql.Tx, version int) error {
+	_, err := tx.Exec("INSERT OR REPLACE INTO project_metadata (key, value) VALUES ('schema_version', ?)", version)
+	if err != nil {
+		return fmt.Errorf("failed to update schema version: %w", err)
+	}
+	return nil
+}
+
+// createSchema creates all tables and indexes of the latest schema that don't exist yet
+func createSchema(tx *sql.Tx) error {
 	statements := []string{
 		createRoadmapsTable,
 		createTracksTable,
 		createTrackDependenciesTable,
 		createTasksTable,
+		createTaskDependenciesTable,
+		createTaskStatusHistoryTable,
 		createIterationsTable,
 		createIterationTasksTable,
 		createProjectMetadataTable,
@@ -320,6 +507,9 @@ func InitSchema(db *sql.DB) error {
 		createTasksTrackIDIndex,
 		createTasksStatusIndex,
 		createTasksRankIndex,
+		createTaskDependenciesBlockedByIndex,
+		createTaskStatusHistoryTaskIDIndex,
+		createTaskStatusHistoryChangedAtIndex,
 		createIterationsStatusIndex,
 		createIterationsRankIndex,
 		createIterationTasksIterationIndex,
@@ -331,21 +521,20 @@ func InitSchema(db *sql.DB) error {
 		createDocumentsTrackIDIndex,
 		createDocumentsIterationNumberIndex,
 		createDocumentsTypeIndex,
+		createEntityEventsTable,
+		createEntityEventsEntityIDIndex,
+		createEntityEventsOccurredAtIndex,
+		createSyncStateTable,
 	}
 
 	for _, stmt := range statements {
-		if _, err := db.Exec(stmt); err != nil {
+		if _, err := tx.Exec(stmt); err != nil {
 			return fmt.Errorf("failed to create schema: %w", err)
 		}
 	}
 
-	// Update schema version
-	_, err = db.Exec("INSERT OR REPLACE INTO project_metadata (key, value) VALUES ('schema_version', ?)", SchemaVersion)
-	if err != nil {
-		return fmt.Errorf("failed to update schema version: %w", err)
-	}
-
-	return nil
+	// The full-text index depends on the tables above and the driver's FTS support
+	return ensureSearchIndex(tx)
 }
 
 // MigrateFromFileStorage migrates existing task JSON files to the database.
@@ -452,7 +641,7 @@ func MigrateFromFileStorage(db *sql.DB, tasksDir string) error {
 		data, err := os.ReadFile(taskPath)
 		if err != nil {
 			// Log error but continue with next file
-			fmt.Printf("Warning: failed to read task file %s: %v\n", entry.Name(), err)
+			fmt.Fprintf(os.Stderr, "Warning: failed to read task file %s: %v\n", entry.Name(), err)
 			continue
 		}
 
@@ -460,7 +649,7 @@ func MigrateFromFileStorage(db *sql.DB, tasksDir string) error {
 		var oldTask entities.TaskEntity
 		if err := json.Unmarshal(data, &oldTask); err != nil {
 			// Log error but continue
-			fmt.Printf("Warning: failed to parse task file %s: %v\n", entry.Name(), err)
+			fmt.Fprintf(os.Stderr, "Warning: failed to parse task file %s: %v\n", entry.Name(), err)
 			continue
 		}
 
@@ -471,7 +660,7 @@ func MigrateFromFileStorage(db *sql.DB, tasksDir string) error {
 		)
 		if err != nil {
 			// Log error but continue
-			fmt.Printf("Warning: failed to migrate task %s: %v\n", oldTask.ID, err)
+			fmt.Fprintf(os.Stderr, "Warning: failed to migrate task %s: %v\n", oldTask.ID, err)
 			continue
 		}
 
@@ -479,7 +668,7 @@ func MigrateFromFileStorage(db *sql.DB, tasksDir string) error {
 	}
 
 	if migratedCount > 0 {
-		fmt.Printf("Migrated %d tasks to database\n", migratedCount)
+		fmt.Fprintf(os.Stderr, "Migrated %d tasks to database\n", migratedCount)
 	}
 
 	return nil
@@ -492,14 +681,7 @@ func GetCurrentTime() time.Time {
 }
 
 // migrateV3ToV4 migrates database from schema version 3 (priority TEXT) to version 4 (rank INTEGER)
-func migrateV3ToV4(db *sql.DB) error {
-	// Start transaction
-	tx, err := db.Begin()
-	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
-	}
-	defer tx.Rollback()
-
+func migrateV3ToV4(tx *sql.Tx) error {
 	// Check if tracks table has priority column (version 3)
 	var hasPriority bool
 	rows, err := tx.Query("PRAGMA table_info(tracks)")
@@ -525,10 +707,10 @@ func migrateV3ToV4(db *sql.DB) error {
 
 	if !hasPriority {
 		// Already migrated or new database
-		return tx.Commit()
+		return nil
 	}
 
-	fmt.Println("Migrating database from schema v3 to v4 (priority -> rank)...")
+	fmt.Fprintln(os.Stderr, "Migrating database from schema v3 to v4 (priority -> rank)...")
 
 	// MIGRATE TRACKS TABLE
 	// 1. Create new tracks table with rank
@@ -773,25 +955,13 @@ func migrateV3ToV4(db *sql.DB) error {
 		return fmt.Errorf("failed to create iterations rank index: %w", err)
 	}
 
-	// Commit transaction
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit migration: %w", err)
-	}
-
-	fmt.Println("✓ Migration to schema v4 complete!")
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v4 complete!")
 	return nil
 }
 
 // migrateV4ToV5 migrates database from schema version 4 to version 5
 // Adds testing_instructions column to acceptance_criteria table
-func migrateV4ToV5(db *sql.DB) error {
-	// Start transaction
-	tx, err := db.Begin()
-	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
-	}
-	defer tx.Rollback()
-
+func migrateV4ToV5(tx *sql.Tx) error {
 	// Check if acceptance_criteria table has testing_instructions column (version 5)
 	var hasTestingInstructions bool
 	rows, err := tx.Query("PRAGMA table_info(acceptance_criteria)")
@@ -817,10 +987,10 @@ func migrateV4ToV5(db *sql.DB) error {
 
 	if hasTestingInstructions {
 		// Already migrated or new database
-		return tx.Commit()
+		return nil
 	}
 
-	fmt.Println("Migrating database from schema v4 to v5 (adding testing_instructions column)...")
+	fmt.Fprintln(os.Stderr, "Migrating database from schema v4 to v5 (adding testing_instructions column)...")
 
 	// MIGRATE ACCEPTANCE_CRITERIA TABLE
 	// 1. Create new acceptance_criteria table with testing_instructions
@@ -880,38 +1050,26 @@ func migrateV4ToV5(db *sql.DB) error {
 		return fmt.Errorf("failed to create ac_status index: %w", err)
 	}
 
-	// Commit transaction
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit migration: %w", err)
-	}
-
-	fmt.Println("✓ Migration to schema v5 complete!")
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v5 complete!")
 	return nil
 }
 
 // migrateV5ToV6 migrates database from schema version 5 to version 6
 // Adds documents table for ADR, plan, and retrospective documents
-func migrateV5ToV6(db *sql.DB) error {
-	// Start transaction
-	tx, err := db.Begin()
-	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
-	}
-	defer tx.Rollback()
-
+func migrateV5ToV6(tx *sql.Tx) error {
 	// Check if documents table already exists
 	var tableExists int
-	err = tx.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type='table' AND name='documents'").Scan(&tableExists)
+	err := tx.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type='table' AND name='documents'").Scan(&tableExists)
 	if err != nil {
 		return fmt.Errorf("failed to check for documents table: %w", err)
 	}
 
 	if tableExists > 0 {
 		// Already migrated
-		return tx.Commit()
+		return nil
 	}
 
-	fmt.Println("Migrating database from schema v5 to v6 (adding documents table)...")
+	fmt.Fprintln(os.Stderr, "Migrating database from schema v5 to v6 (adding documents table)...")
 
 	// Create documents table
 	_, err = tx.Exec(`
@@ -949,25 +1107,13 @@ func migrateV5ToV6(db *sql.DB) error {
 		return fmt.Errorf("failed to create documents type index: %w", err)
 	}
 
-	// Commit transaction
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit migration: %w", err)
-	}
-
-	fmt.Println("✓ Migration to schema v6 complete!")
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v6 complete!")
 	return nil
 }
 
 // migrateV6ToV7 migrates database from schema version 6 to version 7
 // Changes iteration rank column from INTEGER to REAL to support fractional ranking
-func migrateV6ToV7(db *sql.DB) error {
-	// Start transaction
-	tx, err := db.Begin()
-	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
-	}
-	defer tx.Rollback()
-
+func migrateV6ToV7(tx *sql.Tx) error {
 	// Check if iterations table has rank column with REAL type (already migrated)
 	var columnType string
 	rows, err := tx.Query("PRAGMA table_info(iterations)")
@@ -999,10 +1145,10 @@ func migrateV6ToV7(db *sql.DB) error {
 
 	// If already REAL, migration complete
 	if columnType == "REAL" {
-		return tx.Commit()
+		return nil
 	}
 
-	fmt.Println("Migrating database from schema v6 to v7 (iteration rank: INTEGER -> REAL)...")
+	fmt.Fprintln(os.Stderr, "Migrating database from schema v6 to v7 (iteration rank: INTEGER -> REAL)...")
 
 	// MIGRATE ITERATIONS TABLE
 	// 1. Create new iterations table with REAL rank
@@ -1063,23 +1209,12 @@ func migrateV6ToV7(db *sql.DB) error {
 		return fmt.Errorf("failed to create iterations rank index: %w", err)
 	}
 
-	// Commit transaction
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit migration: %w", err)
-	}
-
-	fmt.Println("✓ Migration to schema v7 complete!")
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v7 complete!")
 	return nil
 }
 
 // migrateV7ToV8 normalizes iteration ranks to prevent collisions
-func migrateV7ToV8(db *sql.DB) error {
-	tx, err := db.Begin()
-	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
-	}
-	defer tx.Rollback()
-
+func migrateV7ToV8(tx *sql.Tx) error {
 	// Fetch all iterations ordered by current rank and number (database order)
 	rows, err := tx.Query(`
 		SELECT number, rank
@@ -1125,10 +1260,297 @@ func migrateV7ToV8(db *sql.DB) error {
 		}
 	}
 
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v8 complete! (Normalized iteration ranks)")
+	return nil
+}
+
+// migrateV8ToV9 migrates database from schema version 8 to version 9
+// Adds the append-only entity_events table backing `tm history`
+func migrateV8ToV9(tx *sql.Tx) error {
+	if _, err := tx.Exec(createEntityEventsTable); err != nil {
+		return fmt.Errorf("failed to create entity_events table: %w", err)
+	}
+
+	if _, err := tx.Exec(createEntityEventsEntityIDIndex); err != nil {
+		return fmt.Errorf("failed to create entity_events entity_id index: %w", err)
+	}
+
+	if _, err := tx.Exec(createEntityEventsOccurredAtIndex); err != nil {
+		return fmt.Errorf("failed to create entity_events occurred_at index: %w", err)
+	}
+
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v9 complete! (Added entity history log)")
+	return nil
+}
+
+// migrateV9ToV10 migrates database from schema version 9 to version 10
+// Adds check_command column to acceptance_criteria for `tm ac run`
+func migrateV9ToV10(tx *sql.Tx) error {
+	// Check if acceptance_criteria table already has check_command column
+	var hasCheckCommand bool
+	rows, err := tx.Query("PRAGMA table_info(acceptance_criteria)")
+	if err != nil {
+		return fmt.Errorf("failed to check acceptance_criteria table: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid int
+		var name, typ string
+		var notnull, pk int
+		var dfltValue sql.NullString
+		if err := rows.Scan(&cid, &name, &typ, &notnull, &dfltValue, &pk); err != nil {
+			return fmt.Errorf("failed to scan column info: %w", err)
+		}
+		if name == "check_command" {
+			hasCheckCommand = true
+			break
+		}
+	}
+	rows.Close()
+
+	if hasCheckCommand {
+		// Already migrated or new database
+		return nil
+	}
+
+	if _, err := tx.Exec("ALTER TABLE acceptance_criteria ADD COLUMN check_command TEXT NOT NULL DEFAULT ''"); err != nil {
+		return fmt.Errorf("failed to add check_command column: %w", err)
+	}
+
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v10 complete! (Added acceptance criteria check commands)")
+	return nil
+}
+
+// migrateV10ToV11 migrates database from schema version 10 to version 11
+// Adds the full-text search index over tasks, ACs, ADRs and documents for `tm search`
+func migrateV10ToV11(tx *sql.Tx) error {
+	if err := ensureSearchIndex(tx); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v11 complete! (Added full-text search index)")
+	return nil
+}
+
+// migrateV11ToV12 migrates database from schema version 11 to version 12
+// Adds task_dependencies table for task-level "blocked by" links
+func migrateV11ToV12(tx *sql.Tx) error {
+	for _, stmt := range []string{createTaskDependenciesTable, createTaskDependenciesBlockedByIndex} {
+		if _, err := tx.Exec(stmt); err != nil {
+			return fmt.Errorf("failed to create task_dependencies table: %w", err)
+		}
+	}
+
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v12 complete! (Added task dependencies)")
+	return nil
+}
+
+// migrateV12ToV13 migrates database from schema version 12 to version 13
+// Adds task_status_history table for metrics and backfills it: from the status changes recorded in
+// entity_events where available, otherwise with each task's current status as of its last update
+func migrateV12ToV13(tx *sql.Tx) error {
+	for _, stmt := range []string{createTaskStatusHistoryTable, createTaskStatusHistoryTaskIDIndex, createTaskStatusHistoryChangedAtIndex} {
+		if _, err := tx.Exec(stmt); err != nil {
+			return fmt.Errorf("failed to create task_status_history table: %w", err)
+		}
+	}
+
+	var existing int
+	if err := tx.QueryRow("SELECT COUNT(*) FROM task_status_history").Scan(&existing); err != nil {
+		return fmt.Errorf("failed to check task_status_history: %w", err)
+	}
+	if existing > 0 {
+		// Already migrated
+		return nil
+	}
+
+	// Status changes recorded by the history log, oldest first
+	recorded := make(map[string][]entities.TaskStatusChange)
+	rows, err := tx.Query("SELECT entity_id, changes, occurred_at FROM entity_events WHERE entity_type = 'task' ORDER BY occurred_at, id")
+	if err != nil {
+		return fmt.Errorf("failed to query task events: %w", err)
+	}
+	for rows.Next() {
+		var taskID, changesJSON string
+		var occurredAt time.Time
+		if err := rows.Scan(&taskID, &changesJSON, &occurredAt); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan task event: %w", err)
+		}
+		var changes []entities.FieldChange
+		if err := json.Unmarshal([]byte(changesJSON), &changes); err != nil {
+			continue
+		}
+		for _, change := range changes {
+			to, ok := change.After.(string)
+			if change.Field != "status" || !ok {
+				continue
+			}
+			from, _ := change.Before.(string)
+			recorded[taskID] = append(recorded[taskID], entities.TaskStatusChange{FromStatus: from, ToStatus: to, ChangedAt: occurredAt})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return fmt.Errorf("error iterating task events: %w", err)
+	}
+	rows.Close()
+
+	type taskRow struct {
+		id, status           string
+		createdAt, updatedAt time.Time
+	}
+	var tasks []taskRow
+	rows, err = tx.Query("SELECT id, status, created_at, updated_at FROM tasks")
+	if err != nil {
+		return fmt.Errorf("failed to query tasks: %w", err)
+	}
+	for rows.Next() {
+		var task taskRow
+		if err := rows.Scan(&task.id, &task.status, &task.createdAt, &task.updatedAt); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return fmt.Errorf("error iterating tasks: %w", err)
+	}
+	rows.Close()
+
+	for _, task := range tasks {
+		changes := recorded[task.id]
+		if len(changes) == 0 {
+			// No recorded history: the task reached its current status by its last update
+			changes = []entities.TaskStatusChange{{ToStatus: task.status, ChangedAt: task.updatedAt}}
+		} else if changes[0].FromStatus != "" {
+			// History starts mid-life: add the status the task was created with
+			initial := entities.TaskStatusChange{ToStatus: changes[0].FromStatus, ChangedAt: task.createdAt}
+			changes = append([]entities.TaskStatusChange{initial}, changes...)
+		}
+		for _, change := range changes {
+			if _, err := tx.Exec(
+				"INSERT INTO task_status_history (task_id, from_status, to_status, changed_at) VALUES (?, ?, ?, ?)",
+				task.id, change.FromStatus, change.ToStatus, change.ChangedAt,
+			); err != nil {
+				return fmt.Errorf("failed to backfill status history of %s: %w", task.id, err)
+			}
+		}
+	}
+
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v13 complete! (Added task status history)")
+	return nil
+}
+
+// migrateV13ToV14 migrates database from schema version 13 to version 14
+// Adds sync_state table holding the baselines of `tm sync`
+func migrateV13ToV14(tx *sql.Tx) error {
+	if _, err := tx.Exec(createSyncStateTable); err != nil {
+		return fmt.Errorf("failed to create sync_state table: %w", err)
+	}
+
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v14 complete! (Added sync state)")
+	return nil
+}
+
+// migrateV14ToV15 migrates database from schema version 14 to version 15
+// Adds estimate column to tasks and capacity column to iterations for capacity tracking
+func migrateV14ToV15(tx *sql.Tx) error {
+	for _, column := range []struct{ table, name string }{
+		{"tasks", "estimate"},
+		{"iterations", "capacity"},
+	} {
+		exists, err := tableHasColumn(tx, column.table, column.name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			// Already migrated or new database
+			continue
+		}
+		if _, err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s REAL", column.table, column.name)); err != nil {
+			return fmt.Errorf("failed to add %s column to %s: %w", column.name, column.table, err)
+		}
 	}
 
-	fmt.Println("✓ Migration to schema v8 complete! (Normalized iteration ranks)")
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v15 complete! (Added task estimates and iteration capacity)")
 	return nil
 }
+
+// migrateV15ToV16 migrates database from schema version 15 to version 16
+// Adds version columns to tasks, acceptance criteria, iterations and documents for optimistic locking
+func migrateV15ToV16(tx *sql.Tx) error {
+	for _, table := range []string{"tasks", "acceptance_criteria", "iterations", "documents"} {
+		exists, err := tableHasColumn(tx, table, "version")
+		if err != nil {
+			return err
+		}
+		if exists {
+			// Already migrated or new database
+			continue
+		}
+		if _, err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN version INTEGER NOT NULL DEFAULT 1", table)); err != nil {
+			return fmt.Errorf("failed to add version column to %s: %w", table, err)
+		}
+	}
+
+	fmt.Fprintln(os.Stderr, "✓ Migration to schema v16 complete! (Added versions for optimistic locking)")
+	return nil
+}
+
+// tableHasColumn reports whether a table has the named column
+func tableHasColumn(tx *sql.Tx, table, column string) (bool, error) {
+	rows, err := tx.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return false, fmt.Errorf("failed to check %s table: %w", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid int
+		var name, typ string
+		var notnull, pk int
+		var dfltValue sql.NullString
+		if err := rows.Scan(&cid, &name, &typ, &notnull, &dfltValue, &pk); err != nil {
+			return false, fmt.Errorf("failed to scan column info: %w", err)
+		}
+		if name == column {
+			return true, nil
+		}
+	}
+	return false, rows.Err()
+}
+
+// dropTables returns a down step removing the tables a migration added
+func dropTables(tables ...string) func(tx *sql.Tx) error {
+	return func(tx *sql.Tx) error {
+		for _, table := range tables {
+			if _, err := tx.Exec("DROP TABLE IF EXISTS " + table); err != nil {
+				return fmt.Errorf("failed to drop %s table: %w", table, err)
+			}
+		}
+		return nil
+	}
+}
+
+// dropColumns returns a down step removing the columns a migration added, given as "table.column"
+func dropColumns(columns ...string) func(tx *sql.Tx) error {
+	return func(tx *sql.Tx) error {
+		for _, qualified := range columns {
+			table, column, _ := strings.Cut(qualified, ".")
+			exists, err := tableHasColumn(tx, table, column)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				continue
+			}
+			if _, err := tx.Exec(fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", table, column)); err != nil {
+				return fmt.Errorf("failed to drop %s column from %s: %w", column, table, err)
+			}
+		}
+		return nil
+	}
+}
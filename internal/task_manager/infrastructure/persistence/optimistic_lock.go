@@ -0,0 +1,35 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+)
+
+// versionGuard is appended to the WHERE clause of an update so that it only matches the
+// row version the entity was read at. Entities that were never read (version 0) match any version.
+// Its arguments come from versionArgs.
+const versionGuard = " AND (? = 0 OR version = ?)"
+
+// versionArgs returns the arguments of versionGuard for an entity read at version
+func versionArgs(version int) []interface{} {
+	return []interface{}{version, version}
+}
+
+// updateMissed explains an update that matched no row: either the row is gone, or it was
+// updated by someone else since it was read.
+func updateMissed(ctx context.Context, q interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}, table, keyColumn string, key interface{}, entity string) error {
+	var exists int
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s = ?", table, keyColumn)
+	if err := q.QueryRowContext(ctx, query, key).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check %s existence: %w", entity, err)
+	}
+	if exists == 0 {
+		return fmt.Errorf("%w: %s %v not found", tmerrors.ErrNotFound, entity, key)
+	}
+	return fmt.Errorf("%w: %s %v was modified since it was read", tmerrors.ErrConflict, entity, key)
+}
@@ -6,8 +6,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/mattn/go-sqlite3"
 )
 
 const (
@@ -54,13 +57,74 @@ func GetActiveProject(workingDir string) (string, error) {
 	return projectName, nil
 }
 
+// ProjectEnvVar is the environment variable that selects a project for a single invocation.
+const ProjectEnvVar = "TM_PROJECT"
+
+// ResolveProject determines which project a command operates on.
+// Priority:
+//  1. Explicit selection (the --project flag)
+//  2. Environment variable TM_PROJECT
+//  3. The active project from <workingDir>/active-project.txt
+//
+// Unlike the active project, an explicitly selected project must already exist,
+// so a typo doesn't silently create an empty database.
+func ResolveProject(workingDir, selected string) (string, error) {
+	if selected == "" {
+		selected = strings.TrimSpace(os.Getenv(ProjectEnvVar))
+	}
+	if selected == "" {
+		return GetActiveProject(workingDir)
+	}
+
+	projectDir := filepath.Join(workingDir, "projects", selected)
+	info, err := os.Stat(projectDir)
+	if err != nil || !info.IsDir() {
+		return "", fmt.Errorf("%w: project '%s' does not exist (create it with 'tm project create %s')", tmerrors.ErrNotFound, selected, selected)
+	}
+	return selected, nil
+}
+
 // GetProjectDatabasePath returns the database file path for a project.
 // Does not open the database or verify its existence.
 func GetProjectDatabasePath(workingDir, projectName string) string {
 	return filepath.Join(workingDir, "projects", projectName, "roadmap.db")
 }
 
-// OpenProjectDatabase opens or creates a project database and runs migrations.
+// SQLiteDriverName is the database/sql driver project databases are opened with: go-sqlite3
+// with the SQL functions tm's queries use registered on every connection.
+const SQLiteDriverName = "sqlite3_tm"
+
+func init() {
+	sql.Register(SQLiteDriverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			// FTS4 has no built-in ranking, so search hits are ordered by this function
+			return conn.RegisterFunc("search_rank", scoreMatchInfo, true)
+		},
+	})
+}
+
+// busyTimeoutMillis is how long a connection waits for another process to release
+// the database before failing with "database is locked".
+const busyTimeoutMillis = 5000
+
+// projectDatabaseDSN returns the connection string of a project database.
+// Every connection of the pool gets the same settings:
+//   - WAL journaling, so readers (tm ui, tm serve) don't block a writer and vice versa
+//   - a busy timeout, so concurrent writers wait for each other instead of failing
+//   - immediate transactions, which take the write lock at BEGIN rather than failing
+//     halfway through when a read lock cannot be upgraded
+//   - enforced foreign keys, unless the connection runs migrations: table rebuilds
+//     drop tables that other tables reference with ON DELETE CASCADE.
+func projectDatabaseDSN(dbPath string, foreignKeys bool) string {
+	dsn := fmt.Sprintf("%s?_journal_mode=WAL&_busy_timeout=%d&_txlock=immediate", dbPath, busyTimeoutMillis)
+	if foreignKeys {
+		dsn += "&_foreign_keys=on"
+	}
+	return dsn
+}
+
+// OpenProjectDatabase opens or creates a project database and runs migrations,
+// backing up an existing database before it is migrated.
 // Creates the project directory structure if it doesn't exist.
 // Returns the database path and an open database connection.
 func OpenProjectDatabase(workingDir, projectName string) (string, *sql.DB, error) {
@@ -73,8 +137,18 @@ func OpenProjectDatabase(workingDir, projectName string) (string, *sql.DB, error
 
 	dbPath := GetProjectDatabasePath(workingDir, projectName)
 
+	// Initialize schema (run migrations) without foreign keys
+	change, err := migrateProjectDatabase(workingDir, projectName, SchemaVersion)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to initialize database schema: %w", err)
+	}
+	// Progress goes to stderr: stdout carries structured output and the MCP stream
+	if change.BackupPath != "" {
+		fmt.Fprintf(os.Stderr, "Backed up schema v%d database to %s\n", change.FromVersion, change.BackupPath)
+	}
+
 	// Open database connection
-	db, err := sql.Open("sqlite3", dbPath)
+	db, err := sql.Open(SQLiteDriverName, projectDatabaseDSN(dbPath, true))
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -85,11 +159,83 @@ func OpenProjectDatabase(workingDir, projectName string) (string, *sql.DB, error
 		return "", nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	// Initialize schema (run migrations)
-	if err := InitSchema(db); err != nil {
-		db.Close()
-		return "", nil, fmt.Errorf("failed to initialize database schema: %w", err)
+	return dbPath, db, nil
+}
+
+// GetProjectBackupDir returns the directory holding the backups of a project database.
+// Backups are kept outside the project directory so they survive its deletion.
+func GetProjectBackupDir(workingDir, projectName string) string {
+	return filepath.Join(workingDir, "backups", projectName)
+}
+
+// migrateProjectDatabase moves the schema of a project database to targetVersion on a
+// connection of its own, closed before the database is used. An existing database is
+// backed up first if any migration is going to run.
+func migrateProjectDatabase(workingDir, projectName string, targetVersion int) (*entities.SchemaChange, error) {
+	db, err := sql.Open(SQLiteDriverName, projectDatabaseDSN(GetProjectDatabasePath(workingDir, projectName), false))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
+	defer db.Close()
 
-	return dbPath, db, nil
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	currentVersion, err := readSchemaVersion(db)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := migrationPlan(currentVersion, targetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &entities.SchemaChange{FromVersion: currentVersion, ToVersion: targetVersion, Migrations: []entities.SchemaMigration{}}
+	if len(plan) > 0 {
+		reason := fmt.Sprintf("%s%d", entities.SnapshotReasonMigrationPrefix, currentVersion)
+		change.BackupPath, err = backupDatabase(db, GetProjectBackupDir(workingDir, projectName), reason)
+		if err != nil {
+			return nil, fmt.Errorf("failed to back up database before migrating: %w", err)
+		}
+	}
+
+	ran, err := migrateSchema(db, targetVersion)
+	if err != nil && change.BackupPath != "" {
+		return nil, fmt.Errorf("%w (backup at %s)", err, change.BackupPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range ran {
+		change.Migrations = append(change.Migrations, schemaMigration(m, targetVersion >= currentVersion))
+	}
+	return change, nil
+}
+
+// snapshotTimeFormat is the UTC timestamp starting the file name of every backup,
+// so that names sort chronologically
+const snapshotTimeFormat = "20060102T150405Z"
+
+// backupDatabase writes a consistent copy of an open database to a new file in dir, named
+// after the current time and reason, and returns its path. VACUUM INTO includes changes
+// still held in the WAL.
+func backupDatabase(db *sql.DB, dir, reason string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	base := fmt.Sprintf("%s-%s", time.Now().UTC().Format(snapshotTimeFormat), reason)
+	path := filepath.Join(dir, base+".db")
+	for i := 2; ; i++ {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			break
+		}
+		path = filepath.Join(dir, fmt.Sprintf("%s-%d.db", base, i))
+	}
+
+	if _, err := db.Exec("VACUUM INTO ?", path); err != nil {
+		return "", fmt.Errorf("failed to write backup: %w", err)
+	}
+	return path, nil
 }
@@ -1,10 +1,16 @@
 package persistence_test
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/persistence"
 )
 
@@ -100,6 +106,54 @@ func TestGetActiveProject_Empty(t *testing.T) {
 	}
 }
 
+// ResolveProject tests
+
+func TestResolveProject_Precedence(t *testing.T) {
+	tempDir := t.TempDir()
+	for _, name := range []string{"flag-project", "env-project"} {
+		if err := os.MkdirAll(filepath.Join(tempDir, "projects", name), 0755); err != nil {
+			t.Fatalf("failed to create project dir: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "active-project.txt"), []byte("file-project"), 0644); err != nil {
+		t.Fatalf("failed to write active project file: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		selected string
+		env      string
+		want     string
+	}{
+		{"flag wins over env and file", "flag-project", "env-project", "flag-project"},
+		{"env wins over file", "", "env-project", "env-project"},
+		{"falls back to active file", "", "", "file-project"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(persistence.ProjectEnvVar, tt.env)
+			got, err := persistence.ResolveProject(tempDir, tt.selected)
+			if err != nil {
+				t.Fatalf("ResolveProject() failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveProject() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveProject_UnknownProject(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv(persistence.ProjectEnvVar, "")
+
+	_, err := persistence.ResolveProject(tempDir, "missing")
+	if !errors.Is(err, tmerrors.ErrNotFound) {
+		t.Errorf("ResolveProject() error = %v, want ErrNotFound", err)
+	}
+}
+
 // GetProjectDatabasePath tests
 
 func TestGetProjectDatabasePath(t *testing.T) {
@@ -223,3 +277,71 @@ func TestOpenProjectDatabase_ExistingDatabase(t *testing.T) {
 		t.Errorf("Database query failed: %v", err)
 	}
 }
+
+func TestOpenProjectDatabase_ConnectionSettings(t *testing.T) {
+	// Setup: Open a database and hold several connections of its pool
+	_, db, err := persistence.OpenProjectDatabase(t.TempDir(), "settings-project")
+	if err != nil {
+		t.Fatalf("OpenProjectDatabase() error = %v", err)
+	}
+	defer db.Close()
+	db.SetMaxIdleConns(3)
+
+	for i := 0; i < 3; i++ {
+		conn, err := db.Conn(context.Background())
+		if err != nil {
+			t.Fatalf("db.Conn() error = %v", err)
+		}
+		defer conn.Close()
+
+		// Verify: Every connection is configured, not just the first one
+		pragmas := map[string]string{"journal_mode": "wal", "busy_timeout": "5000", "foreign_keys": "1"}
+		for pragma, want := range pragmas {
+			var got string
+			if err := conn.QueryRowContext(context.Background(), "PRAGMA "+pragma).Scan(&got); err != nil {
+				t.Fatalf("PRAGMA %s failed: %v", pragma, err)
+			}
+			if got != want {
+				t.Errorf("connection %d: PRAGMA %s = %v, want %v", i, pragma, got, want)
+			}
+		}
+	}
+}
+
+func TestOpenProjectDatabase_ConcurrentWriters(t *testing.T) {
+	// Setup: Two processes' worth of handles on the same project
+	tempDir := t.TempDir()
+	_, db1, err := persistence.OpenProjectDatabase(tempDir, "shared-project")
+	if err != nil {
+		t.Fatalf("OpenProjectDatabase() error = %v", err)
+	}
+	defer db1.Close()
+	_, db2, err := persistence.OpenProjectDatabase(tempDir, "shared-project")
+	if err != nil {
+		t.Fatalf("OpenProjectDatabase() error = %v", err)
+	}
+	defer db2.Close()
+
+	// Test: Write from both handles at once
+	var wg sync.WaitGroup
+	errs := make(chan error, 40)
+	for i, db := range []*sql.DB{db1, db2} {
+		wg.Add(1)
+		go func(writer int, db *sql.DB) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				_, err := db.Exec("INSERT INTO project_metadata (key, value) VALUES (?, 'x')", fmt.Sprintf("writer-%d-%d", writer, j))
+				errs <- err
+			}
+		}(i, db)
+	}
+	wg.Wait()
+	close(errs)
+
+	// Verify: Writers waited for each other instead of failing with "database is locked"
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent write failed: %v", err)
+		}
+	}
+}
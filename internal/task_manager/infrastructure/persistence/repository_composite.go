@@ -12,19 +12,24 @@ import (
 
 // Compile-time interface check
 var _ domain.RoadmapRepository = (*SQLiteRepositoryComposite)(nil)
+var _ repositories.TransactionManager = (*SQLiteRepositoryComposite)(nil)
 
 // SQLiteRepositoryComposite implements domain.RoadmapRepository by delegating to focused repositories.
 // This provides backward compatibility during the migration from the old monolithic repository
 // to the new focused repository architecture.
 type SQLiteRepositoryComposite struct {
-	Roadmap   repositories.RoadmapRepository
-	Track     repositories.TrackRepository
-	Task      repositories.TaskRepository
-	Iteration repositories.IterationRepository
-	ADR       repositories.ADRRepository
-	AC        repositories.AcceptanceCriteriaRepository
-	Document  repositories.DocumentRepository
-	Aggregate repositories.AggregateRepository
+	Roadmap     repositories.RoadmapRepository
+	Track       repositories.TrackRepository
+	Task        repositories.TaskRepository
+	Iteration   repositories.IterationRepository
+	ADR         repositories.ADRRepository
+	AC          repositories.AcceptanceCriteriaRepository
+	Document    repositories.DocumentRepository
+	Aggregate   repositories.AggregateRepository
+	Events      repositories.EntityEventRepository
+	SearchIndex repositories.SearchRepository
+	SyncState   repositories.SyncStateRepository
+	Integrity   repositories.IntegrityRepository
 
 	DB     *sql.DB
 	logger logger.Logger
@@ -36,16 +41,20 @@ func NewSQLiteRepositoryComposite(db *sql.DB, logger logger.Logger) *SQLiteRepos
 	acRepo := NewSQLiteAcceptanceCriteriaRepository(db, logger)
 
 	return &SQLiteRepositoryComposite{
-		Roadmap:   NewSQLiteRoadmapOnlyRepository(db, logger),
-		Track:     NewSQLiteTrackRepository(db, logger),
-		Task:      NewSQLiteTaskRepository(db, logger),
-		Iteration: NewSQLiteIterationRepository(db, logger, acRepo),
-		ADR:       NewSQLiteADRRepository(db, logger),
-		AC:        acRepo,
-		Document:  NewSQLiteDocumentRepository(db),
-		Aggregate: NewSQLiteAggregateRepository(db, logger),
-		DB:        db,
-		logger:    logger,
+		Roadmap:     NewSQLiteRoadmapOnlyRepository(db, logger),
+		Track:       NewSQLiteTrackRepository(db, logger),
+		Task:        NewSQLiteTaskRepository(db, logger),
+		Iteration:   NewSQLiteIterationRepository(db, logger, acRepo),
+		ADR:         NewSQLiteADRRepository(db, logger),
+		AC:          acRepo,
+		Document:    NewSQLiteDocumentRepository(db),
+		Aggregate:   NewSQLiteAggregateRepository(db, logger),
+		Events:      NewSQLiteEntityEventRepository(db),
+		SearchIndex: NewSQLiteSearchRepository(db),
+		SyncState:   NewSQLiteSyncStateRepository(db),
+		Integrity:   NewSQLiteIntegrityRepository(db),
+		DB:          db,
+		logger:      logger,
 	}
 }
 
@@ -133,7 +142,7 @@ func (c *SQLiteRepositoryComposite) GetTrackWithTasks(ctx context.Context, track
 }
 
 // ============================================================================
-// Task operations (7 methods) - delegate to Task repository
+// Task operations (12 methods) - delegate to Task repository
 // ============================================================================
 
 // SaveTask persists a new task to storage.
@@ -171,6 +180,31 @@ func (c *SQLiteRepositoryComposite) GetBacklogTasks(ctx context.Context) ([]*ent
 	return c.Task.GetBacklogTasks(ctx)
 }
 
+// AddTaskDependency records that taskID is blocked by blockedByID.
+func (c *SQLiteRepositoryComposite) AddTaskDependency(ctx context.Context, taskID, blockedByID string) error {
+	return c.Task.AddTaskDependency(ctx, taskID, blockedByID)
+}
+
+// RemoveTaskDependency removes the link from taskID to blockedByID.
+func (c *SQLiteRepositoryComposite) RemoveTaskDependency(ctx context.Context, taskID, blockedByID string) error {
+	return c.Task.RemoveTaskDependency(ctx, taskID, blockedByID)
+}
+
+// GetTaskDependencies returns the IDs of all tasks that block taskID.
+func (c *SQLiteRepositoryComposite) GetTaskDependencies(ctx context.Context, taskID string) ([]string, error) {
+	return c.Task.GetTaskDependencies(ctx, taskID)
+}
+
+// GetBlockedTasks returns the IDs of all tasks that taskID blocks.
+func (c *SQLiteRepositoryComposite) GetBlockedTasks(ctx context.Context, taskID string) ([]string, error) {
+	return c.Task.GetBlockedTasks(ctx, taskID)
+}
+
+// ListTaskStatusChanges returns recorded task status transitions matching the filters.
+func (c *SQLiteRepositoryComposite) ListTaskStatusChanges(ctx context.Context, filters entities.TaskStatusChangeFilters) ([]*entities.TaskStatusChange, error) {
+	return c.Task.ListTaskStatusChanges(ctx, filters)
+}
+
 // ============================================================================
 // Iteration operations (13 methods) - delegate to Iteration repository
 // ============================================================================
@@ -327,9 +361,9 @@ func (c *SQLiteRepositoryComposite) ListACByTask(ctx context.Context, taskID str
 // ListACByTrack returns all acceptance criteria for all tasks in a track.
 // NOTE: This is a cross-entity query not yet in focused repositories, implemented directly.
 func (c *SQLiteRepositoryComposite) ListACByTrack(ctx context.Context, trackID string) ([]*entities.AcceptanceCriteriaEntity, error) {
-	rows, err := c.DB.QueryContext(
+	rows, err := conn(ctx, c.DB).QueryContext(
 		ctx,
-		`SELECT ac.id, ac.task_id, ac.description, ac.verification_type, ac.status, ac.notes, ac.testing_instructions, ac.created_at, ac.updated_at
+		`SELECT ac.id, ac.task_id, ac.description, ac.verification_type, ac.status, ac.notes, ac.testing_instructions, ac.check_command, ac.created_at, ac.updated_at, ac.version
 		 FROM acceptance_criteria ac
 		 JOIN tasks t ON ac.task_id = t.id
 		 WHERE t.track_id = ?
@@ -346,8 +380,8 @@ func (c *SQLiteRepositoryComposite) ListACByTrack(ctx context.Context, trackID s
 		ac := &entities.AcceptanceCriteriaEntity{}
 		err := rows.Scan(
 			&ac.ID, &ac.TaskID, &ac.Description, &ac.VerificationType,
-			&ac.Status, &ac.Notes, &ac.TestingInstructions,
-			&ac.CreatedAt, &ac.UpdatedAt,
+			&ac.Status, &ac.Notes, &ac.TestingInstructions, &ac.CheckCommand,
+			&ac.CreatedAt, &ac.UpdatedAt, &ac.Version,
 		)
 		if err != nil {
 			return nil, err
@@ -445,6 +479,21 @@ func (c *SQLiteRepositoryComposite) DeleteDocument(ctx context.Context, id strin
 	return c.Document.DeleteDocument(ctx, id)
 }
 
+// ============================================================================
+// Search operations (1 method) - delegate to SearchIndex repository
+// ============================================================================
+
+// Search returns full-text search hits across tasks, ACs, ADRs and documents.
+func (c *SQLiteRepositoryComposite) Search(ctx context.Context, filters entities.SearchFilters) ([]*entities.SearchHit, error) {
+	return c.SearchIndex.Search(ctx, filters)
+}
+
+// InTransaction runs fn in a transaction on the database. Every focused repository joins it
+// through the context fn receives.
+func (c *SQLiteRepositoryComposite) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return inTransaction(ctx, c.DB, fn)
+}
+
 // Close closes the database connection
 func (c *SQLiteRepositoryComposite) Close() error {
 	if c.DB != nil {
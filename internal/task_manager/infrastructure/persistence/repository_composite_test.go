@@ -2,6 +2,7 @@ package persistence_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -60,6 +61,68 @@ func TestGetDB(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// Transaction Tests
+// ============================================================================
+
+func TestComposite_InTransaction_CommitsOnSuccess(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	composite := persistence.NewSQLiteRepositoryComposite(db, createTestLogger())
+	now := time.Now().UTC()
+
+	err := composite.InTransaction(ctx, func(ctx context.Context) error {
+		roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+		if err := composite.SaveRoadmap(ctx, roadmap); err != nil {
+			return err
+		}
+		track, _ := entities.NewTrackEntity("TM-track-1", "roadmap-1", "Core", "", "not-started", 100, nil, now, now)
+		return composite.SaveTrack(ctx, track)
+	})
+	if err != nil {
+		t.Fatalf("InTransaction failed: %v", err)
+	}
+
+	if _, err := composite.GetTrack(ctx, "TM-track-1"); err != nil {
+		t.Errorf("expected the track to be committed: %v", err)
+	}
+}
+
+func TestComposite_InTransaction_RollsBackOnError(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	composite := persistence.NewSQLiteRepositoryComposite(db, createTestLogger())
+	now := time.Now().UTC()
+	failure := errors.New("import failed")
+
+	err := composite.InTransaction(ctx, func(ctx context.Context) error {
+		roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+		if err := composite.SaveRoadmap(ctx, roadmap); err != nil {
+			return err
+		}
+		// SaveTrack begins its own transaction, which joins this one
+		track, _ := entities.NewTrackEntity("TM-track-1", "roadmap-1", "Core", "", "not-started", 100, nil, now, now)
+		if err := composite.SaveTrack(ctx, track); err != nil {
+			return err
+		}
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected the error of fn, got %v", err)
+	}
+
+	if _, err := composite.GetRoadmap(ctx, "roadmap-1"); err == nil {
+		t.Error("expected the roadmap to be rolled back")
+	}
+	if _, err := composite.GetTrack(ctx, "TM-track-1"); err == nil {
+		t.Error("expected the track to be rolled back")
+	}
+}
+
 // ============================================================================
 // Roadmap Operation Delegation Tests
 // ============================================================================
@@ -38,7 +38,7 @@ func NewSQLiteRoadmapOnlyRepository(db *sql.DB, logger logger.Logger) *SQLiteRoa
 func (r *SQLiteRoadmapRepository) SaveRoadmap(ctx context.Context, roadmap *entities.RoadmapEntity) error {
 	// Check if roadmap already exists
 	var exists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM roadmaps WHERE id = ?", roadmap.ID).Scan(&exists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM roadmaps WHERE id = ?", roadmap.ID).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check roadmap existence: %w", err)
 	}
@@ -46,7 +46,7 @@ func (r *SQLiteRoadmapRepository) SaveRoadmap(ctx context.Context, roadmap *enti
 		return fmt.Errorf("%w: roadmap %s already exists", tmerrors.ErrAlreadyExists, roadmap.ID)
 	}
 
-	_, err = r.DB.ExecContext(
+	_, err = conn(ctx, r.DB).ExecContext(
 		ctx,
 		"INSERT INTO roadmaps (id, vision, success_criteria, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
 		roadmap.ID, roadmap.Vision, roadmap.SuccessCriteria, roadmap.CreatedAt, roadmap.UpdatedAt,
@@ -62,7 +62,7 @@ func (r *SQLiteRoadmapRepository) SaveRoadmap(ctx context.Context, roadmap *enti
 func (r *SQLiteRoadmapRepository) GetRoadmap(ctx context.Context, id string) (*entities.RoadmapEntity, error) {
 	var roadmap entities.RoadmapEntity
 
-	err := r.DB.QueryRowContext(
+	err := conn(ctx, r.DB).QueryRowContext(
 		ctx,
 		"SELECT id, vision, success_criteria, created_at, updated_at FROM roadmaps WHERE id = ?",
 		id,
@@ -82,7 +82,7 @@ func (r *SQLiteRoadmapRepository) GetRoadmap(ctx context.Context, id string) (*e
 func (r *SQLiteRoadmapRepository) GetActiveRoadmap(ctx context.Context) (*entities.RoadmapEntity, error) {
 	var roadmap entities.RoadmapEntity
 
-	err := r.DB.QueryRowContext(
+	err := conn(ctx, r.DB).QueryRowContext(
 		ctx,
 		"SELECT id, vision, success_criteria, created_at, updated_at FROM roadmaps ORDER BY created_at DESC LIMIT 1",
 	).Scan(&roadmap.ID, &roadmap.Vision, &roadmap.SuccessCriteria, &roadmap.CreatedAt, &roadmap.UpdatedAt)
@@ -99,7 +99,7 @@ func (r *SQLiteRoadmapRepository) GetActiveRoadmap(ctx context.Context) (*entiti
 
 // UpdateRoadmap updates an existing roadmap.
 func (r *SQLiteRoadmapRepository) UpdateRoadmap(ctx context.Context, roadmap *entities.RoadmapEntity) error {
-	result, err := r.DB.ExecContext(
+	result, err := conn(ctx, r.DB).ExecContext(
 		ctx,
 		"UPDATE roadmaps SET vision = ?, success_criteria = ?, updated_at = ? WHERE id = ?",
 		roadmap.Vision, roadmap.SuccessCriteria, roadmap.UpdatedAt, roadmap.ID,
@@ -16,10 +16,10 @@ import (
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/persistence"
 )
 
-// Helper to create a test database
+// Helper to create a test database that enforces foreign keys, like project database connections
 func createTestDB(t *testing.T) *sql.DB {
 	dbPath := filepath.Join(t.TempDir(), "test.db")
-	db, err := sql.Open("sqlite3", dbPath)
+	db, err := sql.Open(persistence.SQLiteDriverName, dbPath+"?_foreign_keys=on")
 	if err != nil {
 		t.Fatalf("failed to open test database: %v", err)
 	}
@@ -0,0 +1,79 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+)
+
+// Compile-time interface check
+var _ repositories.SchemaRepository = (*SQLiteSchemaRepository)(nil)
+
+// SQLiteSchemaRepository implements SchemaRepository for the SQLite database of a project.
+// It opens connections of its own: migrations run without foreign keys enforced.
+type SQLiteSchemaRepository struct {
+	workingDir  string
+	projectName string
+}
+
+// NewSQLiteSchemaRepository creates a new schema repository for a project's database
+func NewSQLiteSchemaRepository(workingDir, projectName string) *SQLiteSchemaRepository {
+	return &SQLiteSchemaRepository{
+		workingDir:  workingDir,
+		projectName: projectName,
+	}
+}
+
+// GetSchemaStatus returns the schema version of the project database and the known migrations.
+// A database that does not exist yet is reported at version 0 and is not created.
+func (r *SQLiteSchemaRepository) GetSchemaStatus(ctx context.Context) (*entities.SchemaStatus, error) {
+	currentVersion := 0
+	dbPath := GetProjectDatabasePath(r.workingDir, r.projectName)
+	if _, err := os.Stat(dbPath); err == nil {
+		db, err := sql.Open(SQLiteDriverName, projectDatabaseDSN(dbPath, false))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open database: %w", err)
+		}
+		defer db.Close()
+
+		if currentVersion, err = readSchemaVersion(db); err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to check database: %w", err)
+	}
+
+	status := &entities.SchemaStatus{
+		CurrentVersion: currentVersion,
+		LatestVersion:  SchemaVersion,
+		Migrations:     make([]entities.SchemaMigration, 0, len(migrations)),
+	}
+	for _, m := range migrations {
+		status.Migrations = append(status.Migrations, schemaMigration(m, m.version <= currentVersion))
+	}
+	return status, nil
+}
+
+// MigrateSchema moves the project database to targetVersion after backing it up.
+// A database that does not exist yet is created at the latest version.
+func (r *SQLiteSchemaRepository) MigrateSchema(ctx context.Context, targetVersion int) (*entities.SchemaChange, error) {
+	if err := os.MkdirAll(filepath.Join(r.workingDir, "projects", r.projectName), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create project directory: %w", err)
+	}
+	return migrateProjectDatabase(r.workingDir, r.projectName, targetVersion)
+}
+
+// schemaMigration converts a migration into its domain description
+func schemaMigration(m migration, applied bool) entities.SchemaMigration {
+	return entities.SchemaMigration{
+		Version:     m.version,
+		Description: m.description,
+		Reversible:  m.down != nil,
+		Applied:     applied,
+	}
+}
@@ -0,0 +1,217 @@
+package persistence_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/persistence"
+)
+
+// TestSQLiteSchemaRepository_NewDatabase tests that status leaves a missing database alone
+// and that migrating creates it at the latest version
+func TestSQLiteSchemaRepository_NewDatabase(t *testing.T) {
+	workingDir := t.TempDir()
+	ctx := context.Background()
+	repo := persistence.NewSQLiteSchemaRepository(workingDir, "alpha")
+
+	status, err := repo.GetSchemaStatus(ctx)
+	if err != nil {
+		t.Fatalf("GetSchemaStatus failed: %v", err)
+	}
+	if status.CurrentVersion != 0 || status.LatestVersion != persistence.SchemaVersion {
+		t.Errorf("expected v0 of v%d, got v%d of v%d", persistence.SchemaVersion, status.CurrentVersion, status.LatestVersion)
+	}
+	if last := status.Migrations[len(status.Migrations)-1]; last.Version != persistence.SchemaVersion {
+		t.Errorf("the last migration should bring the database to v%d, got v%d", persistence.SchemaVersion, last.Version)
+	}
+	if _, err := os.Stat(persistence.GetProjectDatabasePath(workingDir, "alpha")); !os.IsNotExist(err) {
+		t.Errorf("status should not create the database: %v", err)
+	}
+
+	if _, err := repo.MigrateSchema(ctx, 10); !errors.Is(err, tmerrors.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument migrating a new database to an old version, got %v", err)
+	}
+
+	change, err := repo.MigrateSchema(ctx, persistence.SchemaVersion)
+	if err != nil {
+		t.Fatalf("MigrateSchema failed: %v", err)
+	}
+	if change.BackupPath != "" || len(change.Migrations) != 0 {
+		t.Errorf("a new database needs no backup or migrations, got %+v", change)
+	}
+
+}
+
+// TestSQLiteSchemaRepository_RollbackAndMigrate tests that a rollback undoes the schema changes
+// of the migrations it reverts, keeps the data, and backs up the database first
+func TestSQLiteSchemaRepository_RollbackAndMigrate(t *testing.T) {
+	workingDir := t.TempDir()
+	ctx := context.Background()
+	_, db, err := persistence.OpenProjectDatabase(workingDir, "alpha")
+	if err != nil {
+		t.Fatalf("OpenProjectDatabase failed: %v", err)
+	}
+	createTrack(t, db, "TM-track-1")
+	db.Close()
+
+	repo := persistence.NewSQLiteSchemaRepository(workingDir, "alpha")
+	change, err := repo.MigrateSchema(ctx, 13)
+	if err != nil {
+		t.Fatalf("rollback failed: %v", err)
+	}
+	if !change.RolledBack() || len(change.Migrations) != persistence.SchemaVersion-13 {
+		t.Errorf("expected %d migrations rolled back, got %+v", persistence.SchemaVersion-13, change)
+	}
+	if change.Migrations[0].Version != persistence.SchemaVersion {
+		t.Errorf("the newest migration should be rolled back first, got v%d", change.Migrations[0].Version)
+	}
+	if _, err := os.Stat(change.BackupPath); err != nil {
+		t.Errorf("expected a backup at %q: %v", change.BackupPath, err)
+	}
+	if filepath.Dir(change.BackupPath) != persistence.GetProjectBackupDir(workingDir, "alpha") {
+		t.Errorf("backup written outside the backup directory: %s", change.BackupPath)
+	}
+
+	status, err := repo.GetSchemaStatus(ctx)
+	if err != nil {
+		t.Fatalf("GetSchemaStatus failed: %v", err)
+	}
+	if status.CurrentVersion != 13 || len(status.Pending()) != persistence.SchemaVersion-13 {
+		t.Errorf("expected v13 with %d pending migrations, got %+v", persistence.SchemaVersion-13, status)
+	}
+
+	db, err = sql.Open(persistence.SQLiteDriverName, persistence.GetProjectDatabasePath(workingDir, "alpha"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+	assertTableExists(t, db, "task_status_history", true)
+	assertTableExists(t, db, "sync_state", false)
+	if _, err := db.Exec("SELECT version FROM tasks"); err == nil {
+		t.Error("expected the version column to be dropped")
+	}
+
+	change, err = repo.MigrateSchema(ctx, persistence.SchemaVersion)
+	if err != nil {
+		t.Fatalf("migrate failed: %v", err)
+	}
+	if change.RolledBack() || len(change.Migrations) != persistence.SchemaVersion-13 || change.BackupPath == "" {
+		t.Errorf("expected a backed up migration back to the latest version, got %+v", change)
+	}
+	assertTableExists(t, db, "sync_state", true)
+	var tracks int
+	if err := db.QueryRow("SELECT COUNT(*) FROM tracks").Scan(&tracks); err != nil || tracks != 1 {
+		t.Errorf("expected the track to survive, got %d (%v)", tracks, err)
+	}
+}
+
+// TestSQLiteSchemaRepository_FailedVersionUpdateUndoesMigration tests that a migration step
+// is undone when its version cannot be recorded, so the version never lags behind the schema
+func TestSQLiteSchemaRepository_FailedVersionUpdateUndoesMigration(t *testing.T) {
+	workingDir := t.TempDir()
+	ctx := context.Background()
+	_, db, err := persistence.OpenProjectDatabase(workingDir, "alpha")
+	if err != nil {
+		t.Fatalf("OpenProjectDatabase failed: %v", err)
+	}
+	db.Close()
+
+	repo := persistence.NewSQLiteSchemaRepository(workingDir, "alpha")
+	if _, err := repo.MigrateSchema(ctx, 15); err != nil {
+		t.Fatalf("rollback failed: %v", err)
+	}
+
+	db, err = sql.Open(persistence.SQLiteDriverName, persistence.GetProjectDatabasePath(workingDir, "alpha"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`CREATE TRIGGER reject_v16 BEFORE INSERT ON project_metadata
+		WHEN new.key = 'schema_version' AND CAST(new.value AS INTEGER) = 16
+		BEGIN SELECT RAISE(ABORT, 'disk full'); END`); err != nil {
+		t.Fatalf("failed to create trigger: %v", err)
+	}
+
+	if _, err := repo.MigrateSchema(ctx, persistence.SchemaVersion); err == nil {
+		t.Fatal("expected the migration to v16 to fail")
+	}
+
+	status, err := repo.GetSchemaStatus(ctx)
+	if err != nil {
+		t.Fatalf("GetSchemaStatus failed: %v", err)
+	}
+	if status.CurrentVersion != 15 {
+		t.Errorf("expected the database to stay at v15, got v%d", status.CurrentVersion)
+	}
+	if _, err := db.Exec("SELECT version FROM tasks"); err == nil {
+		t.Error("expected the version column added by v16 to be rolled back with its version")
+	}
+}
+
+// TestSQLiteSchemaRepository_RejectedMigrations tests versions the database cannot be moved to
+func TestSQLiteSchemaRepository_RejectedMigrations(t *testing.T) {
+	workingDir := t.TempDir()
+	ctx := context.Background()
+	_, db, err := persistence.OpenProjectDatabase(workingDir, "alpha")
+	if err != nil {
+		t.Fatalf("OpenProjectDatabase failed: %v", err)
+	}
+	db.Close()
+	repo := persistence.NewSQLiteSchemaRepository(workingDir, "alpha")
+
+	if _, err := repo.MigrateSchema(ctx, persistence.SchemaVersion+1); !errors.Is(err, tmerrors.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for an unknown version, got %v", err)
+	}
+	if _, err := repo.MigrateSchema(ctx, 5); !errors.Is(err, tmerrors.ErrRejected) {
+		t.Errorf("expected ErrRejected rolling back past an irreversible migration, got %v", err)
+	}
+	status, err := repo.GetSchemaStatus(ctx)
+	if err != nil {
+		t.Fatalf("GetSchemaStatus failed: %v", err)
+	}
+	if !status.UpToDate() {
+		t.Errorf("a rejected rollback should leave the database alone, got v%d", status.CurrentVersion)
+	}
+}
+
+// TestOpenProjectDatabase_RejectsNewerSchema tests that a database written by a newer tm is not opened
+func TestOpenProjectDatabase_RejectsNewerSchema(t *testing.T) {
+	workingDir := t.TempDir()
+	_, db, err := persistence.OpenProjectDatabase(workingDir, "alpha")
+	if err != nil {
+		t.Fatalf("OpenProjectDatabase failed: %v", err)
+	}
+	if _, err := db.Exec("UPDATE project_metadata SET value = ? WHERE key = 'schema_version'", persistence.SchemaVersion+1); err != nil {
+		t.Fatalf("failed to set schema version: %v", err)
+	}
+	db.Close()
+
+	if _, _, err := persistence.OpenProjectDatabase(workingDir, "alpha"); !errors.Is(err, tmerrors.ErrRejected) {
+		t.Errorf("expected ErrRejected opening a newer database, got %v", err)
+	}
+
+	status, err := persistence.NewSQLiteSchemaRepository(workingDir, "alpha").GetSchemaStatus(context.Background())
+	if err != nil {
+		t.Fatalf("GetSchemaStatus failed: %v", err)
+	}
+	if !status.TooNew() {
+		t.Errorf("expected status to report the newer schema, got %+v", status)
+	}
+}
+
+// assertTableExists checks whether a table is present in the database
+func assertTableExists(t *testing.T, db *sql.DB, table string, want bool) {
+	t.Helper()
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&count); err != nil {
+		t.Fatalf("failed to check table %s: %v", table, err)
+	}
+	if (count > 0) != want {
+		t.Errorf("table %s exists = %v, want %v", table, count > 0, want)
+	}
+}
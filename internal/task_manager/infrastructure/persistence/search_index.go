@@ -0,0 +1,161 @@
+package persistence
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// searchIndexTable is the FTS4 virtual table holding one row per searchable entity
+const searchIndexTable = "search_index"
+
+// searchIndexKeysTable maps each indexed entity to the docid of its search_index row, so the
+// triggers find the row by docid instead of scanning the unindexed entity columns
+const searchIndexKeysTable = "search_index_keys"
+
+const createSearchIndexKeys = `
+CREATE TABLE IF NOT EXISTS search_index_keys (
+    docid INTEGER PRIMARY KEY,
+    entity_type TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    UNIQUE (entity_type, entity_id)
+)
+`
+
+const createSearchIndex = `
+CREATE VIRTUAL TABLE IF NOT EXISTS search_index USING fts4(
+    entity_type,
+    entity_id,
+    title,
+    body,
+    notindexed=entity_type,
+    notindexed=entity_id,
+    tokenize=unicode61
+)
+`
+
+// searchSource describes how rows of a table are indexed.
+// Expressions refer to the source row as "src" and are rewritten to "new" inside triggers.
+type searchSource struct {
+	entityType string
+	table      string
+	title      string
+	body       string
+	columns    string // Columns whose updates require reindexing
+}
+
+var searchSources = []searchSource{
+	{"task", "tasks", "src.title", "COALESCE(src.description, '')", "title, description"},
+	{"ac", "acceptance_criteria", "src.description", "COALESCE(src.testing_instructions, '')", "description, testing_instructions"},
+	{"adr", "adrs", "src.title", "src.context || char(10) || src.decision", "title, context, decision"},
+	{"document", "documents", "src.title", "src.content", "title, content"},
+}
+
+// searchIndexExists reports whether the search index has been created.
+func searchIndexExists(q interface {
+	QueryRow(query string, args ...interface{}) *sql.Row
+}) (bool, error) {
+	var count int
+	if err := q.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", searchIndexTable).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to inspect search index: %w", err)
+	}
+	return count > 0, nil
+}
+
+// ensureSearchIndex creates the search index and its triggers if missing, filling a new index from existing rows.
+func ensureSearchIndex(tx *sql.Tx) error {
+	exists, err := searchIndexExists(tx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		for _, stmt := range []string{createSearchIndexKeys, createSearchIndex} {
+			if _, err := tx.Exec(stmt); err != nil {
+				return fmt.Errorf("failed to create search index: %w", err)
+			}
+		}
+	}
+
+	// Also recreates triggers lost when a later migration rebuilt a source table
+	for _, stmt := range searchTriggerStatements() {
+		if _, err := tx.Exec(stmt); err != nil {
+			return fmt.Errorf("failed to create search index trigger: %w", err)
+		}
+	}
+	if exists {
+		return nil
+	}
+	return rebuildSearchIndex(tx)
+}
+
+// dropSearchIndex removes the search index and its triggers.
+func dropSearchIndex(tx *sql.Tx) error {
+	if err := dropSearchTriggers(tx); err != nil {
+		return err
+	}
+	for _, table := range []string{searchIndexTable, searchIndexKeysTable} {
+		if _, err := tx.Exec("DROP TABLE IF EXISTS " + table); err != nil {
+			return fmt.Errorf("failed to drop search index: %w", err)
+		}
+	}
+	return nil
+}
+
+// dropSearchTriggers removes the triggers keeping the search index in sync.
+func dropSearchTriggers(tx *sql.Tx) error {
+	for _, s := range searchSources {
+		for _, trigger := range []string{"insert", "update", "delete"} {
+			if _, err := tx.Exec(fmt.Sprintf("DROP TRIGGER IF EXISTS %s_search_%s", s.table, trigger)); err != nil {
+				return fmt.Errorf("failed to drop search index trigger: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// rebuildSearchIndex replaces the index contents with the current rows of every source table.
+func rebuildSearchIndex(tx *sql.Tx) error {
+	for _, table := range []string{searchIndexTable, searchIndexKeysTable} {
+		if _, err := tx.Exec("DELETE FROM " + table); err != nil {
+			return fmt.Errorf("failed to clear search index: %w", err)
+		}
+	}
+	for _, source := range searchSources {
+		statements := []string{
+			fmt.Sprintf("INSERT INTO %s (entity_type, entity_id) SELECT '%s', src.id FROM %s AS src",
+				searchIndexKeysTable, source.entityType, source.table),
+			fmt.Sprintf("INSERT INTO %s (docid, entity_type, entity_id, title, body) SELECT k.docid, k.entity_type, src.id, %s, %s "+
+				"FROM %s AS src JOIN %s AS k ON k.entity_type = '%s' AND k.entity_id = src.id",
+				searchIndexTable, source.title, source.body, source.table, searchIndexKeysTable, source.entityType),
+		}
+		for _, stmt := range statements {
+			if _, err := tx.Exec(stmt); err != nil {
+				return fmt.Errorf("failed to index %s: %w", source.table, err)
+			}
+		}
+	}
+	return nil
+}
+
+// searchTriggerStatements returns the insert, update and delete triggers keeping the index in sync.
+// Index rows are addressed by the docid recorded in search_index_keys.
+func searchTriggerStatements() []string {
+	statements := []string{}
+	for _, s := range searchSources {
+		docid := func(row string) string {
+			return fmt.Sprintf("(SELECT docid FROM %s WHERE entity_type = '%s' AND entity_id = %s.id)", searchIndexKeysTable, s.entityType, row)
+		}
+		addKey := fmt.Sprintf("INSERT INTO %s (entity_type, entity_id) VALUES ('%s', new.id);", searchIndexKeysTable, s.entityType)
+		insert := fmt.Sprintf("INSERT INTO %s (docid, entity_type, entity_id, title, body) VALUES (%s, '%s', new.id, %s, %s);",
+			searchIndexTable, docid("new"), s.entityType, strings.ReplaceAll(s.title, "src.", "new."), strings.ReplaceAll(s.body, "src.", "new."))
+		remove := fmt.Sprintf("DELETE FROM %s WHERE docid = %s;", searchIndexTable, docid("old"))
+		removeKey := fmt.Sprintf("DELETE FROM %s WHERE entity_type = '%s' AND entity_id = old.id;", searchIndexKeysTable, s.entityType)
+
+		statements = append(statements,
+			fmt.Sprintf("CREATE TRIGGER IF NOT EXISTS %s_search_insert AFTER INSERT ON %s BEGIN %s %s END", s.table, s.table, addKey, insert),
+			fmt.Sprintf("CREATE TRIGGER IF NOT EXISTS %s_search_update AFTER UPDATE OF %s ON %s BEGIN %s %s END", s.table, s.columns, s.table, remove, insert),
+			fmt.Sprintf("CREATE TRIGGER IF NOT EXISTS %s_search_delete AFTER DELETE ON %s BEGIN %s %s END", s.table, s.table, remove, removeKey),
+		)
+	}
+	return statements
+}
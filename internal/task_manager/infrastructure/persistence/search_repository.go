@@ -0,0 +1,138 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+)
+
+// Compile-time check that SQLiteSearchRepository implements repositories.SearchRepository
+var _ repositories.SearchRepository = (*SQLiteSearchRepository)(nil)
+
+// Relevance weights of the indexed columns: matches in titles count more than in bodies
+const (
+	searchTitleWeight = 5.0
+	searchBodyWeight  = 1.0
+)
+
+// searchSnippetTokens is the approximate length of a snippet in tokens
+const searchSnippetTokens = 16
+
+// SQLiteSearchRepository queries the full-text search index maintained by triggers on the source tables.
+type SQLiteSearchRepository struct {
+	DB *sql.DB
+}
+
+// NewSQLiteSearchRepository creates a new SQLite search repository.
+func NewSQLiteSearchRepository(db *sql.DB) *SQLiteSearchRepository {
+	return &SQLiteSearchRepository{DB: db}
+}
+
+// Search returns hits matching the filters, most relevant first.
+func (r *SQLiteSearchRepository) Search(ctx context.Context, filters entities.SearchFilters) ([]*entities.SearchHit, error) {
+	exists, err := searchIndexExists(r.DB)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: search index is missing", tmerrors.ErrInternal)
+	}
+
+	match := buildMatchQuery(filters.Query)
+	if match == "" {
+		return nil, fmt.Errorf("%w: search query must contain at least one word", tmerrors.ErrInvalidArgument)
+	}
+
+	query := fmt.Sprintf("SELECT entity_type, entity_id, title, snippet(search_index, '%s', '%s', '…', -1, %d), search_rank(matchinfo(search_index, 'pcnx')) AS score FROM search_index WHERE search_index MATCH ?",
+		entities.SearchHighlightStart, entities.SearchHighlightEnd, searchSnippetTokens)
+	args := []interface{}{match}
+	if len(filters.EntityTypes) > 0 {
+		query += " AND entity_type IN (?" + strings.Repeat(", ?", len(filters.EntityTypes)-1) + ")"
+		for _, entityType := range filters.EntityTypes {
+			args = append(args, entityType)
+		}
+	}
+	query += " ORDER BY score DESC, rowid"
+	if filters.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filters.Limit)
+	}
+
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+	defer rows.Close()
+
+	hits := []*entities.SearchHit{}
+	for rows.Next() {
+		hit := &entities.SearchHit{}
+		if err := rows.Scan(&hit.EntityType, &hit.EntityID, &hit.Title, &hit.Snippet, &hit.Score); err != nil {
+			return nil, fmt.Errorf("failed to scan search hit: %w", err)
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read search hits: %w", err)
+	}
+	return hits, nil
+}
+
+// buildMatchQuery turns free text into an FTS4 query in which every word must match.
+// Words are quoted so punctuation such as the dashes in "TM-task-12" is never read as query syntax;
+// a trailing * keeps its prefix meaning.
+func buildMatchQuery(text string) string {
+	terms := []string{}
+	for _, word := range strings.Fields(text) {
+		prefix := strings.HasSuffix(word, "*")
+		word = strings.Trim(word, `*"`)
+		if word == "" {
+			continue
+		}
+		word = strings.ReplaceAll(word, `"`, `""`)
+		if prefix {
+			terms = append(terms, `"`+word+`*"`)
+		} else {
+			terms = append(terms, `"`+word+`"`)
+		}
+	}
+	return strings.Join(terms, " ")
+}
+
+// scoreMatchInfo ranks an FTS4 hit from matchinfo(search_index, 'pcnx'), which FTS4 has no built-in ranking for.
+// Queries call it as the SQL function search_rank, registered on every connection of SQLiteDriverName.
+// Each phrase scores per column by a saturating term frequency times its inverse document frequency.
+func scoreMatchInfo(data []byte) float64 {
+	if len(data) < 12 {
+		return 0
+	}
+	value := func(i int) float64 {
+		return float64(binary.NativeEndian.Uint32(data[i*4:]))
+	}
+	phrases, columns, documents := int(value(0)), int(value(1)), value(2)
+	weights := []float64{0, 0, searchTitleWeight, searchBodyWeight}
+
+	score := 0.0
+	for p := 0; p < phrases; p++ {
+		for c := 0; c < columns && c < len(weights); c++ {
+			offset := 3 + 3*(p*columns+c)
+			if (offset+3)*4 > len(data) {
+				return score
+			}
+			hits, documentsWithHits := value(offset), value(offset+2)
+			if hits == 0 {
+				continue
+			}
+			idf := math.Log(1 + (documents-documentsWithHits+0.5)/(documentsWithHits+0.5))
+			score += weights[c] * idf * hits / (hits + 1.2)
+		}
+	}
+	return score
+}
@@ -0,0 +1,221 @@
+package persistence_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/persistence"
+)
+
+// seedSearchData creates one task, AC, ADR and document mentioning "oauth"
+func seedSearchData(t *testing.T, db *sql.DB) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	if err := persistence.NewSQLiteRoadmapRepository(db, createTestLogger()).SaveRoadmap(ctx, roadmap); err != nil {
+		t.Fatalf("failed to save roadmap: %v", err)
+	}
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Auth", "", "not-started", 200, []string{}, now, now)
+	if err := persistence.NewSQLiteTrackRepository(db, createTestLogger()).SaveTrack(ctx, track); err != nil {
+		t.Fatalf("failed to save track: %v", err)
+	}
+
+	task, _ := entities.NewTaskEntity("task-1", "track-1", "OAuth login", "Sign users in with an OAuth provider", "todo", 200, "", now, now)
+	if err := persistence.NewSQLiteTaskRepository(db, createTestLogger()).SaveTask(ctx, task); err != nil {
+		t.Fatalf("failed to save task: %v", err)
+	}
+	ac := entities.NewAcceptanceCriteriaEntity("ac-1", "task-1", "Users can log in", entities.VerificationTypeManual, "Use the oauth sandbox account", now, now)
+	if err := persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger()).SaveAC(ctx, ac); err != nil {
+		t.Fatalf("failed to save AC: %v", err)
+	}
+	adr, _ := entities.NewADREntity("adr-1", "track-1", "Identity provider", "proposed", "We need single sign-on", "Adopt OAuth 2.0", "Depends on a provider", "", now, now, nil)
+	if err := persistence.NewSQLiteADRRepository(db, createTestLogger()).SaveADR(ctx, adr); err != nil {
+		t.Fatalf("failed to save ADR: %v", err)
+	}
+	doc, _ := entities.NewDocumentEntity("TM-doc-1", "Rollout plan", entities.DocumentTypePlan, entities.DocumentStatusDraft, "Enable oauth for all users", nil, nil, now, now)
+	if err := persistence.NewSQLiteDocumentRepository(db).SaveDocument(ctx, doc); err != nil {
+		t.Fatalf("failed to save document: %v", err)
+	}
+}
+
+// hitIDs returns the entity IDs of search hits in order
+func hitIDs(hits []*entities.SearchHit) []string {
+	ids := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.EntityID)
+	}
+	return ids
+}
+
+// TestSQLiteSearchRepository_Search tests matching, ranking, snippets and filters across all entity types
+func TestSQLiteSearchRepository_Search(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+	seedSearchData(t, db)
+
+	repo := persistence.NewSQLiteSearchRepository(db)
+	ctx := context.Background()
+
+	hits, err := repo.Search(ctx, entities.SearchFilters{Query: "oauth"})
+	if err != nil {
+		t.Fatalf("failed to search: %v", err)
+	}
+	if len(hits) != 4 {
+		t.Fatalf("expected 4 hits, got %v", hitIDs(hits))
+	}
+	if hits[0].EntityID != "task-1" {
+		t.Errorf("expected the title match to rank first, got %v", hitIDs(hits))
+	}
+	if hits[0].Snippet == "" || hits[0].Score <= 0 {
+		t.Errorf("expected a snippet and positive score, got %+v", hits[0])
+	}
+
+	tests := []struct {
+		name    string
+		filters entities.SearchFilters
+		want    []string
+	}{
+		{"all words must match", entities.SearchFilters{Query: "oauth sandbox"}, []string{"ac-1"}},
+		{"prefix match", entities.SearchFilters{Query: "sign*"}, []string{"task-1", "adr-1"}},
+		{"case insensitive", entities.SearchFilters{Query: "ROLLOUT"}, []string{"TM-doc-1"}},
+		{"type filter", entities.SearchFilters{Query: "oauth", EntityTypes: []string{"adr", "document"}}, []string{"adr-1", "TM-doc-1"}},
+		{"limit", entities.SearchFilters{Query: "oauth", Limit: 1}, []string{"task-1"}},
+		{"query syntax is literal", entities.SearchFilters{Query: "oauth OR nothing"}, []string{}},
+		{"no match", entities.SearchFilters{Query: "kerberos"}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits, err := repo.Search(ctx, tt.filters)
+			if err != nil {
+				t.Fatalf("failed to search: %v", err)
+			}
+			got := hitIDs(hits)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			wanted := map[string]bool{}
+			for _, id := range tt.want {
+				wanted[id] = true
+			}
+			for _, id := range got {
+				if !wanted[id] {
+					t.Errorf("expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+// TestSQLiteSearchRepository_EmptyQuery tests that a query without words is rejected
+func TestSQLiteSearchRepository_EmptyQuery(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	repo := persistence.NewSQLiteSearchRepository(db)
+	_, err := repo.Search(context.Background(), entities.SearchFilters{Query: ` "* `})
+	if !errors.Is(err, tmerrors.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument, got %v", err)
+	}
+}
+
+// TestSQLiteSearchRepository_TriggersKeepIndexInSync tests that updates and deletes are reflected in search
+func TestSQLiteSearchRepository_TriggersKeepIndexInSync(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+	seedSearchData(t, db)
+
+	repo := persistence.NewSQLiteSearchRepository(db)
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	task, err := taskRepo.GetTask(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+	task.Title = "SAML login"
+	task.Description = "Federated sign-in"
+	if err := taskRepo.UpdateTask(ctx, task); err != nil {
+		t.Fatalf("failed to update task: %v", err)
+	}
+
+	hits, _ := repo.Search(ctx, entities.SearchFilters{Query: "saml", EntityTypes: []string{"task"}})
+	if len(hits) != 1 || hits[0].Title != "SAML login" {
+		t.Errorf("expected updated task to be found, got %v", hitIDs(hits))
+	}
+	hits, _ = repo.Search(ctx, entities.SearchFilters{Query: "oauth", EntityTypes: []string{"task"}})
+	if len(hits) != 0 {
+		t.Errorf("expected old task text to be gone, got %v", hitIDs(hits))
+	}
+
+	if err := persistence.NewSQLiteDocumentRepository(db).DeleteDocument(ctx, "TM-doc-1"); err != nil {
+		t.Fatalf("failed to delete document: %v", err)
+	}
+	hits, _ = repo.Search(ctx, entities.SearchFilters{Query: "rollout"})
+	if len(hits) != 0 {
+		t.Errorf("expected deleted document to be gone, got %v", hitIDs(hits))
+	}
+
+	// Every index row is keyed by the docid recorded for its entity
+	var unkeyed, stale int
+	if err := db.QueryRow("SELECT COUNT(*) FROM search_index AS i LEFT JOIN search_index_keys AS k ON k.docid = i.docid " +
+		"WHERE k.entity_id IS NOT i.entity_id").Scan(&unkeyed); err != nil {
+		t.Fatalf("failed to check index keys: %v", err)
+	}
+	if err := db.QueryRow("SELECT COUNT(*) FROM search_index_keys WHERE entity_type = 'document' AND entity_id = 'TM-doc-1'").Scan(&stale); err != nil {
+		t.Fatalf("failed to check index keys: %v", err)
+	}
+	if unkeyed != 0 || stale != 0 {
+		t.Errorf("expected index rows to match their keys, got %d unkeyed rows and %d stale keys", unkeyed, stale)
+	}
+}
+
+// TestInitSchema_MigratesSearchIndex tests that upgrading a v10 database indexes existing rows
+func TestInitSchema_MigratesSearchIndex(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+	seedSearchData(t, db)
+
+	// Rewind to a v10 database, which predates the search index
+	statements := []string{
+		"DROP TABLE search_index",
+		"DROP TABLE search_index_keys",
+		"UPDATE project_metadata SET value = '10' WHERE key = 'schema_version'",
+	}
+	for _, table := range []string{"tasks", "acceptance_criteria", "adrs", "documents"} {
+		for _, trigger := range []string{"insert", "update", "delete"} {
+			statements = append(statements, "DROP TRIGGER "+table+"_search_"+trigger)
+		}
+	}
+	for _, stmt := range statements {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("failed to prepare v10 database: %v", err)
+		}
+	}
+
+	if err := persistence.InitSchema(db); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	hits, err := persistence.NewSQLiteSearchRepository(db).Search(context.Background(), entities.SearchFilters{Query: "oauth"})
+	if err != nil {
+		t.Fatalf("failed to search: %v", err)
+	}
+	if len(hits) != 4 {
+		t.Errorf("expected existing rows to be indexed, got %v", hitIDs(hits))
+	}
+
+	var version int
+	if err := db.QueryRow("SELECT CAST(value AS INTEGER) FROM project_metadata WHERE key = 'schema_version'").Scan(&version); err != nil {
+		t.Fatalf("failed to read schema version: %v", err)
+	}
+	if version != persistence.SchemaVersion {
+		t.Errorf("expected schema version %d, got %d", persistence.SchemaVersion, version)
+	}
+}
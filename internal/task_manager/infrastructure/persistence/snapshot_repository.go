@@ -0,0 +1,220 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+	"github.com/mattn/go-sqlite3"
+)
+
+// Compile-time interface check
+var _ repositories.SnapshotRepository = (*FileSystemSnapshotRepository)(nil)
+
+// snapshotFileRegex matches backup file names: timestamp, reason and an optional
+// counter added when two backups are taken within the same second
+var snapshotFileRegex = regexp.MustCompile(`^(\d{8}T\d{6}Z)-(.+?)(?:-\d+)?\.db$`)
+
+// FileSystemSnapshotRepository implements SnapshotRepository with SQLite database copies
+// stored under <workingDir>/backups/<project>/.
+type FileSystemSnapshotRepository struct {
+	workingDir string
+}
+
+// NewFileSystemSnapshotRepository creates a new filesystem-based snapshot repository
+func NewFileSystemSnapshotRepository(workingDir string) *FileSystemSnapshotRepository {
+	return &FileSystemSnapshotRepository{
+		workingDir: workingDir,
+	}
+}
+
+// CreateSnapshot copies a project database with VACUUM INTO, which is safe while other
+// connections use the database.
+func (r *FileSystemSnapshotRepository) CreateSnapshot(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error) {
+	dbPath := GetProjectDatabasePath(r.workingDir, projectName)
+	if _, err := os.Stat(dbPath); err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: project '%s' has no database", tmerrors.ErrNotFound, projectName)
+		}
+		return nil, fmt.Errorf("failed to check database: %w", err)
+	}
+
+	db, err := sql.Open(SQLiteDriverName, projectDatabaseDSN(dbPath, false))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	path, err := backupDatabase(db, GetProjectBackupDir(r.workingDir, projectName), reason)
+	if err != nil {
+		return nil, err
+	}
+	return r.GetSnapshot(ctx, projectName+"/"+strings.TrimSuffix(filepath.Base(path), ".db"))
+}
+
+// ListSnapshots returns the snapshots of a project, newest first.
+// Files in the backup directory that are not snapshots are ignored.
+func (r *FileSystemSnapshotRepository) ListSnapshots(ctx context.Context, projectName string) ([]*entities.ProjectSnapshot, error) {
+	entries, err := os.ReadDir(GetProjectBackupDir(r.workingDir, projectName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*entities.ProjectSnapshot{}, nil
+		}
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	snapshots := []*entities.ProjectSnapshot{}
+	modTimes := map[string]time.Time{}
+	for _, entry := range entries {
+		if entry.IsDir() || !snapshotFileRegex.MatchString(entry.Name()) {
+			continue
+		}
+		snapshot, err := r.GetSnapshot(ctx, projectName+"/"+strings.TrimSuffix(entry.Name(), ".db"))
+		if err != nil {
+			return nil, err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read snapshot: %w", err)
+		}
+		snapshots = append(snapshots, snapshot)
+		modTimes[snapshot.ID] = info.ModTime()
+	}
+
+	// Names carry the time to the second; the file time orders snapshots taken within one
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if !snapshots[i].CreatedAt.Equal(snapshots[j].CreatedAt) {
+			return snapshots[i].CreatedAt.After(snapshots[j].CreatedAt)
+		}
+		if !modTimes[snapshots[i].ID].Equal(modTimes[snapshots[j].ID]) {
+			return modTimes[snapshots[i].ID].After(modTimes[snapshots[j].ID])
+		}
+		return snapshots[i].ID > snapshots[j].ID
+	})
+	return snapshots, nil
+}
+
+// GetSnapshot returns the snapshot with the given "<project>/<name>" ID.
+func (r *FileSystemSnapshotRepository) GetSnapshot(ctx context.Context, id string) (*entities.ProjectSnapshot, error) {
+	projectName, name, ok := strings.Cut(id, "/")
+	match := snapshotFileRegex.FindStringSubmatch(name + ".db")
+	if !ok || projectName == "" || filepath.Base(projectName) != projectName || match == nil {
+		return nil, fmt.Errorf("%w: invalid snapshot ID '%s' (expected <project>/<name>, see 'tm project snapshots')", tmerrors.ErrInvalidArgument, id)
+	}
+
+	path := filepath.Join(GetProjectBackupDir(r.workingDir, projectName), name+".db")
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: snapshot '%s' not found", tmerrors.ErrNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	createdAt, err := time.Parse(snapshotTimeFormat, match[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot time of '%s': %w", id, err)
+	}
+
+	return &entities.ProjectSnapshot{
+		ID:        id,
+		Project:   projectName,
+		Reason:    match[2],
+		Path:      path,
+		Size:      info.Size(),
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// RestoreSnapshot copies a snapshot into its project's database with SQLite's online backup,
+// which replaces the content in a single transaction and is safe while other connections
+// use the database. A snapshot of an older schema is migrated the next time the project is opened.
+func (r *FileSystemSnapshotRepository) RestoreSnapshot(ctx context.Context, id string) error {
+	snapshot, err := r.GetSnapshot(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	source, err := sql.Open(SQLiteDriverName, "file:"+snapshot.Path+"?mode=ro")
+	if err != nil {
+		return fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer source.Close()
+
+	version, err := readSchemaVersion(source)
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	if version > SchemaVersion {
+		return fmt.Errorf("%w: snapshot schema v%d is newer than this tm supports (v%d); upgrade tm", tmerrors.ErrRejected, version, SchemaVersion)
+	}
+
+	if err := os.MkdirAll(filepath.Join(r.workingDir, "projects", snapshot.Project), 0755); err != nil {
+		return fmt.Errorf("failed to create project directory: %w", err)
+	}
+	target, err := sql.Open(SQLiteDriverName, projectDatabaseDSN(GetProjectDatabasePath(r.workingDir, snapshot.Project), false))
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer target.Close()
+
+	if err := copyDatabase(ctx, source, target); err != nil {
+		return fmt.Errorf("failed to restore snapshot '%s': %w", id, err)
+	}
+	return nil
+}
+
+// DeleteSnapshot removes a snapshot file.
+func (r *FileSystemSnapshotRepository) DeleteSnapshot(ctx context.Context, id string) error {
+	snapshot, err := r.GetSnapshot(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(snapshot.Path); err != nil {
+		return fmt.Errorf("failed to delete snapshot: %w", err)
+	}
+	return nil
+}
+
+// copyDatabase replaces the content of target with the content of source using
+// SQLite's online backup API.
+func copyDatabase(ctx context.Context, source, target *sql.DB) error {
+	sourceConn, err := source.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer sourceConn.Close()
+	targetConn, err := target.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer targetConn.Close()
+
+	return targetConn.Raw(func(targetDriverConn interface{}) error {
+		return sourceConn.Raw(func(sourceDriverConn interface{}) error {
+			to, ok := targetDriverConn.(*sqlite3.SQLiteConn)
+			from, ok2 := sourceDriverConn.(*sqlite3.SQLiteConn)
+			if !ok || !ok2 {
+				return fmt.Errorf("online backup requires SQLite connections")
+			}
+
+			backup, err := to.Backup("main", from, "main")
+			if err != nil {
+				return err
+			}
+			if _, err := backup.Step(-1); err != nil {
+				backup.Finish()
+				return err
+			}
+			return backup.Finish()
+		})
+	})
+}
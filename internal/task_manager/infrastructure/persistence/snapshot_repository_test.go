@@ -0,0 +1,144 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/persistence"
+)
+
+// TestFileSystemSnapshotRepository_CreateAndList tests that snapshots are listed newest first
+// with the reason they were taken for
+func TestFileSystemSnapshotRepository_CreateAndList(t *testing.T) {
+	workingDir := t.TempDir()
+	ctx := context.Background()
+	repo := persistence.NewFileSystemSnapshotRepository(workingDir)
+
+	if _, err := repo.CreateSnapshot(ctx, "alpha", entities.SnapshotReasonManual); !errors.Is(err, tmerrors.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a project without database, got %v", err)
+	}
+
+	_, db, err := persistence.OpenProjectDatabase(workingDir, "alpha")
+	if err != nil {
+		t.Fatalf("OpenProjectDatabase failed: %v", err)
+	}
+	defer db.Close()
+
+	// The reasons sort against creation order, so listing must not go by name alone
+	first, err := repo.CreateSnapshot(ctx, "alpha", entities.SnapshotReasonTaskDelete)
+	if err != nil {
+		t.Fatalf("CreateSnapshot failed: %v", err)
+	}
+	older := time.Now().Add(-time.Minute)
+	if err := os.Chtimes(first.Path, older, older); err != nil {
+		t.Fatalf("failed to age snapshot: %v", err)
+	}
+	second, err := repo.CreateSnapshot(ctx, "alpha", entities.SnapshotReasonManual)
+	if err != nil {
+		t.Fatalf("CreateSnapshot failed: %v", err)
+	}
+	if second.Reason != entities.SnapshotReasonManual || second.Project != "alpha" || second.Size == 0 {
+		t.Errorf("unexpected snapshot %+v", second)
+	}
+
+	// Files that are not snapshots are ignored
+	if err := os.WriteFile(filepath.Join(persistence.GetProjectBackupDir(workingDir, "alpha"), "notes.txt"), nil, 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	snapshots, err := repo.ListSnapshots(ctx, "alpha")
+	if err != nil {
+		t.Fatalf("ListSnapshots failed: %v", err)
+	}
+	if len(snapshots) != 2 || snapshots[0].ID != second.ID || snapshots[1].ID != first.ID {
+		t.Errorf("expected [%s %s], got %+v", second.ID, first.ID, snapshots)
+	}
+
+	for _, id := range []string{"alpha", "../alpha/x", "alpha/not-a-snapshot"} {
+		if _, err := repo.GetSnapshot(ctx, id); !errors.Is(err, tmerrors.ErrInvalidArgument) {
+			t.Errorf("expected ErrInvalidArgument for %q, got %v", id, err)
+		}
+	}
+
+	if err := repo.DeleteSnapshot(ctx, first.ID); err != nil {
+		t.Fatalf("DeleteSnapshot failed: %v", err)
+	}
+	if _, err := repo.GetSnapshot(ctx, first.ID); !errors.Is(err, tmerrors.ErrNotFound) {
+		t.Errorf("expected ErrNotFound after deletion, got %v", err)
+	}
+}
+
+// TestFileSystemSnapshotRepository_Restore tests restoring into an open database and into a deleted project
+func TestFileSystemSnapshotRepository_Restore(t *testing.T) {
+	workingDir := t.TempDir()
+	ctx := context.Background()
+	repo := persistence.NewFileSystemSnapshotRepository(workingDir)
+
+	_, db, err := persistence.OpenProjectDatabase(workingDir, "alpha")
+	if err != nil {
+		t.Fatalf("OpenProjectDatabase failed: %v", err)
+	}
+	defer db.Close()
+	createTrack(t, db, "TM-track-1")
+
+	snapshot, err := repo.CreateSnapshot(ctx, "alpha", entities.SnapshotReasonManual)
+	if err != nil {
+		t.Fatalf("CreateSnapshot failed: %v", err)
+	}
+	createTrack(t, db, "TM-track-2")
+
+	// The connection stays open while the snapshot is restored underneath it
+	if err := repo.RestoreSnapshot(ctx, snapshot.ID); err != nil {
+		t.Fatalf("RestoreSnapshot failed: %v", err)
+	}
+	var tracks int
+	if err := db.QueryRow("SELECT COUNT(*) FROM tracks").Scan(&tracks); err != nil || tracks != 1 {
+		t.Errorf("expected the snapshot's single track, got %d (%v)", tracks, err)
+	}
+
+	db.Close()
+	if err := os.RemoveAll(filepath.Join(workingDir, "projects", "alpha")); err != nil {
+		t.Fatalf("failed to delete project: %v", err)
+	}
+	if err := repo.RestoreSnapshot(ctx, snapshot.ID); err != nil {
+		t.Fatalf("RestoreSnapshot of a deleted project failed: %v", err)
+	}
+	_, db, err = persistence.OpenProjectDatabase(workingDir, "alpha")
+	if err != nil {
+		t.Fatalf("OpenProjectDatabase of the restored project failed: %v", err)
+	}
+	defer db.Close()
+	if err := db.QueryRow("SELECT COUNT(*) FROM tracks").Scan(&tracks); err != nil || tracks != 1 {
+		t.Errorf("expected the restored project to hold the track, got %d (%v)", tracks, err)
+	}
+}
+
+// TestFileSystemSnapshotRepository_RestoreRejectsNewerSchema tests that snapshots of a newer tm are not restored
+func TestFileSystemSnapshotRepository_RestoreRejectsNewerSchema(t *testing.T) {
+	workingDir := t.TempDir()
+	ctx := context.Background()
+	repo := persistence.NewFileSystemSnapshotRepository(workingDir)
+
+	_, db, err := persistence.OpenProjectDatabase(workingDir, "alpha")
+	if err != nil {
+		t.Fatalf("OpenProjectDatabase failed: %v", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("UPDATE project_metadata SET value = ? WHERE key = 'schema_version'", persistence.SchemaVersion+1); err != nil {
+		t.Fatalf("failed to set schema version: %v", err)
+	}
+	snapshot, err := repo.CreateSnapshot(ctx, "alpha", entities.SnapshotReasonManual)
+	if err != nil {
+		t.Fatalf("CreateSnapshot failed: %v", err)
+	}
+
+	if err := repo.RestoreSnapshot(ctx, snapshot.ID); !errors.Is(err, tmerrors.ErrRejected) {
+		t.Errorf("expected ErrRejected, got %v", err)
+	}
+}
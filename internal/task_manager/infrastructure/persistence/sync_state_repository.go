@@ -0,0 +1,90 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
+)
+
+// Compile-time check that SQLiteSyncStateRepository implements repositories.SyncStateRepository
+var _ repositories.SyncStateRepository = (*SQLiteSyncStateRepository)(nil)
+
+// SQLiteSyncStateRepository implements repositories.SyncStateRepository using SQLite as the backend.
+type SQLiteSyncStateRepository struct {
+	DB *sql.DB
+}
+
+// NewSQLiteSyncStateRepository creates a new SQLite-backed sync state repository.
+func NewSQLiteSyncStateRepository(db *sql.DB) *SQLiteSyncStateRepository {
+	return &SQLiteSyncStateRepository{
+		DB: db,
+	}
+}
+
+// ============================================================================
+// Sync State Operations
+// ============================================================================
+
+// ListSyncStates returns the baselines recorded for a sync directory.
+// Returns empty slice if the directory was never synced.
+func (r *SQLiteSyncStateRepository) ListSyncStates(ctx context.Context, directory string) ([]*entities.SyncState, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(
+		ctx,
+		`SELECT directory, entity_type, entity_id, content_hash, updated_at, synced_at
+			FROM sync_state WHERE directory = ? ORDER BY entity_type, entity_id`,
+		directory,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sync state: %w", err)
+	}
+	defer rows.Close()
+
+	states := []*entities.SyncState{}
+	for rows.Next() {
+		state := &entities.SyncState{}
+		if err := rows.Scan(
+			&state.Directory, &state.EntityType, &state.EntityID,
+			&state.ContentHash, &state.UpdatedAt, &state.SyncedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan sync state: %w", err)
+		}
+		states = append(states, state)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating sync state: %w", err)
+	}
+
+	return states, nil
+}
+
+// SaveSyncState creates or replaces the baseline of an entity in a sync directory.
+func (r *SQLiteSyncStateRepository) SaveSyncState(ctx context.Context, state *entities.SyncState) error {
+	_, err := conn(ctx, r.DB).ExecContext(
+		ctx,
+		`INSERT OR REPLACE INTO sync_state (directory, entity_type, entity_id, content_hash, updated_at, synced_at)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+		state.Directory, state.EntityType, state.EntityID, state.ContentHash, state.UpdatedAt, state.SyncedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save sync state: %w", err)
+	}
+	return nil
+}
+
+// DeleteSyncState removes the baseline of an entity in a sync directory.
+// Deleting a missing baseline is not an error.
+func (r *SQLiteSyncStateRepository) DeleteSyncState(ctx context.Context, directory, entityType, entityID string) error {
+	_, err := conn(ctx, r.DB).ExecContext(
+		ctx,
+		"DELETE FROM sync_state WHERE directory = ? AND entity_type = ? AND entity_id = ?",
+		directory, entityType, entityID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete sync state: %w", err)
+	}
+	return nil
+}
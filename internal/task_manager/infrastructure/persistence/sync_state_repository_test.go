@@ -0,0 +1,67 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/persistence"
+)
+
+// TestSQLiteSyncStateRepository_SaveListDelete tests that baselines are upserted, scoped per directory and deleted
+func TestSQLiteSyncStateRepository_SaveListDelete(t *testing.T) {
+	tmpDir := t.TempDir()
+	db := setupTestDB(t, tmpDir)
+	defer db.Close()
+
+	repo := persistence.NewSQLiteSyncStateRepository(db)
+	ctx := context.Background()
+	updatedAt := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	state := &entities.SyncState{
+		Directory:   "/repo/roadmap",
+		EntityType:  "task",
+		EntityID:    "TM-task-1",
+		ContentHash: "abc",
+		UpdatedAt:   updatedAt,
+		SyncedAt:    updatedAt,
+	}
+	if err := repo.SaveSyncState(ctx, state); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+	state.ContentHash = "def"
+	if err := repo.SaveSyncState(ctx, state); err != nil {
+		t.Fatalf("failed to replace state: %v", err)
+	}
+	other := *state
+	other.Directory = "/repo/other"
+	if err := repo.SaveSyncState(ctx, &other); err != nil {
+		t.Fatalf("failed to save state of another directory: %v", err)
+	}
+
+	states, err := repo.ListSyncStates(ctx, "/repo/roadmap")
+	if err != nil {
+		t.Fatalf("failed to list states: %v", err)
+	}
+	if len(states) != 1 {
+		t.Fatalf("expected 1 state for the directory, got %d", len(states))
+	}
+	if states[0].ContentHash != "def" || !states[0].UpdatedAt.Equal(updatedAt) {
+		t.Errorf("state mismatch: got %+v", states[0])
+	}
+
+	if err := repo.DeleteSyncState(ctx, "/repo/roadmap", "task", "TM-task-1"); err != nil {
+		t.Fatalf("failed to delete state: %v", err)
+	}
+	if err := repo.DeleteSyncState(ctx, "/repo/roadmap", "task", "TM-task-1"); err != nil {
+		t.Errorf("deleting a missing state should not fail: %v", err)
+	}
+	states, err = repo.ListSyncStates(ctx, "/repo/roadmap")
+	if err != nil {
+		t.Fatalf("failed to list states: %v", err)
+	}
+	if len(states) != 0 {
+		t.Errorf("expected no states after delete, got %d", len(states))
+	}
+}
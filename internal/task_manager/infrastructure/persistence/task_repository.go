@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
@@ -37,7 +38,7 @@ func NewSQLiteTaskRepository(db *sql.DB, logger logger.Logger) *SQLiteTaskReposi
 func (r *SQLiteTaskRepository) SaveTask(ctx context.Context, task *entities.TaskEntity) error {
 	// Check if task already exists
 	var exists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", task.ID).Scan(&exists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", task.ID).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check task existence: %w", err)
 	}
@@ -47,7 +48,7 @@ func (r *SQLiteTaskRepository) SaveTask(ctx context.Context, task *entities.Task
 
 	// Check if track exists
 	var trackExists int
-	err = r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tracks WHERE id = ?", task.TrackID).Scan(&trackExists)
+	err = conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM tracks WHERE id = ?", task.TrackID).Scan(&trackExists)
 	if err != nil {
 		return fmt.Errorf("failed to check track existence: %w", err)
 	}
@@ -55,28 +56,30 @@ func (r *SQLiteTaskRepository) SaveTask(ctx context.Context, task *entities.Task
 		return fmt.Errorf("%w: track %s not found", tmerrors.ErrNotFound, task.TrackID)
 	}
 
-	_, err = r.DB.ExecContext(
+	_, err = conn(ctx, r.DB).ExecContext(
 		ctx,
-		"INSERT INTO tasks (id, track_id, title, description, status, rank, branch, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
-		task.ID, task.TrackID, task.Title, task.Description, task.Status, task.Rank, task.Branch, task.CreatedAt, task.UpdatedAt,
+		"INSERT INTO tasks (id, track_id, title, description, status, rank, branch, estimate, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		task.ID, task.TrackID, task.Title, task.Description, task.Status, task.Rank, task.Branch, task.Estimate, task.CreatedAt, task.UpdatedAt,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to insert task: %w", err)
 	}
+	task.Version = 1
 
-	return nil
+	return r.recordStatusChange(ctx, task.ID, "", task.Status, task.CreatedAt)
 }
 
 // GetTask retrieves a task by its ID.
 func (r *SQLiteTaskRepository) GetTask(ctx context.Context, id string) (*entities.TaskEntity, error) {
 	var task entities.TaskEntity
 	var branch sql.NullString
+	var estimate sql.NullFloat64
 
-	err := r.DB.QueryRowContext(
+	err := conn(ctx, r.DB).QueryRowContext(
 		ctx,
-		"SELECT id, track_id, title, description, status, rank, branch, created_at, updated_at FROM tasks WHERE id = ?",
+		"SELECT id, track_id, title, description, status, rank, branch, estimate, created_at, updated_at, version FROM tasks WHERE id = ?",
 		id,
-	).Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &task.CreatedAt, &task.UpdatedAt)
+	).Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &estimate, &task.CreatedAt, &task.UpdatedAt, &task.Version)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -88,13 +91,20 @@ func (r *SQLiteTaskRepository) GetTask(ctx context.Context, id string) (*entitie
 	if branch.Valid {
 		task.Branch = branch.String
 	}
+	if estimate.Valid {
+		task.Estimate = &estimate.Float64
+	}
+
+	if err := loadTaskBlockers(ctx, conn(ctx, r.DB), []*entities.TaskEntity{&task}); err != nil {
+		return nil, err
+	}
 
 	return &task, nil
 }
 
 // ListTasks returns all tasks matching the filters.
 func (r *SQLiteTaskRepository) ListTasks(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
-	query := "SELECT id, track_id, title, description, status, rank, branch, created_at, updated_at FROM tasks WHERE 1=1"
+	query := "SELECT id, track_id, title, description, status, rank, branch, estimate, created_at, updated_at, version FROM tasks WHERE 1=1"
 	args := []interface{}{}
 
 	// Add track filter if provided
@@ -129,9 +139,16 @@ func (r *SQLiteTaskRepository) ListTasks(ctx context.Context, filters entities.T
 		query += " AND rank IN (" + placeholders + ")"
 	}
 
+	// Keep only actionable tasks: not done and without unfinished blockers
+	if filters.Ready {
+		query += " AND status != 'done' AND NOT EXISTS (" +
+			"SELECT 1 FROM task_dependencies d JOIN tasks b ON b.id = d.blocked_by_id " +
+			"WHERE d.task_id = tasks.id AND b.status != 'done')"
+	}
+
 	query += " ORDER BY id"
 
-	rows, err := r.DB.QueryContext(ctx, query, args...)
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query tasks: %w", err)
 	}
@@ -141,8 +158,9 @@ func (r *SQLiteTaskRepository) ListTasks(ctx context.Context, filters entities.T
 	for rows.Next() {
 		var task entities.TaskEntity
 		var branch sql.NullString
+		var estimate sql.NullFloat64
 
-		err := rows.Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &task.CreatedAt, &task.UpdatedAt)
+		err := rows.Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &estimate, &task.CreatedAt, &task.UpdatedAt, &task.Version)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan task: %w", err)
 		}
@@ -150,6 +168,9 @@ func (r *SQLiteTaskRepository) ListTasks(ctx context.Context, filters entities.T
 		if branch.Valid {
 			task.Branch = branch.String
 		}
+		if estimate.Valid {
+			task.Estimate = &estimate.Float64
+		}
 
 		tasks = append(tasks, &task)
 	}
@@ -158,35 +179,56 @@ func (r *SQLiteTaskRepository) ListTasks(ctx context.Context, filters entities.T
 		return nil, fmt.Errorf("error iterating tasks: %w", err)
 	}
 
+	if err := loadTaskBlockers(ctx, conn(ctx, r.DB), tasks); err != nil {
+		return nil, err
+	}
+
 	return tasks, nil
 }
 
 // UpdateTask updates an existing task.
+// A status change is recorded in the task status history, in the same transaction as the update.
+// A task read at a version that is no longer stored is rejected with ErrConflict.
 func (r *SQLiteTaskRepository) UpdateTask(ctx context.Context, task *entities.TaskEntity) error {
-	result, err := r.DB.ExecContext(
-		ctx,
-		"UPDATE tasks SET track_id = ?, title = ?, description = ?, status = ?, rank = ?, branch = ?, updated_at = ? WHERE id = ?",
-		task.TrackID, task.Title, task.Description, task.Status, task.Rank, task.Branch, task.UpdatedAt, task.ID,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to update task: %w", err)
-	}
+	version := task.Version
+	err := inTransaction(ctx, r.DB, func(ctx context.Context) error {
+		var previousStatus string
+		err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT status FROM tasks WHERE id = ?", task.ID).Scan(&previousStatus)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("%w: task %s not found", tmerrors.ErrNotFound, task.ID)
+			}
+			return fmt.Errorf("failed to query task: %w", err)
+		}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
+		err = conn(ctx, r.DB).QueryRowContext(
+			ctx,
+			"UPDATE tasks SET track_id = ?, title = ?, description = ?, status = ?, rank = ?, branch = ?, estimate = ?, updated_at = ?, version = version + 1 WHERE id = ?"+versionGuard+" RETURNING version",
+			append([]interface{}{task.TrackID, task.Title, task.Description, task.Status, task.Rank, task.Branch, task.Estimate, task.UpdatedAt, task.ID}, versionArgs(task.Version)...)...,
+		).Scan(&task.Version)
+		if err == sql.ErrNoRows {
+			return updateMissed(ctx, conn(ctx, r.DB), "tasks", "id", task.ID, "task")
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update task: %w", err)
+		}
 
-	if rows == 0 {
-		return fmt.Errorf("%w: task %s not found", tmerrors.ErrNotFound, task.ID)
+		if previousStatus != task.Status {
+			return r.recordStatusChange(ctx, task.ID, previousStatus, task.Status, task.UpdatedAt)
+		}
+		return nil
+	})
+	if err != nil {
+		// The update was rolled back, so the task is still at the version it was read at
+		task.Version = version
 	}
-
-	return nil
+	return err
 }
 
-// DeleteTask removes a task from storage.
+// DeleteTask removes a task from storage. Its dependency links and status history go with it
+// through ON DELETE CASCADE, which relies on the connection enforcing foreign keys.
 func (r *SQLiteTaskRepository) DeleteTask(ctx context.Context, id string) error {
-	result, err := r.DB.ExecContext(ctx, "DELETE FROM tasks WHERE id = ?", id)
+	result, err := conn(ctx, r.DB).ExecContext(ctx, "DELETE FROM tasks WHERE id = ?", id)
 	if err != nil {
 		return fmt.Errorf("failed to delete task: %w", err)
 	}
@@ -213,7 +255,7 @@ func (r *SQLiteTaskRepository) MoveTaskToTrack(ctx context.Context, taskID, newT
 
 	// Check if new track exists
 	var trackExists int
-	err = r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tracks WHERE id = ?", newTrackID).Scan(&trackExists)
+	err = conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM tracks WHERE id = ?", newTrackID).Scan(&trackExists)
 	if err != nil {
 		return fmt.Errorf("failed to check track existence: %w", err)
 	}
@@ -229,9 +271,9 @@ func (r *SQLiteTaskRepository) MoveTaskToTrack(ctx context.Context, taskID, newT
 
 // GetBacklogTasks returns all tasks that are not in any iteration and not done.
 func (r *SQLiteTaskRepository) GetBacklogTasks(ctx context.Context) ([]*entities.TaskEntity, error) {
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
-		`SELECT t.id, t.track_id, t.title, t.description, t.status, t.rank, t.branch, t.created_at, t.updated_at
+		`SELECT t.id, t.track_id, t.title, t.description, t.status, t.rank, t.branch, t.estimate, t.created_at, t.updated_at, t.version
 		 FROM tasks t
 		 LEFT JOIN iteration_tasks it ON t.id = it.task_id
 		 WHERE it.task_id IS NULL AND t.status != 'done'
@@ -246,8 +288,9 @@ func (r *SQLiteTaskRepository) GetBacklogTasks(ctx context.Context) ([]*entities
 	for rows.Next() {
 		var task entities.TaskEntity
 		var branch sql.NullString
+		var estimate sql.NullFloat64
 
-		err := rows.Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &task.CreatedAt, &task.UpdatedAt)
+		err := rows.Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &estimate, &task.CreatedAt, &task.UpdatedAt, &task.Version)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan task: %w", err)
 		}
@@ -255,6 +298,9 @@ func (r *SQLiteTaskRepository) GetBacklogTasks(ctx context.Context) ([]*entities
 		if branch.Valid {
 			task.Branch = branch.String
 		}
+		if estimate.Valid {
+			task.Estimate = &estimate.Float64
+		}
 
 		tasks = append(tasks, &task)
 	}
@@ -263,14 +309,18 @@ func (r *SQLiteTaskRepository) GetBacklogTasks(ctx context.Context) ([]*entities
 		return nil, fmt.Errorf("error iterating tasks: %w", err)
 	}
 
+	if err := loadTaskBlockers(ctx, conn(ctx, r.DB), tasks); err != nil {
+		return nil, err
+	}
+
 	return tasks, nil
 }
 
 // GetIterationsForTask returns all iterations that contain a specific task.
 func (r *SQLiteTaskRepository) GetIterationsForTask(ctx context.Context, taskID string) ([]*entities.IterationEntity, error) {
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
-		`SELECT i.number, i.name, i.goal, i.status, i.rank, i.deliverable, i.started_at, i.completed_at, i.created_at, i.updated_at
+		`SELECT i.number, i.name, i.goal, i.status, i.rank, i.deliverable, i.capacity, i.started_at, i.completed_at, i.created_at, i.updated_at, i.version
 		 FROM iterations i
 		 JOIN iteration_tasks it ON i.number = it.iteration_number
 		 WHERE it.task_id = ?
@@ -286,12 +336,16 @@ func (r *SQLiteTaskRepository) GetIterationsForTask(ctx context.Context, taskID
 	for rows.Next() {
 		var iteration entities.IterationEntity
 		var startedAt, completedAt sql.NullTime
+		var capacity sql.NullFloat64
 
-		err := rows.Scan(&iteration.Number, &iteration.Name, &iteration.Goal, &iteration.Status, &iteration.Rank, &iteration.Deliverable, &startedAt, &completedAt, &iteration.CreatedAt, &iteration.UpdatedAt)
+		err := rows.Scan(&iteration.Number, &iteration.Name, &iteration.Goal, &iteration.Status, &iteration.Rank, &iteration.Deliverable, &capacity, &startedAt, &completedAt, &iteration.CreatedAt, &iteration.UpdatedAt, &iteration.Version)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan iteration: %w", err)
 		}
 
+		if capacity.Valid {
+			iteration.Capacity = &capacity.Float64
+		}
 		if startedAt.Valid {
 			iteration.StartedAt = &startedAt.Time
 		}
@@ -316,13 +370,146 @@ func (r *SQLiteTaskRepository) GetIterationsForTask(ctx context.Context, taskID
 	return iterations, nil
 }
 
+// AddTaskDependency records that taskID is blocked by blockedByID.
+func (r *SQLiteTaskRepository) AddTaskDependency(ctx context.Context, taskID, blockedByID string) error {
+	// Check for self-dependency
+	if taskID == blockedByID {
+		return fmt.Errorf("%w: task cannot be blocked by itself", tmerrors.ErrInvalidArgument)
+	}
+
+	// Check both tasks exist
+	for _, id := range []string{taskID, blockedByID} {
+		var exists int
+		err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", id).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("failed to check task existence: %w", err)
+		}
+		if exists == 0 {
+			return fmt.Errorf("%w: task %s not found", tmerrors.ErrNotFound, id)
+		}
+	}
+
+	// Check if dependency already exists
+	var exists int
+	err := conn(ctx, r.DB).QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM task_dependencies WHERE task_id = ? AND blocked_by_id = ?",
+		taskID, blockedByID,
+	).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check dependency existence: %w", err)
+	}
+	if exists > 0 {
+		return fmt.Errorf("%w: task %s is already blocked by %s", tmerrors.ErrAlreadyExists, taskID, blockedByID)
+	}
+
+	// Insert dependency
+	_, err = conn(ctx, r.DB).ExecContext(
+		ctx,
+		"INSERT INTO task_dependencies (task_id, blocked_by_id) VALUES (?, ?)",
+		taskID, blockedByID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add task dependency: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveTaskDependency removes the link from taskID to blockedByID.
+func (r *SQLiteTaskRepository) RemoveTaskDependency(ctx context.Context, taskID, blockedByID string) error {
+	result, err := conn(ctx, r.DB).ExecContext(
+		ctx,
+		"DELETE FROM task_dependencies WHERE task_id = ? AND blocked_by_id = ?",
+		taskID, blockedByID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to remove task dependency: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%w: task %s is not blocked by %s", tmerrors.ErrNotFound, taskID, blockedByID)
+	}
+
+	return nil
+}
+
+// GetTaskDependencies returns the IDs of all tasks that block taskID.
+func (r *SQLiteTaskRepository) GetTaskDependencies(ctx context.Context, taskID string) ([]string, error) {
+	return r.queryTaskIDs(ctx, "SELECT blocked_by_id FROM task_dependencies WHERE task_id = ? ORDER BY blocked_by_id", taskID)
+}
+
+// GetBlockedTasks returns the IDs of all tasks that taskID blocks.
+func (r *SQLiteTaskRepository) GetBlockedTasks(ctx context.Context, taskID string) ([]string, error) {
+	return r.queryTaskIDs(ctx, "SELECT task_id FROM task_dependencies WHERE blocked_by_id = ? ORDER BY task_id", taskID)
+}
+
+// ListTaskStatusChanges returns recorded task status transitions matching the filters.
+func (r *SQLiteTaskRepository) ListTaskStatusChanges(ctx context.Context, filters entities.TaskStatusChangeFilters) ([]*entities.TaskStatusChange, error) {
+	query := "SELECT id, task_id, from_status, to_status, changed_at FROM task_status_history WHERE 1=1"
+	args := []interface{}{}
+
+	if len(filters.TaskIDs) > 0 {
+		query += " AND task_id IN (?" + strings.Repeat(", ?", len(filters.TaskIDs)-1) + ")"
+		for _, taskID := range filters.TaskIDs {
+			args = append(args, taskID)
+		}
+	}
+
+	if filters.Since != nil {
+		query += " AND changed_at >= ?"
+		args = append(args, *filters.Since)
+	}
+
+	query += " ORDER BY changed_at, id"
+
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query task status history: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []*entities.TaskStatusChange{}
+	for rows.Next() {
+		var change entities.TaskStatusChange
+		if err := rows.Scan(&change.ID, &change.TaskID, &change.FromStatus, &change.ToStatus, &change.ChangedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan task status change: %w", err)
+		}
+		changes = append(changes, &change)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task status history: %w", err)
+	}
+
+	return changes, nil
+}
+
 // ============================================================================
 // Helper Methods
 // ============================================================================
 
+// recordStatusChange appends a transition to the task status history.
+func (r *SQLiteTaskRepository) recordStatusChange(ctx context.Context, taskID, fromStatus, toStatus string, changedAt time.Time) error {
+	_, err := conn(ctx, r.DB).ExecContext(
+		ctx,
+		"INSERT INTO task_status_history (task_id, from_status, to_status, changed_at) VALUES (?, ?, ?, ?)",
+		taskID, fromStatus, toStatus, changedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record task status change: %w", err)
+	}
+	return nil
+}
+
 // getIterationTaskIDs retrieves all task IDs for an iteration.
 func (r *SQLiteTaskRepository) getIterationTaskIDs(ctx context.Context, iterationNum int) ([]string, error) {
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
 		"SELECT task_id FROM iteration_tasks WHERE iteration_number = ? ORDER BY task_id",
 		iterationNum,
@@ -347,3 +534,75 @@ func (r *SQLiteTaskRepository) getIterationTaskIDs(ctx context.Context, iteratio
 
 	return taskIDs, nil
 }
+
+// queryTaskIDs runs a query selecting a single task ID column.
+func (r *SQLiteTaskRepository) queryTaskIDs(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query task dependencies: %w", err)
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan task ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task dependencies: %w", err)
+	}
+
+	return ids, nil
+}
+
+// loadTaskBlockers fills BlockedBy and UnfinishedBlockers of the given tasks with a single query.
+func loadTaskBlockers(ctx context.Context, db sqlConn, tasks []*entities.TaskEntity) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*entities.TaskEntity, len(tasks))
+	args := make([]interface{}, 0, len(tasks))
+	for _, task := range tasks {
+		task.BlockedBy = []string{}
+		task.UnfinishedBlockers = nil
+		byID[task.ID] = task
+		args = append(args, task.ID)
+	}
+
+	rows, err := db.QueryContext(
+		ctx,
+		`SELECT d.task_id, d.blocked_by_id, b.status
+		 FROM task_dependencies d
+		 JOIN tasks b ON b.id = d.blocked_by_id
+		 WHERE d.task_id IN (?`+strings.Repeat(", ?", len(args)-1)+`)
+		 ORDER BY d.task_id, d.blocked_by_id`,
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to query task blockers: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var taskID, blockedByID, status string
+		if err := rows.Scan(&taskID, &blockedByID, &status); err != nil {
+			return fmt.Errorf("failed to scan task blocker: %w", err)
+		}
+		task := byID[taskID]
+		task.BlockedBy = append(task.BlockedBy, blockedByID)
+		if status != string(entities.TaskStatusDone) {
+			task.UnfinishedBlockers = append(task.UnfinishedBlockers, blockedByID)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("error iterating task blockers: %w", err)
+	}
+
+	return nil
+}
@@ -289,6 +289,113 @@ func TestUpdateTask(t *testing.T) {
 	}
 }
 
+func TestUpdateTask_StatusHistoryFailureRollsBack(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	// Setup
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+
+	task, _ := entities.NewTaskEntity("task-1", "track-1", "Task", "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+	taskRepo.SaveTask(ctx, task)
+
+	// Recording the status change fails once the history table is gone
+	if _, err := db.Exec("DROP TABLE task_status_history"); err != nil {
+		t.Fatalf("failed to drop status history: %v", err)
+	}
+
+	task.Status = "done"
+	if err := taskRepo.UpdateTask(ctx, task); err == nil {
+		t.Fatal("expected the update to fail")
+	}
+	if task.Version != 1 {
+		t.Errorf("expected task to stay at version 1, got %d", task.Version)
+	}
+
+	// The task row is left as it was
+	retrieved, _ := taskRepo.GetTask(ctx, "task-1")
+	if retrieved.Status != "todo" || retrieved.Version != 1 {
+		t.Errorf("expected update to be rolled back, got status %q, version %d", retrieved.Status, retrieved.Version)
+	}
+}
+
+func TestUpdateTask_Conflict(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	// Setup
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+
+	task, _ := entities.NewTaskEntity("task-1", "track-1", "Task", "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+	taskRepo.SaveTask(ctx, task)
+	if task.Version != 1 {
+		t.Errorf("expected saved task at version 1, got %d", task.Version)
+	}
+
+	// Two writers read the same version
+	first, _ := taskRepo.GetTask(ctx, "task-1")
+	second, _ := taskRepo.GetTask(ctx, "task-1")
+
+	first.Status = "in-progress"
+	if err := taskRepo.UpdateTask(ctx, first); err != nil {
+		t.Fatalf("failed to update task: %v", err)
+	}
+	if first.Version != 2 {
+		t.Errorf("expected updated task at version 2, got %d", first.Version)
+	}
+
+	// The stale write is rejected and leaves the first write in place
+	second.Title = "Stale title"
+	err := taskRepo.UpdateTask(ctx, second)
+	if !errors.Is(err, tmerrors.ErrConflict) {
+		t.Fatalf("expected ErrConflict, got %v", err)
+	}
+	retrieved, _ := taskRepo.GetTask(ctx, "task-1")
+	if retrieved.Title != "Task" || retrieved.Status != "in-progress" || retrieved.Version != 2 {
+		t.Errorf("expected first update to be kept, got title %q, status %q, version %d", retrieved.Title, retrieved.Status, retrieved.Version)
+	}
+
+	// Re-reading resolves the conflict
+	retrieved.Title = "Fresh title"
+	if err := taskRepo.UpdateTask(ctx, retrieved); err != nil {
+		t.Fatalf("failed to update re-read task: %v", err)
+	}
+
+	// An entity that was never read overwrites unconditionally
+	unread, _ := entities.NewTaskEntity("task-1", "track-1", "Imported", "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+	if err := taskRepo.UpdateTask(ctx, unread); err != nil {
+		t.Fatalf("failed to update unread task: %v", err)
+	}
+	if unread.Version != 4 {
+		t.Errorf("expected version 4, got %d", unread.Version)
+	}
+
+	// A missing task is still reported as not found
+	missing, _ := entities.NewTaskEntity("task-9", "track-1", "Missing", "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+	missing.Version = 1
+	if err := taskRepo.UpdateTask(ctx, missing); !errors.Is(err, tmerrors.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
 func TestDeleteTask(t *testing.T) {
 	db := createTestDB(t)
 	defer db.Close()
@@ -319,3 +426,429 @@ func TestDeleteTask(t *testing.T) {
 		t.Errorf("expected ErrNotFound, got: %v", err)
 	}
 }
+
+// ============================================================================
+// Task Dependency Tests
+// ============================================================================
+
+func TestTaskDependencies(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	// Setup: task-3 is blocked by task-1 (done) and task-2 (todo)
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+
+	for _, spec := range []struct{ id, status string }{{"task-1", "done"}, {"task-2", "todo"}, {"task-3", "todo"}} {
+		task, _ := entities.NewTaskEntity(spec.id, "track-1", "Task "+spec.id, "", spec.status, 200, "", time.Now().UTC(), time.Now().UTC())
+		if err := taskRepo.SaveTask(ctx, task); err != nil {
+			t.Fatalf("failed to save task: %v", err)
+		}
+	}
+
+	if err := taskRepo.AddTaskDependency(ctx, "task-3", "task-1"); err != nil {
+		t.Fatalf("failed to add dependency: %v", err)
+	}
+	if err := taskRepo.AddTaskDependency(ctx, "task-3", "task-2"); err != nil {
+		t.Fatalf("failed to add dependency: %v", err)
+	}
+
+	// Invalid links
+	if err := taskRepo.AddTaskDependency(ctx, "task-3", "task-2"); !errors.Is(err, tmerrors.ErrAlreadyExists) {
+		t.Errorf("expected ErrAlreadyExists for duplicate link, got: %v", err)
+	}
+	if err := taskRepo.AddTaskDependency(ctx, "task-3", "task-99"); !errors.Is(err, tmerrors.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for unknown blocker, got: %v", err)
+	}
+	if err := taskRepo.AddTaskDependency(ctx, "task-3", "task-3"); !errors.Is(err, tmerrors.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for self link, got: %v", err)
+	}
+
+	// Blocker state is loaded with the task
+	task, err := taskRepo.GetTask(ctx, "task-3")
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+	if len(task.BlockedBy) != 2 || task.BlockedBy[0] != "task-1" || task.BlockedBy[1] != "task-2" {
+		t.Errorf("expected blockers [task-1 task-2], got %v", task.BlockedBy)
+	}
+	if !task.IsBlocked() || len(task.UnfinishedBlockers) != 1 || task.UnfinishedBlockers[0] != "task-2" {
+		t.Errorf("expected task-3 to be blocked by task-2 only, got %v", task.UnfinishedBlockers)
+	}
+
+	blocked, err := taskRepo.GetBlockedTasks(ctx, "task-2")
+	if err != nil {
+		t.Fatalf("failed to get blocked tasks: %v", err)
+	}
+	if len(blocked) != 1 || blocked[0] != "task-3" {
+		t.Errorf("expected task-2 to block [task-3], got %v", blocked)
+	}
+
+	// Ready filter leaves out done and blocked tasks
+	ready, err := taskRepo.ListTasks(ctx, entities.TaskFilters{Ready: true})
+	if err != nil {
+		t.Fatalf("failed to list ready tasks: %v", err)
+	}
+	if len(ready) != 1 || ready[0].ID != "task-2" {
+		t.Errorf("expected only task-2 to be ready, got %d task(s)", len(ready))
+	}
+
+	// Finishing the last blocker makes the task ready
+	blocker, _ := taskRepo.GetTask(ctx, "task-2")
+	blocker.Status = "done"
+	if err := taskRepo.UpdateTask(ctx, blocker); err != nil {
+		t.Fatalf("failed to update task: %v", err)
+	}
+	ready, _ = taskRepo.ListTasks(ctx, entities.TaskFilters{Ready: true})
+	if len(ready) != 1 || ready[0].ID != "task-3" || ready[0].IsBlocked() {
+		t.Errorf("expected task-3 to be ready once its blockers are done, got %d task(s)", len(ready))
+	}
+
+	// Removing links
+	if err := taskRepo.RemoveTaskDependency(ctx, "task-3", "task-1"); err != nil {
+		t.Fatalf("failed to remove dependency: %v", err)
+	}
+	if err := taskRepo.RemoveTaskDependency(ctx, "task-3", "task-1"); !errors.Is(err, tmerrors.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for missing link, got: %v", err)
+	}
+
+	// Deleting a task drops its links
+	if err := taskRepo.DeleteTask(ctx, "task-2"); err != nil {
+		t.Fatalf("failed to delete task: %v", err)
+	}
+	deps, err := taskRepo.GetTaskDependencies(ctx, "task-3")
+	if err != nil {
+		t.Fatalf("failed to get dependencies: %v", err)
+	}
+	if len(deps) != 0 {
+		t.Errorf("expected no dependencies after deleting the blocker, got %v", deps)
+	}
+}
+
+// TestInitSchema_MigratesTaskDependencies tests that upgrading a v11 database adds the task_dependencies table
+func TestInitSchema_MigratesTaskDependencies(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	// Rewind to a v11 database, which predates task dependencies
+	for _, stmt := range []string{
+		"DROP TABLE task_dependencies",
+		"UPDATE project_metadata SET value = '11' WHERE key = 'schema_version'",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("failed to prepare v11 database: %v", err)
+		}
+	}
+
+	if err := persistence.InitSchema(db); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM task_dependencies").Scan(&count); err != nil {
+		t.Errorf("expected task_dependencies table to exist: %v", err)
+	}
+
+	var version int
+	if err := db.QueryRow("SELECT CAST(value AS INTEGER) FROM project_metadata WHERE key = 'schema_version'").Scan(&version); err != nil {
+		t.Fatalf("failed to read schema version: %v", err)
+	}
+	if version != persistence.SchemaVersion {
+		t.Errorf("expected schema version %d, got %d", persistence.SchemaVersion, version)
+	}
+}
+
+// TestInitSchema_MigratesEstimates tests that upgrading a v14 database adds task estimates and iteration capacity
+func TestInitSchema_MigratesEstimates(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	// Rewind to a v14 database, which predates estimates
+	for _, stmt := range []string{
+		"ALTER TABLE tasks DROP COLUMN estimate",
+		"ALTER TABLE iterations DROP COLUMN capacity",
+		"UPDATE project_metadata SET value = '14' WHERE key = 'schema_version'",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("failed to prepare v14 database: %v", err)
+		}
+	}
+
+	if err := persistence.InitSchema(db); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	var version int
+	if err := db.QueryRow("SELECT CAST(value AS INTEGER) FROM project_metadata WHERE key = 'schema_version'").Scan(&version); err != nil {
+		t.Fatalf("failed to read schema version: %v", err)
+	}
+	if version != persistence.SchemaVersion {
+		t.Errorf("expected schema version %d, got %d", persistence.SchemaVersion, version)
+	}
+
+	// Estimates and capacity round-trip, and clearing them stores NULL
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	iterationRepo := persistence.NewSQLiteIterationRepository(db, createTestLogger(), persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger()))
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, now, now)
+	trackRepo.SaveTrack(ctx, track)
+
+	estimate, capacity := 5.5, 20.0
+	task, _ := entities.NewTaskEntity("task-1", "track-1", "Sized task", "", "todo", 200, "", now, now)
+	task.Estimate = &estimate
+	if err := taskRepo.SaveTask(ctx, task); err != nil {
+		t.Fatalf("failed to save task: %v", err)
+	}
+	iteration, _ := entities.NewIterationEntity(1, "Sprint 1", "Goal", "", []string{"task-1"}, "planned", 500, time.Time{}, time.Time{}, now, now)
+	iteration.Capacity = &capacity
+	if err := iterationRepo.SaveIteration(ctx, iteration); err != nil {
+		t.Fatalf("failed to save iteration: %v", err)
+	}
+
+	tasks, err := iterationRepo.GetIterationTasks(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to get iteration tasks: %v", err)
+	}
+	if len(tasks) != 1 || tasks[0].Estimate == nil || *tasks[0].Estimate != 5.5 {
+		t.Errorf("expected iteration task with estimate 5.5, got %+v", tasks)
+	}
+	retrievedIteration, err := iterationRepo.GetIteration(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to get iteration: %v", err)
+	}
+	if retrievedIteration.Capacity == nil || *retrievedIteration.Capacity != 20 {
+		t.Errorf("expected capacity 20, got %v", retrievedIteration.Capacity)
+	}
+
+	task.Estimate = nil
+	if err := taskRepo.UpdateTask(ctx, task); err != nil {
+		t.Fatalf("failed to update task: %v", err)
+	}
+	retrievedTask, err := taskRepo.GetTask(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+	if retrievedTask.Estimate != nil {
+		t.Errorf("expected estimate to be cleared, got %v", *retrievedTask.Estimate)
+	}
+}
+
+func TestInitSchema_MigratesVersions(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, now, now)
+	trackRepo.SaveTrack(ctx, track)
+	task, _ := entities.NewTaskEntity("task-1", "track-1", "Existing task", "", "todo", 200, "", now, now)
+	taskRepo.SaveTask(ctx, task)
+
+	// Rewind to a v15 database, which predates versions
+	for _, stmt := range []string{
+		"ALTER TABLE tasks DROP COLUMN version",
+		"ALTER TABLE acceptance_criteria DROP COLUMN version",
+		"ALTER TABLE iterations DROP COLUMN version",
+		"ALTER TABLE documents DROP COLUMN version",
+		"UPDATE project_metadata SET value = '15' WHERE key = 'schema_version'",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("failed to prepare v15 database: %v", err)
+		}
+	}
+
+	if err := persistence.InitSchema(db); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	var version int
+	if err := db.QueryRow("SELECT CAST(value AS INTEGER) FROM project_metadata WHERE key = 'schema_version'").Scan(&version); err != nil {
+		t.Fatalf("failed to read schema version: %v", err)
+	}
+	if version != persistence.SchemaVersion {
+		t.Errorf("expected schema version %d, got %d", persistence.SchemaVersion, version)
+	}
+
+	// Existing rows start at version 1 and take versioned updates
+	retrieved, err := taskRepo.GetTask(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+	if retrieved.Version != 1 {
+		t.Errorf("expected migrated task at version 1, got %d", retrieved.Version)
+	}
+	retrieved.Status = "done"
+	if err := taskRepo.UpdateTask(ctx, retrieved); err != nil {
+		t.Fatalf("failed to update migrated task: %v", err)
+	}
+	if retrieved.Version != 2 {
+		t.Errorf("expected version 2, got %d", retrieved.Version)
+	}
+}
+
+func TestTaskStatusHistory(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	// Setup
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+
+	created := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	for _, id := range []string{"task-1", "task-2"} {
+		task, _ := entities.NewTaskEntity(id, "track-1", "Task "+id, "", "todo", 200, "", created, created)
+		if err := taskRepo.SaveTask(ctx, task); err != nil {
+			t.Fatalf("failed to save task: %v", err)
+		}
+	}
+
+	// Status changes are recorded at the task's update time; other updates are not
+	task, _ := taskRepo.GetTask(ctx, "task-1")
+	for i, status := range []string{"in-progress", "in-progress", "done"} {
+		task.Status = status
+		task.UpdatedAt = created.Add(time.Duration(i+1) * time.Hour)
+		if err := taskRepo.UpdateTask(ctx, task); err != nil {
+			t.Fatalf("failed to update task: %v", err)
+		}
+	}
+
+	changes, err := taskRepo.ListTaskStatusChanges(ctx, entities.TaskStatusChangeFilters{TaskIDs: []string{"task-1"}})
+	if err != nil {
+		t.Fatalf("failed to list status changes: %v", err)
+	}
+	want := []struct {
+		from, to string
+		at       time.Time
+	}{
+		{"", "todo", created},
+		{"todo", "in-progress", created.Add(time.Hour)},
+		{"in-progress", "done", created.Add(3 * time.Hour)},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("expected %d status changes, got %d", len(want), len(changes))
+	}
+	for i, w := range want {
+		if changes[i].FromStatus != w.from || changes[i].ToStatus != w.to || !changes[i].ChangedAt.Equal(w.at) {
+			t.Errorf("change %d = %s -> %s at %v, want %s -> %s at %v",
+				i, changes[i].FromStatus, changes[i].ToStatus, changes[i].ChangedAt, w.from, w.to, w.at)
+		}
+	}
+
+	// Since filter
+	since := created.Add(2 * time.Hour)
+	recent, err := taskRepo.ListTaskStatusChanges(ctx, entities.TaskStatusChangeFilters{Since: &since})
+	if err != nil {
+		t.Fatalf("failed to list status changes: %v", err)
+	}
+	if len(recent) != 1 || recent[0].ToStatus != "done" {
+		t.Errorf("expected only the move to done since %v, got %d change(s)", since, len(recent))
+	}
+
+	// Updating an unknown task fails
+	missing, _ := entities.NewTaskEntity("task-99", "track-1", "Missing", "", "todo", 200, "", created, created)
+	if err := taskRepo.UpdateTask(ctx, missing); !errors.Is(err, tmerrors.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for unknown task, got: %v", err)
+	}
+
+	// Deleting a task drops its history
+	if err := taskRepo.DeleteTask(ctx, "task-1"); err != nil {
+		t.Fatalf("failed to delete task: %v", err)
+	}
+	all, _ := taskRepo.ListTaskStatusChanges(ctx, entities.TaskStatusChangeFilters{})
+	if len(all) != 1 || all[0].TaskID != "task-2" {
+		t.Errorf("expected only task-2's history to remain, got %d change(s)", len(all))
+	}
+}
+
+// TestInitSchema_MigratesTaskStatusHistory tests that upgrading a v12 database backfills task status history
+func TestInitSchema_MigratesTaskStatusHistory(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+
+	// task-1 has a logged move to done, task-2 has no logged history
+	created := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	task1, _ := entities.NewTaskEntity("task-1", "track-1", "Logged", "", "done", 200, "", created, created.Add(5*time.Hour))
+	task2, _ := entities.NewTaskEntity("task-2", "track-1", "Unlogged", "", "review", 200, "", created, created.Add(2*time.Hour))
+	for _, task := range []*entities.TaskEntity{task1, task2} {
+		if err := taskRepo.SaveTask(ctx, task); err != nil {
+			t.Fatalf("failed to save task: %v", err)
+		}
+	}
+
+	// Rewind to a v12 database, which predates task status history
+	for _, stmt := range []string{
+		"DROP TABLE task_status_history",
+		`INSERT INTO entity_events (entity_type, entity_id, event_type, changes, occurred_at)
+		 VALUES ('task', 'task-1', 'task.updated', '[{"field":"status","before":"in-progress","after":"done"}]', '2026-03-02 14:00:00+00:00')`,
+		"UPDATE project_metadata SET value = '12' WHERE key = 'schema_version'",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("failed to prepare v12 database: %v", err)
+		}
+	}
+
+	if err := persistence.InitSchema(db); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	changes, err := taskRepo.ListTaskStatusChanges(ctx, entities.TaskStatusChangeFilters{})
+	if err != nil {
+		t.Fatalf("failed to list status changes: %v", err)
+	}
+	history := make(map[string][]string)
+	for _, change := range changes {
+		history[change.TaskID] = append(history[change.TaskID], change.FromStatus+">"+change.ToStatus)
+	}
+	if got := history["task-1"]; len(got) != 2 || got[0] != ">in-progress" || got[1] != "in-progress>done" {
+		t.Errorf("expected task-1 history from its logged events, got %v", got)
+	}
+	if got := history["task-2"]; len(got) != 1 || got[0] != ">review" {
+		t.Errorf("expected task-2 history to hold its current status, got %v", got)
+	}
+
+	var version int
+	if err := db.QueryRow("SELECT CAST(value AS INTEGER) FROM project_metadata WHERE key = 'schema_version'").Scan(&version); err != nil {
+		t.Fatalf("failed to read schema version: %v", err)
+	}
+	if version != persistence.SchemaVersion {
+		t.Errorf("expected schema version %d, got %d", persistence.SchemaVersion, version)
+	}
+}
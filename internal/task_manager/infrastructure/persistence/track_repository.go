@@ -36,7 +36,7 @@ func NewSQLiteTrackRepository(db *sql.DB, logger logger.Logger) *SQLiteTrackRepo
 func (r *SQLiteTrackRepository) SaveTrack(ctx context.Context, track *entities.TrackEntity) error {
 	// Check if track already exists
 	var exists int
-	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tracks WHERE id = ?", track.ID).Scan(&exists)
+	err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM tracks WHERE id = ?", track.ID).Scan(&exists)
 	if err != nil {
 		return fmt.Errorf("failed to check track existence: %w", err)
 	}
@@ -46,7 +46,7 @@ func (r *SQLiteTrackRepository) SaveTrack(ctx context.Context, track *entities.T
 
 	// Check if roadmap exists
 	var roadmapExists int
-	err = r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM roadmaps WHERE id = ?", track.RoadmapID).Scan(&roadmapExists)
+	err = conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM roadmaps WHERE id = ?", track.RoadmapID).Scan(&roadmapExists)
 	if err != nil {
 		return fmt.Errorf("failed to check roadmap existence: %w", err)
 	}
@@ -55,7 +55,7 @@ func (r *SQLiteTrackRepository) SaveTrack(ctx context.Context, track *entities.T
 	}
 
 	// Start transaction for track and dependencies
-	tx, err := r.DB.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.DB)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -94,7 +94,7 @@ func (r *SQLiteTrackRepository) SaveTrack(ctx context.Context, track *entities.T
 func (r *SQLiteTrackRepository) GetTrack(ctx context.Context, id string) (*entities.TrackEntity, error) {
 	var track entities.TrackEntity
 
-	err := r.DB.QueryRowContext(
+	err := conn(ctx, r.DB).QueryRowContext(
 		ctx,
 		"SELECT id, roadmap_id, title, description, status, rank, created_at, updated_at FROM tracks WHERE id = ?",
 		id,
@@ -150,7 +150,7 @@ func (r *SQLiteTrackRepository) ListTracks(ctx context.Context, roadmapID string
 
 	query += " ORDER BY id"
 
-	rows, err := r.DB.QueryContext(ctx, query, args...)
+	rows, err := conn(ctx, r.DB).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query tracks: %w", err)
 	}
@@ -184,7 +184,7 @@ func (r *SQLiteTrackRepository) ListTracks(ctx context.Context, roadmapID string
 // UpdateTrack updates an existing track.
 func (r *SQLiteTrackRepository) UpdateTrack(ctx context.Context, track *entities.TrackEntity) error {
 	// Start transaction for track and dependencies update
-	tx, err := r.DB.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.DB)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -236,7 +236,7 @@ func (r *SQLiteTrackRepository) UpdateTrack(ctx context.Context, track *entities
 
 // DeleteTrack removes a track from storage.
 func (r *SQLiteTrackRepository) DeleteTrack(ctx context.Context, id string) error {
-	result, err := r.DB.ExecContext(ctx, "DELETE FROM tracks WHERE id = ?", id)
+	result, err := conn(ctx, r.DB).ExecContext(ctx, "DELETE FROM tracks WHERE id = ?", id)
 	if err != nil {
 		return fmt.Errorf("failed to delete track: %w", err)
 	}
@@ -263,7 +263,7 @@ func (r *SQLiteTrackRepository) AddTrackDependency(ctx context.Context, trackID,
 	// Check both tracks exist
 	for _, id := range []string{trackID, dependsOnID} {
 		var exists int
-		err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tracks WHERE id = ?", id).Scan(&exists)
+		err := conn(ctx, r.DB).QueryRowContext(ctx, "SELECT COUNT(*) FROM tracks WHERE id = ?", id).Scan(&exists)
 		if err != nil {
 			return fmt.Errorf("failed to check track existence: %w", err)
 		}
@@ -274,7 +274,7 @@ func (r *SQLiteTrackRepository) AddTrackDependency(ctx context.Context, trackID,
 
 	// Check if dependency already exists
 	var exists int
-	err := r.DB.QueryRowContext(
+	err := conn(ctx, r.DB).QueryRowContext(
 		ctx,
 		"SELECT COUNT(*) FROM track_dependencies WHERE track_id = ? AND depends_on_id = ?",
 		trackID, dependsOnID,
@@ -287,7 +287,7 @@ func (r *SQLiteTrackRepository) AddTrackDependency(ctx context.Context, trackID,
 	}
 
 	// Insert dependency
-	_, err = r.DB.ExecContext(
+	_, err = conn(ctx, r.DB).ExecContext(
 		ctx,
 		"INSERT INTO track_dependencies (track_id, depends_on_id) VALUES (?, ?)",
 		trackID, dependsOnID,
@@ -301,7 +301,7 @@ func (r *SQLiteTrackRepository) AddTrackDependency(ctx context.Context, trackID,
 
 // RemoveTrackDependency removes a dependency from trackID to dependsOnID.
 func (r *SQLiteTrackRepository) RemoveTrackDependency(ctx context.Context, trackID, dependsOnID string) error {
-	result, err := r.DB.ExecContext(
+	result, err := conn(ctx, r.DB).ExecContext(
 		ctx,
 		"DELETE FROM track_dependencies WHERE track_id = ? AND depends_on_id = ?",
 		trackID, dependsOnID,
@@ -324,7 +324,7 @@ func (r *SQLiteTrackRepository) RemoveTrackDependency(ctx context.Context, track
 
 // GetTrackDependencies returns the IDs of all tracks that trackID depends on.
 func (r *SQLiteTrackRepository) GetTrackDependencies(ctx context.Context, trackID string) ([]string, error) {
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
 		"SELECT depends_on_id FROM track_dependencies WHERE track_id = ? ORDER BY depends_on_id",
 		trackID,
@@ -366,7 +366,7 @@ func (r *SQLiteTrackRepository) GetTrackWithTasks(ctx context.Context, trackID s
 	}
 
 	// Load all tasks for this track
-	rows, err := r.DB.QueryContext(
+	rows, err := conn(ctx, r.DB).QueryContext(
 		ctx,
 		"SELECT id, track_id, title, description, status, rank, branch, created_at, updated_at FROM tasks WHERE track_id = ?",
 		trackID,
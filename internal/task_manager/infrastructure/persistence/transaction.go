@@ -0,0 +1,87 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// sqlConn is what repositories query through: the database, or the transaction open on it
+type sqlConn interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// sqlTx is a transaction begun by beginTx
+type sqlTx interface {
+	sqlConn
+	Commit() error
+	Rollback() error
+}
+
+// txContextKey keys the transaction carried by a context
+type txContextKey struct{}
+
+// contextTx is a transaction carried by a context, with the database it was begun on
+type contextTx struct {
+	db *sql.DB
+	tx *sql.Tx
+}
+
+// joinedTx is a transaction joined by a repository method that begins its own: committing and
+// rolling back are left to whoever began it
+type joinedTx struct {
+	*sql.Tx
+}
+
+func (joinedTx) Commit() error   { return nil }
+func (joinedTx) Rollback() error { return nil }
+
+// transactionOf returns the transaction ctx carries for db, or nil
+func transactionOf(ctx context.Context, db *sql.DB) *sql.Tx {
+	if current, ok := ctx.Value(txContextKey{}).(contextTx); ok && current.db == db {
+		return current.tx
+	}
+	return nil
+}
+
+// conn returns the transaction ctx carries for db, or db itself.
+// Every repository query goes through it so that it joins the transaction of inTransaction.
+func conn(ctx context.Context, db *sql.DB) sqlConn {
+	if tx := transactionOf(ctx, db); tx != nil {
+		return tx
+	}
+	return db
+}
+
+// beginTx begins a transaction on db, or joins the one ctx carries for db
+func beginTx(ctx context.Context, db *sql.DB) (sqlTx, error) {
+	if tx := transactionOf(ctx, db); tx != nil {
+		return joinedTx{tx}, nil
+	}
+	return db.BeginTx(ctx, nil)
+}
+
+// inTransaction runs fn in a transaction on db. The repository calls fn makes with the context it
+// receives are committed together when fn returns nil, and rolled back together otherwise.
+// A context that already carries a transaction on db runs fn in that transaction.
+func inTransaction(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) error {
+	if transactionOf(ctx, db) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, contextTx{db: db, tx: tx})); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Package policy loads per-project rules that gate task manager workflows.
+package policy
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the policy file inside a project directory (.tm/projects/<name>/policy.yaml)
+const FileName = "policy.yaml"
+
+// fileConfig mirrors the project policy file:
+//
+//	iteration_completion:
+//	  unfinished_tasks: error
+//	  not_started_acs: warning
+//	  missing_tasks: off
+type fileConfig struct {
+	IterationCompletion map[string]string `yaml:"iteration_completion"`
+}
+
+// LoadIterationCompletionPolicy reads the iteration completion rules from a project policy file.
+// A missing file yields the default policy; checks the file leaves out keep their default severity.
+func LoadIterationCompletionPolicy(path string) (entities.IterationCompletionPolicy, error) {
+	policy := entities.DefaultIterationCompletionPolicy()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return policy, nil
+		}
+		return nil, fmt.Errorf("failed to read policy %s: %w", path, err)
+	}
+
+	var parsed fileConfig
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse policy %s: %w", path, err)
+	}
+
+	configured := entities.IterationCompletionPolicy(parsed.IterationCompletion)
+	if err := configured.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid iteration_completion in %s: %w", path, err)
+	}
+	for check, severity := range configured {
+		policy[check] = severity
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,52 @@
+package policy_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadIterationCompletionPolicy_MissingFile(t *testing.T) {
+	loaded, err := policy.LoadIterationCompletionPolicy(filepath.Join(t.TempDir(), policy.FileName))
+	require.NoError(t, err)
+	assert.Equal(t, entities.DefaultIterationCompletionPolicy(), loaded)
+}
+
+func TestLoadIterationCompletionPolicy_Overrides(t *testing.T) {
+	path := filepath.Join(t.TempDir(), policy.FileName)
+	require.NoError(t, os.WriteFile(path, []byte(`iteration_completion:
+  not_started_acs: warning
+  missing_tasks: off
+`), 0644))
+
+	loaded, err := policy.LoadIterationCompletionPolicy(path)
+	require.NoError(t, err)
+	assert.Equal(t, entities.CheckSeverityWarning, loaded.Severity(entities.IterationCheckNotStartedACs))
+	assert.Equal(t, entities.CheckSeverityOff, loaded.Severity(entities.IterationCheckMissingTasks))
+	// Checks left out keep their default
+	assert.Equal(t, entities.CheckSeverityError, loaded.Severity(entities.IterationCheckUnfinishedTasks))
+}
+
+func TestLoadIterationCompletionPolicy_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := map[string]string{
+		"unknown check":    "iteration_completion:\n  stale_branches: error\n",
+		"unknown severity": "iteration_completion:\n  failed_acs: fatal\n",
+		"malformed yaml":   "iteration_completion: [",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name+".yaml")
+			require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+			_, err := policy.LoadIterationCompletionPolicy(path)
+			assert.Error(t, err)
+		})
+	}
+}
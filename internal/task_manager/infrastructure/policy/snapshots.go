@@ -0,0 +1,62 @@
+package policy
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"gopkg.in/yaml.v3"
+)
+
+// snapshotConfig mirrors the `backups` section of ~/.tm/config.yaml:
+//
+//	backups:
+//	  before_destructive: true
+//	  keep: 20
+//	  max_age: 720h
+type snapshotConfig struct {
+	Backups struct {
+		BeforeDestructive *bool  `yaml:"before_destructive"`
+		Keep              *int   `yaml:"keep"`
+		MaxAge            string `yaml:"max_age"`
+	} `yaml:"backups"`
+}
+
+// LoadSnapshotPolicy reads the snapshot settings, which apply to every project, from the
+// global config file. A missing file or section yields the default policy; settings the
+// file leaves out keep their default.
+func LoadSnapshotPolicy(configPath string) (entities.SnapshotPolicy, error) {
+	policy := entities.DefaultSnapshotPolicy()
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return policy, nil
+		}
+		return policy, fmt.Errorf("failed to read config %s: %w", configPath, err)
+	}
+
+	var parsed snapshotConfig
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return policy, fmt.Errorf("failed to parse config %s: %w", configPath, err)
+	}
+
+	if parsed.Backups.BeforeDestructive != nil {
+		policy.BeforeDestructive = *parsed.Backups.BeforeDestructive
+	}
+	if parsed.Backups.Keep != nil {
+		if *parsed.Backups.Keep < 0 {
+			return policy, fmt.Errorf("invalid backups.keep in %s: must not be negative", configPath)
+		}
+		policy.Keep = *parsed.Backups.Keep
+	}
+	if parsed.Backups.MaxAge != "" {
+		policy.MaxAge, err = time.ParseDuration(parsed.Backups.MaxAge)
+		if err != nil || policy.MaxAge < 0 {
+			return policy, fmt.Errorf("invalid backups.max_age in %s: expected a duration such as 720h", configPath)
+		}
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,53 @@
+package policy_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/infrastructure/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSnapshotPolicy_MissingFile(t *testing.T) {
+	loaded, err := policy.LoadSnapshotPolicy(filepath.Join(t.TempDir(), "config.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, entities.DefaultSnapshotPolicy(), loaded)
+}
+
+func TestLoadSnapshotPolicy_Overrides(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`hooks:
+  timeout: 10s
+backups:
+  before_destructive: false
+  max_age: 720h
+`), 0644))
+
+	loaded, err := policy.LoadSnapshotPolicy(path)
+	require.NoError(t, err)
+	assert.False(t, loaded.BeforeDestructive)
+	assert.Equal(t, 720*time.Hour, loaded.MaxAge)
+	// Settings left out keep their default
+	assert.Equal(t, entities.DefaultSnapshotPolicy().Keep, loaded.Keep)
+}
+
+func TestLoadSnapshotPolicy_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := map[string]string{
+		"negative keep":   "backups:\n  keep: -1\n",
+		"invalid max age": "backups:\n  max_age: a month\n",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name+".yaml")
+			require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+			_, err := policy.LoadSnapshotPolicy(path)
+			assert.Error(t, err)
+		})
+	}
+}
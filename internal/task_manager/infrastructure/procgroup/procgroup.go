@@ -0,0 +1,7 @@
+// Package procgroup runs external commands in their own process group so a timeout kills everything they started.
+package procgroup
+
+import "time"
+
+// waitDelay bounds how long Wait blocks on output pipes after the command has been killed.
+const waitDelay = time.Second
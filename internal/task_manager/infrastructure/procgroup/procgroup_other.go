@@ -0,0 +1,15 @@
+//go:build !unix
+
+package procgroup
+
+import "os/exec"
+
+// KillOnCancel makes context cancellation kill cmd. Without process groups only the
+// command itself is killed; processes it started may outlive it.
+// cmd must have been created with exec.CommandContext.
+func KillOnCancel(cmd *exec.Cmd) {
+	cmd.Cancel = func() error {
+		return cmd.Process.Kill()
+	}
+	cmd.WaitDelay = waitDelay
+}
@@ -0,0 +1,19 @@
+//go:build unix
+
+package procgroup
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// KillOnCancel puts cmd in a new process group and makes context cancellation SIGKILL the whole group,
+// so grandchildren (e.g. `sleep` under `sh -c`) don't outlive a timed-out command.
+// cmd must have been created with exec.CommandContext.
+func KillOnCancel(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	cmd.WaitDelay = waitDelay
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+)
+
+// createACRequest is the body of POST /acs
+type createACRequest struct {
+	TaskID              string `json:"task_id"`
+	Description         string `json:"description"`
+	TestingInstructions string `json:"testing_instructions"`
+	CheckCommand        string `json:"check_command"`
+}
+
+// updateACRequest is the body of PATCH /acs/{id}; omitted fields are left unchanged
+type updateACRequest struct {
+	Description         *string `json:"description"`
+	TestingInstructions *string `json:"testing_instructions"`
+	CheckCommand        *string `json:"check_command"` // Empty string removes the check
+	Version             *int    `json:"version"`       // Version the change is based on; 409 if it changed since
+}
+
+// verifyACRequest is the body of POST /acs/{id}/verify
+type verifyACRequest struct {
+	VerifiedBy string `json:"verified_by"` // Defaults to user, as in the CLI
+}
+
+// failACRequest is the body of POST /acs/{id}/fail
+type failACRequest struct {
+	Feedback string `json:"feedback"`
+}
+
+// skipACRequest is the body of POST /acs/{id}/skip
+type skipACRequest struct {
+	Reason string `json:"reason"`
+}
+
+// ============================================================================
+// Acceptance criteria
+// ============================================================================
+
+func (s *Server) listACs(r *http.Request) (*response, error) {
+	iteration, err := queryNumber(r, "iteration")
+	if err != nil {
+		return nil, err
+	}
+
+	var acs []*entities.AcceptanceCriteriaEntity
+	switch taskID := r.URL.Query().Get("task"); {
+	case taskID != "":
+		acs, err = s.acService.ListAC(r.Context(), taskID)
+	case iteration != nil:
+		acs, err = s.acService.ListACByIteration(r.Context(), *iteration)
+	default:
+		return nil, fmt.Errorf("%w: task or iteration is required", tmerrors.ErrInvalidArgument)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list acceptance criteria: %w", err)
+	}
+
+	if statuses := queryList(r, "status"); len(statuses) > 0 {
+		wanted := make(map[string]bool, len(statuses))
+		for _, status := range statuses {
+			wanted[status] = true
+		}
+		filtered := make([]*entities.AcceptanceCriteriaEntity, 0, len(acs))
+		for _, ac := range acs {
+			if wanted[string(ac.Status)] {
+				filtered = append(filtered, ac)
+			}
+		}
+		acs = filtered
+	}
+	return ok("ac_list", acs)
+}
+
+func (s *Server) createAC(r *http.Request) (*response, error) {
+	var req createACRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	ac, err := s.acService.CreateAC(r.Context(), dto.CreateACDTO{
+		TaskID:              req.TaskID,
+		Description:         req.Description,
+		TestingInstructions: req.TestingInstructions,
+		CheckCommand:        req.CheckCommand,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add acceptance criterion: %w", err)
+	}
+	return created("ac", ac)
+}
+
+func (s *Server) getAC(r *http.Request) (*response, error) {
+	return s.reloadAC(r, r.PathValue("id"))
+}
+
+func (s *Server) updateAC(r *http.Request) (*response, error) {
+	var req updateACRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	ac, err := s.acService.UpdateAC(r.Context(), dto.UpdateACDTO{
+		ID:                  r.PathValue("id"),
+		Description:         req.Description,
+		TestingInstructions: req.TestingInstructions,
+		CheckCommand:        req.CheckCommand,
+		Version:             req.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update acceptance criterion: %w", err)
+	}
+	return ok("ac", ac)
+}
+
+func (s *Server) deleteAC(r *http.Request) (*response, error) {
+	if err := s.acService.DeleteAC(r.Context(), r.PathValue("id")); err != nil {
+		return nil, fmt.Errorf("failed to delete acceptance criterion: %w", err)
+	}
+	return noContent()
+}
+
+func (s *Server) verifyAC(r *http.Request) (*response, error) {
+	var req verifyACRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	if req.VerifiedBy == "" {
+		req.VerifiedBy = "user"
+	}
+	acID := r.PathValue("id")
+	if err := s.acService.VerifyAC(r.Context(), dto.VerifyACDTO{ID: acID, VerifiedBy: req.VerifiedBy, VerifiedAt: "now"}); err != nil {
+		return nil, fmt.Errorf("failed to verify acceptance criterion: %w", err)
+	}
+	return s.reloadAC(r, acID)
+}
+
+func (s *Server) failAC(r *http.Request) (*response, error) {
+	var req failACRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	acID := r.PathValue("id")
+	if err := s.acService.FailAC(r.Context(), dto.FailACDTO{ID: acID, Feedback: req.Feedback}); err != nil {
+		return nil, fmt.Errorf("failed to mark acceptance criterion as failed: %w", err)
+	}
+	return s.reloadAC(r, acID)
+}
+
+func (s *Server) skipAC(r *http.Request) (*response, error) {
+	var req skipACRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	acID := r.PathValue("id")
+	if err := s.acService.SkipAC(r.Context(), dto.SkipACDTO{ID: acID, Reason: req.Reason}); err != nil {
+		return nil, fmt.Errorf("failed to skip acceptance criterion: %w", err)
+	}
+	return s.reloadAC(r, acID)
+}
+
+// reloadAC returns an acceptance criterion read from storage
+func (s *Server) reloadAC(r *http.Request, acID string) (*response, error) {
+	ac, err := s.acService.GetAC(r.Context(), acID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get acceptance criterion: %w", err)
+	}
+	return ok("ac", ac)
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// createADRRequest is the body of POST /adrs
+type createADRRequest struct {
+	TrackID      string `json:"track_id"`
+	Title        string `json:"title"`
+	Context      string `json:"context"`
+	Decision     string `json:"decision"`
+	Consequences string `json:"consequences"`
+	Alternatives string `json:"alternatives"`
+	Status       string `json:"status"` // Defaults to proposed
+}
+
+// updateADRRequest is the body of PATCH /adrs/{id}; omitted fields are left unchanged
+type updateADRRequest struct {
+	Title        *string `json:"title"`
+	Context      *string `json:"context"`
+	Decision     *string `json:"decision"`
+	Consequences *string `json:"consequences"`
+	Alternatives *string `json:"alternatives"`
+	Status       *string `json:"status"`
+}
+
+// supersedeADRRequest is the body of POST /adrs/{id}/supersede
+type supersedeADRRequest struct {
+	SupersededBy string `json:"superseded_by"`
+}
+
+// ============================================================================
+// ADRs
+// ============================================================================
+
+func (s *Server) listADRs(r *http.Request) (*response, error) {
+	adrs, err := s.adrService.ListADRs(r.Context(), queryString(r, "track"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ADRs: %w", err)
+	}
+	return ok("adr_list", adrs)
+}
+
+func (s *Server) createADR(r *http.Request) (*response, error) {
+	var req createADRRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	input := dto.CreateADRDTO{
+		TrackID:      req.TrackID,
+		Title:        req.Title,
+		Context:      req.Context,
+		Decision:     req.Decision,
+		Consequences: req.Consequences,
+		Alternatives: req.Alternatives,
+		Status:       req.Status,
+	}
+	if input.Status == "" {
+		input.Status = string(entities.ADRStatusProposed)
+	}
+	adr, err := s.adrService.CreateADR(r.Context(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ADR: %w", err)
+	}
+	return created("adr", adr)
+}
+
+func (s *Server) getADR(r *http.Request) (*response, error) {
+	return s.reloadADR(r, r.PathValue("id"))
+}
+
+func (s *Server) updateADR(r *http.Request) (*response, error) {
+	var req updateADRRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	adr, err := s.adrService.UpdateADR(r.Context(), dto.UpdateADRDTO{
+		ID:           r.PathValue("id"),
+		Title:        req.Title,
+		Context:      req.Context,
+		Decision:     req.Decision,
+		Consequences: req.Consequences,
+		Alternatives: req.Alternatives,
+		Status:       req.Status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ADR: %w", err)
+	}
+	return ok("adr", adr)
+}
+
+func (s *Server) supersedeADR(r *http.Request) (*response, error) {
+	var req supersedeADRRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	adrID := r.PathValue("id")
+	if err := s.adrService.SupersedeADR(r.Context(), adrID, req.SupersededBy); err != nil {
+		return nil, fmt.Errorf("failed to supersede ADR: %w", err)
+	}
+	return s.reloadADR(r, adrID)
+}
+
+func (s *Server) deprecateADR(r *http.Request) (*response, error) {
+	adrID := r.PathValue("id")
+	if err := s.adrService.DeprecateADR(r.Context(), adrID); err != nil {
+		return nil, fmt.Errorf("failed to deprecate ADR: %w", err)
+	}
+	return s.reloadADR(r, adrID)
+}
+
+// reloadADR returns an ADR read from storage
+func (s *Server) reloadADR(r *http.Request, adrID string) (*response, error) {
+	adr, err := s.adrService.GetADR(r.Context(), adrID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ADR: %w", err)
+	}
+	return ok("adr", adr)
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// DefaultAddr is the address tm serve listens on without --addr
+const DefaultAddr = "127.0.0.1:8080"
+
+// TokenEnvVar sets the API token when --token is not given
+const TokenEnvVar = "TM_API_TOKEN"
+
+// shutdownTimeout bounds how long in-flight requests may run after an interrupt
+const shutdownTimeout = 5 * time.Second
+
+// Connection timeouts. Requests are handled one at a time, so a client that stops
+// reading or writing must not keep the server from answering the others.
+const (
+	readHeaderTimeout = 10 * time.Second
+	readTimeout       = 30 * time.Second
+	writeTimeout      = 60 * time.Second
+	idleTimeout       = 2 * time.Minute
+)
+
+// NewServeCommand creates the serve command running the API server until interrupted
+func NewServeCommand(server *Server) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the project as a local REST/JSON API",
+		Long: `Serves the active project as a REST/JSON API under ` + BasePath + `, for dashboards and
+editor plugins that would otherwise shell out to tm.
+
+Tracks, tasks, iterations, acceptance criteria, ADRs and documents can be listed,
+created, read, updated and deleted, and iterations and acceptance criteria have
+endpoints for their transitions (start, complete, verify, fail, skip). Responses
+are the envelopes of 'tm -o json', with the same kinds and fields. The OpenAPI
+description is served at ` + BasePath + `/openapi.json.
+
+Every request must carry the token printed at startup as "Authorization: Bearer
+<token>"; a new token is generated each time unless --token (or TM_API_TOKEN)
+sets one. Request bodies must be sent as Content-Type: application/json and are
+limited to 1 MiB, and on a loopback address only requests for localhost or a
+loopback IP are accepted, so web pages in a browser cannot use the API. Requests
+are handled one at a time. Stop the server with Ctrl+C.`,
+		Example: `  # Serve on the default address
+  tm serve
+
+  # Serve on another port with a fixed token, then list the ready tasks
+  tm serve --addr 127.0.0.1:9000 --token s3cret
+  curl -H 'Authorization: Bearer s3cret' 'http://127.0.0.1:9000/api/v1/tasks?ready=true'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, _ := cmd.Flags().GetString("addr")
+			token, _ := cmd.Flags().GetString("token")
+			if token == "" {
+				token = os.Getenv(TokenEnvVar)
+			}
+			if token == "" {
+				generated, err := newToken()
+				if err != nil {
+					return err
+				}
+				token = generated
+			}
+			server.RequireToken(token)
+
+			listener, err := net.Listen("tcp", addr)
+			if err != nil {
+				return fmt.Errorf("failed to listen on %s: %w", addr, err)
+			}
+			if !isLoopback(listener.Addr()) {
+				// Clients on other machines use this machine's name, not a loopback one
+				server.anyHost = true
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s is reachable from other machines over plain HTTP; anyone with the token can change the project\n", listener.Addr())
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			httpServer := &http.Server{
+				Handler:           server,
+				ReadHeaderTimeout: readHeaderTimeout,
+				ReadTimeout:       readTimeout,
+				WriteTimeout:      writeTimeout,
+				IdleTimeout:       idleTimeout,
+			}
+			errs := make(chan error, 1)
+			go func() {
+				errs <- httpServer.Serve(listener)
+			}()
+
+			url := fmt.Sprintf("http://%s%s", listener.Addr(), BasePath)
+			fmt.Fprintf(cmd.OutOrStdout(), "Serving tm API on %s\n", url)
+			fmt.Fprintf(cmd.OutOrStdout(), "OpenAPI description: %s/openapi.json\n", url)
+			fmt.Fprintf(cmd.OutOrStdout(), "Token: %s (send as 'Authorization: Bearer <token>')\n", token)
+
+			select {
+			case err := <-errs:
+				return fmt.Errorf("server stopped: %w", err)
+			case <-ctx.Done():
+			}
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+			if err := httpServer.Shutdown(shutdownCtx); err != nil {
+				return fmt.Errorf("failed to shut down server: %w", err)
+			}
+			if err := <-errs; err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return fmt.Errorf("server stopped: %w", err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Server stopped\n")
+			return nil
+		},
+	}
+
+	cmd.Flags().String("addr", DefaultAddr, "Address to listen on (host:port)")
+	cmd.Flags().String("token", "", "API token clients must send (default: $"+TokenEnvVar+", else a new random token)")
+
+	return cmd
+}
+
+// isLoopback returns true if a listener only accepts local connections
+func isLoopback(addr net.Addr) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	return ok && tcpAddr.IP.IsLoopback()
+}
+
+// newToken returns a random API token
+func newToken() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate API token: %w", err)
+	}
+	return hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+)
+
+// createDocumentRequest is the body of POST /documents
+type createDocumentRequest struct {
+	Title           string  `json:"title"`
+	Type            string  `json:"type"`
+	Status          string  `json:"status"` // Defaults to draft
+	Content         string  `json:"content"`
+	TrackID         *string `json:"track_id"`
+	IterationNumber *int    `json:"iteration_number"`
+}
+
+// updateDocumentRequest is the body of PATCH /documents/{id}; omitted fields are left unchanged
+type updateDocumentRequest struct {
+	Content         *string `json:"content"`
+	Status          *string `json:"status"`
+	TrackID         *string `json:"track_id"`
+	IterationNumber *int    `json:"iteration_number"`
+	Detach          bool    `json:"detach"`  // Remove the track or iteration attachment
+	Version         *int    `json:"version"` // Version the change is based on; 409 if it changed since
+}
+
+// ============================================================================
+// Documents
+// ============================================================================
+
+func (s *Server) listDocuments(r *http.Request) (*response, error) {
+	iteration, err := queryNumber(r, "iteration")
+	if err != nil {
+		return nil, err
+	}
+	docs, err := s.documentService.ListDocuments(r.Context(), queryString(r, "track"), iteration, queryString(r, "type"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+	return ok("document_list", docs)
+}
+
+func (s *Server) createDocument(r *http.Request) (*response, error) {
+	var req createDocumentRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	input := dto.CreateDocumentDTO{
+		Title:           req.Title,
+		Type:            req.Type,
+		Status:          req.Status,
+		Content:         req.Content,
+		TrackID:         req.TrackID,
+		IterationNumber: req.IterationNumber,
+	}
+	if input.Status == "" {
+		input.Status = "draft"
+	}
+	id, err := s.documentService.CreateDocument(r.Context(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create document: %w", err)
+	}
+	resp, err := s.reloadDocument(r, id)
+	if err != nil {
+		return nil, err
+	}
+	resp.status = http.StatusCreated
+	return resp, nil
+}
+
+func (s *Server) getDocument(r *http.Request) (*response, error) {
+	return s.reloadDocument(r, r.PathValue("id"))
+}
+
+func (s *Server) updateDocument(r *http.Request) (*response, error) {
+	var req updateDocumentRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	docID := r.PathValue("id")
+	err := s.documentService.UpdateDocument(r.Context(), dto.UpdateDocumentDTO{
+		ID:              docID,
+		Content:         req.Content,
+		Status:          req.Status,
+		TrackID:         req.TrackID,
+		IterationNumber: req.IterationNumber,
+		Detach:          req.Detach,
+		Version:         req.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update document: %w", err)
+	}
+	return s.reloadDocument(r, docID)
+}
+
+func (s *Server) deleteDocument(r *http.Request) (*response, error) {
+	if _, err := s.documentService.DeleteDocument(r.Context(), r.PathValue("id")); err != nil {
+		return nil, fmt.Errorf("failed to delete document: %w", err)
+	}
+	return noContent()
+}
+
+// reloadDocument returns a document read from storage
+func (s *Server) reloadDocument(r *http.Request, docID string) (*response, error) {
+	doc, err := s.documentService.GetDocument(r.Context(), docID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+	return ok("document", doc)
+}
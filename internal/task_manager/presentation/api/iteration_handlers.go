@@ -0,0 +1,217 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+)
+
+// createIterationRequest is the body of POST /iterations
+type createIterationRequest struct {
+	Name        string   `json:"name"`
+	Goal        string   `json:"goal"`
+	Deliverable string   `json:"deliverable"`
+	Capacity    *float64 `json:"capacity"`
+}
+
+// updateIterationRequest is the body of PATCH /iterations/{number}; omitted fields are left unchanged
+type updateIterationRequest struct {
+	Name        *string  `json:"name"`
+	Goal        *string  `json:"goal"`
+	Deliverable *string  `json:"deliverable"`
+	Capacity    *float64 `json:"capacity"` // Zero clears the capacity
+	Version     *int     `json:"version"`  // Version the change is based on; 409 if it changed since
+}
+
+// completeIterationRequest is the body of POST /iterations/{number}/complete
+type completeIterationRequest struct {
+	Force bool `json:"force"` // Complete even if the completion policy is not met
+}
+
+// iterationTasksRequest is the body of POST /iterations/{number}/tasks
+type iterationTasksRequest struct {
+	TaskIDs []string `json:"task_ids"`
+}
+
+// ============================================================================
+// Iterations
+// ============================================================================
+
+func (s *Server) listIterations(r *http.Request) (*response, error) {
+	iterations, err := s.iterationService.ListIterations(r.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list iterations: %w", err)
+	}
+	return ok("iteration_list", iterations)
+}
+
+func (s *Server) createIteration(r *http.Request) (*response, error) {
+	var req createIterationRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	iteration, err := s.iterationService.CreateIteration(r.Context(), dto.CreateIterationDTO{
+		Name:        req.Name,
+		Goal:        req.Goal,
+		Deliverable: req.Deliverable,
+		Capacity:    req.Capacity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create iteration: %w", err)
+	}
+	resp, err := s.reloadIteration(r, iteration.Number)
+	if err != nil {
+		return nil, err
+	}
+	resp.status = http.StatusCreated
+	return resp, nil
+}
+
+func (s *Server) getCurrentIteration(r *http.Request) (*response, error) {
+	result, err := s.iterationService.GetCurrentIteration(r.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current iteration: %w", err)
+	}
+	if result.Iteration == nil {
+		return ok("iteration", &cli.IterationDetailOutput{Message: result.FallbackMsg})
+	}
+	iteration, isEntity := result.Iteration.(*entities.IterationEntity)
+	if !isEntity {
+		return nil, fmt.Errorf("%w: unexpected iteration type", tmerrors.ErrInternal)
+	}
+
+	resp, err := s.iterationDetail(r, iteration)
+	if err != nil {
+		return nil, err
+	}
+	output := resp.data.(*cli.IterationDetailOutput)
+	output.IsFallback = result.IsFallback
+	if result.IsFallback {
+		output.Message = result.FallbackMsg
+	}
+	return resp, nil
+}
+
+func (s *Server) getIteration(r *http.Request) (*response, error) {
+	number, err := pathNumber(r, "number")
+	if err != nil {
+		return nil, err
+	}
+	return s.reloadIteration(r, number)
+}
+
+func (s *Server) updateIteration(r *http.Request) (*response, error) {
+	number, err := pathNumber(r, "number")
+	if err != nil {
+		return nil, err
+	}
+	var req updateIterationRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	iteration, err := s.iterationService.UpdateIteration(r.Context(), dto.UpdateIterationDTO{
+		Number:      number,
+		Name:        req.Name,
+		Goal:        req.Goal,
+		Deliverable: req.Deliverable,
+		Capacity:    req.Capacity,
+		Version:     req.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update iteration: %w", err)
+	}
+	return s.iterationDetail(r, iteration)
+}
+
+func (s *Server) deleteIteration(r *http.Request) (*response, error) {
+	number, err := pathNumber(r, "number")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.iterationService.DeleteIteration(r.Context(), number); err != nil {
+		return nil, fmt.Errorf("failed to delete iteration: %w", err)
+	}
+	return noContent()
+}
+
+func (s *Server) startIteration(r *http.Request) (*response, error) {
+	number, err := pathNumber(r, "number")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.iterationService.StartIteration(r.Context(), number); err != nil {
+		return nil, fmt.Errorf("failed to start iteration: %w", err)
+	}
+	return s.reloadIteration(r, number)
+}
+
+func (s *Server) completeIteration(r *http.Request) (*response, error) {
+	number, err := pathNumber(r, "number")
+	if err != nil {
+		return nil, err
+	}
+	var req completeIterationRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.iterationService.CompleteIteration(r.Context(), number, req.Force); err != nil {
+		return nil, fmt.Errorf("failed to complete iteration: %w", err)
+	}
+	return s.reloadIteration(r, number)
+}
+
+func (s *Server) addIterationTasks(r *http.Request) (*response, error) {
+	number, err := pathNumber(r, "number")
+	if err != nil {
+		return nil, err
+	}
+	var req iterationTasksRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	if len(req.TaskIDs) == 0 {
+		return nil, fmt.Errorf("%w: task_ids is required", tmerrors.ErrInvalidArgument)
+	}
+	if err := s.iterationService.AddTasks(r.Context(), number, req.TaskIDs); err != nil {
+		return nil, fmt.Errorf("failed to add tasks to iteration: %w", err)
+	}
+	return s.reloadIteration(r, number)
+}
+
+func (s *Server) removeIterationTask(r *http.Request) (*response, error) {
+	number, err := pathNumber(r, "number")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.iterationService.RemoveTask(r.Context(), number, r.PathValue("taskID")); err != nil {
+		return nil, fmt.Errorf("failed to remove task from iteration: %w", err)
+	}
+	return s.reloadIteration(r, number)
+}
+
+// reloadIteration returns the detail of an iteration read from storage
+func (s *Server) reloadIteration(r *http.Request, number int) (*response, error) {
+	iteration, err := s.iterationService.GetIteration(r.Context(), number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iteration: %w", err)
+	}
+	return s.iterationDetail(r, iteration)
+}
+
+// iterationDetail returns an iteration with its tasks, capacity and documents,
+// the shape of `tm iteration show -o json`
+func (s *Server) iterationDetail(r *http.Request, iteration *entities.IterationEntity) (*response, error) {
+	tasks, err := s.iterationService.GetIterationTasks(r.Context(), iteration.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iteration tasks: %w", err)
+	}
+	docs, err := s.documentService.ListDocuments(r.Context(), nil, &iteration.Number, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list iteration documents: %w", err)
+	}
+	return ok("iteration", cli.NewIterationDetailOutput(iteration, tasks, docs))
+}
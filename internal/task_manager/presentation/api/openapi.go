@@ -0,0 +1,35 @@
+package api
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"gopkg.in/yaml.v3"
+)
+
+// openAPISpec describes every route registered by the server
+//
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+// OpenAPIDocument returns the OpenAPI description of the API as JSON
+func OpenAPIDocument() ([]byte, error) {
+	var document interface{}
+	if err := yaml.Unmarshal(openAPISpec, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI description: %w", err)
+	}
+	return json.MarshalIndent(document, "", "  ")
+}
+
+// serveOpenAPI serves the OpenAPI description; it is the only response without an envelope
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	document, err := OpenAPIDocument()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(document)
+}
@@ -0,0 +1,353 @@
+// Package api exposes the application services as a local REST/JSON API.
+package api
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+)
+
+// BasePath prefixes every API route
+const BasePath = "/api/v1"
+
+// MaxBodyBytes is the largest request body accepted; larger ones are answered with 413
+const MaxBodyBytes = 1 << 20
+
+// Server serves the REST/JSON API. Responses use the same envelopes, kinds and DTOs
+// as the CLI's structured output, so clients can share decoders with `tm -o json`.
+type Server struct {
+	roadmapService   *application.RoadmapApplicationService
+	trackService     *application.TrackApplicationService
+	taskService      *application.TaskApplicationService
+	iterationService *application.IterationApplicationService
+	acService        *application.ACApplicationService
+	adrService       *application.ADRApplicationService
+	documentService  *application.DocumentApplicationService
+
+	// token, when set, must accompany every request as "Authorization: Bearer <token>"
+	token string
+	// anyHost accepts Host headers other than loopback names, for servers on a public address
+	anyHost bool
+
+	// mu serializes requests: the services assume a single caller and SQLite a single writer
+	mu  sync.Mutex
+	mux *http.ServeMux
+}
+
+// NewServer creates an API server over the application services
+func NewServer(
+	roadmapService *application.RoadmapApplicationService,
+	trackService *application.TrackApplicationService,
+	taskService *application.TaskApplicationService,
+	iterationService *application.IterationApplicationService,
+	acService *application.ACApplicationService,
+	adrService *application.ADRApplicationService,
+	documentService *application.DocumentApplicationService,
+) *Server {
+	s := &Server{
+		roadmapService:   roadmapService,
+		trackService:     trackService,
+		taskService:      taskService,
+		iterationService: iterationService,
+		acService:        acService,
+		adrService:       adrService,
+		documentService:  documentService,
+		mux:              http.NewServeMux(),
+	}
+	s.registerRoutes()
+	return s
+}
+
+// RequireToken makes every request present the token as "Authorization: Bearer <token>".
+func (s *Server) RequireToken(token string) {
+	s.token = token
+}
+
+// ServeHTTP handles one API request at a time
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if status, err := s.authorize(r); err != nil {
+		if status == http.StatusUnauthorized {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+		}
+		writeErrorStatus(w, status, err)
+		return
+	}
+
+	// Read the body before taking the lock, so a slow or oversized upload cannot hold up other requests
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxBodyBytes))
+	if err != nil {
+		writeError(w, fmt.Errorf("%w: failed to read request body: %w", tmerrors.ErrInvalidArgument, err))
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mux.ServeHTTP(w, r)
+}
+
+// authorize rejects requests a web page could have sent: browsers let any page post
+// plain-text bodies to localhost, and a DNS-rebinding page reaches it under its own
+// host name, but neither can add the token or a JSON content type without a CORS
+// preflight, which the API never answers.
+func (s *Server) authorize(r *http.Request) (int, error) {
+	if !s.anyHost && !isLoopbackHost(r.Host) {
+		return http.StatusForbidden, fmt.Errorf("%w: host %q is not a loopback name", tmerrors.ErrRejected, r.Host)
+	}
+	if s.token != "" {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			return http.StatusUnauthorized, fmt.Errorf("%w: missing or wrong API token (printed by tm serve)", tmerrors.ErrRejected)
+		}
+	}
+	if r.ContentLength != 0 {
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || mediaType != "application/json" {
+			return http.StatusUnsupportedMediaType, fmt.Errorf("%w: request bodies must be sent as Content-Type: application/json", tmerrors.ErrInvalidArgument)
+		}
+	}
+	return 0, nil
+}
+
+// isLoopbackHost returns true if a Host header names the local machine
+func isLoopbackHost(hostport string) bool {
+	host := hostport
+	if h, _, err := net.SplitHostPort(hostport); err == nil {
+		host = h
+	}
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(host, "[]"))
+	return ip != nil && ip.IsLoopback()
+}
+
+// registerRoutes wires every endpoint; keep openapi.yaml in step with this list
+func (s *Server) registerRoutes() {
+	s.mux.HandleFunc("GET "+BasePath+"/openapi.json", serveOpenAPI)
+	s.handle("GET /roadmap", s.getRoadmap)
+
+	s.handle("GET /tracks", s.listTracks)
+	s.handle("POST /tracks", s.createTrack)
+	s.handle("GET /tracks/{id}", s.getTrack)
+	s.handle("PATCH /tracks/{id}", s.updateTrack)
+	s.handle("DELETE /tracks/{id}", s.deleteTrack)
+	s.handle("POST /tracks/{id}/dependencies", s.addTrackDependency)
+	s.handle("DELETE /tracks/{id}/dependencies/{dependsOn}", s.removeTrackDependency)
+
+	s.handle("GET /tasks", s.listTasks)
+	s.handle("POST /tasks", s.createTask)
+	s.handle("GET /tasks/{id}", s.getTask)
+	s.handle("PATCH /tasks/{id}", s.updateTask)
+	s.handle("DELETE /tasks/{id}", s.deleteTask)
+
+	s.handle("GET /iterations", s.listIterations)
+	s.handle("POST /iterations", s.createIteration)
+	s.handle("GET /iterations/current", s.getCurrentIteration)
+	s.handle("GET /iterations/{number}", s.getIteration)
+	s.handle("PATCH /iterations/{number}", s.updateIteration)
+	s.handle("DELETE /iterations/{number}", s.deleteIteration)
+	s.handle("POST /iterations/{number}/start", s.startIteration)
+	s.handle("POST /iterations/{number}/complete", s.completeIteration)
+	s.handle("POST /iterations/{number}/tasks", s.addIterationTasks)
+	s.handle("DELETE /iterations/{number}/tasks/{taskID}", s.removeIterationTask)
+
+	s.handle("GET /acs", s.listACs)
+	s.handle("POST /acs", s.createAC)
+	s.handle("GET /acs/{id}", s.getAC)
+	s.handle("PATCH /acs/{id}", s.updateAC)
+	s.handle("DELETE /acs/{id}", s.deleteAC)
+	s.handle("POST /acs/{id}/verify", s.verifyAC)
+	s.handle("POST /acs/{id}/fail", s.failAC)
+	s.handle("POST /acs/{id}/skip", s.skipAC)
+
+	s.handle("GET /adrs", s.listADRs)
+	s.handle("POST /adrs", s.createADR)
+	s.handle("GET /adrs/{id}", s.getADR)
+	s.handle("PATCH /adrs/{id}", s.updateADR)
+	s.handle("POST /adrs/{id}/supersede", s.supersedeADR)
+	s.handle("POST /adrs/{id}/deprecate", s.deprecateADR)
+
+	s.handle("GET /documents", s.listDocuments)
+	s.handle("POST /documents", s.createDocument)
+	s.handle("GET /documents/{id}", s.getDocument)
+	s.handle("PATCH /documents/{id}", s.updateDocument)
+	s.handle("DELETE /documents/{id}", s.deleteDocument)
+}
+
+// ============================================================================
+// Responses
+// ============================================================================
+
+// response is a successful handler result. A response without a kind has no body.
+type response struct {
+	status int
+	kind   string
+	data   interface{}
+}
+
+// handlerFunc handles a request and returns its response or the error to report
+type handlerFunc func(r *http.Request) (*response, error)
+
+// handle registers a handler for "METHOD /path" under BasePath
+func (s *Server) handle(route string, handler handlerFunc) {
+	method, path, _ := strings.Cut(route, " ")
+	s.mux.HandleFunc(method+" "+BasePath+path, func(w http.ResponseWriter, r *http.Request) {
+		resp, err := handler(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if resp.kind == "" {
+			w.WriteHeader(resp.status)
+			return
+		}
+		writeEnvelope(w, resp.status, cli.Envelope{
+			SchemaVersion: cli.OutputSchemaVersion,
+			Kind:          resp.kind,
+			Data:          emptyList(resp.data),
+		})
+	})
+}
+
+// ok returns a 200 response of the given kind
+func ok(kind string, data interface{}) (*response, error) {
+	return &response{status: http.StatusOK, kind: kind, data: data}, nil
+}
+
+// created returns a 201 response of the given kind
+func created(kind string, data interface{}) (*response, error) {
+	return &response{status: http.StatusCreated, kind: kind, data: data}, nil
+}
+
+// noContent returns an empty 204 response
+func noContent() (*response, error) {
+	return &response{status: http.StatusNoContent}, nil
+}
+
+// writeError reports an error envelope with the HTTP status matching its error code.
+// A body over MaxBodyBytes is an invalid argument reported as 413.
+func writeError(w http.ResponseWriter, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		writeErrorStatus(w, http.StatusRequestEntityTooLarge, err)
+		return
+	}
+	writeErrorStatus(w, StatusCode(cli.ErrorCode(err)), err)
+}
+
+// writeErrorStatus reports an error envelope with the given HTTP status
+func writeErrorStatus(w http.ResponseWriter, status int, err error) {
+	writeEnvelope(w, status, cli.Envelope{
+		SchemaVersion: cli.OutputSchemaVersion,
+		Kind:          "error",
+		Error: &cli.ErrorPayload{
+			Code:    cli.ErrorCode(err),
+			Message: err.Error(),
+		},
+	})
+}
+
+// StatusCode maps a structured error code to its HTTP status
+func StatusCode(code string) int {
+	switch code {
+	case cli.ErrorCodeNotFound:
+		return http.StatusNotFound
+	case cli.ErrorCodeInvalidArgument:
+		return http.StatusBadRequest
+	case cli.ErrorCodeAlreadyExists, cli.ErrorCodeConflict:
+		return http.StatusConflict
+	case cli.ErrorCodeRejected:
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeEnvelope encodes an envelope as the JSON body of the response
+func writeEnvelope(w http.ResponseWriter, status int, envelope cli.Envelope) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(envelope)
+}
+
+// emptyList turns nil slices into empty ones so clients can iterate unconditionally
+func emptyList(data interface{}) interface{} {
+	if v := reflect.ValueOf(data); v.Kind() == reflect.Slice && v.IsNil() {
+		return []interface{}{}
+	}
+	return data
+}
+
+// ============================================================================
+// Requests
+// ============================================================================
+
+// decodeBody decodes the JSON request body into v. An empty body leaves v unchanged.
+func decodeBody(r *http.Request, v interface{}) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: invalid request body: %v", tmerrors.ErrInvalidArgument, err)
+	}
+	return nil
+}
+
+// pathNumber parses an integer path parameter
+func pathNumber(r *http.Request, name string) (int, error) {
+	value := r.PathValue(name)
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s must be a number, got %q", tmerrors.ErrInvalidArgument, name, value)
+	}
+	return number, nil
+}
+
+// queryNumber parses an optional integer query parameter; it returns nil when absent
+func queryNumber(r *http.Request, name string) (*int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s must be a number, got %q", tmerrors.ErrInvalidArgument, name, value)
+	}
+	return &number, nil
+}
+
+// queryString returns an optional query parameter; it returns nil when absent
+func queryString(r *http.Request, name string) *string {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// queryList returns a list query parameter, given repeated or comma-separated
+func queryList(r *http.Request, name string) []string {
+	var values []string
+	for _, value := range r.URL.Query()[name] {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+	return values
+}
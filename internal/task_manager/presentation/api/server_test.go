@@ -0,0 +1,254 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/api"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// apiEnvelope is a decoded response envelope with its data left raw
+type apiEnvelope struct {
+	SchemaVersion string            `json:"schema_version"`
+	Kind          string            `json:"kind"`
+	Data          json.RawMessage   `json:"data"`
+	Error         *cli.ErrorPayload `json:"error"`
+}
+
+// setupServerTest returns a server whose task service holds TM-task-1 on TM-track-1
+func setupServerTest(t *testing.T) (*api.Server, *mocks.MockTaskRepository) {
+	t.Helper()
+	now := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+
+	trackRepo := mocks.NewMockTrackRepository()
+	track, err := entities.NewTrackEntity("TM-track-1", "roadmap-1", "Core", "", "in-progress", 100, nil, now, now)
+	require.NoError(t, err)
+	require.NoError(t, trackRepo.SaveTrack(context.Background(), track))
+
+	taskRepo := mocks.NewMockTaskRepository()
+	task, err := entities.NewTaskEntity("TM-task-1", track.ID, "Model", "", "todo", 100, "", now, now)
+	require.NoError(t, err)
+	require.NoError(t, taskRepo.SaveTask(context.Background(), task))
+	taskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		if id != task.ID {
+			return nil, fmt.Errorf("%w: task %s not found", tmerrors.ErrNotFound, id)
+		}
+		return task, nil
+	}
+
+	taskService := application.NewTaskApplicationService(taskRepo, trackRepo, &mocks.MockAggregateRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, services.NewValidationService(), nil, nil, nil)
+	server := api.NewServer(nil, nil, taskService, nil, nil, nil, nil)
+	server.RequireToken(testToken)
+	return server, taskRepo
+}
+
+// testToken is the API token of the servers under test
+const testToken = "test-token"
+
+// newRequest builds a request as a well-behaved local client sends it
+func newRequest(method, path, body string) *http.Request {
+	request := httptest.NewRequest(method, api.BasePath+path, strings.NewReader(body))
+	request.Host = "127.0.0.1:8080"
+	request.Header.Set("Authorization", "Bearer "+testToken)
+	if body != "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	return request
+}
+
+// serve sends a request to the server and decodes the envelope of the response
+func serve(t *testing.T, server *api.Server, method, path, body string) (int, *apiEnvelope) {
+	t.Helper()
+	return serveRequest(t, server, newRequest(method, path, body))
+}
+
+// serveRequest sends a prepared request to the server and decodes the envelope of the response
+func serveRequest(t *testing.T, server *api.Server, request *http.Request) (int, *apiEnvelope) {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+
+	if recorder.Body.Len() == 0 {
+		return recorder.Code, nil
+	}
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	var envelope apiEnvelope
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &envelope), recorder.Body.String())
+	assert.Equal(t, cli.OutputSchemaVersion, envelope.SchemaVersion)
+	return recorder.Code, &envelope
+}
+
+// TestServer_Envelopes verifies that results use the CLI's envelopes and kinds
+func TestServer_Envelopes(t *testing.T) {
+	server, taskRepo := setupServerTest(t)
+
+	code, envelope := serve(t, server, http.MethodGet, "/tasks/TM-task-1", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "task", envelope.Kind)
+	var task entities.TaskEntity
+	require.NoError(t, json.Unmarshal(envelope.Data, &task))
+	assert.Equal(t, "Model", task.Title)
+
+	taskRepo.ListTasksFunc = func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+		assert.Equal(t, "TM-track-1", filters.TrackID)
+		assert.Equal(t, []string{"todo", "review"}, filters.Status)
+		assert.True(t, filters.Ready)
+		return nil, nil
+	}
+	code, envelope = serve(t, server, http.MethodGet, "/tasks?track=TM-track-1&status=todo,review&ready=true", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "task_list", envelope.Kind)
+	assert.JSONEq(t, "[]", string(envelope.Data), "empty lists are [] rather than null")
+
+	code, envelope = serve(t, server, http.MethodDelete, "/tasks/TM-task-1", "")
+	assert.Equal(t, http.StatusNoContent, code)
+	assert.Nil(t, envelope)
+}
+
+// TestServer_DeleteSnapshotsFirst verifies that deletes over the API are snapshotted like the CLI's
+func TestServer_DeleteSnapshotsFirst(t *testing.T) {
+	var reasons []string
+	snapshotRepo := &mocks.MockSnapshotRepository{
+		CreateSnapshotFunc: func(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error) {
+			reasons = append(reasons, reason)
+			return &entities.ProjectSnapshot{ID: projectName + "/" + reason, Project: projectName, Reason: reason}, nil
+		},
+	}
+	snapshotService := application.NewSnapshotApplicationService(snapshotRepo, entities.DefaultSnapshotPolicy(), "alpha")
+	taskRepo := &mocks.MockTaskRepository{
+		GetTaskFunc: func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+			return &entities.TaskEntity{ID: id}, nil
+		},
+		DeleteTaskFunc: func(ctx context.Context, id string) error {
+			assert.Equal(t, []string{entities.SnapshotReasonTaskDelete}, reasons, "the snapshot should be taken before the task is deleted")
+			return nil
+		},
+	}
+	taskService := application.NewTaskApplicationService(taskRepo, nil, nil, &mocks.MockAcceptanceCriteriaRepository{}, nil, nil, nil, snapshotService)
+	server := api.NewServer(nil, nil, taskService, nil, nil, nil, nil)
+
+	code, _ := serve(t, server, http.MethodDelete, "/tasks/TM-task-1", "")
+	assert.Equal(t, http.StatusNoContent, code)
+	assert.Equal(t, []string{entities.SnapshotReasonTaskDelete}, reasons)
+}
+
+// TestServer_Errors verifies that errors are reported as error envelopes with matching statuses
+func TestServer_Errors(t *testing.T) {
+	server, _ := setupServerTest(t)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status int
+		code   string
+	}{
+		{"unknown task", http.MethodGet, "/tasks/TM-task-9", "", http.StatusNotFound, cli.ErrorCodeNotFound},
+		{"malformed body", http.MethodPatch, "/tasks/TM-task-1", "{", http.StatusBadRequest, cli.ErrorCodeInvalidArgument},
+		{"unknown field", http.MethodPatch, "/tasks/TM-task-1", `{"titel":"x"}`, http.StatusBadRequest, cli.ErrorCodeInvalidArgument},
+		{"invalid update", http.MethodPatch, "/tasks/TM-task-1", `{"status":"sleeping"}`, http.StatusBadRequest, cli.ErrorCodeInvalidArgument},
+		{"stale version", http.MethodPatch, "/tasks/TM-task-1", `{"title":"x","version":5}`, http.StatusConflict, cli.ErrorCodeConflict},
+		{"bad query", http.MethodGet, "/tasks?ready=maybe", "", http.StatusBadRequest, cli.ErrorCodeInvalidArgument},
+		{"bad iteration number", http.MethodGet, "/iterations/first", "", http.StatusBadRequest, cli.ErrorCodeInvalidArgument},
+		{"oversized body", http.MethodPatch, "/tasks/TM-task-1", `{"title":"` + strings.Repeat("x", api.MaxBodyBytes) + `"}`, http.StatusRequestEntityTooLarge, cli.ErrorCodeInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, envelope := serve(t, server, tt.method, tt.path, tt.body)
+			assert.Equal(t, tt.status, code)
+			require.NotNil(t, envelope)
+			assert.Equal(t, "error", envelope.Kind)
+			require.NotNil(t, envelope.Error)
+			assert.Equal(t, tt.code, envelope.Error.Code)
+			assert.NotEmpty(t, envelope.Error.Message)
+		})
+	}
+}
+
+// TestServer_RejectsBrowserRequests verifies that requests a web page could send are refused
+func TestServer_RejectsBrowserRequests(t *testing.T) {
+	server, _ := setupServerTest(t)
+
+	tests := []struct {
+		name    string
+		prepare func(r *http.Request)
+		status  int
+	}{
+		{"rebound host", func(r *http.Request) { r.Host = "evil.example:8080" }, http.StatusForbidden},
+		{"missing token", func(r *http.Request) { r.Header.Del("Authorization") }, http.StatusUnauthorized},
+		{"wrong token", func(r *http.Request) { r.Header.Set("Authorization", "Bearer guess") }, http.StatusUnauthorized},
+		{"plain-text body", func(r *http.Request) { r.Header.Set("Content-Type", "text/plain") }, http.StatusUnsupportedMediaType},
+		{"localhost", func(r *http.Request) { r.Host = "localhost:8080" }, http.StatusOK},
+		{"IPv6 loopback", func(r *http.Request) { r.Host = "[::1]:8080" }, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := newRequest(http.MethodPatch, "/tasks/TM-task-1", `{"title":"Renamed"}`)
+			tt.prepare(request)
+			code, envelope := serveRequest(t, server, request)
+			assert.Equal(t, tt.status, code)
+			require.NotNil(t, envelope)
+			if tt.status != http.StatusOK {
+				assert.Equal(t, "error", envelope.Kind)
+			}
+		})
+	}
+}
+
+// TestStatusCode verifies the HTTP status of every error code
+func TestStatusCode(t *testing.T) {
+	assert.Equal(t, http.StatusNotFound, api.StatusCode(cli.ErrorCodeNotFound))
+	assert.Equal(t, http.StatusBadRequest, api.StatusCode(cli.ErrorCodeInvalidArgument))
+	assert.Equal(t, http.StatusConflict, api.StatusCode(cli.ErrorCodeAlreadyExists))
+	assert.Equal(t, http.StatusConflict, api.StatusCode(cli.ErrorCodeConflict))
+	assert.Equal(t, http.StatusUnprocessableEntity, api.StatusCode(cli.ErrorCodeRejected))
+	assert.Equal(t, http.StatusInternalServerError, api.StatusCode(cli.ErrorCodeInternal))
+	assert.Equal(t, http.StatusInternalServerError, api.StatusCode(cli.ErrorCodeUnknown))
+}
+
+// TestServer_OpenAPI verifies that the OpenAPI description is served and describes the routes
+func TestServer_OpenAPI(t *testing.T) {
+	server, _ := setupServerTest(t)
+
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, newRequest(http.MethodGet, "/openapi.json", ""))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var document struct {
+		OpenAPI string                                `json:"openapi"`
+		Paths   map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &document))
+	assert.Equal(t, "3.0.3", document.OpenAPI)
+
+	for path, methods := range map[string][]string{
+		"/tracks":                             {"get", "post"},
+		"/tasks/{id}":                         {"get", "patch", "delete"},
+		"/iterations/{number}/start":          {"post"},
+		"/iterations/{number}/complete":       {"post"},
+		"/acs/{id}/verify":                    {"post"},
+		"/acs/{id}/fail":                      {"post"},
+		"/adrs/{id}/supersede":                {"post"},
+		"/documents/{id}":                     {"get", "patch", "delete"},
+		"/iterations/{number}/tasks/{taskID}": {"delete"},
+	} {
+		for _, method := range methods {
+			assert.Contains(t, document.Paths[path], method, "%s %s should be described", method, path)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+)
+
+// createTaskRequest is the body of POST /tasks
+type createTaskRequest struct {
+	TrackID     string   `json:"track_id"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Status      string   `json:"status"` // Defaults to todo
+	Rank        int      `json:"rank"`   // Defaults to 500
+	Branch      string   `json:"branch"`
+	Estimate    *float64 `json:"estimate"`
+}
+
+// updateTaskRequest is the body of PATCH /tasks/{id}; omitted fields are left unchanged
+type updateTaskRequest struct {
+	TrackID     *string  `json:"track_id"`
+	Title       *string  `json:"title"`
+	Description *string  `json:"description"`
+	Status      *string  `json:"status"`
+	Rank        *int     `json:"rank"`
+	Branch      *string  `json:"branch"`   // Empty string unlinks the branch
+	Estimate    *float64 `json:"estimate"` // Zero clears the estimate
+	Version     *int     `json:"version"`  // Version the change is based on; 409 if it changed since
+}
+
+// ============================================================================
+// Tasks
+// ============================================================================
+
+func (s *Server) listTasks(r *http.Request) (*response, error) {
+	filters := entities.TaskFilters{
+		TrackID: r.URL.Query().Get("track"),
+		Status:  queryList(r, "status"),
+	}
+	if value := r.URL.Query().Get("ready"); value != "" {
+		ready, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: ready must be true or false, got %q", tmerrors.ErrInvalidArgument, value)
+		}
+		filters.Ready = ready
+	}
+	tasks, err := s.taskService.ListTasks(r.Context(), filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	return ok("task_list", tasks)
+}
+
+func (s *Server) createTask(r *http.Request) (*response, error) {
+	var req createTaskRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+
+	input := dto.CreateTaskDTO{
+		TrackID:     req.TrackID,
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      req.Status,
+		Rank:        req.Rank,
+		Branch:      req.Branch,
+		Estimate:    req.Estimate,
+	}
+	if input.Status == "" {
+		input.Status = string(entities.TaskStatusTodo)
+	}
+	if input.Rank == 0 {
+		input.Rank = defaultRank
+	}
+	task, err := s.taskService.CreateTask(r.Context(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task: %w", err)
+	}
+	return created("task", task)
+}
+
+func (s *Server) getTask(r *http.Request) (*response, error) {
+	task, err := s.taskService.GetTask(r.Context(), r.PathValue("id"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	return ok("task", task)
+}
+
+func (s *Server) updateTask(r *http.Request) (*response, error) {
+	var req updateTaskRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	task, err := s.taskService.UpdateTask(r.Context(), dto.UpdateTaskDTO{
+		ID:          r.PathValue("id"),
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      req.Status,
+		Rank:        req.Rank,
+		TrackID:     req.TrackID,
+		Branch:      req.Branch,
+		Estimate:    req.Estimate,
+		Version:     req.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update task: %w", err)
+	}
+	return ok("task", task)
+}
+
+func (s *Server) deleteTask(r *http.Request) (*response, error) {
+	if _, err := s.taskService.DeleteTask(r.Context(), r.PathValue("id")); err != nil {
+		return nil, fmt.Errorf("failed to delete task: %w", err)
+	}
+	return noContent()
+}
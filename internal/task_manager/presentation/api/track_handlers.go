@@ -0,0 +1,164 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+)
+
+// defaultRank is the rank of tracks and tasks created without one, as in the CLI
+const defaultRank = 500
+
+// createTrackRequest is the body of POST /tracks
+type createTrackRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Status      string `json:"status"` // Defaults to not-started
+	Rank        int    `json:"rank"`   // Defaults to 500
+}
+
+// updateTrackRequest is the body of PATCH /tracks/{id}; omitted fields are left unchanged
+type updateTrackRequest struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Status      *string `json:"status"`
+	Rank        *int    `json:"rank"`
+}
+
+// dependencyRequest is the body of POST /tracks/{id}/dependencies
+type dependencyRequest struct {
+	DependsOn string `json:"depends_on"`
+}
+
+// ============================================================================
+// Roadmap
+// ============================================================================
+
+func (s *Server) getRoadmap(r *http.Request) (*response, error) {
+	roadmap, err := s.roadmapService.GetRoadmap(r.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+	return ok("roadmap", roadmap)
+}
+
+// ============================================================================
+// Tracks
+// ============================================================================
+
+func (s *Server) listTracks(r *http.Request) (*response, error) {
+	roadmap, err := s.trackService.GetActiveRoadmap(r.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active roadmap: %w", err)
+	}
+	tracks, err := s.trackService.ListTracks(r.Context(), roadmap.ID, entities.TrackFilters{Status: queryList(r, "status")})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracks: %w", err)
+	}
+	return ok("track_list", tracks)
+}
+
+func (s *Server) createTrack(r *http.Request) (*response, error) {
+	var req createTrackRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	roadmap, err := s.trackService.GetActiveRoadmap(r.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active roadmap: %w", err)
+	}
+
+	input := dto.CreateTrackDTO{
+		RoadmapID:   roadmap.ID,
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      req.Status,
+		Rank:        req.Rank,
+	}
+	if input.Status == "" {
+		input.Status = string(entities.TrackStatusNotStarted)
+	}
+	if input.Rank == 0 {
+		input.Rank = defaultRank
+	}
+	track, err := s.trackService.CreateTrack(r.Context(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create track: %w", err)
+	}
+	resp, err := s.trackDetail(r, track)
+	if err != nil {
+		return nil, err
+	}
+	resp.status = http.StatusCreated
+	return resp, nil
+}
+
+func (s *Server) getTrack(r *http.Request) (*response, error) {
+	return s.reloadTrack(r, r.PathValue("id"))
+}
+
+func (s *Server) updateTrack(r *http.Request) (*response, error) {
+	var req updateTrackRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	track, err := s.trackService.UpdateTrack(r.Context(), dto.UpdateTrackDTO{
+		ID:          r.PathValue("id"),
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      req.Status,
+		Rank:        req.Rank,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update track: %w", err)
+	}
+	return s.trackDetail(r, track)
+}
+
+func (s *Server) deleteTrack(r *http.Request) (*response, error) {
+	if _, err := s.trackService.DeleteTrack(r.Context(), r.PathValue("id")); err != nil {
+		return nil, fmt.Errorf("failed to delete track: %w", err)
+	}
+	return noContent()
+}
+
+func (s *Server) addTrackDependency(r *http.Request) (*response, error) {
+	var req dependencyRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	trackID := r.PathValue("id")
+	if err := s.trackService.AddDependency(r.Context(), trackID, req.DependsOn); err != nil {
+		return nil, fmt.Errorf("failed to add dependency: %w", err)
+	}
+	return s.reloadTrack(r, trackID)
+}
+
+func (s *Server) removeTrackDependency(r *http.Request) (*response, error) {
+	trackID := r.PathValue("id")
+	if err := s.trackService.RemoveDependency(r.Context(), trackID, r.PathValue("dependsOn")); err != nil {
+		return nil, fmt.Errorf("failed to remove dependency: %w", err)
+	}
+	return s.reloadTrack(r, trackID)
+}
+
+// reloadTrack returns the detail of a track read from storage
+func (s *Server) reloadTrack(r *http.Request, trackID string) (*response, error) {
+	track, err := s.trackService.GetTrack(r.Context(), trackID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get track: %w", err)
+	}
+	return s.trackDetail(r, track)
+}
+
+// trackDetail returns a track with its documents, the shape of `tm track show -o json`
+func (s *Server) trackDetail(r *http.Request, track *entities.TrackEntity) (*response, error) {
+	docs, err := s.documentService.ListDocuments(r.Context(), &track.ID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list track documents: %w", err)
+	}
+	return ok("track", &cli.TrackDetailOutput{Track: track, Documents: docs})
+}
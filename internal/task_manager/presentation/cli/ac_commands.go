@@ -3,10 +3,12 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -15,7 +17,7 @@ import (
 // ============================================================================
 
 // NewACCommands creates and returns the acceptance criteria command group with all subcommands.
-func NewACCommands(acService *application.ACApplicationService, taskService *application.TaskApplicationService) *cobra.Command {
+func NewACCommands(acService *application.ACApplicationService, taskService *application.TaskApplicationService, checkService *application.ACCheckApplicationService) *cobra.Command {
 	acCmd := &cobra.Command{
 		Use:     "ac",
 		Short:   "Manage acceptance criteria",
@@ -36,6 +38,7 @@ func NewACCommands(acService *application.ACApplicationService, taskService *app
 		newACVerifyCommand(acService),
 		newACFailCommand(acService),
 		newACSkipCommand(acService),
+		newACRunCommand(checkService),
 		newACFailedCommand(acService),
 		newACDeleteCommand(acService),
 	)
@@ -51,12 +54,18 @@ func newACAddCommand(acService *application.ACApplicationService) *cobra.Command
 	cmd := &cobra.Command{
 		Use:   "add <task-id>",
 		Short: "Add an acceptance criterion to a task",
-		Long:  `Adds an acceptance criterion to a task with description and optional testing instructions.`,
+		Long: `Adds an acceptance criterion to a task with description and optional testing instructions.
+
+With --check the AC becomes automated: 'tm ac run' executes the shell command
+and marks the AC automatically_verified on exit code 0, failed otherwise.`,
 		Example: `  # Add simple AC
   tm ac add TM-task-1 --description "User can log in"
 
   # Add AC with testing instructions
-  tm ac add TM-task-1 --description "User can log in" --testing-instructions "1. Click login\n2. Enter credentials\n3. Verify redirected"`,
+  tm ac add TM-task-1 --description "User can log in" --testing-instructions "1. Click login\n2. Enter credentials\n3. Verify redirected"
+
+  # Add an automated AC checked by 'tm ac run'
+  tm ac add TM-task-1 --description "Login handler is tested" --check "go test ./internal/auth -run TestLogin"`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -64,6 +73,7 @@ func newACAddCommand(acService *application.ACApplicationService) *cobra.Command
 
 			description, _ := cmd.Flags().GetString("description")
 			testingInstructions, _ := cmd.Flags().GetString("testing-instructions")
+			checkCommand, _ := cmd.Flags().GetString("check")
 
 			// Validate required flags
 			if description == "" {
@@ -75,6 +85,7 @@ func newACAddCommand(acService *application.ACApplicationService) *cobra.Command
 				TaskID:              taskID,
 				Description:         description,
 				TestingInstructions: testingInstructions,
+				CheckCommand:        checkCommand,
 			}
 
 			ac, err := acService.CreateAC(ctx, input)
@@ -82,6 +93,10 @@ func newACAddCommand(acService *application.ACApplicationService) *cobra.Command
 				return fmt.Errorf("failed to add acceptance criterion: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "ac", ac); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Acceptance criterion added successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:          %s\n", ac.ID)
@@ -91,6 +106,9 @@ func newACAddCommand(acService *application.ACApplicationService) *cobra.Command
 			if ac.TestingInstructions != "" {
 				fmt.Fprintf(cmd.OutOrStdout(), "  Testing:     %s\n", ac.TestingInstructions)
 			}
+			if ac.HasCheck() {
+				fmt.Fprintf(cmd.OutOrStdout(), "  Check:       %s\n", ac.CheckCommand)
+			}
 
 			return nil
 		},
@@ -98,6 +116,7 @@ func newACAddCommand(acService *application.ACApplicationService) *cobra.Command
 
 	cmd.Flags().String("description", "", "AC description (required)")
 	cmd.Flags().String("testing-instructions", "", "Step-by-step testing instructions (optional)")
+	cmd.Flags().String("check", "", "Shell command that verifies the AC when it exits 0 (optional)")
 
 	cmd.MarkFlagRequired("description")
 
@@ -126,6 +145,10 @@ func newACListCommand(acService *application.ACApplicationService) *cobra.Comman
 				return fmt.Errorf("failed to list ACs: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "ac_list", acs); ok {
+				return err
+			}
+
 			if len(acs) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No acceptance criteria found for task %s\n", taskID)
 				return nil
@@ -203,6 +226,10 @@ func newACListIterationCommand(acService *application.ACApplicationService) *cob
 				return fmt.Errorf("failed to get ACs for iteration: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "ac_list", acs); ok {
+				return err
+			}
+
 			if len(acs) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "Iteration %d has no acceptance criteria\n", iteration)
 				return nil
@@ -294,6 +321,10 @@ func newACShowCommand(acService *application.ACApplicationService) *cobra.Comman
 				return fmt.Errorf("failed to get AC: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "ac", ac); ok {
+				return err
+			}
+
 			// Display AC details
 			fmt.Fprintf(cmd.OutOrStdout(), "Acceptance Criterion Details\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "============================\n\n")
@@ -303,6 +334,9 @@ func newACShowCommand(acService *application.ACApplicationService) *cobra.Comman
 			fmt.Fprintf(cmd.OutOrStdout(), "Description: %s\n", ac.Description)
 			statusIcon := getStatusIndicator(ac.Status)
 			fmt.Fprintf(cmd.OutOrStdout(), "Status:      %s %s\n", statusIcon, ac.Status)
+			if ac.HasCheck() {
+				fmt.Fprintf(cmd.OutOrStdout(), "Check:       %s\n", ac.CheckCommand)
+			}
 
 			// Show testing instructions if present
 			if ac.TestingInstructions != "" {
@@ -320,6 +354,13 @@ func newACShowCommand(acService *application.ACApplicationService) *cobra.Comman
 				fmt.Fprintf(cmd.OutOrStdout(), "%s\n", ac.Notes)
 			}
 
+			// Show check output if AC was verified by its check
+			if ac.Status == entities.ACStatusAutomaticallyVerified && ac.Notes != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "\nCheck Result:\n")
+				fmt.Fprintf(cmd.OutOrStdout(), "-------------\n")
+				fmt.Fprintf(cmd.OutOrStdout(), "%s\n", ac.Notes)
+			}
+
 			// Show timestamps
 			fmt.Fprintf(cmd.OutOrStdout(), "\nTimestamps:\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "-----------\n")
@@ -341,7 +382,9 @@ func newACUpdateCommand(acService *application.ACApplicationService) *cobra.Comm
 	cmd := &cobra.Command{
 		Use:   "update <ac-id>",
 		Short: "Update an acceptance criterion",
-		Long:  `Updates an acceptance criterion's description or testing instructions. At least one field must be specified.`,
+		Long: `Updates an acceptance criterion's description, testing instructions or check command. At least one field must be specified.
+
+Setting --check makes the AC automated; --check "" removes the check and makes it manual again.`,
 		Example: `  # Update description
   tm ac update TM-ac-1 --description "Updated requirement"
 
@@ -349,7 +392,10 @@ func newACUpdateCommand(acService *application.ACApplicationService) *cobra.Comm
   tm ac update TM-ac-1 --testing-instructions "1. New step\n2. Another step"
 
   # Update both
-  tm ac update TM-ac-1 --description "New desc" --testing-instructions "New steps"`,
+  tm ac update TM-ac-1 --description "New desc" --testing-instructions "New steps"
+
+  # Attach a check run by 'tm ac run'
+  tm ac update TM-ac-1 --check "go test ./... -run TestLogin"`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -357,10 +403,11 @@ func newACUpdateCommand(acService *application.ACApplicationService) *cobra.Comm
 
 			descSet := cmd.Flags().Changed("description")
 			testSet := cmd.Flags().Changed("testing-instructions")
+			checkSet := cmd.Flags().Changed("check")
 
 			// Check that at least one field is being updated
-			if !descSet && !testSet {
-				return fmt.Errorf("at least one field must be specified to update (--description or --testing-instructions)")
+			if !descSet && !testSet && !checkSet {
+				return fmt.Errorf("at least one field must be specified to update (--description, --testing-instructions or --check)")
 			}
 
 			// Create DTO with only updated fields
@@ -376,13 +423,25 @@ func newACUpdateCommand(acService *application.ACApplicationService) *cobra.Comm
 				testingInstructions, _ := cmd.Flags().GetString("testing-instructions")
 				input.TestingInstructions = &testingInstructions
 			}
+			if checkSet {
+				checkCommand, _ := cmd.Flags().GetString("check")
+				input.CheckCommand = &checkCommand
+			}
 
 			// Execute via application service
-			ac, err := acService.UpdateAC(ctx, input)
+			var ac *entities.AcceptanceCriteriaEntity
+			err := tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() (err error) {
+				ac, err = acService.UpdateAC(ctx, input)
+				return err
+			})
 			if err != nil {
 				return fmt.Errorf("failed to update AC: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "ac", ac); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Acceptance criterion updated successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:          %s\n", ac.ID)
@@ -396,6 +455,13 @@ func newACUpdateCommand(acService *application.ACApplicationService) *cobra.Comm
 					fmt.Fprintf(cmd.OutOrStdout(), "  Testing Instructions: Cleared\n")
 				}
 			}
+			if checkSet {
+				if ac.HasCheck() {
+					fmt.Fprintf(cmd.OutOrStdout(), "  Check: %s\n", ac.CheckCommand)
+				} else {
+					fmt.Fprintf(cmd.OutOrStdout(), "  Check: Cleared\n")
+				}
+			}
 
 			return nil
 		},
@@ -403,6 +469,7 @@ func newACUpdateCommand(acService *application.ACApplicationService) *cobra.Comm
 
 	cmd.Flags().String("description", "", "New AC description")
 	cmd.Flags().String("testing-instructions", "", "New testing instructions")
+	cmd.Flags().String("check", "", "New check command (empty to remove)")
 
 	return cmd
 }
@@ -431,7 +498,9 @@ func newACVerifyCommand(acService *application.ACApplicationService) *cobra.Comm
 			}
 
 			// Execute via application service
-			if err := acService.VerifyAC(ctx, input); err != nil {
+			if err := tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+				return acService.VerifyAC(ctx, input)
+			}); err != nil {
 				return fmt.Errorf("failed to verify acceptance criterion: %w", err)
 			}
 
@@ -441,6 +510,10 @@ func newACVerifyCommand(acService *application.ACApplicationService) *cobra.Comm
 				return fmt.Errorf("failed to get AC: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "ac", ac); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Acceptance criterion verified successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:     %s\n", ac.ID)
@@ -483,7 +556,9 @@ func newACFailCommand(acService *application.ACApplicationService) *cobra.Comman
 			}
 
 			// Execute via application service
-			if err := acService.FailAC(ctx, input); err != nil {
+			if err := tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+				return acService.FailAC(ctx, input)
+			}); err != nil {
 				return fmt.Errorf("failed to mark acceptance criterion as failed: %w", err)
 			}
 
@@ -493,6 +568,10 @@ func newACFailCommand(acService *application.ACApplicationService) *cobra.Comman
 				return fmt.Errorf("failed to get AC: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "ac", ac); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Acceptance criterion marked as failed\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:       %s\n", ac.ID)
@@ -539,7 +618,9 @@ func newACSkipCommand(acService *application.ACApplicationService) *cobra.Comman
 			}
 
 			// Execute via application service
-			if err := acService.SkipAC(ctx, input); err != nil {
+			if err := tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+				return acService.SkipAC(ctx, input)
+			}); err != nil {
 				return fmt.Errorf("failed to skip acceptance criterion: %w", err)
 			}
 
@@ -549,6 +630,10 @@ func newACSkipCommand(acService *application.ACApplicationService) *cobra.Comman
 				return fmt.Errorf("failed to get AC: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "ac", ac); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Acceptance criterion skipped successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:     %s\n", ac.ID)
@@ -614,6 +699,10 @@ func newACFailedCommand(acService *application.ACApplicationService) *cobra.Comm
 				return fmt.Errorf("failed to list failed ACs: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "ac_list", failedACs); ok {
+				return err
+			}
+
 			if len(failedACs) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No failed acceptance criteria found")
 				if hasIteration {
@@ -674,6 +763,130 @@ func newACFailedCommand(acService *application.ACApplicationService) *cobra.Comm
 	return cmd
 }
 
+// ============================================================================
+// ac run command
+// ============================================================================
+
+// maxRunOutputLines caps how much output of a failed check is echoed in table output.
+const maxRunOutputLines = 20
+
+func newACRunCommand(checkService *application.ACCheckApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run [ac-id|task-id]",
+		Short: "Run the automated checks of acceptance criteria",
+		Long: `Executes the check command attached to acceptance criteria (see 'tm ac add --check').
+
+Checks run through 'sh -c' in the current directory with TM_AC_ID and TM_TASK_ID set.
+A check exiting 0 marks its AC automatically_verified; a non-zero exit or timeout marks it
+failed. The check output is stored in the AC notes.
+
+Given a task ID or --iteration, every automated AC is run except skipped ones;
+manual ACs are left for 'tm ac verify' even if they carry a check command.
+The command exits non-zero if any check failed or timed out, so it can gate scripts.`,
+		Example: `  # Run the check of a single AC
+  tm ac run TM-ac-1
+
+  # Run all checks of a task
+  tm ac run TM-task-1
+
+  # Run all checks in iteration 2 with a 10 minute limit per check
+  tm ac run --iteration 2 --timeout 10m`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			input := dto.RunACChecksDTO{}
+			if len(args) > 0 {
+				input.TargetID = args[0]
+			}
+			if cmd.Flags().Changed("iteration") {
+				iterationNum, _ := cmd.Flags().GetInt("iteration")
+				input.IterationNumber = &iterationNum
+			}
+			input.Timeout, _ = cmd.Flags().GetDuration("timeout")
+
+			results, err := checkService.RunChecks(ctx, input)
+			if err != nil {
+				return fmt.Errorf("failed to run checks: %w", err)
+			}
+
+			if ok, err := writeStructuredFailure(cmd, "ac_check_results", results, checksFailure(results)); ok {
+				return err
+			}
+
+			if len(results) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No acceptance criteria with checks found\n")
+				return nil
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%-20s %-24s %-6s %-10s %s\n", "AC ID", "Status", "Exit", "Duration", "Command")
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\n", strings.Repeat("-", 90))
+
+			for _, result := range results {
+				exit := fmt.Sprintf("%d", result.ExitCode)
+				if result.TimedOut {
+					exit = "timeout"
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%-20s %-24s %-6s %-10s %s\n",
+					result.ACID,
+					getStatusIndicator(entities.AcceptanceCriteriaStatus(result.Status))+" "+result.Status,
+					exit,
+					(time.Duration(result.DurationMs) * time.Millisecond).String(),
+					truncateString(result.Command, 40),
+				)
+				if result.Error != "" {
+					fmt.Fprintf(cmd.OutOrStdout(), "  %s\n", result.Error)
+				}
+				if result.Status != string(entities.ACStatusAutomaticallyVerified) {
+					for _, line := range lastLines(result.Output, maxRunOutputLines) {
+						fmt.Fprintf(cmd.OutOrStdout(), "  | %s\n", line)
+					}
+				}
+			}
+			failed := countFailedChecks(results)
+			fmt.Fprintf(cmd.OutOrStdout(), "\nTotal: %d check(s), %d passed, %d failed\n", len(results), len(results)-failed, failed)
+
+			return checksFailure(results)
+		},
+	}
+
+	cmd.Flags().Int("iteration", 0, "Run the checks of all ACs in this iteration")
+	cmd.Flags().Duration("timeout", application.DefaultACCheckTimeout, "Timeout for each check")
+
+	return cmd
+}
+
+// countFailedChecks counts the checks that did not verify their AC.
+func countFailedChecks(results []*dto.ACCheckResultDTO) int {
+	failed := 0
+	for _, result := range results {
+		if result.Status != string(entities.ACStatusAutomaticallyVerified) {
+			failed++
+		}
+	}
+	return failed
+}
+
+// checksFailure is the error that fails 'ac run' when any check did not pass, or nil.
+func checksFailure(results []*dto.ACCheckResultDTO) error {
+	if failed := countFailedChecks(results); failed > 0 {
+		return fmt.Errorf("%d of %d check(s) did not pass", failed, len(results))
+	}
+	return nil
+}
+
+// lastLines returns at most n trailing lines of output.
+func lastLines(output string, n int) []string {
+	if output == "" {
+		return nil
+	}
+	lines := strings.Split(output, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines
+}
+
 // ============================================================================
 // ac delete command
 // ============================================================================
@@ -702,6 +915,10 @@ func newACDeleteCommand(acService *application.ACApplicationService) *cobra.Comm
 				return fmt.Errorf("failed to delete AC: %w", err)
 			}
 
+			if ok, err := writeDeleted(cmd, "ac", acID); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Acceptance criterion deleted\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID: %s\n", acID)
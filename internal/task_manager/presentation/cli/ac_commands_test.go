@@ -13,7 +13,7 @@ import (
 
 // TestNewACCommands verifies that NewACCommands returns a valid Cobra command group
 func TestNewACCommands_Structure(t *testing.T) {
-	acCommands := cli.NewACCommands(nil, nil)
+	acCommands := cli.NewACCommands(nil, nil, nil)
 
 	assert.NotNil(t, acCommands, "NewACCommands should return a command group")
 	assert.Equal(t, "ac", acCommands.Name(), "command name should be 'ac'")
@@ -21,9 +21,9 @@ func TestNewACCommands_Structure(t *testing.T) {
 	assert.NotEmpty(t, acCommands.Long, "command should have long description")
 }
 
-// TestACCommands_AllSubcommands verifies all 9 subcommands are present
+// TestACCommands_AllSubcommands verifies all subcommands are present
 func TestACCommands_AllSubcommands(t *testing.T) {
-	acCommands := cli.NewACCommands(nil, nil)
+	acCommands := cli.NewACCommands(nil, nil, nil)
 
 	expectedSubcommands := []string{
 		"add",
@@ -34,6 +34,7 @@ func TestACCommands_AllSubcommands(t *testing.T) {
 		"verify",
 		"fail",
 		"failed",
+		"run",
 		"delete",
 	}
 
@@ -49,17 +50,18 @@ func TestACCommands_AllSubcommands(t *testing.T) {
 
 // TestACAddCommand_Flags verifies add command has required flags
 func TestACAddCommand_Flags(t *testing.T) {
-	acCommands := cli.NewACCommands(nil, nil)
+	acCommands := cli.NewACCommands(nil, nil, nil)
 	addCmd := findCommand(acCommands, "add")
 
 	assert.NotNil(t, addCmd, "add command should exist")
 	assert.NotNil(t, addCmd.Flags().Lookup("description"), "--description flag should exist")
 	assert.NotNil(t, addCmd.Flags().Lookup("testing-instructions"), "--testing-instructions flag should exist")
+	assert.NotNil(t, addCmd.Flags().Lookup("check"), "--check flag should exist")
 }
 
 // TestACListCommand_Arguments verifies list command requires task ID
 func TestACListCommand_Arguments(t *testing.T) {
-	acCommands := cli.NewACCommands(nil, nil)
+	acCommands := cli.NewACCommands(nil, nil, nil)
 	listCmd := findCommand(acCommands, "list")
 
 	assert.NotNil(t, listCmd, "list command should exist")
@@ -68,7 +70,7 @@ func TestACListCommand_Arguments(t *testing.T) {
 
 // TestACShowCommand_Arguments verifies show command requires AC ID
 func TestACShowCommand_Arguments(t *testing.T) {
-	acCommands := cli.NewACCommands(nil, nil)
+	acCommands := cli.NewACCommands(nil, nil, nil)
 	showCmd := findCommand(acCommands, "show")
 
 	assert.NotNil(t, showCmd, "show command should exist")
@@ -77,7 +79,7 @@ func TestACShowCommand_Arguments(t *testing.T) {
 
 // TestACUpdateCommand_Flags verifies update command has optional field flags
 func TestACUpdateCommand_Flags(t *testing.T) {
-	acCommands := cli.NewACCommands(nil, nil)
+	acCommands := cli.NewACCommands(nil, nil, nil)
 	updateCmd := findCommand(acCommands, "update")
 
 	assert.NotNil(t, updateCmd, "update command should exist")
@@ -87,7 +89,7 @@ func TestACUpdateCommand_Flags(t *testing.T) {
 
 // TestACVerifyCommand_Arguments verifies verify command requires AC ID
 func TestACVerifyCommand_Arguments(t *testing.T) {
-	acCommands := cli.NewACCommands(nil, nil)
+	acCommands := cli.NewACCommands(nil, nil, nil)
 	verifyCmd := findCommand(acCommands, "verify")
 
 	assert.NotNil(t, verifyCmd, "verify command should exist")
@@ -96,7 +98,7 @@ func TestACVerifyCommand_Arguments(t *testing.T) {
 
 // TestACFailCommand_Flags verifies fail command has required feedback flag
 func TestACFailCommand_Flags(t *testing.T) {
-	acCommands := cli.NewACCommands(nil, nil)
+	acCommands := cli.NewACCommands(nil, nil, nil)
 	failCmd := findCommand(acCommands, "fail")
 
 	assert.NotNil(t, failCmd, "fail command should exist")
@@ -105,7 +107,7 @@ func TestACFailCommand_Flags(t *testing.T) {
 
 // TestACFailedCommand_Flags verifies failed command has optional filter flags
 func TestACFailedCommand_Flags(t *testing.T) {
-	acCommands := cli.NewACCommands(nil, nil)
+	acCommands := cli.NewACCommands(nil, nil, nil)
 	failedCmd := findCommand(acCommands, "failed")
 
 	assert.NotNil(t, failedCmd, "failed command should exist")
@@ -116,16 +118,26 @@ func TestACFailedCommand_Flags(t *testing.T) {
 
 // TestACDeleteCommand_Flags verifies delete command has force flag
 func TestACDeleteCommand_Flags(t *testing.T) {
-	acCommands := cli.NewACCommands(nil, nil)
+	acCommands := cli.NewACCommands(nil, nil, nil)
 	deleteCmd := findCommand(acCommands, "delete")
 
 	assert.NotNil(t, deleteCmd, "delete command should exist")
 	assert.NotNil(t, deleteCmd.Flags().Lookup("force"), "--force flag should exist")
 }
 
+// TestACRunCommand_Flags verifies run command has iteration and timeout flags
+func TestACRunCommand_Flags(t *testing.T) {
+	acCommands := cli.NewACCommands(nil, nil, nil)
+	runCmd := findCommand(acCommands, "run")
+
+	assert.NotNil(t, runCmd, "run command should exist")
+	assert.NotNil(t, runCmd.Flags().Lookup("iteration"), "--iteration flag should exist")
+	assert.NotNil(t, runCmd.Flags().Lookup("timeout"), "--timeout flag should exist")
+}
+
 // TestACListIterationCommand_Arguments verifies list-iteration command requires iteration number
 func TestACListIterationCommand_Arguments(t *testing.T) {
-	acCommands := cli.NewACCommands(nil, nil)
+	acCommands := cli.NewACCommands(nil, nil, nil)
 	listIterCmd := findCommand(acCommands, "list-iteration")
 
 	assert.NotNil(t, listIterCmd, "list-iteration command should exist")
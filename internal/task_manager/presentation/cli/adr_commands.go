@@ -108,6 +108,10 @@ func newADRCreateCommand(adrService *application.ADRApplicationService) *cobra.C
 				return fmt.Errorf("failed to create ADR: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "adr", adr); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "ADR created successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:           %s\n", adr.ID)
@@ -165,6 +169,10 @@ func newADRListCommand(adrService *application.ADRApplicationService) *cobra.Com
 				return fmt.Errorf("failed to list ADRs: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "adr_list", adrs); ok {
+				return err
+			}
+
 			// Format output
 			if len(adrs) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No ADRs found\n")
@@ -222,6 +230,10 @@ func newADRShowCommand(adrService *application.ADRApplicationService) *cobra.Com
 				return fmt.Errorf("failed to get ADR: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "adr", adr); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Architecture Decision Record\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "============================\n\n")
@@ -331,6 +343,10 @@ func newADRUpdateCommand(adrService *application.ADRApplicationService) *cobra.C
 				return fmt.Errorf("failed to update ADR: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "adr", adr); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "ADR updated successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:           %s\n", adr.ID)
@@ -382,6 +398,12 @@ func newADRSupersedeCommand(adrService *application.ADRApplicationService) *cobr
 				return fmt.Errorf("failed to supersede ADR: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "adr", func() (interface{}, error) {
+				return adrService.GetADR(ctx, adrID)
+			}); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "ADR superseded successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  %s is now superseded by %s\n", adrID, supersededByID)
@@ -417,6 +439,12 @@ func newADRDeprecateCommand(adrService *application.ADRApplicationService) *cobr
 				return fmt.Errorf("failed to deprecate ADR: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "adr", func() (interface{}, error) {
+				return adrService.GetADR(ctx, adrID)
+			}); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "ADR deprecated successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID: %s\n", adrID)
@@ -448,6 +476,10 @@ func newADRCheckCommand(adrService *application.ADRApplicationService) *cobra.Co
 				return fmt.Errorf("failed to list ADRs: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "adr_list", adrs); ok {
+				return err
+			}
+
 			// Format output
 			if len(adrs) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No ADRs found\n")
@@ -20,7 +20,7 @@ func TestNewADRCommands(t *testing.T) {
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 
 	validationService := services.NewValidationService()
-	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService)
+	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService, nil)
 
 	cmd := cli.NewADRCommands(adrService)
 
@@ -50,7 +50,7 @@ func TestADRCreateCommand_FlagsConfigured(t *testing.T) {
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 
 	validationService := services.NewValidationService()
-	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService)
+	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService, nil)
 
 	parentCmd := cli.NewADRCommands(adrService)
 	cmd := findCommand(parentCmd, "create")
@@ -86,7 +86,7 @@ func TestADRListCommand_FlagsConfigured(t *testing.T) {
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 
 	validationService := services.NewValidationService()
-	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService)
+	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService, nil)
 
 	parentCmd := cli.NewADRCommands(adrService)
 	cmd := findCommand(parentCmd, "list")
@@ -107,7 +107,7 @@ func TestADRShowCommand_ArgumentsConfigured(t *testing.T) {
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 
 	validationService := services.NewValidationService()
-	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService)
+	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService, nil)
 
 	parentCmd := cli.NewADRCommands(adrService)
 	cmd := findCommand(parentCmd, "show")
@@ -131,7 +131,7 @@ func TestADRUpdateCommand_FlagsConfigured(t *testing.T) {
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 
 	validationService := services.NewValidationService()
-	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService)
+	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService, nil)
 
 	parentCmd := cli.NewADRCommands(adrService)
 	cmd := findCommand(parentCmd, "update")
@@ -167,7 +167,7 @@ func TestADRSupersedeCommand_FlagsConfigured(t *testing.T) {
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 
 	validationService := services.NewValidationService()
-	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService)
+	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService, nil)
 
 	parentCmd := cli.NewADRCommands(adrService)
 	cmd := findCommand(parentCmd, "supersede")
@@ -188,7 +188,7 @@ func TestADRDeprecateCommand_ArgumentsConfigured(t *testing.T) {
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 
 	validationService := services.NewValidationService()
-	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService)
+	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService, nil)
 
 	parentCmd := cli.NewADRCommands(adrService)
 	cmd := findCommand(parentCmd, "deprecate")
@@ -209,7 +209,7 @@ func TestADRCheckCommand_HasDescription(t *testing.T) {
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 
 	validationService := services.NewValidationService()
-	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService)
+	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService, nil)
 
 	parentCmd := cli.NewADRCommands(adrService)
 	cmd := findCommand(parentCmd, "check")
@@ -237,7 +237,7 @@ func TestADRCommands_HelpAvailable(t *testing.T) {
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 
 	validationService := services.NewValidationService()
-	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService)
+	adrService := application.NewADRApplicationService(mockADRRepo, mockTrackRepo, mockAggregateRepo, validationService, nil)
 
 	parentCmd := cli.NewADRCommands(adrService)
 
@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+// DatabaseCommandName is the name of the command group maintaining the project database.
+// Its commands work on the database as it is, so tm must not migrate it before they run.
+const DatabaseCommandName = "db"
+
+// ============================================================================
+// NewDBCommands returns the db command group for Cobra
+// ============================================================================
+
+// NewDBCommands creates the db command group showing and moving the schema version of the project database.
+func NewDBCommands(schemaService *application.SchemaApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   DatabaseCommandName,
+		Short: "Inspect and migrate the project database schema",
+		Long: `Every other command migrates the project database to the latest schema when it
+opens it, after copying the database to .tm/backups/<project>/. These commands work
+on the database as it is: they show its schema version, migrate it step by step, or
+roll migrations back before the database is handed to an older tm.
+
+A rollback is not pinned: the next command of this tm outside 'tm db' migrates the
+database to the latest schema again. Roll back only to run an older tm afterwards.
+
+A database written by a newer tm is never opened; upgrade tm instead.`,
+	}
+
+	cmd.AddCommand(newDBStatusCommand(schemaService))
+	cmd.AddCommand(newDBMigrateCommand(schemaService))
+	cmd.AddCommand(newDBRollbackCommand(schemaService))
+
+	return cmd
+}
+
+// ============================================================================
+// tm db status
+// ============================================================================
+
+func newDBStatusCommand(schemaService *application.SchemaApplicationService) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show the schema version and pending migrations",
+		Long:  "Shows the schema version of the project database and every known migration, without changing the database.",
+		Example: `  tm db status
+  tm db status -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			status, err := schemaService.Status(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to read schema status: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "schema_status", status); ok {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			switch {
+			case status.CurrentVersion == 0:
+				fmt.Fprintf(out, "Schema: no database yet (created at v%d on first use)\n", status.LatestVersion)
+			case status.TooNew():
+				fmt.Fprintf(out, "Schema: v%d, newer than this tm supports (v%d); upgrade tm\n", status.CurrentVersion, status.LatestVersion)
+			case status.UpToDate():
+				fmt.Fprintf(out, "Schema: v%d (up to date)\n", status.CurrentVersion)
+			default:
+				fmt.Fprintf(out, "Schema: v%d of v%d, %d migration(s) pending\n", status.CurrentVersion, status.LatestVersion, len(status.Pending()))
+			}
+
+			fmt.Fprintf(out, "\n%-10s %-10s %-10s %s\n", "Version", "Status", "Rollback", "Description")
+			fmt.Fprintf(out, "%s\n", strings.Repeat("-", 80))
+			for _, migration := range status.Migrations {
+				state := "pending"
+				if migration.Applied {
+					state = "applied"
+				}
+				rollback := "no"
+				if migration.Reversible {
+					rollback = "yes"
+				}
+				fmt.Fprintf(out, "v%-9d %-10s %-10s %s\n", migration.Version, state, rollback, migration.Description)
+			}
+			return nil
+		},
+	}
+}
+
+// ============================================================================
+// tm db migrate
+// ============================================================================
+
+func newDBMigrateCommand(schemaService *application.SchemaApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply pending migrations",
+		Long: `Applies the pending migrations, oldest first, up to --to or the latest version.
+The database is backed up to .tm/backups/<project>/ before any migration runs.`,
+		Example: `  # Migrate to the latest schema
+  tm db migrate
+
+  # Apply the migrations up to v14 only
+  tm db migrate --to 14`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, _ := cmd.Flags().GetInt("to")
+			change, err := schemaService.Migrate(cmd.Context(), to)
+			if err != nil {
+				return fmt.Errorf("failed to migrate database: %w", err)
+			}
+			return writeSchemaChange(cmd, change)
+		},
+	}
+
+	cmd.Flags().Int("to", 0, "Schema version to migrate to (default: latest)")
+
+	return cmd
+}
+
+// ============================================================================
+// tm db rollback
+// ============================================================================
+
+func newDBRollbackCommand(schemaService *application.SchemaApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back applied migrations",
+		Long: `Rolls back the last applied migration, or every migration newer than --to, newest first.
+The database is backed up to .tm/backups/<project>/ first. Rolling back drops the
+tables and columns the migrations added, with their data; migrations that rebuilt
+tables cannot be rolled back.
+
+Rollback exists only to hand the database to an older tm. It does not pin the
+schema: any command of this tm other than 'tm db' migrates the database back to
+the latest version, so run the older tm next.`,
+		Example: `  # Undo the last migration
+  tm db rollback
+
+  # Go back to schema v13
+  tm db rollback --to 13`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, _ := cmd.Flags().GetInt("to")
+			change, err := schemaService.Rollback(cmd.Context(), to)
+			if err != nil {
+				return fmt.Errorf("failed to roll back database: %w", err)
+			}
+			if err := writeSchemaChange(cmd, change); err != nil {
+				return err
+			}
+			// On stderr so structured output stays parseable
+			if change.RolledBack() {
+				latest := "the latest schema"
+				if status, err := schemaService.Status(cmd.Context()); err == nil {
+					latest = fmt.Sprintf("v%d", status.LatestVersion)
+				}
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: run the older tm next; any command of this tm other than 'tm db' migrates the database to %s again.\n", latest)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int("to", 0, "Schema version to roll back to (default: the previous version)")
+
+	return cmd
+}
+
+// writeSchemaChange prints the migrations a migrate or rollback ran
+func writeSchemaChange(cmd *cobra.Command, change *entities.SchemaChange) error {
+	if ok, err := writeStructured(cmd, "schema_change", change); ok {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if len(change.Migrations) == 0 {
+		fmt.Fprintf(out, "Schema is at v%d, nothing to do\n", change.ToVersion)
+		return nil
+	}
+	if change.BackupPath != "" {
+		fmt.Fprintf(out, "Backed up schema v%d database to %s\n", change.FromVersion, change.BackupPath)
+	}
+	verb := "Applied"
+	if change.RolledBack() {
+		verb = "Rolled back"
+	}
+	for _, migration := range change.Migrations {
+		fmt.Fprintf(out, "%s v%d: %s\n", verb, migration.Version, migration.Description)
+	}
+	fmt.Fprintf(out, "Schema: v%d → v%d\n", change.FromVersion, change.ToVersion)
+	return nil
+}
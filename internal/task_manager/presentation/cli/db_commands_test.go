@@ -0,0 +1,70 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDBCommands_Structure verifies the db command group's subcommands and flags
+func TestDBCommands_Structure(t *testing.T) {
+	dbCmd := cli.NewDBCommands(nil)
+
+	assert.Equal(t, cli.DatabaseCommandName, dbCmd.Use)
+	assert.NotEmpty(t, dbCmd.Short, "command should have short description")
+
+	subcommands := make(map[string]bool)
+	for _, sub := range dbCmd.Commands() {
+		subcommands[sub.Name()] = true
+		assert.Error(t, sub.Args(sub, []string{"extra"}), "%s should take no arguments", sub.Name())
+	}
+	for _, name := range []string{"status", "migrate", "rollback"} {
+		assert.True(t, subcommands[name], "db should have %s subcommand", name)
+	}
+
+	for _, name := range []string{"migrate", "rollback"} {
+		sub, _, err := dbCmd.Find([]string{name})
+		if assert.NoError(t, err) {
+			to := sub.Flags().Lookup("to")
+			if assert.NotNil(t, to, "%s should have --to", name) {
+				assert.Equal(t, "0", to.DefValue)
+			}
+		}
+	}
+}
+
+// TestDBRollbackCommand_WarnsAboutAutoMigration verifies a rollback tells the user the next command migrates again
+func TestDBRollbackCommand_WarnsAboutAutoMigration(t *testing.T) {
+	repo := &mocks.MockSchemaRepository{
+		GetSchemaStatusFunc: func(ctx context.Context) (*entities.SchemaStatus, error) {
+			// Already rolled back once: the warning names the latest version, not the one left
+			return &entities.SchemaStatus{CurrentVersion: 15, LatestVersion: 16}, nil
+		},
+		MigrateSchemaFunc: func(ctx context.Context, targetVersion int) (*entities.SchemaChange, error) {
+			return &entities.SchemaChange{
+				FromVersion: 15,
+				ToVersion:   targetVersion,
+				Migrations:  []entities.SchemaMigration{{Version: 15, Description: "Added estimates"}},
+			}, nil
+		},
+	}
+	rollbackCmd, _, err := cli.NewDBCommands(application.NewSchemaApplicationService(repo)).Find([]string{"rollback"})
+	require.NoError(t, err)
+
+	var stdout, stderr bytes.Buffer
+	rollbackCmd.SetOut(&stdout)
+	rollbackCmd.SetErr(&stderr)
+	require.NoError(t, rollbackCmd.RunE(rollbackCmd, nil))
+
+	assert.Contains(t, stdout.String(), "Schema: v15 → v14")
+	assert.Contains(t, stderr.String(), "Warning:")
+	assert.Contains(t, stderr.String(), "migrates the database to v16 again")
+	assert.NotContains(t, stdout.String(), "migrates the database")
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -149,6 +150,12 @@ func newDocCreateCommand(docService *application.DocumentApplicationService) *co
 				return fmt.Errorf("failed to create document: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "document", func() (interface{}, error) {
+				return docService.GetDocument(ctx, docID)
+			}); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Document created successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:    %s\n", docID)
@@ -228,6 +235,10 @@ func newDocListCommand(docService *application.DocumentApplicationService) *cobr
 				return fmt.Errorf("failed to list documents: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "document_list", docs); ok {
+				return err
+			}
+
 			// Format output
 			if len(docs) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No documents found\n")
@@ -296,6 +307,10 @@ func newDocShowCommand(docService *application.DocumentApplicationService) *cobr
 				return fmt.Errorf("failed to get document: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "document", doc); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Document Details\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "================\n")
@@ -396,11 +411,19 @@ func newDocUpdateCommand(docService *application.DocumentApplicationService) *co
 			}
 
 			// Execute via application service
-			err := docService.UpdateDocument(ctx, input)
+			err := tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+				return docService.UpdateDocument(ctx, input)
+			})
 			if err != nil {
 				return fmt.Errorf("failed to update document: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "document", func() (interface{}, error) {
+				return docService.GetDocument(ctx, docID)
+			}); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Document %s updated successfully\n", docID)
 
@@ -461,11 +484,19 @@ func newDocAttachCommand(docService *application.DocumentApplicationService) *co
 			}
 
 			// Execute via application service
-			err := docService.AttachDocument(ctx, docID, trackPtr, iterationPtr)
+			err := tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+				return docService.AttachDocument(ctx, docID, trackPtr, iterationPtr)
+			})
 			if err != nil {
 				return fmt.Errorf("failed to attach document: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "document", func() (interface{}, error) {
+				return docService.GetDocument(ctx, docID)
+			}); ok {
+				return err
+			}
+
 			// Format output
 			if track != "" {
 				fmt.Fprintf(cmd.OutOrStdout(), "Document %s attached to track %s successfully\n", docID, track)
@@ -500,11 +531,19 @@ func newDocDetachCommand(docService *application.DocumentApplicationService) *co
 			docID := args[0]
 
 			// Execute via application service
-			err := docService.DetachDocument(ctx, docID)
+			err := tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+				return docService.DetachDocument(ctx, docID)
+			})
 			if err != nil {
 				return fmt.Errorf("failed to detach document: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "document", func() (interface{}, error) {
+				return docService.GetDocument(ctx, docID)
+			}); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Document %s detached successfully\n", docID)
 
@@ -523,7 +562,9 @@ func newDocDeleteCommand(docService *application.DocumentApplicationService) *co
 	cmd := &cobra.Command{
 		Use:   "delete <doc-id>",
 		Short: "Delete a document",
-		Long:  `Deletes a document. By default, prompts for confirmation unless --force is used.`,
+		Long: `Deletes a document. By default, prompts for confirmation unless --force is used.
+The project is snapshotted first (see 'tm project snapshots') unless automatic
+snapshots are disabled.`,
 		Example: `  # Delete with confirmation prompt
   tm doc delete TM-doc-1
 
@@ -538,7 +579,8 @@ func newDocDeleteCommand(docService *application.DocumentApplicationService) *co
 
 			// Prompt for confirmation unless --force
 			if !force {
-				fmt.Fprintf(cmd.OutOrStdout(), "Are you sure you want to delete document %s? (yes/no): ", docID)
+				// The prompt goes to stderr so structured output stays parseable
+				fmt.Fprintf(cmd.ErrOrStderr(), "Are you sure you want to delete document %s? (yes/no): ", docID)
 
 				// Read user input
 				var response string
@@ -548,17 +590,22 @@ func newDocDeleteCommand(docService *application.DocumentApplicationService) *co
 				}
 
 				if strings.ToLower(response) != "yes" && strings.ToLower(response) != "y" {
-					fmt.Fprintf(cmd.OutOrStdout(), "Deletion cancelled\n")
+					fmt.Fprintf(cmd.ErrOrStderr(), "Deletion cancelled\n")
 					return nil
 				}
 			}
 
-			// Execute via application service
-			err := docService.DeleteDocument(ctx, docID)
+			// Execute via application service, which snapshots the project first
+			snapshot, err := docService.DeleteDocument(ctx, docID)
+			printSnapshotTaken(cmd, snapshot)
 			if err != nil {
 				return fmt.Errorf("failed to delete document: %w", err)
 			}
 
+			if ok, err := writeDeleted(cmd, "document", docID); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Document %s deleted successfully\n", docID)
 
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// NewDoctorCommand returns the doctor command for Cobra
+// ============================================================================
+
+// NewDoctorCommand creates the doctor command that checks the project database for inconsistencies.
+func NewDoctorCommand(doctorService *application.DoctorApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the project database for inconsistencies",
+		Long: `Scans the project database and reports:
+  - corruption found by SQLite's integrity check
+  - orphans: rows referencing tasks, tracks, iterations or ADRs that no longer exist
+  - tracks, tasks, iterations, ACs and ADRs with invalid statuses
+  - ranks outside 1-1000
+  - more than one current iteration
+  - track and task dependency cycles
+  - ID counters behind the IDs already in use
+
+--fix applies the safe repairs in a single transaction: dangling links, dependencies,
+status history and ACs of missing tasks are deleted, documents and supersessions
+pointing at missing entities are detached, ranks are clamped and counters advanced.
+Tasks, tracks and ADRs are never deleted; problems that need a decision are only reported.
+Exits with an error while problems remain.`,
+		Example: `  # Check the project
+  tm doctor
+
+  # Apply the safe repairs
+  tm doctor --fix
+
+  # Machine-readable report
+  tm doctor -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			fix, _ := cmd.Flags().GetBool("fix")
+
+			report, err := doctorService.Diagnose(ctx, fix)
+			if err != nil {
+				return fmt.Errorf("failed to check project: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "integrity_report", report); ok {
+				return err
+			}
+
+			if len(report.Issues) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No problems found\n")
+				return nil
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%-20s %-10s %-20s %s\n", "Check", "Entity", "ID", "Problem")
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\n", strings.Repeat("-", 90))
+			for _, check := range entities.IntegrityChecks() {
+				for _, issue := range report.Issues {
+					if issue.Check != check {
+						continue
+					}
+					marker := "✗"
+					if issue.Fixed {
+						marker = "✓"
+					}
+					fmt.Fprintf(cmd.OutOrStdout(), "%s %-18s %-10s %-20s %s\n",
+						marker, issue.Check, issue.EntityType, truncateString(issue.EntityID, 20), issue.Message)
+					switch {
+					case issue.Fixed:
+						fmt.Fprintf(cmd.OutOrStdout(), "    fixed: %s\n", issue.Repair)
+					case issue.Repair != "":
+						fmt.Fprintf(cmd.OutOrStdout(), "    fix with --fix: %s\n", issue.Repair)
+					}
+				}
+			}
+
+			unresolved := report.Unresolved()
+			fmt.Fprintf(cmd.OutOrStdout(), "\nTotal: %d problem(s), %d fixed\n", len(report.Issues), len(report.Fixed()))
+			if len(unresolved) > 0 {
+				return fmt.Errorf("%d problem(s) remain", len(unresolved))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool("fix", false, "Apply the safe repairs in a single transaction")
+
+	return cmd
+}
@@ -0,0 +1,23 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDoctorCommand_Structure verifies the doctor command's flags and arguments
+func TestDoctorCommand_Structure(t *testing.T) {
+	doctorCmd := cli.NewDoctorCommand(nil)
+
+	assert.Equal(t, "doctor", doctorCmd.Use)
+	assert.NotEmpty(t, doctorCmd.Short, "command should have short description")
+	assert.NotEmpty(t, doctorCmd.Long, "command should have long description")
+	assert.Error(t, doctorCmd.Args(doctorCmd, []string{"extra"}), "doctor should take no arguments")
+
+	fix := doctorCmd.Flags().Lookup("fix")
+	if assert.NotNil(t, fix, "--fix flag should exist") {
+		assert.Equal(t, "false", fix.DefValue)
+	}
+}
@@ -1,5 +1,10 @@
 package cli
 
+import (
+	"fmt"
+	"strconv"
+)
+
 // GetStatusIcon returns the icon for a given status string
 // Used by CLI output formatting (roadmap full view, etc.)
 func GetStatusIcon(status string) string {
@@ -27,3 +32,46 @@ func truncateString(s string, maxLen int) string {
 	}
 	return s[:maxLen-3] + "..."
 }
+
+// sparklineBars are the block characters sparklines are drawn with, lowest first
+var sparklineBars = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline draws values as a row of block characters scaled to the largest value
+// Used by CLI output formatting for metrics trends
+func sparkline(values []float64) string {
+	max := 0.0
+	for _, value := range values {
+		if value > max {
+			max = value
+		}
+	}
+
+	bars := make([]rune, 0, len(values))
+	for _, value := range values {
+		level := 0
+		if max > 0 && value > 0 {
+			level = int(value/max*float64(len(sparklineBars)-1) + 0.5)
+		}
+		bars = append(bars, sparklineBars[level])
+	}
+	return string(bars)
+}
+
+// formatHours formats a duration in hours as days and hours
+// Used by CLI output formatting for cycle and lead times
+func formatHours(hours float64) string {
+	switch {
+	case hours < 1:
+		return "<1h"
+	case hours < 24:
+		return fmt.Sprintf("%dh", int(hours))
+	default:
+		return fmt.Sprintf("%dd %dh", int(hours)/24, int(hours)%24)
+	}
+}
+
+// formatEstimate formats an estimate or capacity without trailing zeros
+// Used by CLI output formatting for task estimates and iteration capacity
+func formatEstimate(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
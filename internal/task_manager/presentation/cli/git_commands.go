@@ -0,0 +1,215 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// NewGitCommands returns the git command group for Cobra
+// ============================================================================
+
+// NewGitCommands creates the git command group.
+// workingDir and project are baked into installed hooks so they reach the same database
+// no matter where git runs them from.
+func NewGitCommands(gitService *application.GitApplicationService, workingDir, project string) *cobra.Command {
+	gitCmd := &cobra.Command{
+		Use:   "git",
+		Short: "Integrate the task manager with the local git repository",
+		Long: `Commands for keeping task status in step with git.
+
+Installed hooks require every commit to reference a task of the current iteration
+and apply Review: / Closes: commit trailers when a merge lands on the main branch.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	gitCmd.AddCommand(
+		newGitInstallHooksCommand(gitService, workingDir, project),
+		newGitCheckCommitMsgCommand(gitService),
+		newGitPostMergeCommand(gitService),
+	)
+
+	return gitCmd
+}
+
+// ============================================================================
+// git install-hooks command
+// ============================================================================
+
+func newGitInstallHooksCommand(gitService *application.GitApplicationService, workingDir, project string) *cobra.Command {
+	var (
+		mainBranch string
+		force      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "install-hooks",
+		Short: "Install commit-msg and post-merge hooks in the current repository",
+		Long: `Installs two git hooks in the repository of the current directory:
+
+  commit-msg   Rejects commits whose message does not mention a task ID of the
+               current iteration (merge, revert and fixup commits are exempt).
+  post-merge   When a merge or pull lands on the main branch, moves tasks named in
+               commit trailers: "Review: TM-task-12" to review and
+               "Closes: TM-task-12" to done.
+
+The hooks run this tm binary against the current project. Hooks previously
+installed by tm are replaced; other existing hooks are kept unless --force is given.`,
+		Example: `  # Install the hooks
+  tm git install-hooks
+
+  # Apply trailers on merges into a differently named main branch
+  tm git install-hooks --main-branch master
+
+  # Commit message referencing a task and closing it once merged
+  git commit -m "TM-task-12: add login form" -m "Closes: TM-task-12"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			command, err := hookCommand(workingDir, project)
+			if err != nil {
+				return err
+			}
+
+			paths, err := gitService.InstallHooks(ctx, dto.InstallGitHooksDTO{
+				Command:    command,
+				MainBranch: mainBranch,
+				Force:      force,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to install git hooks: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "git_hook_list", paths); ok {
+				return err
+			}
+
+			for _, path := range paths {
+				fmt.Fprintf(cmd.OutOrStdout(), "Installed %s\n", path)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Commit trailers are applied on merges into %s\n", mainBranch)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&mainBranch, "main-branch", application.DefaultMainBranch, "Branch whose merges apply Review:/Closes: trailers")
+	cmd.Flags().BoolVar(&force, "force", false, "Replace existing hooks that were not installed by tm")
+
+	return cmd
+}
+
+// hookCommand builds the shell command hooks use to run this tm binary against the current project.
+func hookCommand(workingDir, project string) (string, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the tm binary: %w", err)
+	}
+	absWorkingDir, err := filepath.Abs(workingDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve working directory: %w", err)
+	}
+	return fmt.Sprintf("env TM_WORKING_DIR=%s TM_PROJECT=%s %s",
+		shellQuote(absWorkingDir), shellQuote(project), shellQuote(executable)), nil
+}
+
+// shellQuote quotes a string for a POSIX shell
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// ============================================================================
+// git check-commit-msg command
+// ============================================================================
+
+func newGitCheckCommitMsgCommand(gitService *application.GitApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-commit-msg <message-file>",
+		Short: "Check that a commit message references a task of the current iteration",
+		Long: `Reads a commit message file and fails unless the message mentions a task ID
+of the current iteration (or the next planned one when none is current).
+
+This is what the commit-msg hook installed by 'tm git install-hooks' runs.
+Commits are not checked when there is no current or planned iteration.`,
+		Example: `  # Check a message by hand
+  echo "TM-task-12: add login form" > /tmp/msg && tm git check-commit-msg /tmp/msg`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			message, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read commit message: %w", err)
+			}
+
+			if _, err := gitService.CheckCommitMessage(ctx, string(message)); err != nil {
+				return fmt.Errorf("commit rejected: %w", err)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// ============================================================================
+// git post-merge command
+// ============================================================================
+
+func newGitPostMergeCommand(gitService *application.GitApplicationService) *cobra.Command {
+	var mainBranch string
+
+	cmd := &cobra.Command{
+		Use:   "post-merge",
+		Short: "Apply Review:/Closes: trailers of freshly merged commits",
+		Long: `Moves the tasks named in commit trailers of the commits brought in by the last
+merge or pull, provided the current branch is the main branch:
+
+  Review: TM-task-12   moves the task to review
+  Closes: TM-task-12   moves the task to done (all ACs must be verified or skipped)
+
+This is what the post-merge hook installed by 'tm git install-hooks' runs.
+A transition that fails is reported but never fails the command, as the merge
+has already happened.`,
+		Example: `  # Apply trailers after merging by hand
+  tm git post-merge --main-branch main`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			results, err := gitService.ApplyMergedCommits(ctx, mainBranch)
+			if err != nil {
+				return fmt.Errorf("failed to apply commit trailers: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "commit_transition_list", results); ok {
+				return err
+			}
+
+			for _, result := range results {
+				if result.Error != "" {
+					fmt.Fprintf(cmd.OutOrStdout(), "✗ %s not moved to %s (%s: in %s): %s\n",
+						result.TaskID, result.ToStatus, result.Trailer, result.Commit, result.Error)
+					continue
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "✓ %s: %s → %s (%s: in %s)\n",
+					result.TaskID, result.FromStatus, result.ToStatus, result.Trailer, result.Commit)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&mainBranch, "main-branch", application.DefaultMainBranch, "Only apply trailers when this branch is checked out")
+
+	return cmd
+}
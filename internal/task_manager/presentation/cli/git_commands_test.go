@@ -0,0 +1,39 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGitCommands_Structure verifies the git command group structure
+func TestGitCommands_Structure(t *testing.T) {
+	gitCommands := cli.NewGitCommands(nil, "", "")
+
+	assert.Equal(t, "git", gitCommands.Use, "command use should be 'git'")
+	assert.NotEmpty(t, gitCommands.Short, "command should have short description")
+	assert.NotEmpty(t, gitCommands.Long, "command should have long description")
+
+	for _, name := range []string{"install-hooks", "check-commit-msg", "post-merge"} {
+		assert.NotNil(t, findCommand(gitCommands, name), "command '%s' should exist", name)
+	}
+}
+
+// TestGitHookCommands_Flags verifies the hook commands' flags and arguments
+func TestGitHookCommands_Flags(t *testing.T) {
+	gitCommands := cli.NewGitCommands(nil, "", "")
+
+	installCmd := findCommand(gitCommands, "install-hooks")
+	assert.NotNil(t, installCmd.Flags().Lookup("force"), "--force flag should exist")
+	mainBranch := installCmd.Flags().Lookup("main-branch")
+	if assert.NotNil(t, mainBranch, "--main-branch flag should exist") {
+		assert.Equal(t, "main", mainBranch.DefValue)
+	}
+
+	postMergeCmd := findCommand(gitCommands, "post-merge")
+	assert.NotNil(t, postMergeCmd.Flags().Lookup("main-branch"), "--main-branch flag should exist")
+
+	checkCmd := findCommand(gitCommands, "check-commit-msg")
+	assert.Error(t, checkCmd.Args(checkCmd, []string{}), "check-commit-msg should require a message file")
+}
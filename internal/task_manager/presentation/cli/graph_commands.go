@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// NewGraphCommand returns the graph command for Cobra
+// ============================================================================
+
+// NewGraphCommand creates the graph command that renders the roadmap hierarchy and track dependencies.
+func NewGraphCommand(graphService *application.GraphApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Render the roadmap hierarchy and track dependency graph",
+		Long: `Renders the roadmap → track → task hierarchy together with the track dependency DAG.
+Nodes are colored by status, and the critical path - the chain of unfinished tracks with
+the most open tasks - is highlighted.
+
+Formats:
+  ascii     tree for the terminal (default)
+  dot       Graphviz; pipe into 'dot -Tsvg'
+  mermaid   Mermaid flowchart; renders on GitHub and in most markdown viewers
+
+With --save, the graph is also stored as a draft document, embedded in a fenced code
+block so it shows up in the TUI document viewer.`,
+		Example: `  # Tree in the terminal
+  tm graph
+
+  # SVG of the track dependencies
+  tm graph --format dot --tracks-only | dot -Tsvg > roadmap.svg
+
+  # Store a Mermaid diagram as a document
+  tm graph --format mermaid --save --title "Roadmap Q3"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			format, _ := cmd.Flags().GetString("format")
+			tracksOnly, _ := cmd.Flags().GetBool("tracks-only")
+			save, _ := cmd.Flags().GetBool("save")
+			title, _ := cmd.Flags().GetString("title")
+
+			graph, err := graphService.GenerateGraph(ctx, dto.GraphDTO{Format: format, TracksOnly: tracksOnly})
+			if err != nil {
+				return fmt.Errorf("failed to generate graph: %w", err)
+			}
+			if save {
+				if err := graphService.SaveGraph(ctx, graph, title); err != nil {
+					return err
+				}
+			}
+
+			if ok, err := writeStructured(cmd, "roadmap_graph", graph); ok {
+				return err
+			}
+
+			fmt.Fprint(cmd.OutOrStdout(), graph.Rendered)
+			if graph.DocumentID != "" {
+				// Keep stdout pipeable into renderers; the note goes to stderr
+				fmt.Fprintf(cmd.ErrOrStderr(), "Saved as document %s\n", graph.DocumentID)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String("format", dto.GraphFormatASCII, "Graph format: ascii, dot or mermaid")
+	cmd.Flags().Bool("tracks-only", false, "Leave tasks out of the graph")
+	cmd.Flags().Bool("save", false, "Store the graph as a draft document")
+	cmd.Flags().String("title", application.DefaultGraphTitle, "Title of the saved document")
+
+	return cmd
+}
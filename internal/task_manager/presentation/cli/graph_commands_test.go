@@ -0,0 +1,31 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGraphCommand_Structure verifies the graph command's flags and arguments
+func TestGraphCommand_Structure(t *testing.T) {
+	graphCmd := cli.NewGraphCommand(nil)
+
+	assert.Equal(t, "graph", graphCmd.Use)
+	assert.NotEmpty(t, graphCmd.Short, "command should have short description")
+	assert.NotEmpty(t, graphCmd.Long, "command should have long description")
+	assert.Error(t, graphCmd.Args(graphCmd, []string{"extra"}), "graph should take no arguments")
+
+	format := graphCmd.Flags().Lookup("format")
+	if assert.NotNil(t, format, "--format flag should exist") {
+		assert.Equal(t, dto.GraphFormatASCII, format.DefValue, "the terminal tree should be the default")
+	}
+	title := graphCmd.Flags().Lookup("title")
+	if assert.NotNil(t, title, "--title flag should exist") {
+		assert.Equal(t, application.DefaultGraphTitle, title.DefValue)
+	}
+	assert.NotNil(t, graphCmd.Flags().Lookup("tracks-only"), "--tracks-only flag should exist")
+	assert.NotNil(t, graphCmd.Flags().Lookup("save"), "--save flag should exist")
+}
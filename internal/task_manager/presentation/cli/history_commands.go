@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// NewHistoryCommand returns the history command for Cobra
+// ============================================================================
+
+// NewHistoryCommand creates the history command that queries the entity audit log.
+func NewHistoryCommand(historyService *application.HistoryApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [entity-id]",
+		Short: "Show the change history of entities",
+		Long: `Shows the append-only audit log of mutations: who changed what and when.
+
+Every create, update and deletion of roadmaps, tracks, tasks, iterations,
+acceptance criteria, ADRs and documents is recorded once, with a field-level
+before/after diff. Status changes are updates whose diff shows the status field;
+filtering by task.status_changed, task.completed, track.status_changed,
+track.completed or track.blocked selects those updates.
+Iterations are identified by their number.`,
+		Example: `  # History of a single task
+  tm history TM-task-5
+
+  # Everything that changed since a date
+  tm history --since 2025-01-31
+
+  # Only updates (including status changes) of a task
+  tm history TM-task-5 --type task.updated
+
+  # Only the status changes of a task
+  tm history TM-task-5 --type task.status_changed`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			filters := entities.EntityEventFilters{}
+			if len(args) > 0 {
+				filters.EntityID = args[0]
+			}
+
+			sinceStr, _ := cmd.Flags().GetString("since")
+			if sinceStr != "" {
+				since, err := parseHistoryTime(sinceStr)
+				if err != nil {
+					return err
+				}
+				filters.Since = &since
+			}
+
+			filters.EventTypes, _ = cmd.Flags().GetStringSlice("type")
+
+			history, err := historyService.ListHistory(ctx, filters)
+			if err != nil {
+				return fmt.Errorf("failed to get history: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "history", history); ok {
+				return err
+			}
+
+			if len(history) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No history found\n")
+				return nil
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%-20s %-32s %-20s %s\n", "Time", "Event", "Entity", "Actor")
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\n", strings.Repeat("-", 90))
+			for _, event := range history {
+				fmt.Fprintf(cmd.OutOrStdout(), "%-20s %-32s %-20s %s\n",
+					event.OccurredAt.Local().Format("2006-01-02 15:04:05"),
+					event.EventType,
+					truncateString(event.EntityID, 20),
+					event.Actor,
+				)
+				for _, change := range event.Changes {
+					fmt.Fprintf(cmd.OutOrStdout(), "    %s: %s → %s\n",
+						change.Field,
+						formatHistoryValue(change.Before),
+						formatHistoryValue(change.After),
+					)
+				}
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "\nTotal: %d event(s)\n", len(history))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String("since", "", "Only show events at or after this date (YYYY-MM-DD or RFC3339)")
+	cmd.Flags().StringSlice("type", nil, "Filter by event type, e.g. task.created (repeatable)")
+
+	return cmd
+}
+
+// parseHistoryTime accepts a local calendar date or a full RFC3339 timestamp.
+func parseHistoryTime(value string) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%w: invalid --since value %q (expected YYYY-MM-DD or RFC3339)", tmerrors.ErrInvalidArgument, value)
+}
+
+// formatHistoryValue renders a diff value on a single line for table output.
+func formatHistoryValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "(none)"
+	case string:
+		return fmt.Sprintf("%q", truncateString(strings.ReplaceAll(v, "\n", " "), 60))
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return truncateString(string(data), 60)
+	}
+}
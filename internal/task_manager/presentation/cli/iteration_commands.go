@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -15,7 +18,7 @@ import (
 // ============================================================================
 
 // NewIterationCommands creates and returns the iteration command group with all subcommands.
-func NewIterationCommands(iterationService *application.IterationApplicationService, docService *application.DocumentApplicationService, acService *application.ACApplicationService) *cobra.Command {
+func NewIterationCommands(iterationService *application.IterationApplicationService, docService *application.DocumentApplicationService, acService *application.ACApplicationService, reportService *application.ReportApplicationService, planService *application.IterationPlanApplicationService) *cobra.Command {
 	iterCmd := &cobra.Command{
 		Use:     "iteration",
 		Short:   "Manage iterations",
@@ -33,7 +36,10 @@ func NewIterationCommands(iterationService *application.IterationApplicationServ
 		newIterationShowCommand(iterationService, docService),
 		newIterationCurrentCommand(iterationService, acService),
 		newIterationStartCommand(iterationService),
+		newIterationValidateCommand(iterationService),
 		newIterationCompleteCommand(iterationService),
+		newIterationReportCommand(reportService),
+		newIterationPlanCommand(planService),
 		newIterationAddTaskCommand(iterationService),
 		newIterationRemoveTaskCommand(iterationService),
 		newIterationDeleteCommand(iterationService),
@@ -56,13 +62,17 @@ func newIterationCreateCommand(iterationService *application.IterationApplicatio
   tm iteration create --name "Sprint 1" --goal "Complete core features" --deliverable "MVP release"
 
   # Create with custom rank
-  tm iteration create --name "Sprint 2" --goal "Bug fixes" --deliverable "Patch release" --rank 100`,
+  tm iteration create --name "Sprint 2" --goal "Bug fixes" --deliverable "Patch release" --rank 100
+
+  # Create with a capacity in estimate units
+  tm iteration create --name "Sprint 3" --goal "Auth" --deliverable "OAuth login" --capacity 20`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
 			name, _ := cmd.Flags().GetString("name")
 			goal, _ := cmd.Flags().GetString("goal")
 			deliverable, _ := cmd.Flags().GetString("deliverable")
+			capacity, _ := cmd.Flags().GetFloat64("capacity")
 
 			// Validate required flags
 			if name == "" {
@@ -81,12 +91,19 @@ func newIterationCreateCommand(iterationService *application.IterationApplicatio
 				Goal:        goal,
 				Deliverable: deliverable,
 			}
+			if cmd.Flags().Changed("capacity") {
+				input.Capacity = &capacity
+			}
 
 			iteration, err := iterationService.CreateIteration(ctx, input)
 			if err != nil {
 				return fmt.Errorf("failed to create iteration: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "iteration", loadIterationDetail(ctx, iterationService, iteration.Number)); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Iteration created successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  Number:      %d\n", iteration.Number)
@@ -94,6 +111,9 @@ func newIterationCreateCommand(iterationService *application.IterationApplicatio
 			fmt.Fprintf(cmd.OutOrStdout(), "  Goal:        %s\n", iteration.Goal)
 			fmt.Fprintf(cmd.OutOrStdout(), "  Deliverable: %s\n", iteration.Deliverable)
 			fmt.Fprintf(cmd.OutOrStdout(), "  Status:      %s\n", iteration.Status)
+			if iteration.Capacity != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "  Capacity:    %s\n", formatEstimate(*iteration.Capacity))
+			}
 
 			return nil
 		},
@@ -103,6 +123,7 @@ func newIterationCreateCommand(iterationService *application.IterationApplicatio
 	cmd.Flags().String("goal", "", "Iteration goal (required)")
 	cmd.Flags().String("deliverable", "", "Deliverable description (required)")
 	cmd.Flags().Int("rank", 500, "Iteration rank (1-1000, default: 500)")
+	cmd.Flags().Float64("capacity", 0, "Estimate total the iteration can take on (optional)")
 
 	cmd.MarkFlagRequired("name")
 	cmd.MarkFlagRequired("goal")
@@ -131,6 +152,10 @@ func newIterationListCommand(iterationService *application.IterationApplicationS
 				return fmt.Errorf("failed to list iterations: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "iteration_list", iterations); ok {
+				return err
+			}
+
 			// Format output
 			if len(iterations) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No iterations found\n")
@@ -205,6 +230,15 @@ func newIterationShowCommand(iterationService *application.IterationApplicationS
 				return fmt.Errorf("failed to get iteration tasks: %w", err)
 			}
 
+			if GetOutputFormat(cmd) != OutputTable {
+				docs, err := docService.ListDocuments(ctx, nil, &number, nil)
+				if err != nil {
+					return fmt.Errorf("failed to list iteration documents: %w", err)
+				}
+				_, err = writeStructured(cmd, "iteration", NewIterationDetailOutput(iteration, tasks, docs))
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Iteration Details\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "=================\n")
@@ -214,12 +248,13 @@ func newIterationShowCommand(iterationService *application.IterationApplicationS
 			fmt.Fprintf(cmd.OutOrStdout(), "  Deliverable: %s\n", iteration.Deliverable)
 			fmt.Fprintf(cmd.OutOrStdout(), "  Status:      %s\n", iteration.Status)
 			fmt.Fprintf(cmd.OutOrStdout(), "  Task Count:  %d\n", len(tasks))
+			printIterationCapacity(cmd, entities.NewIterationCapacity(iteration, tasks))
 
 			// Display tasks if any (always show, not just with --full)
 			if len(tasks) > 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "\n  Tasks:\n")
 				for _, task := range tasks {
-					fmt.Fprintf(cmd.OutOrStdout(), "    - %s (%s): %s\n", task.ID, task.Status, task.Title)
+					fmt.Fprintf(cmd.OutOrStdout(), "    - %s (%s): %s%s\n", task.ID, task.Status, task.Title, estimateSuffix(task))
 				}
 			}
 
@@ -280,6 +315,9 @@ func newIterationCurrentCommand(iterationService *application.IterationApplicati
 
 			// Check if no iterations found at all
 			if result.Iteration == nil {
+				if ok, err := writeStructured(cmd, "iteration", &IterationDetailOutput{Message: result.FallbackMsg}); ok {
+					return err
+				}
 				fmt.Fprintf(cmd.OutOrStdout(), "%s\n", result.FallbackMsg)
 				return nil
 			}
@@ -290,17 +328,26 @@ func newIterationCurrentCommand(iterationService *application.IterationApplicati
 				return fmt.Errorf("unexpected iteration type")
 			}
 
-			// Display fallback message if applicable
-			if result.IsFallback {
-				fmt.Fprintf(cmd.OutOrStdout(), "%s\n\n", result.FallbackMsg)
-			}
-
 			// Get tasks in iteration
 			tasks, err := iterationService.GetIterationTasks(ctx, iteration.Number)
 			if err != nil {
 				return fmt.Errorf("failed to get iteration tasks: %w", err)
 			}
 
+			output := NewIterationDetailOutput(iteration, tasks, nil)
+			output.IsFallback = result.IsFallback
+			if result.IsFallback {
+				output.Message = result.FallbackMsg
+			}
+			if ok, err := writeStructured(cmd, "iteration", output); ok {
+				return err
+			}
+
+			// Display fallback message if applicable
+			if result.IsFallback {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s\n\n", result.FallbackMsg)
+			}
+
 			// Format output
 			if result.IsFallback {
 				fmt.Fprintf(cmd.OutOrStdout(), "Iteration: %d - %s\n", iteration.Number, iteration.Name)
@@ -311,6 +358,7 @@ func newIterationCurrentCommand(iterationService *application.IterationApplicati
 			fmt.Fprintf(cmd.OutOrStdout(), "  Goal:        %s\n", iteration.Goal)
 			fmt.Fprintf(cmd.OutOrStdout(), "  Deliverable: %s\n", iteration.Deliverable)
 			fmt.Fprintf(cmd.OutOrStdout(), "  Task Count:  %d\n", len(tasks))
+			printIterationCapacity(cmd, output.Capacity)
 
 			if full {
 				fmt.Fprintf(cmd.OutOrStdout(), "  Created:     %s\n", iteration.CreatedAt.Format("2006-01-02 15:04:05 UTC"))
@@ -327,7 +375,7 @@ func newIterationCurrentCommand(iterationService *application.IterationApplicati
 				if len(tasks) > 0 {
 					fmt.Fprintf(cmd.OutOrStdout(), "\n  Tasks:\n")
 					for _, task := range tasks {
-						fmt.Fprintf(cmd.OutOrStdout(), "    - %s (%s): %s\n", task.ID, task.Status, task.Title)
+						fmt.Fprintf(cmd.OutOrStdout(), "    - %s (%s): %s%s\n", task.ID, task.Status, task.Title, estimateSuffix(task))
 
 						// Fetch and display ACs for this task
 						acs, err := acService.ListAC(ctx, task.ID)
@@ -351,6 +399,76 @@ func newIterationCurrentCommand(iterationService *application.IterationApplicati
 	return cmd
 }
 
+// IterationDetailOutput is the structured form of `iteration show` and `iteration current`.
+// The API server returns the same shape.
+type IterationDetailOutput struct {
+	Iteration  *entities.IterationEntity   `json:"iteration"`
+	Tasks      []*entities.TaskEntity      `json:"tasks"`
+	Capacity   *entities.IterationCapacity `json:"capacity"`
+	Documents  []*dto.DocumentViewDTO      `json:"documents,omitempty"`
+	IsFallback bool                        `json:"is_fallback,omitempty"`
+	Message    string                      `json:"message,omitempty"`
+}
+
+// NewIterationDetailOutput builds the detail output of an iteration, computing its capacity from tasks.
+func NewIterationDetailOutput(iteration *entities.IterationEntity, tasks []*entities.TaskEntity, docs []*dto.DocumentViewDTO) *IterationDetailOutput {
+	if tasks == nil {
+		tasks = []*entities.TaskEntity{}
+	}
+	return &IterationDetailOutput{
+		Iteration: iteration,
+		Tasks:     tasks,
+		Capacity:  entities.NewIterationCapacity(iteration, tasks),
+		Documents: docs,
+	}
+}
+
+// loadIterationDetail returns a loader of an iteration and its tasks, for the structured
+// output of commands that change an iteration
+func loadIterationDetail(ctx context.Context, iterationService *application.IterationApplicationService, number int) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		iteration, err := iterationService.GetIteration(ctx, number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get iteration: %w", err)
+		}
+		tasks, err := iterationService.GetIterationTasks(ctx, number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get iteration tasks: %w", err)
+		}
+		return NewIterationDetailOutput(iteration, tasks, nil), nil
+	}
+}
+
+// estimateSuffix returns " [estimate]" for estimated tasks, for appending to a task line
+func estimateSuffix(task *entities.TaskEntity) string {
+	if task.Estimate == nil {
+		return ""
+	}
+	return " [" + formatEstimate(*task.Estimate) + "]"
+}
+
+// printIterationCapacity prints the capacity totals of an iteration.
+// Nothing is printed for iterations without a capacity and without estimated tasks.
+func printIterationCapacity(cmd *cobra.Command, capacity *entities.IterationCapacity) {
+	if capacity.Capacity == nil && capacity.Committed == 0 {
+		return
+	}
+
+	out := cmd.OutOrStdout()
+	if capacity.Capacity != nil {
+		fmt.Fprintf(out, "  Capacity:    %s\n", formatEstimate(*capacity.Capacity))
+	}
+	fmt.Fprintf(out, "  Committed:   %s", formatEstimate(capacity.Committed))
+	if capacity.Unestimated > 0 {
+		fmt.Fprintf(out, " (%d task(s) without estimate)", capacity.Unestimated)
+	}
+	fmt.Fprintf(out, "\n")
+	fmt.Fprintf(out, "  Remaining:   %s\n", formatEstimate(capacity.Remaining))
+	if capacity.IsOvercommitted() {
+		fmt.Fprintf(out, "  ⚠ Committed work exceeds capacity by %s\n", formatEstimate(capacity.Committed-*capacity.Capacity))
+	}
+}
+
 // ============================================================================
 // iteration start command
 // ============================================================================
@@ -372,7 +490,9 @@ func newIterationStartCommand(iterationService *application.IterationApplication
 			}
 
 			// Execute via application service
-			if err := iterationService.StartIteration(ctx, number); err != nil {
+			if err := tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+				return iterationService.StartIteration(ctx, number)
+			}); err != nil {
 				return fmt.Errorf("failed to start iteration: %w", err)
 			}
 
@@ -382,6 +502,10 @@ func newIterationStartCommand(iterationService *application.IterationApplication
 				return fmt.Errorf("failed to get iteration: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "iteration", loadIterationDetail(ctx, iterationService, number)); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Iteration %d started successfully\n", iteration.Number)
 			fmt.Fprintf(cmd.OutOrStdout(), "  Status: %s\n", iteration.Status)
@@ -393,6 +517,81 @@ func newIterationStartCommand(iterationService *application.IterationApplication
 	return cmd
 }
 
+// ============================================================================
+// iteration validate command
+// ============================================================================
+
+func newIterationValidateCommand(iterationService *application.IterationApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate <iteration-number>",
+		Short: "Check whether an iteration can be completed",
+		Long: `Reports what stands between an iteration and completion: unfinished tasks, failed or not-started
+acceptance criteria, ACs pending human review, and task IDs that no longer exist.
+
+Each check is an error (blocks 'tm iteration complete'), a warning, or off, as set by the
+iteration_completion section of the project policy file (.tm/projects/<name>/policy.yaml).
+Exits with an error while blocking problems remain.`,
+		Example: `  # Validate iteration 1
+  tm iteration validate 1
+
+  # Machine-readable report
+  tm iteration validate 1 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			var number int
+			_, err := fmt.Sscanf(args[0], "%d", &number)
+			if err != nil {
+				return fmt.Errorf("invalid iteration number: %w", err)
+			}
+
+			// Execute via application service
+			result, err := iterationService.ValidateIteration(ctx, number)
+			if err != nil {
+				return fmt.Errorf("failed to validate iteration: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "iteration_validation", result); ok {
+				return err
+			}
+
+			// Format output
+			problems := result.Errors()
+			warnings := result.Warnings()
+			fmt.Fprintf(cmd.OutOrStdout(), "Iteration %d validation\n", result.IterationNumber)
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\n", strings.Repeat("=", 90))
+			printIterationIssues(cmd, "Errors", "✗", problems)
+			printIterationIssues(cmd, "Warnings", "!", warnings)
+
+			fmt.Fprintf(cmd.OutOrStdout(), "\nTotal: %d error(s), %d warning(s)\n", len(problems), len(warnings))
+			if len(problems) > 0 {
+				return fmt.Errorf("iteration %d has %d blocking problem(s)", result.IterationNumber, len(problems))
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Iteration %d can be completed\n", result.IterationNumber)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printIterationIssues prints one severity section of a validation report, grouped by check.
+func printIterationIssues(cmd *cobra.Command, title, marker string, issues []entities.IterationIssue) {
+	if len(issues) == 0 {
+		return
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "\n%s:\n", title)
+	for _, check := range entities.IterationChecks() {
+		for _, issue := range issues {
+			if issue.Check != check {
+				continue
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "  %s %-16s %-20s %s\n", marker, issue.Check, issue.EntityID, truncateString(issue.Message, 60))
+		}
+	}
+}
+
 // ============================================================================
 // iteration complete command
 // ============================================================================
@@ -401,9 +600,14 @@ func newIterationCompleteCommand(iterationService *application.IterationApplicat
 	cmd := &cobra.Command{
 		Use:   "complete <iteration-number>",
 		Short: "Mark an iteration as complete",
-		Long:  `Marks an iteration as complete. Sets the completion timestamp.`,
+		Long: `Marks an iteration as complete. Sets the completion timestamp.
+
+Completion is refused while 'tm iteration validate' reports errors; --force completes anyway.`,
 		Example: `  # Complete iteration 1
-  tm iteration complete 1`,
+  tm iteration complete 1
+
+  # Complete despite open problems
+  tm iteration complete 1 --force`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -412,9 +616,12 @@ func newIterationCompleteCommand(iterationService *application.IterationApplicat
 			if err != nil {
 				return fmt.Errorf("invalid iteration number: %w", err)
 			}
+			force, _ := cmd.Flags().GetBool("force")
 
 			// Execute via application service
-			if err := iterationService.CompleteIteration(ctx, number); err != nil {
+			if err := tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+				return iterationService.CompleteIteration(ctx, number, force)
+			}); err != nil {
 				return fmt.Errorf("failed to complete iteration: %w", err)
 			}
 
@@ -424,6 +631,10 @@ func newIterationCompleteCommand(iterationService *application.IterationApplicat
 				return fmt.Errorf("failed to get iteration: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "iteration", loadIterationDetail(ctx, iterationService, number)); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Iteration %d completed successfully\n", iteration.Number)
 			fmt.Fprintf(cmd.OutOrStdout(), "  Status: %s\n", iteration.Status)
@@ -432,6 +643,150 @@ func newIterationCompleteCommand(iterationService *application.IterationApplicat
 		},
 	}
 
+	cmd.Flags().Bool("force", false, "Complete even if validation reports errors")
+
+	return cmd
+}
+
+// ============================================================================
+// iteration report command
+// ============================================================================
+
+func newIterationReportCommand(reportService *application.ReportApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report <iteration-number>",
+		Short: "Generate a retrospective report for an iteration",
+		Long: `Builds a markdown report from the iteration's data: tasks by final status, acceptance criteria
+stats with failure feedback, lead time per task, planned vs delivered scope, and linked ADRs and documents.
+
+With --save, the report is also stored as a draft retrospective document attached to the iteration.`,
+		Example: `  # Print the report for iteration 1
+  tm iteration report 1
+
+  # Store it as a retrospective document
+  tm iteration report 1 --save`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			var number int
+			_, err := fmt.Sscanf(args[0], "%d", &number)
+			if err != nil {
+				return fmt.Errorf("invalid iteration number: %w", err)
+			}
+			save, _ := cmd.Flags().GetBool("save")
+
+			// Execute via application service
+			report, err := reportService.GenerateIterationReport(ctx, number)
+			if err != nil {
+				return fmt.Errorf("failed to generate report: %w", err)
+			}
+			if save {
+				if err := reportService.SaveIterationReport(ctx, report); err != nil {
+					return err
+				}
+			}
+
+			if ok, err := writeStructured(cmd, "iteration_report", report); ok {
+				return err
+			}
+
+			// Format output
+			fmt.Fprint(cmd.OutOrStdout(), report.Markdown)
+			if report.DocumentID != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "\nSaved as retrospective document %s\n", report.DocumentID)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool("save", false, "Store the report as a retrospective document attached to the iteration")
+
+	return cmd
+}
+
+// ============================================================================
+// iteration plan command
+// ============================================================================
+
+func newIterationPlanCommand(planService *application.IterationPlanApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan <iteration-number>",
+		Short: "Propose iteration scope from the backlog",
+		Long: `Fills an iteration up to --capacity tasks (counting the tasks already in it) with
+backlog tasks, and shows the proposal as a diff against the iteration's current scope.
+
+Tasks are picked in track dependency order: tasks of tracks whose unfinished dependencies
+come first, then by track rank, then by task rank. Tasks of blocked tracks are skipped.
+
+With --apply the proposed tasks are added in a single update: all of them or none.`,
+		Example: `  # Propose up to 8 tasks for iteration 2
+  tm iteration plan 2 --capacity 8
+
+  # Add the proposed tasks
+  tm iteration plan 2 --capacity 8 --apply`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			var number int
+			_, err := fmt.Sscanf(args[0], "%d", &number)
+			if err != nil {
+				return fmt.Errorf("invalid iteration number: %w", err)
+			}
+			capacity, _ := cmd.Flags().GetInt("capacity")
+			apply, _ := cmd.Flags().GetBool("apply")
+
+			// Execute via application service
+			plan, err := planService.PlanIteration(ctx, dto.PlanIterationDTO{Number: number, Capacity: capacity, Apply: apply})
+			if err != nil {
+				return fmt.Errorf("failed to plan iteration: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "iteration_plan", plan); ok {
+				return err
+			}
+
+			// Format output: unchanged scope, then the additions
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Iteration %d: %s (capacity %d task(s))\n", plan.IterationNumber, plan.IterationName, plan.Capacity)
+			fmt.Fprintf(out, "%s\n", strings.Repeat("=", 90))
+			for _, task := range plan.Existing {
+				fmt.Fprintf(out, "  %-20s %-40s %-12s %s\n", task.ID, truncateString(task.Title, 39), task.Status, task.TrackID)
+			}
+			for _, task := range plan.Proposed {
+				fmt.Fprintf(out, "+ %-20s %-40s %-12s %s\n", task.ID, truncateString(task.Title, 39), task.Status, task.TrackID)
+			}
+			if len(plan.Existing) == 0 && len(plan.Proposed) == 0 {
+				fmt.Fprintf(out, "  (no tasks)\n")
+			}
+
+			if len(plan.Skipped) > 0 {
+				fmt.Fprintf(out, "\nSkipped:\n")
+				for _, task := range plan.Skipped {
+					fmt.Fprintf(out, "  %-20s %-40s %s\n", task.ID, truncateString(task.Title, 39), task.Reason)
+				}
+			}
+
+			fmt.Fprintf(out, "\n%d in iteration, %d proposed, %d slot(s) left", len(plan.Existing), len(plan.Proposed), plan.Remaining)
+			if plan.Unscheduled > 0 {
+				fmt.Fprintf(out, "; %d backlog task(s) did not fit", plan.Unscheduled)
+			}
+			fmt.Fprintf(out, "\n")
+
+			switch {
+			case plan.Applied:
+				fmt.Fprintf(out, "Added %d task(s) to iteration %d\n", len(plan.Proposed), plan.IterationNumber)
+			case len(plan.Proposed) > 0:
+				fmt.Fprintf(out, "Run with --apply to add the proposed tasks.\n")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int("capacity", 0, "Number of tasks the iteration can hold, including the tasks already in it (required)")
+	cmd.Flags().Bool("apply", false, "Add the proposed tasks to the iteration")
+	cmd.MarkFlagRequired("capacity")
+
 	return cmd
 }
 
@@ -462,25 +817,33 @@ func newIterationAddTaskCommand(iterationService *application.IterationApplicati
 			successCount := 0
 			var lastErr error
 
+			// Progress goes to stderr in structured output so stdout stays parseable
+			out := cmd.OutOrStdout()
+			if GetOutputFormat(cmd) != OutputTable {
+				out = cmd.ErrOrStderr()
+			}
+
 			// Add each task
 			for _, taskID := range taskIDs {
 				if err := iterationService.AddTask(ctx, number, taskID); err != nil {
-					fmt.Fprintf(cmd.OutOrStdout(), "Failed to add task %s: %v\n", taskID, err)
+					fmt.Fprintf(out, "Failed to add task %s: %v\n", taskID, err)
 					lastErr = err
 				} else {
-					fmt.Fprintf(cmd.OutOrStdout(), "Added task %s to iteration %d\n", taskID, number)
+					fmt.Fprintf(out, "Added task %s to iteration %d\n", taskID, number)
 					successCount++
 				}
 			}
 
-			if successCount > 0 {
-				fmt.Fprintf(cmd.OutOrStdout(), "Successfully added %d task(s)\n", successCount)
-			}
-
 			if lastErr != nil && successCount == 0 {
 				return lastErr
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "iteration", loadIterationDetail(ctx, iterationService, number)); ok {
+				return err
+			}
+
+			fmt.Fprintf(out, "Successfully added %d task(s)\n", successCount)
+
 			return nil
 		},
 	}
@@ -515,25 +878,33 @@ func newIterationRemoveTaskCommand(iterationService *application.IterationApplic
 			successCount := 0
 			var lastErr error
 
+			// Progress goes to stderr in structured output so stdout stays parseable
+			out := cmd.OutOrStdout()
+			if GetOutputFormat(cmd) != OutputTable {
+				out = cmd.ErrOrStderr()
+			}
+
 			// Remove each task
 			for _, taskID := range taskIDs {
 				if err := iterationService.RemoveTask(ctx, number, taskID); err != nil {
-					fmt.Fprintf(cmd.OutOrStdout(), "Failed to remove task %s: %v\n", taskID, err)
+					fmt.Fprintf(out, "Failed to remove task %s: %v\n", taskID, err)
 					lastErr = err
 				} else {
-					fmt.Fprintf(cmd.OutOrStdout(), "Removed task %s from iteration %d\n", taskID, number)
+					fmt.Fprintf(out, "Removed task %s from iteration %d\n", taskID, number)
 					successCount++
 				}
 			}
 
-			if successCount > 0 {
-				fmt.Fprintf(cmd.OutOrStdout(), "Successfully removed %d task(s)\n", successCount)
-			}
-
 			if lastErr != nil && successCount == 0 {
 				return lastErr
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "iteration", loadIterationDetail(ctx, iterationService, number)); ok {
+				return err
+			}
+
+			fmt.Fprintf(out, "Successfully removed %d task(s)\n", successCount)
+
 			return nil
 		},
 	}
@@ -549,7 +920,9 @@ func newIterationDeleteCommand(iterationService *application.IterationApplicatio
 	cmd := &cobra.Command{
 		Use:   "delete <iteration-number>",
 		Short: "Delete an iteration",
-		Long:  `Deletes an iteration from the project and removes its task associations.`,
+		Long: `Deletes an iteration from the project and removes its task associations. The
+project is snapshotted first (see 'tm project snapshots') unless automatic
+snapshots are disabled.`,
 		Example: `  # Delete iteration 1
   tm iteration delete 1`,
 		Args: cobra.ExactArgs(1),
@@ -561,11 +934,17 @@ func newIterationDeleteCommand(iterationService *application.IterationApplicatio
 				return fmt.Errorf("invalid iteration number: %w", err)
 			}
 
-			// Execute via application service
-			if err := iterationService.DeleteIteration(ctx, number); err != nil {
+			// Execute via application service, which snapshots the project first
+			snapshot, err := iterationService.DeleteIteration(ctx, number)
+			printSnapshotTaken(cmd, snapshot)
+			if err != nil {
 				return fmt.Errorf("failed to delete iteration: %w", err)
 			}
 
+			if ok, err := writeDeleted(cmd, "iteration", strconv.Itoa(number)); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Iteration %d deleted successfully\n", number)
 
@@ -589,7 +968,11 @@ func newIterationUpdateCommand(iterationService *application.IterationApplicatio
   tm iteration update 1 --name "Sprint 1 - Updated"
 
   # Update multiple fields
-  tm iteration update 1 --name "Sprint 2" --goal "Refactoring" --rank 200`,
+  tm iteration update 1 --name "Sprint 2" --goal "Refactoring" --rank 200
+
+  # Set or clear the capacity
+  tm iteration update 1 --capacity 20
+  tm iteration update 1 --capacity 0`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -603,10 +986,11 @@ func newIterationUpdateCommand(iterationService *application.IterationApplicatio
 			goalSet := cmd.Flags().Changed("goal")
 			deliverableSet := cmd.Flags().Changed("deliverable")
 			rankSet := cmd.Flags().Changed("rank")
+			capacitySet := cmd.Flags().Changed("capacity")
 
 			// Check that at least one field is being updated
-			if !nameSet && !goalSet && !deliverableSet && !rankSet {
-				return fmt.Errorf("at least one field must be specified to update (--name, --goal, --deliverable, or --rank)")
+			if !nameSet && !goalSet && !deliverableSet && !rankSet && !capacitySet {
+				return fmt.Errorf("at least one field must be specified to update (--name, --goal, --deliverable, --rank, or --capacity)")
 			}
 
 			// Create DTO with only updated fields
@@ -626,20 +1010,35 @@ func newIterationUpdateCommand(iterationService *application.IterationApplicatio
 				deliverable, _ := cmd.Flags().GetString("deliverable")
 				input.Deliverable = &deliverable
 			}
+			if capacitySet {
+				capacity, _ := cmd.Flags().GetFloat64("capacity")
+				input.Capacity = &capacity
+			}
 			// (rank field is currently ignored in the DTO, but we keep the flag for future compatibility)
 
 			// Execute via application service
-			iteration, err := iterationService.UpdateIteration(ctx, input)
+			var iteration *entities.IterationEntity
+			err = tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() (err error) {
+				iteration, err = iterationService.UpdateIteration(ctx, input)
+				return err
+			})
 			if err != nil {
 				return fmt.Errorf("failed to update iteration: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "iteration", loadIterationDetail(ctx, iterationService, number)); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Iteration updated successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  Number:      %d\n", iteration.Number)
 			fmt.Fprintf(cmd.OutOrStdout(), "  Name:        %s\n", iteration.Name)
 			fmt.Fprintf(cmd.OutOrStdout(), "  Goal:        %s\n", iteration.Goal)
 			fmt.Fprintf(cmd.OutOrStdout(), "  Deliverable: %s\n", iteration.Deliverable)
+			if iteration.Capacity != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "  Capacity:    %s\n", formatEstimate(*iteration.Capacity))
+			}
 
 			return nil
 		},
@@ -649,6 +1048,7 @@ func newIterationUpdateCommand(iterationService *application.IterationApplicatio
 	cmd.Flags().String("goal", "", "New iteration goal")
 	cmd.Flags().String("deliverable", "", "New deliverable description")
 	cmd.Flags().Int("rank", 0, "New iteration rank (1-1000)")
+	cmd.Flags().Float64("capacity", 0, "Estimate total the iteration can take on (0 to clear)")
 
 	return cmd
 }
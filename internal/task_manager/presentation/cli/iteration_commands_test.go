@@ -13,7 +13,7 @@ import (
 
 // TestNewIterationCommands verifies that NewIterationCommands returns a valid Cobra command group
 func TestNewIterationCommands_Structure(t *testing.T) {
-	iterationCommands := cli.NewIterationCommands(nil, nil, nil)
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
 
 	assert.NotNil(t, iterationCommands, "NewIterationCommands should return a command group")
 	assert.Equal(t, "iteration", iterationCommands.Name(), "command name should be 'iteration'")
@@ -21,9 +21,9 @@ func TestNewIterationCommands_Structure(t *testing.T) {
 	assert.NotEmpty(t, iterationCommands.Long, "command should have long description")
 }
 
-// TestIterationCommands_AllSubcommands verifies all 10 subcommands are present
+// TestIterationCommands_AllSubcommands verifies all 13 subcommands are present
 func TestIterationCommands_AllSubcommands(t *testing.T) {
-	iterationCommands := cli.NewIterationCommands(nil, nil, nil)
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
 
 	expectedSubcommands := []string{
 		"create",
@@ -31,7 +31,10 @@ func TestIterationCommands_AllSubcommands(t *testing.T) {
 		"show",
 		"current",
 		"start",
+		"validate",
 		"complete",
+		"report",
+		"plan",
 		"add-task",
 		"remove-task",
 		"delete",
@@ -50,19 +53,20 @@ func TestIterationCommands_AllSubcommands(t *testing.T) {
 
 // TestIterationCreateCommand_Flags verifies create command has required flags
 func TestIterationCreateCommand_Flags(t *testing.T) {
-	iterationCommands := cli.NewIterationCommands(nil, nil, nil)
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
 	createCmd := findCommand(iterationCommands, "create")
 
 	assert.NotNil(t, createCmd, "create command should exist")
 	assert.NotNil(t, createCmd.Flags().Lookup("name"), "--name flag should exist")
 	assert.NotNil(t, createCmd.Flags().Lookup("goal"), "--goal flag should exist")
 	assert.NotNil(t, createCmd.Flags().Lookup("deliverable"), "--deliverable flag should exist")
+	assert.NotNil(t, createCmd.Flags().Lookup("capacity"), "--capacity flag should exist")
 	assert.NotNil(t, createCmd.Flags().Lookup("rank"), "--rank flag should exist")
 }
 
 // TestIterationListCommand_Structure verifies list command exists
 func TestIterationListCommand_Structure(t *testing.T) {
-	iterationCommands := cli.NewIterationCommands(nil, nil, nil)
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
 	listCmd := findCommand(iterationCommands, "list")
 
 	assert.NotNil(t, listCmd, "list command should exist")
@@ -71,7 +75,7 @@ func TestIterationListCommand_Structure(t *testing.T) {
 
 // TestIterationShowCommand_Arguments verifies show command requires iteration number
 func TestIterationShowCommand_Arguments(t *testing.T) {
-	iterationCommands := cli.NewIterationCommands(nil, nil, nil)
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
 	showCmd := findCommand(iterationCommands, "show")
 
 	assert.NotNil(t, showCmd, "show command should exist")
@@ -81,7 +85,7 @@ func TestIterationShowCommand_Arguments(t *testing.T) {
 
 // TestIterationCurrentCommand_Structure verifies current command exists
 func TestIterationCurrentCommand_Structure(t *testing.T) {
-	iterationCommands := cli.NewIterationCommands(nil, nil, nil)
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
 	currentCmd := findCommand(iterationCommands, "current")
 
 	assert.NotNil(t, currentCmd, "current command should exist")
@@ -91,7 +95,7 @@ func TestIterationCurrentCommand_Structure(t *testing.T) {
 
 // TestIterationStartCommand_Arguments verifies start command requires iteration number
 func TestIterationStartCommand_Arguments(t *testing.T) {
-	iterationCommands := cli.NewIterationCommands(nil, nil, nil)
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
 	startCmd := findCommand(iterationCommands, "start")
 
 	assert.NotNil(t, startCmd, "start command should exist")
@@ -100,16 +104,36 @@ func TestIterationStartCommand_Arguments(t *testing.T) {
 
 // TestIterationCompleteCommand_Arguments verifies complete command requires iteration number
 func TestIterationCompleteCommand_Arguments(t *testing.T) {
-	iterationCommands := cli.NewIterationCommands(nil, nil, nil)
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
 	completeCmd := findCommand(iterationCommands, "complete")
 
 	assert.NotNil(t, completeCmd, "complete command should exist")
 	assert.NotNil(t, completeCmd.Args, "complete command should have argument validation")
+	assert.NotNil(t, completeCmd.Flags().Lookup("force"), "--force flag should exist")
+}
+
+// TestIterationReportCommand_Flags verifies report command requires iteration number and has --save
+func TestIterationReportCommand_Flags(t *testing.T) {
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
+	reportCmd := findCommand(iterationCommands, "report")
+
+	assert.NotNil(t, reportCmd, "report command should exist")
+	assert.NotNil(t, reportCmd.Args, "report command should have argument validation")
+	assert.NotNil(t, reportCmd.Flags().Lookup("save"), "--save flag should exist")
+}
+
+// TestIterationValidateCommand_Arguments verifies validate command requires iteration number
+func TestIterationValidateCommand_Arguments(t *testing.T) {
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
+	validateCmd := findCommand(iterationCommands, "validate")
+
+	assert.NotNil(t, validateCmd, "validate command should exist")
+	assert.NotNil(t, validateCmd.Args, "validate command should have argument validation")
 }
 
 // TestIterationAddTaskCommand_Arguments verifies add-task command requires iteration and tasks
 func TestIterationAddTaskCommand_Arguments(t *testing.T) {
-	iterationCommands := cli.NewIterationCommands(nil, nil, nil)
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
 	addTaskCmd := findCommand(iterationCommands, "add-task")
 
 	assert.NotNil(t, addTaskCmd, "add-task command should exist")
@@ -118,7 +142,7 @@ func TestIterationAddTaskCommand_Arguments(t *testing.T) {
 
 // TestIterationRemoveTaskCommand_Arguments verifies remove-task command requires iteration and tasks
 func TestIterationRemoveTaskCommand_Arguments(t *testing.T) {
-	iterationCommands := cli.NewIterationCommands(nil, nil, nil)
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
 	removeTaskCmd := findCommand(iterationCommands, "remove-task")
 
 	assert.NotNil(t, removeTaskCmd, "remove-task command should exist")
@@ -127,7 +151,7 @@ func TestIterationRemoveTaskCommand_Arguments(t *testing.T) {
 
 // TestIterationDeleteCommand_Arguments verifies delete command requires iteration number
 func TestIterationDeleteCommand_Arguments(t *testing.T) {
-	iterationCommands := cli.NewIterationCommands(nil, nil, nil)
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
 	deleteCmd := findCommand(iterationCommands, "delete")
 
 	assert.NotNil(t, deleteCmd, "delete command should exist")
@@ -136,16 +160,28 @@ func TestIterationDeleteCommand_Arguments(t *testing.T) {
 
 // TestIterationUpdateCommand_Flags verifies update command has optional field flags
 func TestIterationUpdateCommand_Flags(t *testing.T) {
-	iterationCommands := cli.NewIterationCommands(nil, nil, nil)
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
 	updateCmd := findCommand(iterationCommands, "update")
 
 	assert.NotNil(t, updateCmd, "update command should exist")
 	assert.NotNil(t, updateCmd.Flags().Lookup("name"), "--name flag should exist")
 	assert.NotNil(t, updateCmd.Flags().Lookup("goal"), "--goal flag should exist")
 	assert.NotNil(t, updateCmd.Flags().Lookup("deliverable"), "--deliverable flag should exist")
+	assert.NotNil(t, updateCmd.Flags().Lookup("capacity"), "--capacity flag should exist")
 	assert.NotNil(t, updateCmd.Flags().Lookup("rank"), "--rank flag should exist")
 }
 
+// TestIterationPlanCommand_Flags verifies plan command requires a capacity and can apply the proposal
+func TestIterationPlanCommand_Flags(t *testing.T) {
+	iterationCommands := cli.NewIterationCommands(nil, nil, nil, nil, nil)
+	planCmd := findCommand(iterationCommands, "plan")
+
+	assert.NotNil(t, planCmd, "plan command should exist")
+	assert.NotNil(t, planCmd.Flags().Lookup("capacity"), "--capacity flag should exist")
+	assert.NotNil(t, planCmd.Flags().Lookup("apply"), "--apply flag should exist")
+	assert.Error(t, planCmd.Args(planCmd, []string{}), "plan command should require an iteration number")
+}
+
 // ============================================================================
 // Helper function to find subcommand by name
 // ============================================================================
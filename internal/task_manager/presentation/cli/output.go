@@ -0,0 +1,271 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// ============================================================================
+// Output formats
+// ============================================================================
+
+// OutputFormat selects how commands render their results.
+type OutputFormat string
+
+const (
+	// OutputTable is the default human-readable format.
+	OutputTable OutputFormat = "table"
+	// OutputJSON renders results as an indented JSON envelope.
+	OutputJSON OutputFormat = "json"
+	// OutputYAML renders results as a YAML envelope.
+	OutputYAML OutputFormat = "yaml"
+)
+
+// OutputFlagName is the name of the global persistent flag selecting the output format.
+const OutputFlagName = "output"
+
+// OutputSchemaVersion identifies the structure of machine-readable output.
+// Bump it whenever a field is renamed or removed from an envelope.
+const OutputSchemaVersion = "tm/v1"
+
+// Error codes emitted in structured error envelopes.
+const (
+	ErrorCodeNotFound        = "not_found"
+	ErrorCodeInvalidArgument = "invalid_argument"
+	ErrorCodeAlreadyExists   = "already_exists"
+	ErrorCodeInternal        = "internal"
+	ErrorCodeRejected        = "rejected"
+	ErrorCodeConflict        = "conflict"
+	ErrorCodeUnknown         = "unknown"
+)
+
+// ParseOutputFormat validates a user-supplied output format.
+func ParseOutputFormat(value string) (OutputFormat, error) {
+	switch OutputFormat(value) {
+	case "", OutputTable:
+		return OutputTable, nil
+	case OutputJSON:
+		return OutputJSON, nil
+	case OutputYAML:
+		return OutputYAML, nil
+	default:
+		return OutputTable, fmt.Errorf("%w: unsupported output format %q (expected json, yaml or table)", tmerrors.ErrInvalidArgument, value)
+	}
+}
+
+// GetOutputFormat returns the output format selected for a command.
+// Commands built without the global flag (e.g. in unit tests) fall back to table output.
+func GetOutputFormat(cmd *cobra.Command) OutputFormat {
+	flag := cmd.Flags().Lookup(OutputFlagName)
+	if flag == nil {
+		return OutputTable
+	}
+	format, err := ParseOutputFormat(flag.Value.String())
+	if err != nil {
+		return OutputTable
+	}
+	return format
+}
+
+// ============================================================================
+// Envelopes
+// ============================================================================
+
+// Envelope is the versioned wrapper around every machine-readable result.
+type Envelope struct {
+	SchemaVersion string        `json:"schema_version"`
+	Kind          string        `json:"kind"`
+	Data          interface{}   `json:"data,omitempty"`
+	Error         *ErrorPayload `json:"error,omitempty"`
+}
+
+// ErrorPayload describes a failed command in structured output.
+type ErrorPayload struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ErrorCode maps an error to a stable code based on the domain error it wraps.
+func ErrorCode(err error) string {
+	switch {
+	case errors.Is(err, tmerrors.ErrNotFound):
+		return ErrorCodeNotFound
+	case errors.Is(err, tmerrors.ErrInvalidArgument):
+		return ErrorCodeInvalidArgument
+	case errors.Is(err, tmerrors.ErrAlreadyExists):
+		return ErrorCodeAlreadyExists
+	case errors.Is(err, tmerrors.ErrInternal):
+		return ErrorCodeInternal
+	case errors.Is(err, tmerrors.ErrRejected):
+		return ErrorCodeRejected
+	case errors.Is(err, tmerrors.ErrConflict):
+		return ErrorCodeConflict
+	default:
+		return ErrorCodeUnknown
+	}
+}
+
+// WriteEnvelope encodes an envelope in the given structured format.
+func WriteEnvelope(w io.Writer, format OutputFormat, envelope Envelope) error {
+	data, err := json.MarshalIndent(envelope, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode output: %w", err)
+	}
+
+	switch format {
+	case OutputYAML:
+		out, err := jsonToYAML(data)
+		if err != nil {
+			return fmt.Errorf("failed to encode output: %w", err)
+		}
+		_, err = w.Write(out)
+		return err
+	default:
+		_, err = fmt.Fprintf(w, "%s\n", data)
+		return err
+	}
+}
+
+// WriteError reports a command failure in the given format.
+// Table output keeps the familiar "Error: ..." line; structured formats emit an error envelope.
+func WriteError(w io.Writer, format OutputFormat, err error) {
+	if format == OutputTable {
+		fmt.Fprintf(w, "Error: %v\n", err)
+		return
+	}
+
+	envelope := Envelope{
+		SchemaVersion: OutputSchemaVersion,
+		Kind:          "error",
+		Error: &ErrorPayload{
+			Code:    ErrorCode(err),
+			Message: err.Error(),
+		},
+	}
+	if encodeErr := WriteEnvelope(w, format, envelope); encodeErr != nil {
+		fmt.Fprintf(w, "Error: %v\n", err)
+	}
+}
+
+// writeStructured renders data as a structured envelope when a structured format is selected.
+// It returns false when the command should fall through to its table output.
+func writeStructured(cmd *cobra.Command, kind string, data interface{}) (bool, error) {
+	format := GetOutputFormat(cmd)
+	if format == OutputTable {
+		return false, nil
+	}
+
+	// Emit empty lists as [] rather than null so consumers can iterate unconditionally
+	if v := reflect.ValueOf(data); v.Kind() == reflect.Slice && v.IsNil() {
+		data = []interface{}{}
+	}
+
+	envelope := Envelope{
+		SchemaVersion: OutputSchemaVersion,
+		Kind:          kind,
+		Data:          data,
+	}
+	return true, WriteEnvelope(cmd.OutOrStdout(), format, envelope)
+}
+
+// reportedError is a command failure that has already been written as part of a structured envelope.
+type reportedError struct {
+	err error
+}
+
+func (e *reportedError) Error() string { return e.err.Error() }
+func (e *reportedError) Unwrap() error { return e.err }
+
+// IsReported reports whether err has already been written to the output, so it
+// only needs to set the exit status.
+func IsReported(err error) bool {
+	var reported *reportedError
+	return errors.As(err, &reported)
+}
+
+// writeStructuredFailure is writeStructured for results that still fail the command, e.g. failed checks.
+// The data and the failure go out in a single envelope, and the returned error is marked as reported.
+func writeStructuredFailure(cmd *cobra.Command, kind string, data interface{}, failure error) (bool, error) {
+	format := GetOutputFormat(cmd)
+	if format == OutputTable {
+		return false, nil
+	}
+	if failure == nil {
+		return writeStructured(cmd, kind, data)
+	}
+
+	envelope := Envelope{
+		SchemaVersion: OutputSchemaVersion,
+		Kind:          kind,
+		Data:          data,
+		Error: &ErrorPayload{
+			Code:    ErrorCode(failure),
+			Message: failure.Error(),
+		},
+	}
+	if err := WriteEnvelope(cmd.OutOrStdout(), format, envelope); err != nil {
+		return true, err
+	}
+	return true, &reportedError{err: failure}
+}
+
+// writeStructuredFrom is writeStructured for commands whose result has to be read back
+// after the change; load only runs when a structured format is selected.
+func writeStructuredFrom(cmd *cobra.Command, kind string, load func() (interface{}, error)) (bool, error) {
+	if GetOutputFormat(cmd) == OutputTable {
+		return false, nil
+	}
+	data, err := load()
+	if err != nil {
+		return true, err
+	}
+	return writeStructured(cmd, kind, data)
+}
+
+// deletedOutput is the structured result of a delete command
+type deletedOutput struct {
+	Entity string `json:"entity"`
+	ID     string `json:"id"`
+}
+
+// writeDeleted renders the structured result of deleting an entity.
+// It returns false when the command should fall through to its table output.
+func writeDeleted(cmd *cobra.Command, entity, id string) (bool, error) {
+	return writeStructured(cmd, "deleted", deletedOutput{Entity: entity, ID: id})
+}
+
+// jsonToYAML re-encodes JSON as block-style YAML, preserving key order and json tag names.
+func jsonToYAML(data []byte) ([]byte, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	resetYAMLStyle(&node)
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resetYAMLStyle clears the flow/quoted styles inherited from the JSON source.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
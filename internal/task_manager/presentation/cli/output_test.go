@@ -0,0 +1,153 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Output format parsing
+// ============================================================================
+
+func TestParseOutputFormat(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    cli.OutputFormat
+		wantErr bool
+	}{
+		{"", cli.OutputTable, false},
+		{"table", cli.OutputTable, false},
+		{"json", cli.OutputJSON, false},
+		{"yaml", cli.OutputYAML, false},
+		{"xml", cli.OutputTable, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := cli.ParseOutputFormat(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tmerrors.ErrInvalidArgument)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetOutputFormat_DefaultsToTableWithoutFlag(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	assert.Equal(t, cli.OutputTable, cli.GetOutputFormat(cmd))
+}
+
+func TestGetOutputFormat_ReadsInheritedFlag(t *testing.T) {
+	root := &cobra.Command{Use: "root"}
+	root.PersistentFlags().String(cli.OutputFlagName, "table", "")
+	var got cli.OutputFormat
+	child := &cobra.Command{
+		Use: "child",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			got = cli.GetOutputFormat(cmd)
+			return nil
+		},
+	}
+	root.AddCommand(child)
+	root.SetArgs([]string{"child", "--output", "yaml"})
+
+	require.NoError(t, root.Execute())
+	assert.Equal(t, cli.OutputYAML, got)
+}
+
+// ============================================================================
+// Error codes
+// ============================================================================
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{fmt.Errorf("failed to get task: %w", tmerrors.ErrNotFound), cli.ErrorCodeNotFound},
+		{fmt.Errorf("%w: bad rank", tmerrors.ErrInvalidArgument), cli.ErrorCodeInvalidArgument},
+		{tmerrors.ErrAlreadyExists, cli.ErrorCodeAlreadyExists},
+		{tmerrors.ErrInternal, cli.ErrorCodeInternal},
+		{fmt.Errorf("%w: pre-hook vetoed", tmerrors.ErrRejected), cli.ErrorCodeRejected},
+		{fmt.Errorf("failed to update task: %w", tmerrors.ErrConflict), cli.ErrorCodeConflict},
+		{errors.New("boom"), cli.ErrorCodeUnknown},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, cli.ErrorCode(tt.err), tt.err.Error())
+	}
+}
+
+// ============================================================================
+// Envelope encoding
+// ============================================================================
+
+type sampleEntity struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+func TestWriteEnvelope_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	err := cli.WriteEnvelope(&buf, cli.OutputJSON, cli.Envelope{
+		SchemaVersion: cli.OutputSchemaVersion,
+		Kind:          "sample",
+		Data:          sampleEntity{ID: "TM-task-1", Title: "Hello"},
+	})
+	require.NoError(t, err)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, cli.OutputSchemaVersion, decoded["schema_version"])
+	assert.Equal(t, "sample", decoded["kind"])
+	assert.Equal(t, "TM-task-1", decoded["data"].(map[string]interface{})["id"])
+	assert.NotContains(t, decoded, "error")
+}
+
+func TestWriteEnvelope_YAMLUsesJSONTags(t *testing.T) {
+	var buf bytes.Buffer
+	err := cli.WriteEnvelope(&buf, cli.OutputYAML, cli.Envelope{
+		SchemaVersion: cli.OutputSchemaVersion,
+		Kind:          "sample",
+		Data:          sampleEntity{ID: "TM-task-1", Title: "123"},
+	})
+	require.NoError(t, err)
+
+	expected := `schema_version: tm/v1
+kind: sample
+data:
+  id: TM-task-1
+  title: "123"
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteError_Structured(t *testing.T) {
+	var buf bytes.Buffer
+	cli.WriteError(&buf, cli.OutputJSON, fmt.Errorf("failed to get task: %w", tmerrors.ErrNotFound))
+
+	var decoded cli.Envelope
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "error", decoded.Kind)
+	require.NotNil(t, decoded.Error)
+	assert.Equal(t, cli.ErrorCodeNotFound, decoded.Error.Code)
+	assert.Equal(t, "failed to get task: not found", decoded.Error.Message)
+}
+
+func TestWriteError_Table(t *testing.T) {
+	var buf bytes.Buffer
+	cli.WriteError(&buf, cli.OutputTable, errors.New("boom"))
+	assert.Equal(t, "Error: boom\n", buf.String())
+}
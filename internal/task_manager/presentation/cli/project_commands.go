@@ -1,24 +1,38 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"os"
 	"regexp"
 	"sort"
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 // Project name validation regex: alphanumeric + hyphens/underscores only
 var projectNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
 
+// ProjectCommandName is the name of the command group managing projects.
+// Its commands name projects themselves, so the selected project need not exist for them to run.
+const ProjectCommandName = "project"
+
+// ProjectCommandAliases are the alternative names of the project command group.
+var ProjectCommandAliases = []string{"proj", "p"}
+
 // NewProjectCommands creates and returns the project command group with all subcommands.
-func NewProjectCommands(projectService *application.ProjectApplicationService) *cobra.Command {
+func NewProjectCommands(projectService *application.ProjectApplicationService, snapshotService *application.SnapshotApplicationService) *cobra.Command {
 	projectCmd := &cobra.Command{
-		Use:     "project",
+		Use:     ProjectCommandName,
 		Short:   "Manage projects",
 		Long:    "Commands for managing multiple isolated project databases",
-		Aliases: []string{"proj", "p"},
+		Aliases: ProjectCommandAliases,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
 		},
@@ -30,7 +44,12 @@ func NewProjectCommands(projectService *application.ProjectApplicationService) *
 		newProjectListCommand(projectService),
 		newProjectShowCommand(projectService),
 		newProjectSwitchCommand(projectService),
-		newProjectDeleteCommand(projectService),
+		newProjectDeleteCommand(projectService, snapshotService),
+		newProjectExportCommand(projectService),
+		newProjectImportCommand(projectService),
+		newProjectBackupCommand(snapshotService),
+		newProjectSnapshotsCommand(snapshotService),
+		newProjectRestoreCommand(snapshotService),
 	)
 
 	return projectCmd
@@ -79,6 +98,10 @@ Optionally provide a project code for human-readable IDs (e.g., DW, PROD, TEST).
 				return fmt.Errorf("failed to create project: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "project", loadProjectInfo(provider, projectName)); ok {
+				return err
+			}
+
 			fmt.Fprintf(cmd.OutOrStdout(), "Project created successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  Name: %s\n", projectName)
 			fmt.Fprintf(cmd.OutOrStdout(), "  Project code: %s\n", generatedCode)
@@ -113,14 +136,19 @@ Shows project names and indicates which project is currently active.`,
 				return fmt.Errorf("failed to list projects: %w", err)
 			}
 
+			// Get active project
+			activeProject, _ := provider.GetActiveProject()
+
+			output := &projectListOutput{Projects: projects, Active: activeProject}
+			if ok, err := writeStructured(cmd, "project_list", output); ok {
+				return err
+			}
+
 			if len(projects) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No projects found\n")
 				return nil
 			}
 
-			// Get active project
-			activeProject, _ := provider.GetActiveProject()
-
 			fmt.Fprintf(cmd.OutOrStdout(), "Projects:\n\n")
 			for _, proj := range projects {
 				marker := "  "
@@ -141,6 +169,12 @@ Shows project names and indicates which project is currently active.`,
 	return cmd
 }
 
+// projectListOutput is the structured form of `project list`.
+type projectListOutput struct {
+	Projects []string `json:"projects"`
+	Active   string   `json:"active"`
+}
+
 // ============================================================================
 // project show command
 // ============================================================================
@@ -181,6 +215,11 @@ If no project name is provided, shows the currently active project.`,
 			// Get active project for comparison
 			activeProject, _ := provider.GetActiveProject()
 
+			output := &projectInfoOutput{Name: projectName, Active: projectName == activeProject, Info: info}
+			if ok, err := writeStructured(cmd, "project", output); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Active project: %s\n\n", projectName)
 
@@ -206,6 +245,26 @@ If no project name is provided, shows the currently active project.`,
 	return cmd
 }
 
+// projectInfoOutput is the structured form of `project show`.
+type projectInfoOutput struct {
+	Name   string            `json:"name"`
+	Active bool              `json:"active"`
+	Info   map[string]string `json:"info"`
+}
+
+// loadProjectInfo returns a loader of a project's info, for the structured output of
+// commands that create or switch projects
+func loadProjectInfo(provider *application.ProjectApplicationService, projectName string) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		info, err := provider.GetProjectInfo(projectName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project info: %w", err)
+		}
+		activeProject, _ := provider.GetActiveProject()
+		return &projectInfoOutput{Name: projectName, Active: projectName == activeProject, Info: info}, nil
+	}
+}
+
 // ============================================================================
 // project switch command
 // ============================================================================
@@ -228,6 +287,10 @@ You can override the active project on any command with the --project flag.`,
 				return fmt.Errorf("failed to switch project: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "project", loadProjectInfo(provider, projectName)); ok {
+				return err
+			}
+
 			fmt.Fprintf(cmd.OutOrStdout(), "Switched to project: %s\n", projectName)
 
 			return nil
@@ -241,13 +304,15 @@ You can override the active project on any command with the --project flag.`,
 // project delete command
 // ============================================================================
 
-func newProjectDeleteCommand(provider *application.ProjectApplicationService) *cobra.Command {
+func newProjectDeleteCommand(provider *application.ProjectApplicationService, snapshotService *application.SnapshotApplicationService) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete <project-name>",
 		Short: "Delete a project",
 		Long: `Deletes a project and its entire database.
 
-Warning: This operation is irreversible. All data for the project will be lost.`,
+Unless automatic snapshots are disabled (backups.before_destructive in
+~/.tm/config.yaml), the database is snapshotted first and the project can be
+brought back with 'tm project restore'.`,
 		Example: `  # Delete a project (with confirmation prompt)
   tm project delete myproject
 
@@ -259,24 +324,42 @@ Warning: This operation is irreversible. All data for the project will be lost.`
 			// Get force flag
 			force, _ := cmd.Flags().GetBool("force")
 
+			// Refuse before asking, and before snapshotting
+			if err := provider.ValidateProjectDeletion(projectName); err != nil {
+				return fmt.Errorf("failed to delete project: %w", err)
+			}
+
 			// Confirm deletion unless forced
 			if !force {
-				fmt.Fprintf(cmd.OutOrStdout(), "Are you sure you want to delete project '%s'? This cannot be undone.\n", projectName)
-				fmt.Fprintf(cmd.OutOrStdout(), "Type the project name to confirm: ")
+				// The prompt goes to stderr so structured output stays parseable
+				if snapshotService != nil && snapshotService.SnapshotsBeforeDestructive() {
+					fmt.Fprintf(cmd.ErrOrStderr(), "Are you sure you want to delete project '%s'? A snapshot is kept first; bring the project back with 'tm project restore <snapshot-id>'.\n", projectName)
+				} else {
+					fmt.Fprintf(cmd.ErrOrStderr(), "Are you sure you want to delete project '%s'? This cannot be undone.\n", projectName)
+				}
+				fmt.Fprintf(cmd.ErrOrStderr(), "Type the project name to confirm: ")
 
 				var confirmation string
 				_, err := fmt.Scanln(&confirmation)
 				if err != nil || confirmation != projectName {
-					fmt.Fprintf(cmd.OutOrStdout(), "Deletion cancelled\n")
+					fmt.Fprintf(cmd.ErrOrStderr(), "Deletion cancelled\n")
 					return nil
 				}
 			}
 
+			if err := snapshotBefore(cmd, snapshotService, projectName, entities.SnapshotReasonProjectDelete); err != nil {
+				return err
+			}
+
 			// Delete project via provider
 			if err := provider.DeleteProject(projectName); err != nil {
 				return fmt.Errorf("failed to delete project: %w", err)
 			}
 
+			if ok, err := writeDeleted(cmd, "project", projectName); ok {
+				return err
+			}
+
 			fmt.Fprintf(cmd.OutOrStdout(), "Project deleted successfully: %s\n", projectName)
 
 			return nil
@@ -287,3 +370,203 @@ Warning: This operation is irreversible. All data for the project will be lost.`
 
 	return cmd
 }
+
+// ============================================================================
+// project export command
+// ============================================================================
+
+func newProjectExportCommand(provider *application.ProjectApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <project-name>",
+		Short: "Export a project to a JSON or YAML document",
+		Long: `Exports the complete content of a project as a self-describing document:
+the roadmap, tracks and their dependencies, tasks and their blockers, iterations
+and their tasks, acceptance criteria, ADRs, documents and the task status history.
+
+The document is written to stdout unless --file is given, and can be turned back
+into a project with 'tm project import'.`,
+		Example: `  # Export a project as JSON
+  tm project export myproject > myproject.json
+
+  # Export a project as YAML into a file
+  tm project export myproject --format yaml --file myproject.yaml`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, _ := cmd.Flags().GetString("format")
+			file, _ := cmd.Flags().GetString("file")
+			if format != string(OutputJSON) && format != string(OutputYAML) {
+				return fmt.Errorf("%w: unsupported export format %q (expected json or yaml)", tmerrors.ErrInvalidArgument, format)
+			}
+
+			archive, err := provider.ExportProject(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to export project: %w", err)
+			}
+
+			data, err := encodeProjectArchive(archive, OutputFormat(format))
+			if err != nil {
+				return err
+			}
+			if file == "" {
+				_, err = cmd.OutOrStdout().Write(data)
+				return err
+			}
+			if err := os.WriteFile(file, data, 0644); err != nil {
+				return fmt.Errorf("failed to write export file: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Project exported successfully\n")
+			fmt.Fprintf(cmd.OutOrStdout(), "  Project: %s (%s)\n", archive.Project.Name, archive.Project.Code)
+			fmt.Fprintf(cmd.OutOrStdout(), "  File: %s\n", file)
+			fmt.Fprintf(cmd.OutOrStdout(), "  Tracks: %d, Tasks: %d, Iterations: %d, ACs: %d, ADRs: %d, Documents: %d\n",
+				len(archive.Tracks), len(archive.Tasks), len(archive.Iterations),
+				len(archive.AcceptanceCriteria), len(archive.ADRs), len(archive.Documents))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String("format", string(OutputJSON), "Document format: json or yaml")
+	cmd.Flags().String("file", "", "Write the document to this file instead of stdout")
+
+	return cmd
+}
+
+// encodeProjectArchive renders an archive as indented JSON or block-style YAML
+func encodeProjectArchive(archive *dto.ProjectArchiveDTO, format OutputFormat) ([]byte, error) {
+	data, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode project archive: %w", err)
+	}
+	if format == OutputYAML {
+		if data, err = jsonToYAML(data); err != nil {
+			return nil, fmt.Errorf("failed to encode project archive: %w", err)
+		}
+		return data, nil
+	}
+	return append(data, '\n'), nil
+}
+
+// decodeProjectArchive parses an archive written as JSON or YAML
+func decodeProjectArchive(data []byte) (*dto.ProjectArchiveDTO, error) {
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		// YAML goes through JSON so both formats share the json field names
+		var document interface{}
+		if err := yaml.Unmarshal(data, &document); err != nil {
+			return nil, fmt.Errorf("%w: failed to parse project archive: %v", tmerrors.ErrInvalidArgument, err)
+		}
+		converted, err := json.Marshal(document)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to parse project archive: %v", tmerrors.ErrInvalidArgument, err)
+		}
+		data = converted
+	}
+
+	var archive dto.ProjectArchiveDTO
+	if err := json.Unmarshal(data, &archive); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse project archive: %v", tmerrors.ErrInvalidArgument, err)
+	}
+	return &archive, nil
+}
+
+// ============================================================================
+// project import command
+// ============================================================================
+
+func newProjectImportCommand(provider *application.ProjectApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Create a project from an exported document",
+		Long: `Creates a new project from a document written by 'tm project export'.
+JSON and YAML documents are both accepted.
+
+Every entity is validated and every link between entities is checked before the
+project is created; use --dry-run to only run these checks.
+
+The project keeps its exported name unless --as is given. Entity IDs carry the
+project code (e.g., DW-task-12); when another project already uses that code,
+pass --code to rewrite all IDs to a new code (e.g., DW-task-12 -> NEW-task-12).
+Only ID fields and the links between entities are rewritten: IDs mentioned in
+text (task branches and descriptions, AC notes, document content) are imported
+unchanged and keep referring to the old code.
+
+Acceptance criteria check commands are run in a shell by 'tm ac run', so an
+archive that sets any is refused unless --allow-check-commands is given; --dry-run
+lists them for review.`,
+		Example: `  # Restore a project under its exported name
+  tm project import myproject.json
+
+  # Check a document without creating anything
+  tm project import myproject.yaml --dry-run
+
+  # Import a copy next to the original, remapping its IDs
+  tm project import myproject.json --as myproject-copy --code COPY
+
+  # Import a reviewed document whose ACs carry check commands
+  tm project import myproject.json --allow-check-commands`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectName, _ := cmd.Flags().GetString("as")
+			projectCode, _ := cmd.Flags().GetString("code")
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+			allowCheckCommands, _ := cmd.Flags().GetBool("allow-check-commands")
+
+			content, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read import file: %w", err)
+			}
+			archive, err := decodeProjectArchive(content)
+			if err != nil {
+				return err
+			}
+
+			result, err := provider.ImportProject(dto.ImportProjectDTO{
+				Archive:            archive,
+				ProjectName:        projectName,
+				ProjectCode:        projectCode,
+				DryRun:             dryRun,
+				AllowCheckCommands: allowCheckCommands,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to import project: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "project_import", result); ok {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if result.DryRun {
+				fmt.Fprintf(out, "Dry run: project archive is valid, nothing was imported\n")
+			} else {
+				fmt.Fprintf(out, "Project imported successfully\n")
+			}
+			fmt.Fprintf(out, "  Name: %s\n", result.ProjectName)
+			if result.Remapped {
+				fmt.Fprintf(out, "  Project code: %s (IDs remapped from %s; IDs mentioned in text are unchanged)\n", result.ProjectCode, result.SourceCode)
+			} else {
+				fmt.Fprintf(out, "  Project code: %s\n", result.ProjectCode)
+			}
+			fmt.Fprintf(out, "  Tracks: %d, Tasks: %d, Iterations: %d, ACs: %d, ADRs: %d, Documents: %d\n",
+				result.Tracks, result.Tasks, result.Iterations, result.AcceptanceCriteria, result.ADRs, result.Documents)
+			if len(result.CheckCommands) > 0 {
+				fmt.Fprintf(out, "  Check commands:\n")
+				for _, command := range result.CheckCommands {
+					fmt.Fprintf(out, "    %s: %s\n", command.ACID, command.Command)
+				}
+			}
+			if !result.DryRun {
+				fmt.Fprintf(out, "\nTo switch to this project, run:\n  tm project switch %s\n", result.ProjectName)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String("as", "", "Name of the new project (default: the exported project name)")
+	cmd.Flags().String("code", "", "Remap entity IDs to this project code (default: keep the exported code)")
+	cmd.Flags().Bool("dry-run", false, "Validate the document without creating the project")
+	cmd.Flags().Bool("allow-check-commands", false, "Import acceptance criteria that carry check commands")
+
+	return cmd
+}
@@ -0,0 +1,85 @@
+package cli_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupProjectArchiveTest returns a mock repository holding project "alpha" with one task
+func setupProjectArchiveTest(t *testing.T) (*mocks.MockProjectManagementRepository, time.Time) {
+	t.Helper()
+	now := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "Vision", "Criteria", now, now)
+	track, _ := entities.NewTrackEntity("AL-track-1", roadmap.ID, "Core", "", "in-progress", 100, nil, now, now)
+	task, _ := entities.NewTaskEntity("AL-task-1", track.ID, "Model", "Multi-line\ndescription", "todo", 100, "", now, now)
+
+	repo := mocks.NewMockProjectManagementRepository()
+	require.NoError(t, repo.CreateProject("alpha", "AL"))
+	repo.SetProjectData("alpha", &entities.ProjectData{
+		Code:    "AL",
+		Roadmap: roadmap,
+		Tracks:  []*entities.TrackEntity{track},
+		Tasks:   []*entities.TaskEntity{task},
+	})
+	return repo, now
+}
+
+// TestProjectExportImport_RoundTrip exports a project in each format and imports it under a new name and code
+func TestProjectExportImport_RoundTrip(t *testing.T) {
+	for _, format := range []string{"json", "yaml"} {
+		t.Run(format, func(t *testing.T) {
+			repo, now := setupProjectArchiveTest(t)
+			projectCmd := cli.NewProjectCommands(application.NewProjectService(repo, services.NewValidationService()), nil)
+			file := filepath.Join(t.TempDir(), "alpha."+format)
+
+			exportCmd := findCommand(projectCmd, "export")
+			require.NotNil(t, exportCmd)
+			exportCmd.SetOut(&bytes.Buffer{})
+			require.NoError(t, exportCmd.ParseFlags([]string{"--format", format, "--file", file}))
+			require.NoError(t, exportCmd.RunE(exportCmd, []string{"alpha"}))
+
+			var imported *entities.ProjectData
+			repo.ImportProjectDataFunc = func(projectName string, data *entities.ProjectData) error {
+				assert.Equal(t, "beta", projectName)
+				imported = data
+				return nil
+			}
+			importCmd := findCommand(projectCmd, "import")
+			require.NotNil(t, importCmd)
+			output := &bytes.Buffer{}
+			importCmd.SetOut(output)
+			require.NoError(t, importCmd.ParseFlags([]string{"--as", "beta", "--code", "BE"}))
+			require.NoError(t, importCmd.RunE(importCmd, []string{file}))
+
+			assert.Contains(t, output.String(), "Project imported successfully")
+			assert.Contains(t, output.String(), "IDs remapped from AL")
+			require.NotNil(t, imported)
+			require.Len(t, imported.Tasks, 1)
+			task := imported.Tasks[0]
+			assert.Equal(t, "BE-task-1", task.ID)
+			assert.Equal(t, "BE-track-1", task.TrackID)
+			assert.Equal(t, "Multi-line\ndescription", task.Description)
+			assert.True(t, now.Equal(task.CreatedAt), "timestamps should survive the round trip, got %v", task.CreatedAt)
+		})
+	}
+}
+
+// TestProjectExportCommand_RejectsUnknownFormat verifies that only json and yaml are exported
+func TestProjectExportCommand_RejectsUnknownFormat(t *testing.T) {
+	repo, _ := setupProjectArchiveTest(t)
+	exportCmd := findCommand(cli.NewProjectCommands(application.NewProjectService(repo, services.NewValidationService()), nil), "export")
+	require.NotNil(t, exportCmd)
+
+	require.NoError(t, exportCmd.ParseFlags([]string{"--format", "xml"}))
+	assert.Error(t, exportCmd.RunE(exportCmd, []string{"alpha"}))
+}
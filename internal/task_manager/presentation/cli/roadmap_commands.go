@@ -79,6 +79,10 @@ func newRoadmapInitCommand(roadmapService *application.RoadmapApplicationService
 				return fmt.Errorf("failed to create roadmap: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "roadmap", roadmap); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Roadmap created successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:                %s\n", roadmap.ID)
@@ -118,6 +122,10 @@ func newRoadmapShowCommand(roadmapService *application.RoadmapApplicationService
 				return fmt.Errorf("failed to get roadmap: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "roadmap", roadmap); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Roadmap:\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:                %s\n", roadmap.ID)
@@ -181,6 +189,10 @@ func newRoadmapUpdateCommand(roadmapService *application.RoadmapApplicationServi
 				return fmt.Errorf("failed to update roadmap: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "roadmap", roadmap); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Roadmap updated successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:                %s\n", roadmap.ID)
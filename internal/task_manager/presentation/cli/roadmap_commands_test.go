@@ -30,6 +30,7 @@ func TestNewRoadmapCommands(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	cmd := cli.NewRoadmapCommands(roadmapService)
@@ -72,6 +73,7 @@ func TestRoadmapInitCommand_Success(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	parentCmd := cli.NewRoadmapCommands(roadmapService)
@@ -129,6 +131,7 @@ func TestRoadmapInitCommand_MissingVision(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	parentCmd := cli.NewRoadmapCommands(roadmapService)
@@ -174,6 +177,7 @@ func TestRoadmapInitCommand_MissingSuccessCriteria(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	parentCmd := cli.NewRoadmapCommands(roadmapService)
@@ -241,6 +245,7 @@ func TestRoadmapShowCommand_Success(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	parentCmd := cli.NewRoadmapCommands(roadmapService)
@@ -314,6 +319,7 @@ func TestRoadmapUpdateCommand_UpdateVisionSuccess(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	parentCmd := cli.NewRoadmapCommands(roadmapService)
@@ -386,6 +392,7 @@ func TestRoadmapUpdateCommand_UpdateSuccessCriteria(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	parentCmd := cli.NewRoadmapCommands(roadmapService)
@@ -458,6 +465,7 @@ func TestRoadmapUpdateCommand_UpdateBothFields(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	parentCmd := cli.NewRoadmapCommands(roadmapService)
@@ -509,6 +517,7 @@ func TestRoadmapUpdateCommand_NoFieldsSet(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	parentCmd := cli.NewRoadmapCommands(roadmapService)
@@ -549,6 +558,7 @@ func TestRoadmapCommands_IntegrationFlow(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	rootCmd := cli.NewRoadmapCommands(roadmapService)
@@ -597,6 +607,7 @@ func TestRoadmapInitCommand_FlagValidation(t *testing.T) {
 		mockTaskRepo,
 		mockIterationRepo,
 		validationSvc,
+		nil,
 	)
 
 	parentCmd := cli.NewRoadmapCommands(roadmapService)
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// NewSearchCommand returns the search command for Cobra
+// ============================================================================
+
+// NewSearchCommand creates the search command that queries the full-text index.
+func NewSearchCommand(searchService *application.SearchApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search tasks, acceptance criteria, ADRs and documents",
+		Long: `Full-text search over task titles and descriptions, acceptance criteria and
+their testing instructions, ADR context and decisions, and document content.
+
+Every word of the query must match; a word ending in * matches as a prefix.
+Hits are ranked by relevance, with title matches weighing more than body matches,
+and shown with a snippet in which matched terms are wrapped in **.`,
+		Example: `  # Search everything
+  tm search login form
+
+  # Prefix search in ADRs only
+  tm search "auth*" --type adr
+
+  # Search tasks and ACs, at most 5 hits
+  tm search oauth --type task,ac --limit 5`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			filters := entities.SearchFilters{Query: strings.Join(args, " ")}
+			filters.EntityTypes, _ = cmd.Flags().GetStringSlice("type")
+			filters.Limit, _ = cmd.Flags().GetInt("limit")
+
+			hits, err := searchService.Search(ctx, filters)
+			if err != nil {
+				return fmt.Errorf("failed to search: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "search_hit_list", hits); ok {
+				return err
+			}
+
+			if len(hits) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No results found\n")
+				return nil
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%-10s %-20s %s\n", "Type", "ID", "Title")
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\n", strings.Repeat("-", 90))
+			for _, hit := range hits {
+				fmt.Fprintf(cmd.OutOrStdout(), "%-10s %-20s %s\n",
+					hit.EntityType,
+					truncateString(hit.EntityID, 20),
+					truncateString(hit.Title, 58),
+				)
+				fmt.Fprintf(cmd.OutOrStdout(), "    %s\n", strings.Join(strings.Fields(hit.Snippet), " "))
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "\nTotal: %d hit(s)\n", len(hits))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSlice("type", nil, "Only search these entity types: task, ac, adr, document (repeatable or comma-separated)")
+	cmd.Flags().Int("limit", application.DefaultSearchLimit, "Maximum number of hits")
+
+	return cmd
+}
@@ -0,0 +1,25 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSearchCommand_Structure verifies the search command's flags and arguments
+func TestSearchCommand_Structure(t *testing.T) {
+	searchCmd := cli.NewSearchCommand(nil)
+
+	assert.Equal(t, "search <query>", searchCmd.Use)
+	assert.NotEmpty(t, searchCmd.Short, "command should have short description")
+	assert.NotEmpty(t, searchCmd.Long, "command should have long description")
+	assert.Error(t, searchCmd.Args(searchCmd, []string{}), "search should require a query")
+	assert.NoError(t, searchCmd.Args(searchCmd, []string{"login", "form"}), "search should accept a multi-word query")
+
+	assert.NotNil(t, searchCmd.Flags().Lookup("type"), "--type flag should exist")
+	limit := searchCmd.Flags().Lookup("limit")
+	if assert.NotNil(t, limit, "--limit flag should exist") {
+		assert.Equal(t, "20", limit.DefValue)
+	}
+}
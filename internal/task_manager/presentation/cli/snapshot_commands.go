@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+// snapshotRestoreResult is the structured output of tm project restore
+type snapshotRestoreResult struct {
+	Restored *entities.ProjectSnapshot `json:"restored"`
+	Previous *entities.ProjectSnapshot `json:"previous,omitempty"` // Snapshot of the replaced database
+}
+
+// snapshotBefore takes the automatic snapshot of a project before a destructive command,
+// if automatic snapshots are enabled. An empty project name means the active project.
+// The notice goes to stderr so structured output stays parseable.
+func snapshotBefore(cmd *cobra.Command, snapshotService *application.SnapshotApplicationService, projectName, reason string) error {
+	if snapshotService == nil {
+		return nil
+	}
+	snapshot, err := snapshotService.SnapshotBefore(cmd.Context(), projectName, reason)
+	if err != nil {
+		return fmt.Errorf("failed to snapshot project (disable with backups.before_destructive: false): %w", err)
+	}
+	printSnapshotTaken(cmd, snapshot)
+	return nil
+}
+
+// printSnapshotTaken tells the user how to undo a destructive command, if a snapshot was taken.
+// The notice goes to stderr so structured output stays parseable.
+func printSnapshotTaken(cmd *cobra.Command, snapshot *entities.ProjectSnapshot) {
+	if snapshot != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Snapshot taken: %s (undo with 'tm project restore %s')\n", snapshot.ID, snapshot.ID)
+	}
+}
+
+// ============================================================================
+// project backup command
+// ============================================================================
+
+func newProjectBackupCommand(snapshotService *application.SnapshotApplicationService) *cobra.Command {
+	return &cobra.Command{
+		Use:   "backup [project-name]",
+		Short: "Snapshot a project database",
+		Long: `Writes a consistent copy of a project database (the active project by default)
+to .tm/backups/<project>/, named after the time it was taken. Snapshots are safe to
+take while other tm processes use the database and survive the project's deletion.
+
+Snapshots beyond the retention settings (backups.keep, backups.max_age in
+~/.tm/config.yaml) are removed.`,
+		Example: `  # Snapshot the active project
+  tm project backup
+
+  # Snapshot another project
+  tm project backup myproject`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectName := ""
+			if len(args) > 0 {
+				projectName = args[0]
+			}
+
+			snapshot, err := snapshotService.CreateSnapshot(cmd.Context(), projectName)
+			if err != nil {
+				return fmt.Errorf("failed to snapshot project: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "project_snapshot", snapshot); ok {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Snapshot taken: %s\n", snapshot.ID)
+			fmt.Fprintf(cmd.OutOrStdout(), "  File: %s\n", snapshot.Path)
+			return nil
+		},
+	}
+}
+
+// ============================================================================
+// project snapshots command
+// ============================================================================
+
+func newProjectSnapshotsCommand(snapshotService *application.SnapshotApplicationService) *cobra.Command {
+	return &cobra.Command{
+		Use:   "snapshots [project-name]",
+		Short: "List the snapshots of a project",
+		Long: `Lists the snapshots of a project (the active project by default), newest first:
+manual ones, the automatic ones taken before deletions, restores and schema
+migrations. Deleted projects can be listed by name.`,
+		Example: `  tm project snapshots
+  tm project snapshots myproject -o json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectName := ""
+			if len(args) > 0 {
+				projectName = args[0]
+			}
+
+			snapshots, err := snapshotService.ListSnapshots(cmd.Context(), projectName)
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "project_snapshot_list", snapshots); ok {
+				return err
+			}
+			if len(snapshots) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No snapshots found\n")
+				return nil
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%-50s %-22s %-20s %s\n", "ID", "Reason", "Taken", "Size")
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\n", strings.Repeat("-", 105))
+			for _, snapshot := range snapshots {
+				fmt.Fprintf(cmd.OutOrStdout(), "%-50s %-22s %-20s %d KB\n",
+					snapshot.ID, snapshot.Reason, snapshot.CreatedAt.Local().Format("2006-01-02 15:04:05"), (snapshot.Size+1023)/1024)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "\nTotal: %d snapshot(s)\n", len(snapshots))
+			return nil
+		},
+	}
+}
+
+// ============================================================================
+// project restore command
+// ============================================================================
+
+func newProjectRestoreCommand(snapshotService *application.SnapshotApplicationService) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <snapshot-id>",
+		Short: "Restore a project database from a snapshot",
+		Long: `Replaces the database of the snapshot's project with the snapshot, recreating the
+project if it was deleted. The database being replaced is snapshotted first, so
+the restore itself can be undone. The restore is safe while other tm processes
+use the database.
+
+A snapshot of an older schema is migrated the next time the project is opened.
+Snapshot IDs are listed by 'tm project snapshots'.`,
+		Example: `  tm project snapshots
+  tm project restore myproject/20250901T120000Z-pre-track-delete`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshot, previous, err := snapshotService.RestoreSnapshot(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("failed to restore snapshot: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "project_restore", snapshotRestoreResult{Restored: snapshot, Previous: previous}); ok {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Project %s restored from %s\n", snapshot.Project, snapshot.ID)
+			if previous != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Previous state saved as %s\n", previous.ID)
+			}
+			return nil
+		},
+	}
+}
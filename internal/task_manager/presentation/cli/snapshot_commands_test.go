@@ -0,0 +1,86 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProjectCommands_SnapshotSubcommands verifies the backup, snapshots and restore subcommands exist
+func TestProjectCommands_SnapshotSubcommands(t *testing.T) {
+	projectCmd := cli.NewProjectCommands(nil, nil)
+	for _, name := range []string{"backup", "snapshots", "restore"} {
+		assert.NotNil(t, findCommand(projectCmd, name), "missing subcommand %s", name)
+	}
+	restoreCmd := findCommand(projectCmd, "restore")
+	assert.Error(t, restoreCmd.Args(restoreCmd, []string{}))
+}
+
+// TestProjectBackupCommand_TakesManualSnapshot verifies backup snapshots the active project
+func TestProjectBackupCommand_TakesManualSnapshot(t *testing.T) {
+	repo := &mocks.MockSnapshotRepository{
+		CreateSnapshotFunc: func(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error) {
+			assert.Equal(t, "alpha", projectName)
+			assert.Equal(t, entities.SnapshotReasonManual, reason)
+			return &entities.ProjectSnapshot{ID: "alpha/20250301T093000Z-manual", Project: projectName, Reason: reason}, nil
+		},
+	}
+	snapshotService := application.NewSnapshotApplicationService(repo, entities.DefaultSnapshotPolicy(), "alpha")
+
+	backupCmd := findCommand(cli.NewProjectCommands(nil, snapshotService), "backup")
+	require.NotNil(t, backupCmd)
+	output := &bytes.Buffer{}
+	backupCmd.SetOut(output)
+	require.NoError(t, backupCmd.RunE(backupCmd, []string{}))
+
+	assert.Contains(t, output.String(), "Snapshot taken: alpha/20250301T093000Z-manual")
+}
+
+// TestTaskDeleteCommand_SnapshotsFirst verifies a task is deleted without --force and the snapshot
+// its service took is reported on stderr
+func TestTaskDeleteCommand_SnapshotsFirst(t *testing.T) {
+	var reasons []string
+	repo := &mocks.MockSnapshotRepository{
+		CreateSnapshotFunc: func(ctx context.Context, projectName, reason string) (*entities.ProjectSnapshot, error) {
+			reasons = append(reasons, reason)
+			return &entities.ProjectSnapshot{ID: "alpha/20250301T093000Z-" + reason, Project: projectName, Reason: reason}, nil
+		},
+	}
+	snapshotService := application.NewSnapshotApplicationService(repo, entities.DefaultSnapshotPolicy(), "alpha")
+	var deleted []string
+	taskRepo := &mocks.MockTaskRepository{
+		GetTaskFunc: func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+			return &entities.TaskEntity{ID: id}, nil
+		},
+		DeleteTaskFunc: func(ctx context.Context, id string) error {
+			assert.Len(t, reasons, len(deleted)+1, "the snapshot should be taken before the task is deleted")
+			deleted = append(deleted, id)
+			return nil
+		},
+	}
+	taskService := application.NewTaskApplicationService(taskRepo, nil, nil, &mocks.MockAcceptanceCriteriaRepository{}, nil, nil, nil, snapshotService)
+
+	deleteCmd := findCommand(cli.NewTaskCommands(taskService, nil, nil), "delete")
+	require.NotNil(t, deleteCmd)
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	deleteCmd.SetOut(stdout)
+	deleteCmd.SetErr(stderr)
+	require.NoError(t, deleteCmd.RunE(deleteCmd, []string{"AL-task-1"}))
+
+	assert.Equal(t, []string{"AL-task-1"}, deleted)
+	assert.Equal(t, []string{entities.SnapshotReasonTaskDelete}, reasons)
+	assert.Contains(t, stderr.String(), "Snapshot taken: alpha/20250301T093000Z-pre-task-delete")
+	assert.Contains(t, stdout.String(), "Task AL-task-1 deleted successfully")
+
+	// --force is deprecated but still accepted
+	require.NoError(t, deleteCmd.ParseFlags([]string{"--force"}))
+	require.NoError(t, deleteCmd.RunE(deleteCmd, []string{"AL-task-2"}))
+	assert.Equal(t, []string{"AL-task-1", "AL-task-2"}, deleted)
+}
@@ -0,0 +1,165 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+// statsRecentCycleTimes is the number of most recently completed tasks listed with their cycle time
+const statsRecentCycleTimes = 10
+
+// ============================================================================
+// NewStatsCommand returns the stats command for Cobra
+// ============================================================================
+
+// NewStatsCommand creates the stats command that shows velocity, burndown, cycle time and throughput.
+func NewStatsCommand(metricsService *application.MetricsApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show velocity, burndown, cycle time and throughput metrics",
+		Long: `Shows how work flows through the project, computed from the task status history:
+
+  Velocity     tasks done per started iteration
+  Burndown     remaining and done tasks per day of the current (or given) iteration
+  Cycle time   time from a task's first move to in-progress to done
+  Throughput   tasks done per track and week
+
+Trends are drawn as sparklines; use -o json for the full series.`,
+		Example: `  # Metrics with the burndown of the current iteration
+  tm stats
+
+  # Burndown of iteration 3, throughput over the last 12 weeks
+  tm stats --iteration 3 --weeks 12`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			iterationNum, _ := cmd.Flags().GetInt("iteration")
+			weeks, _ := cmd.Flags().GetInt("weeks")
+
+			metrics, err := metricsService.GetProjectMetrics(ctx, iterationNum, weeks)
+			if err != nil {
+				return fmt.Errorf("failed to compute metrics: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "project_metrics", metrics); ok {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Project Metrics\n")
+			fmt.Fprintf(out, "%s\n", strings.Repeat("=", 90))
+			printVelocity(cmd, metrics.Velocity)
+			printBurndown(cmd, metrics.Burndown)
+			printCycleTimes(cmd, metrics.CycleTimes, metrics.CycleTimeSummary)
+			printThroughput(cmd, metrics.Throughput, metrics.Weeks)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int("iteration", 0, "Iteration to show the burndown of (default: current iteration)")
+	cmd.Flags().Int("weeks", application.DefaultMetricsWeeks, "Number of weeks throughput covers")
+
+	return cmd
+}
+
+// printVelocity prints tasks done per iteration with a trend line
+func printVelocity(cmd *cobra.Command, velocity []entities.IterationVelocity) {
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "\nVelocity (tasks done per iteration)\n")
+	if len(velocity) == 0 {
+		fmt.Fprintf(out, "  No started iterations\n")
+		return
+	}
+
+	trend := make([]float64, 0, len(velocity))
+	total := 0
+	for _, v := range velocity {
+		trend = append(trend, float64(v.CompletedTasks))
+		total += v.CompletedTasks
+	}
+	fmt.Fprintf(out, "  %s  (average: %.1f)\n\n", sparkline(trend), float64(total)/float64(len(velocity)))
+
+	fmt.Fprintf(out, "  %-5s %-30s %-10s %-12s %s\n", "#", "Name", "Status", "Done/Plan", "Days")
+	for _, v := range velocity {
+		fmt.Fprintf(out, "  %-5d %-30s %-10s %-12s %.1f\n",
+			v.IterationNumber, truncateString(v.Name, 30), v.Status,
+			fmt.Sprintf("%d/%d", v.CompletedTasks, v.PlannedTasks), v.Days)
+	}
+}
+
+// printBurndown prints the day-by-day progress of an iteration
+func printBurndown(cmd *cobra.Command, burndown *entities.IterationBurndown) {
+	out := cmd.OutOrStdout()
+	if burndown == nil {
+		fmt.Fprintf(out, "\nBurndown\n  No iteration in progress\n")
+		return
+	}
+
+	fmt.Fprintf(out, "\nBurndown: Iteration %d - %s\n", burndown.IterationNumber, burndown.Name)
+	remaining := make([]float64, 0, len(burndown.Points))
+	completed := make([]float64, 0, len(burndown.Points))
+	for _, point := range burndown.Points {
+		remaining = append(remaining, float64(point.Remaining))
+		completed = append(completed, float64(point.Completed))
+	}
+	fmt.Fprintf(out, "  Remaining  %s\n", sparkline(remaining))
+	fmt.Fprintf(out, "  Done       %s\n\n", sparkline(completed))
+
+	fmt.Fprintf(out, "  %-12s %-7s %-7s %s\n", "Day", "Scope", "Done", "Remaining")
+	for _, point := range burndown.Points {
+		fmt.Fprintf(out, "  %-12s %-7d %-7d %d\n", point.Date.Format("2006-01-02"), point.Scope, point.Completed, point.Remaining)
+	}
+}
+
+// printCycleTimes prints the cycle time summary and the most recently completed tasks
+func printCycleTimes(cmd *cobra.Command, cycleTimes []entities.TaskCycleTime, summary entities.CycleTimeSummary) {
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "\nCycle Time (in-progress to done)\n")
+	if len(cycleTimes) == 0 {
+		fmt.Fprintf(out, "  No tasks done yet\n")
+		return
+	}
+
+	trend := make([]float64, 0, len(cycleTimes))
+	for _, cycleTime := range cycleTimes {
+		trend = append(trend, cycleTime.Hours)
+	}
+	fmt.Fprintf(out, "  %s  (%d task(s), average: %s, median: %s, max: %s)\n\n",
+		sparkline(trend), summary.Count, formatHours(summary.AverageHours), formatHours(summary.MedianHours), formatHours(summary.MaxHours))
+
+	recent := cycleTimes
+	if len(recent) > statsRecentCycleTimes {
+		recent = recent[len(recent)-statsRecentCycleTimes:]
+		fmt.Fprintf(out, "  %d most recent of %d:\n", statsRecentCycleTimes, len(cycleTimes))
+	}
+	fmt.Fprintf(out, "  %-20s %-35s %-12s %s\n", "Task", "Title", "Cycle Time", "Done")
+	for i := len(recent) - 1; i >= 0; i-- {
+		cycleTime := recent[i]
+		fmt.Fprintf(out, "  %-20s %-35s %-12s %s\n",
+			cycleTime.TaskID, truncateString(cycleTime.Title, 35), formatHours(cycleTime.Hours), cycleTime.DoneAt.Format("2006-01-02"))
+	}
+}
+
+// printThroughput prints tasks done per track and week
+func printThroughput(cmd *cobra.Command, throughput []entities.TrackThroughput, weeks int) {
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "\nThroughput (tasks done per week, last %d weeks)\n", weeks)
+	if len(throughput) == 0 {
+		fmt.Fprintf(out, "  No tracks\n")
+		return
+	}
+
+	fmt.Fprintf(out, "  %-20s %-30s %-*s %s\n", "Track", "Title", weeks+2, "Trend", "Total")
+	for _, track := range throughput {
+		trend := make([]float64, 0, len(track.Weekly))
+		for _, count := range track.Weekly {
+			trend = append(trend, float64(count))
+		}
+		fmt.Fprintf(out, "  %-20s %-30s %-*s %d\n", track.TrackID, truncateString(track.Title, 30), weeks+2, sparkline(trend), track.Total)
+	}
+}
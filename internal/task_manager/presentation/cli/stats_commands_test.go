@@ -0,0 +1,29 @@
+package cli_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestStatsCommand_Structure verifies the stats command's flags and arguments
+func TestStatsCommand_Structure(t *testing.T) {
+	statsCmd := cli.NewStatsCommand(nil)
+
+	assert.Equal(t, "stats", statsCmd.Use)
+	assert.NotEmpty(t, statsCmd.Short, "command should have short description")
+	assert.NotEmpty(t, statsCmd.Long, "command should have long description")
+	assert.Error(t, statsCmd.Args(statsCmd, []string{"extra"}), "stats should take no arguments")
+
+	iteration := statsCmd.Flags().Lookup("iteration")
+	if assert.NotNil(t, iteration, "--iteration flag should exist") {
+		assert.Equal(t, "0", iteration.DefValue, "the current iteration should be the default")
+	}
+	weeks := statsCmd.Flags().Lookup("weeks")
+	if assert.NotNil(t, weeks, "--weeks flag should exist") {
+		assert.Equal(t, strconv.Itoa(application.DefaultMetricsWeeks), weeks.DefValue)
+	}
+}
@@ -0,0 +1,225 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/spf13/cobra"
+)
+
+// DefaultSyncDirectory is the directory tm sync mirrors the project to, relative to the working directory
+const DefaultSyncDirectory = "roadmap"
+
+// ============================================================================
+// NewSyncCommands returns the sync command group for Cobra
+// ============================================================================
+
+// NewSyncCommands creates the sync command that mirrors the project to markdown files and back.
+// Without a subcommand it syncs both directions.
+func NewSyncCommands(syncService *application.SyncApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Mirror the project to markdown files and back",
+		Long: `Mirrors every entity to a markdown file with YAML front matter, so the roadmap
+can be committed, reviewed and merged with git:
+
+  roadmap.md                    tracks/<id>.md      tasks/<id>.md
+  iterations/<number>.md        adrs/<id>.md        documents/<id>.md
+  acceptance-criteria/<id>.md
+
+Scalar fields live in the front matter and the entity's text is the body; roadmaps
+and ADRs use "## " sections for their fields.
+
+Each run compares every file with the database and with the state both had at the
+last sync: edited files are imported, database changes are exported, deleted files
+delete their entity and vice versa. An entity changed on both sides is a conflict
+and is left alone unless --prefer picks a side. Before the first sync, the newer
+updated_at wins. Imported files are rewritten in canonical form.
+
+Imports are applied in one transaction: a failure leaves the database untouched.
+They publish the same events as the equivalent tm commands, so they are recorded
+in the history and run hooks, and pre-hooks can veto imported transitions. The
+project is snapshotted before deleted files delete their entities.
+
+An acceptance criterion file that sets a new check_command is listed with the
+command, and the import is refused unless --allow-check-commands is given:
+tm ac run runs check commands in a shell.
+
+Exits with an error while conflicts remain.`,
+		Example: `  # Sync both directions with ./roadmap
+  tm sync
+
+  # See what would change
+  tm sync status
+
+  # After a git merge, take the files' side of every conflict
+  tm sync --prefer files`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSync(cmd, syncService, true, true)
+		},
+	}
+	addSyncFlags(cmd, true)
+	addAllowCheckCommandsFlag(cmd)
+
+	cmd.AddCommand(newSyncStatusCommand(syncService))
+	cmd.AddCommand(newSyncImportCommand(syncService))
+	cmd.AddCommand(newSyncExportCommand(syncService))
+
+	return cmd
+}
+
+// ============================================================================
+// tm sync status
+// ============================================================================
+
+func newSyncStatusCommand(syncService *application.SyncApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show what a sync would change",
+		Long:  "Compares the sync directory with the database and lists the changes a sync would apply, without applying any.",
+		Example: `  tm sync status
+  tm sync status --dir docs/roadmap -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSync(cmd, syncService, true, true)
+		},
+	}
+	addSyncFlags(cmd, false)
+	return cmd
+}
+
+// ============================================================================
+// tm sync import
+// ============================================================================
+
+func newSyncImportCommand(syncService *application.SyncApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Apply file changes to the database",
+		Long: `Applies edited, added and deleted files to the database. Database changes are
+listed but not exported.
+
+Files that set a new acceptance criterion check_command are refused unless
+--allow-check-commands is given, since tm ac run runs the command in a shell.`,
+		Example: `  # Import after pulling
+  tm sync import
+
+  # Overwrite database changes that conflict with the files
+  tm sync import --prefer files
+
+  # Review the check commands a pull brings in, then accept them
+  tm sync import --dry-run
+  tm sync import --allow-check-commands`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSync(cmd, syncService, true, false)
+		},
+	}
+	addSyncFlags(cmd, true)
+	addAllowCheckCommandsFlag(cmd)
+	return cmd
+}
+
+// ============================================================================
+// tm sync export
+// ============================================================================
+
+func newSyncExportCommand(syncService *application.SyncApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Apply database changes to the files",
+		Long: `Writes entities changed, added and deleted in the database to the sync directory.
+File changes are listed but not imported.`,
+		Example: `  # Export before committing
+  tm sync export
+
+  # Overwrite file edits that conflict with the database
+  tm sync export --prefer database`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSync(cmd, syncService, false, true)
+		},
+	}
+	addSyncFlags(cmd, true)
+	return cmd
+}
+
+// addSyncFlags adds the flags shared by the sync commands; status commands only plan
+func addSyncFlags(cmd *cobra.Command, applies bool) {
+	cmd.Flags().String("dir", DefaultSyncDirectory, "Sync directory")
+	if applies {
+		cmd.Flags().String("prefer", "", "Resolve conflicts with the side given: files or database")
+		cmd.Flags().Bool("dry-run", false, "Show the changes without applying them")
+	}
+}
+
+// addAllowCheckCommandsFlag adds the flag accepting imported check commands to the importing commands
+func addAllowCheckCommandsFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("allow-check-commands", false, "Import acceptance criteria whose files set new check commands")
+}
+
+// runSync runs a sync in the enabled directions and prints the report
+func runSync(cmd *cobra.Command, syncService *application.SyncApplicationService, importChanges, exportChanges bool) error {
+	dir, _ := cmd.Flags().GetString("dir")
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("failed to resolve sync directory: %w", err)
+	}
+
+	input := dto.SyncDTO{
+		Directory: absDir,
+		Import:    importChanges,
+		Export:    exportChanges,
+		DryRun:    true,
+	}
+	if cmd.Flags().Lookup("dry-run") != nil {
+		input.DryRun, _ = cmd.Flags().GetBool("dry-run")
+		input.Prefer, _ = cmd.Flags().GetString("prefer")
+	}
+	if cmd.Flags().Lookup("allow-check-commands") != nil {
+		input.AllowCheckCommands, _ = cmd.Flags().GetBool("allow-check-commands")
+	}
+
+	report, err := syncService.Sync(cmd.Context(), input)
+	if err != nil {
+		return fmt.Errorf("failed to sync: %w", err)
+	}
+	if report.Snapshot != "" {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Snapshot taken: %s (undo with 'tm project restore %s')\n", report.Snapshot, report.Snapshot)
+	}
+
+	if ok, err := writeStructured(cmd, "sync_report", report); ok {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Sync directory: %s\n", report.Directory)
+	if len(report.Changes) == 0 {
+		fmt.Fprintf(out, "Everything in sync (%d entities)\n", report.Unchanged)
+		return nil
+	}
+
+	fmt.Fprintf(out, "\n%-10s %-8s %-8s %-40s %s\n", "ACTION", "OP", "STATUS", "PATH", "REASON")
+	for _, change := range report.Changes {
+		status := "applied"
+		switch {
+		case change.Action == dto.SyncActionConflict:
+			status = "open"
+		case !change.Applied:
+			status = "pending"
+		}
+		fmt.Fprintf(out, "%-10s %-8s %-8s %-40s %s\n", change.Action, change.Operation, status, change.Path, change.Reason)
+		if change.CheckCommand != "" {
+			fmt.Fprintf(out, "%-28s sets check command: %s\n", "", change.CheckCommand)
+		}
+	}
+	fmt.Fprintf(out, "\nTotal: %d change(s), %d conflict(s), %d unchanged\n", len(report.Changes), report.Conflicts, report.Unchanged)
+
+	if report.Conflicts > 0 {
+		return fmt.Errorf("%d sync conflict(s) remain; resolve them with --prefer files|database", report.Conflicts)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSyncCommands_Structure verifies the sync subcommands and their flags
+func TestSyncCommands_Structure(t *testing.T) {
+	syncCmd := cli.NewSyncCommands(nil)
+
+	assert.Equal(t, "sync", syncCmd.Use)
+	assert.NotEmpty(t, syncCmd.Long, "command should have long description")
+	assert.Error(t, syncCmd.Args(syncCmd, []string{"extra"}), "sync should take no arguments")
+
+	for _, cmd := range append([]*cobra.Command{syncCmd}, syncCmd.Commands()...) {
+		dir := cmd.Flags().Lookup("dir")
+		if assert.NotNil(t, dir, "%s should have --dir", cmd.Name()) {
+			assert.Equal(t, cli.DefaultSyncDirectory, dir.DefValue)
+		}
+	}
+
+	for _, name := range []string{"import", "export"} {
+		cmd := findCommand(syncCmd, name)
+		if assert.NotNil(t, cmd, "sync %s should exist", name) {
+			assert.NotNil(t, cmd.Flags().Lookup("prefer"), "sync %s should have --prefer", name)
+			assert.NotNil(t, cmd.Flags().Lookup("dry-run"), "sync %s should have --dry-run", name)
+		}
+	}
+
+	status := findCommand(syncCmd, "status")
+	if assert.NotNil(t, status, "sync status should exist") {
+		assert.Nil(t, status.Flags().Lookup("prefer"), "sync status only plans")
+		assert.Nil(t, status.Flags().Lookup("dry-run"), "sync status only plans")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -15,7 +16,7 @@ import (
 // ============================================================================
 
 // NewTaskCommands creates and returns the task command group with all subcommands.
-func NewTaskCommands(taskService *application.TaskApplicationService, acService *application.ACApplicationService) *cobra.Command {
+func NewTaskCommands(taskService *application.TaskApplicationService, acService *application.ACApplicationService, gitService *application.GitApplicationService) *cobra.Command {
 	taskCmd := &cobra.Command{
 		Use:     "task",
 		Short:   "Manage tasks",
@@ -30,12 +31,16 @@ func NewTaskCommands(taskService *application.TaskApplicationService, acService
 	taskCmd.AddCommand(
 		newTaskCreateCommand(taskService),
 		newTaskListCommand(taskService),
-		newTaskShowCommand(taskService),
+		newTaskShowCommand(taskService, gitService),
 		newTaskUpdateCommand(taskService),
 		newTaskDeleteCommand(taskService),
 		newTaskMoveCommand(taskService),
+		newTaskBlockCommand(taskService),
+		newTaskUnblockCommand(taskService),
 		newTaskBacklogCommand(taskService),
 		newTaskCheckReadyCommand(taskService, acService),
+		newTaskStartCommand(gitService),
+		newTaskCommitsCommand(gitService),
 	)
 
 	return taskCmd
@@ -49,12 +54,18 @@ func newTaskCreateCommand(taskService *application.TaskApplicationService) *cobr
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new task in a track",
-		Long:  `Creates a new task within the specified track with optional description, rank, and branch name.`,
+		Long:  `Creates a new task within the specified track with optional description, rank, branch name, and estimate.`,
 		Example: `  # Create a simple task
   tm task create --track TM-track-1 --title "Implement login"
 
   # Create task with description and rank
-  tm task create --track TM-track-1 --title "Add tests" --description "Unit tests for auth" --rank 300`,
+  tm task create --track TM-track-1 --title "Add tests" --description "Unit tests for auth" --rank 300
+
+  # Create task linked to an existing git branch
+  tm task create --track TM-track-1 --title "Fix login" --branch fix/login
+
+  # Create task with an estimate (story points or ideal hours)
+  tm task create --track TM-track-1 --title "Add OAuth" --estimate 5`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
@@ -64,6 +75,7 @@ func newTaskCreateCommand(taskService *application.TaskApplicationService) *cobr
 			description, _ := cmd.Flags().GetString("description")
 			rank, _ := cmd.Flags().GetInt("rank")
 			branch, _ := cmd.Flags().GetString("branch")
+			estimate, _ := cmd.Flags().GetFloat64("estimate")
 
 			// Validate required flags
 			if trackID == "" {
@@ -80,14 +92,21 @@ func newTaskCreateCommand(taskService *application.TaskApplicationService) *cobr
 				Description: description,
 				Status:      "todo",
 				Rank:        rank,
+				Branch:      branch,
+			}
+			if cmd.Flags().Changed("estimate") {
+				input.Estimate = &estimate
 			}
-			_ = branch // Branch field not in DTO, reserved for future use
 
 			task, err := taskService.CreateTask(ctx, input)
 			if err != nil {
 				return fmt.Errorf("failed to create task: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "task", task); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Task created successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:          %s\n", task.ID)
@@ -101,6 +120,9 @@ func newTaskCreateCommand(taskService *application.TaskApplicationService) *cobr
 			if task.Branch != "" {
 				fmt.Fprintf(cmd.OutOrStdout(), "  Branch:      %s\n", task.Branch)
 			}
+			if task.Estimate != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "  Estimate:    %s\n", formatEstimate(*task.Estimate))
+			}
 
 			return nil
 		},
@@ -111,6 +133,7 @@ func newTaskCreateCommand(taskService *application.TaskApplicationService) *cobr
 	cmd.Flags().String("description", "", "Task description (optional)")
 	cmd.Flags().Int("rank", 500, "Task rank (1-1000, default: 500)")
 	cmd.Flags().String("branch", "", "Git branch name (optional)")
+	cmd.Flags().Float64("estimate", 0, "Task size in story points or ideal hours (optional)")
 
 	cmd.MarkFlagRequired("track")
 	cmd.MarkFlagRequired("title")
@@ -126,7 +149,7 @@ func newTaskListCommand(taskService *application.TaskApplicationService) *cobra.
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all tasks with optional filtering",
-		Long:  `Lists all tasks with optional filtering by track or status.`,
+		Long:  `Lists all tasks with optional filtering by track or status. With --ready, only tasks that are not done and not blocked by unfinished tasks are listed.`,
 		Example: `  # List all tasks
   tm task list
 
@@ -136,6 +159,9 @@ func newTaskListCommand(taskService *application.TaskApplicationService) *cobra.
   # List tasks with specific status
   tm task list --status todo
 
+  # List tasks that can be picked up now
+  tm task list --ready --status todo
+
   # Combine filters
   tm task list --track TM-track-1 --status in-progress`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -144,10 +170,12 @@ func newTaskListCommand(taskService *application.TaskApplicationService) *cobra.
 			// Get flags
 			trackID, _ := cmd.Flags().GetString("track")
 			status, _ := cmd.Flags().GetString("status")
+			ready, _ := cmd.Flags().GetBool("ready")
 
 			// Build filters
 			filters := entities.TaskFilters{
 				TrackID: trackID,
+				Ready:   ready,
 			}
 			if status != "" {
 				filters.Status = []string{status}
@@ -159,6 +187,10 @@ func newTaskListCommand(taskService *application.TaskApplicationService) *cobra.
 				return fmt.Errorf("failed to list tasks: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "task_list", tasks); ok {
+				return err
+			}
+
 			// Format output
 			if len(tasks) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No tasks found\n")
@@ -176,10 +208,14 @@ func newTaskListCommand(taskService *application.TaskApplicationService) *cobra.
 
 			// Print tasks
 			for _, task := range tasks {
+				status := task.Status
+				if task.IsBlocked() {
+					status += " (blocked)"
+				}
 				fmt.Fprintf(cmd.OutOrStdout(), "%-15s %-20s %-15s %-40s\n",
 					task.ID,
 					task.TrackID,
-					task.Status,
+					status,
 					truncateString(task.Title, 40),
 				)
 			}
@@ -191,6 +227,7 @@ func newTaskListCommand(taskService *application.TaskApplicationService) *cobra.
 
 	cmd.Flags().String("track", "", "Filter by parent track ID (optional)")
 	cmd.Flags().String("status", "", "Filter by status: todo, in-progress, review, done (optional)")
+	cmd.Flags().Bool("ready", false, "Only list tasks that are not done and not blocked by unfinished tasks")
 
 	return cmd
 }
@@ -199,11 +236,11 @@ func newTaskListCommand(taskService *application.TaskApplicationService) *cobra.
 // task show command
 // ============================================================================
 
-func newTaskShowCommand(taskService *application.TaskApplicationService) *cobra.Command {
+func newTaskShowCommand(taskService *application.TaskApplicationService, gitService *application.GitApplicationService) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show <task-id>",
 		Short: "Show details of a specific task",
-		Long:  `Displays detailed information about a specific task including its status, rank, and metadata.`,
+		Long:  `Displays detailed information about a specific task including its status, rank, metadata and the git commits mentioning it.`,
 		Example: `  # Show task details
   tm task show TM-task-1`,
 		Args: cobra.ExactArgs(1),
@@ -217,6 +254,10 @@ func newTaskShowCommand(taskService *application.TaskApplicationService) *cobra.
 				return fmt.Errorf("failed to get task: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "task", task); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Task Details\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "============\n")
@@ -229,9 +270,45 @@ func newTaskShowCommand(taskService *application.TaskApplicationService) *cobra.
 			if task.Branch != "" {
 				fmt.Fprintf(cmd.OutOrStdout(), "  Branch:      %s\n", task.Branch)
 			}
+			if task.Estimate != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "  Estimate:    %s\n", formatEstimate(*task.Estimate))
+			}
 			fmt.Fprintf(cmd.OutOrStdout(), "  Created:     %s\n", task.CreatedAt.Format("2006-01-02 15:04:05 UTC"))
 			fmt.Fprintf(cmd.OutOrStdout(), "  Updated:     %s\n", task.UpdatedAt.Format("2006-01-02 15:04:05 UTC"))
 
+			// Show dependencies in both directions
+			if len(task.BlockedBy) > 0 {
+				unfinished := make(map[string]bool, len(task.UnfinishedBlockers))
+				for _, id := range task.UnfinishedBlockers {
+					unfinished[id] = true
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "\nBlocked By:\n")
+				for _, id := range task.BlockedBy {
+					state := "done"
+					if unfinished[id] {
+						state = "not done"
+					}
+					fmt.Fprintf(cmd.OutOrStdout(), "  - %s (%s)\n", id, state)
+				}
+			}
+			if blocks, err := taskService.GetBlockedTasks(ctx, task.ID); err == nil && len(blocks) > 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "\nBlocks:\n")
+				for _, id := range blocks {
+					fmt.Fprintf(cmd.OutOrStdout(), "  - %s\n", id)
+				}
+			}
+
+			// The code trail is best effort: outside a git repository there is simply none
+			if gitService != nil {
+				if commits, err := gitService.ListTaskCommits(ctx, task.ID); err == nil && len(commits) > 0 {
+					fmt.Fprintf(cmd.OutOrStdout(), "\nCommits\n")
+					fmt.Fprintf(cmd.OutOrStdout(), "=======\n")
+					for _, commit := range commits {
+						fmt.Fprintf(cmd.OutOrStdout(), "  %s %s %s\n", commit.ShortHash(), commit.Date.Local().Format("2006-01-02"), truncateString(commit.Subject, 60))
+					}
+				}
+			}
+
 			return nil
 		},
 	}
@@ -255,7 +332,14 @@ func newTaskUpdateCommand(taskService *application.TaskApplicationService) *cobr
   tm task update TM-task-1 --status in-progress
 
   # Update multiple fields
-  tm task update TM-task-1 --title "New Title" --status done --rank 100`,
+  tm task update TM-task-1 --title "New Title" --status done --rank 100
+
+  # Link a git branch
+  tm task update TM-task-1 --branch feature/login
+
+  # Set or clear the estimate
+  tm task update TM-task-1 --estimate 3
+  tm task update TM-task-1 --estimate 0`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -266,10 +350,12 @@ func newTaskUpdateCommand(taskService *application.TaskApplicationService) *cobr
 			descSet := cmd.Flags().Changed("description")
 			statusSet := cmd.Flags().Changed("status")
 			rankSet := cmd.Flags().Changed("rank")
+			branchSet := cmd.Flags().Changed("branch")
+			estimateSet := cmd.Flags().Changed("estimate")
 
 			// Check that at least one field is being updated
-			if !titleSet && !descSet && !statusSet && !rankSet {
-				return fmt.Errorf("at least one field must be specified to update (--title, --description, --status, or --rank)")
+			if !titleSet && !descSet && !statusSet && !rankSet && !branchSet && !estimateSet {
+				return fmt.Errorf("at least one field must be specified to update (--title, --description, --status, --rank, --branch, or --estimate)")
 			}
 
 			// Get flag values
@@ -277,6 +363,8 @@ func newTaskUpdateCommand(taskService *application.TaskApplicationService) *cobr
 			description, _ := cmd.Flags().GetString("description")
 			status, _ := cmd.Flags().GetString("status")
 			rank, _ := cmd.Flags().GetInt("rank")
+			branch, _ := cmd.Flags().GetString("branch")
+			estimate, _ := cmd.Flags().GetFloat64("estimate")
 
 			// Create DTO with only updated fields
 			input := dto.UpdateTaskDTO{
@@ -295,13 +383,27 @@ func newTaskUpdateCommand(taskService *application.TaskApplicationService) *cobr
 			if rankSet {
 				input.Rank = &rank
 			}
+			if branchSet {
+				input.Branch = &branch
+			}
+			if estimateSet {
+				input.Estimate = &estimate
+			}
 
 			// Execute via application service
-			task, err := taskService.UpdateTask(ctx, input)
+			var task *entities.TaskEntity
+			err := tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() (err error) {
+				task, err = taskService.UpdateTask(ctx, input)
+				return err
+			})
 			if err != nil {
 				return fmt.Errorf("failed to update task: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "task", task); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Task updated successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:          %s\n", task.ID)
@@ -315,6 +417,9 @@ func newTaskUpdateCommand(taskService *application.TaskApplicationService) *cobr
 			if task.Branch != "" {
 				fmt.Fprintf(cmd.OutOrStdout(), "  Branch:      %s\n", task.Branch)
 			}
+			if task.Estimate != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "  Estimate:    %s\n", formatEstimate(*task.Estimate))
+			}
 
 			return nil
 		},
@@ -324,6 +429,8 @@ func newTaskUpdateCommand(taskService *application.TaskApplicationService) *cobr
 	cmd.Flags().String("description", "", "New task description")
 	cmd.Flags().String("status", "", "New task status (todo, in-progress, review, done)")
 	cmd.Flags().Int("rank", 0, "New task rank (1-1000)")
+	cmd.Flags().String("branch", "", "Git branch name (empty to unlink)")
+	cmd.Flags().Float64("estimate", 0, "Task size in story points or ideal hours (0 to clear)")
 
 	return cmd
 }
@@ -336,19 +443,26 @@ func newTaskDeleteCommand(taskService *application.TaskApplicationService) *cobr
 	cmd := &cobra.Command{
 		Use:   "delete <task-id>",
 		Short: "Delete a task",
-		Long:  `Deletes a task and removes it from any iterations it belongs to.`,
+		Long: `Deletes a task and removes it from any iterations it belongs to. The project is
+snapshotted first (see 'tm project snapshots') unless automatic snapshots are disabled.`,
 		Example: `  # Delete a task
-  tm task delete TM-task-1 --force`,
+  tm task delete TM-task-1`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			taskID := args[0]
 
-			// Execute via application service
-			if err := taskService.DeleteTask(ctx, taskID); err != nil {
+			// Execute via application service, which snapshots the project first
+			snapshot, err := taskService.DeleteTask(ctx, taskID)
+			printSnapshotTaken(cmd, snapshot)
+			if err != nil {
 				return fmt.Errorf("failed to delete task: %w", err)
 			}
 
+			if ok, err := writeDeleted(cmd, "task", taskID); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Task %s deleted successfully\n", taskID)
 
@@ -356,7 +470,9 @@ func newTaskDeleteCommand(taskService *application.TaskApplicationService) *cobr
 		},
 	}
 
+	// Deletion never asked for confirmation; the flag is kept so existing scripts keep working
 	cmd.Flags().Bool("force", false, "Force deletion without confirmation")
+	_ = cmd.Flags().MarkDeprecated("force", "tasks are deleted without confirmation")
 
 	return cmd
 }
@@ -390,6 +506,12 @@ func newTaskMoveCommand(taskService *application.TaskApplicationService) *cobra.
 				return fmt.Errorf("failed to move task: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "task", func() (interface{}, error) {
+				return taskService.GetTask(ctx, taskID)
+			}); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Task %s moved to track %s successfully\n", taskID, newTrackID)
 
@@ -403,6 +525,85 @@ func newTaskMoveCommand(taskService *application.TaskApplicationService) *cobra.
 	return cmd
 }
 
+// ============================================================================
+// task block command
+// ============================================================================
+
+func newTaskBlockCommand(taskService *application.TaskApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block <task-id> <blocked-by-id>",
+		Short: "Mark a task as blocked by another task",
+		Long:  `Records that <task-id> cannot be worked on until <blocked-by-id> is done. The task counts as blocked while any of its blockers is not done, and is left out of 'tm task list --ready'. Circular dependencies are automatically detected and prevented.`,
+		Example: `  # TM-task-2 needs TM-task-1 to be done first
+  tm task block TM-task-2 TM-task-1`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			// Execute via application service
+			task, err := taskService.BlockTask(ctx, args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("failed to block task: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "task", task); ok {
+				return err
+			}
+
+			// Format output
+			fmt.Fprintf(cmd.OutOrStdout(), "Dependency added successfully\n")
+			fmt.Fprintf(cmd.OutOrStdout(), "  %s is blocked by %s\n", task.ID, args[1])
+			if !task.IsBlocked() {
+				fmt.Fprintf(cmd.OutOrStdout(), "  All blockers are done; %s is ready\n", task.ID)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// ============================================================================
+// task unblock command
+// ============================================================================
+
+func newTaskUnblockCommand(taskService *application.TaskApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unblock <task-id> <blocked-by-id>",
+		Short: "Remove a blocked-by link between tasks",
+		Long:  `Removes the link recording that <task-id> is blocked by <blocked-by-id>.`,
+		Example: `  # TM-task-2 no longer waits for TM-task-1
+  tm task unblock TM-task-2 TM-task-1`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			// Execute via application service
+			task, err := taskService.UnblockTask(ctx, args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("failed to unblock task: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "task", task); ok {
+				return err
+			}
+
+			// Format output
+			fmt.Fprintf(cmd.OutOrStdout(), "Dependency removed successfully\n")
+			if task.IsBlocked() {
+				fmt.Fprintf(cmd.OutOrStdout(), "  %s\n", task.GetBlockReason())
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "  %s is no longer blocked\n", task.ID)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 // ============================================================================
 // task backlog command
 // ============================================================================
@@ -423,6 +624,10 @@ func newTaskBacklogCommand(taskService *application.TaskApplicationService) *cob
 				return fmt.Errorf("failed to get backlog tasks: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "task_list", tasks); ok {
+				return err
+			}
+
 			// Format output
 			if len(tasks) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No backlog tasks found\n")
@@ -483,6 +688,10 @@ func newTaskCheckReadyCommand(taskService *application.TaskApplicationService, a
 				return fmt.Errorf("failed to get acceptance criteria: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "task_readiness", newTaskReadinessOutput(task, acs)); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Task: %s\n", task.Title)
 			fmt.Fprintf(cmd.OutOrStdout(), "Task ID: %s\n", task.ID)
@@ -534,3 +743,121 @@ func newTaskCheckReadyCommand(taskService *application.TaskApplicationService, a
 
 	return cmd
 }
+
+// taskReadinessOutput is the structured result of 'tm task check-ready'
+type taskReadinessOutput struct {
+	TaskID             string                               `json:"task_id"`
+	Ready              bool                                 `json:"ready"`
+	Verified           int                                  `json:"verified"`
+	Total              int                                  `json:"total"`
+	AcceptanceCriteria []*entities.AcceptanceCriteriaEntity `json:"acceptance_criteria"`
+}
+
+// newTaskReadinessOutput counts the verified ACs of a task the way the table output does
+func newTaskReadinessOutput(task *entities.TaskEntity, acs []*entities.AcceptanceCriteriaEntity) taskReadinessOutput {
+	output := taskReadinessOutput{TaskID: task.ID, Total: len(acs), AcceptanceCriteria: acs}
+	for _, ac := range acs {
+		if ac.Status == entities.ACStatusVerified {
+			output.Verified++
+		}
+	}
+	if output.AcceptanceCriteria == nil {
+		output.AcceptanceCriteria = []*entities.AcceptanceCriteriaEntity{}
+	}
+	output.Ready = output.Verified == output.Total
+	return output
+}
+
+// ============================================================================
+// task start command
+// ============================================================================
+
+func newTaskStartCommand(gitService *application.GitApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start <task-id>",
+		Short: "Check out a git branch for a task and start working on it",
+		Long: `Creates and checks out a git branch for the task in the current repository,
+records it on the task and moves a todo task to in-progress.
+
+The branch is named from the task ID and title (e.g. TM-task-12-implement-login).
+If the task already has a branch, that branch is checked out instead.`,
+		Example: `  # Start working on a task
+  tm task start TM-task-12`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			task, created, err := gitService.StartTask(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to start task: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "task", task); ok {
+				return err
+			}
+
+			if created {
+				fmt.Fprintf(cmd.OutOrStdout(), "Created and checked out branch %s\n", task.Branch)
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "Checked out existing branch %s\n", task.Branch)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "  ID:          %s\n", task.ID)
+			fmt.Fprintf(cmd.OutOrStdout(), "  Title:       %s\n", task.Title)
+			fmt.Fprintf(cmd.OutOrStdout(), "  Status:      %s\n", task.Status)
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// ============================================================================
+// task commits command
+// ============================================================================
+
+func newTaskCommitsCommand(gitService *application.GitApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commits <task-id>",
+		Short: "List git commits that mention a task",
+		Long: `Scans the local git history (all local branches) for commits whose message
+mentions the task ID, newest first.`,
+		Example: `  # Show the code trail of a task
+  tm task commits TM-task-12`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			taskID := args[0]
+
+			commits, err := gitService.ListTaskCommits(ctx, taskID)
+			if err != nil {
+				return fmt.Errorf("failed to list commits: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "commit_list", commits); ok {
+				return err
+			}
+
+			if len(commits) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No commits found for task %s\n", taskID)
+				return nil
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%-8s %-10s %-20s %s\n", "Commit", "Date", "Author", "Subject")
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\n", strings.Repeat("-", 90))
+			for _, commit := range commits {
+				fmt.Fprintf(cmd.OutOrStdout(), "%-8s %-10s %-20s %s\n",
+					commit.ShortHash(),
+					commit.Date.Local().Format("2006-01-02"),
+					truncateString(commit.Author, 20),
+					truncateString(commit.Subject, 50),
+				)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "\nTotal: %d commit(s)\n", len(commits))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
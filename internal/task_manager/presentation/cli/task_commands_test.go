@@ -13,7 +13,7 @@ import (
 
 // TestNewTaskCommands verifies that NewTaskCommands returns a valid Cobra command group
 func TestNewTaskCommands_Structure(t *testing.T) {
-	taskCommands := cli.NewTaskCommands(nil, nil)
+	taskCommands := cli.NewTaskCommands(nil, nil, nil)
 
 	assert.NotNil(t, taskCommands, "NewTaskCommands should return a command group")
 	assert.Equal(t, "task", taskCommands.Name(), "command name should be 'task'")
@@ -21,9 +21,9 @@ func TestNewTaskCommands_Structure(t *testing.T) {
 	assert.NotEmpty(t, taskCommands.Long, "command should have long description")
 }
 
-// TestTaskCommands_AllSubcommands verifies all 8 subcommands are present
+// TestTaskCommands_AllSubcommands verifies all 10 subcommands are present
 func TestTaskCommands_AllSubcommands(t *testing.T) {
-	taskCommands := cli.NewTaskCommands(nil, nil)
+	taskCommands := cli.NewTaskCommands(nil, nil, nil)
 
 	expectedSubcommands := []string{
 		"create",
@@ -32,8 +32,12 @@ func TestTaskCommands_AllSubcommands(t *testing.T) {
 		"update",
 		"delete",
 		"move",
+		"block",
+		"unblock",
 		"backlog",
 		"check-ready",
+		"start",
+		"commits",
 	}
 
 	commandNames := make(map[string]bool)
@@ -48,7 +52,7 @@ func TestTaskCommands_AllSubcommands(t *testing.T) {
 
 // TestTaskCreateCommand_Flags verifies create command has required flags
 func TestTaskCreateCommand_Flags(t *testing.T) {
-	taskCommands := cli.NewTaskCommands(nil, nil)
+	taskCommands := cli.NewTaskCommands(nil, nil, nil)
 	createCmd := findCommand(taskCommands, "create")
 
 	assert.NotNil(t, createCmd, "create command should exist")
@@ -57,21 +61,23 @@ func TestTaskCreateCommand_Flags(t *testing.T) {
 	assert.NotNil(t, createCmd.Flags().Lookup("description"), "--description flag should exist")
 	assert.NotNil(t, createCmd.Flags().Lookup("rank"), "--rank flag should exist")
 	assert.NotNil(t, createCmd.Flags().Lookup("branch"), "--branch flag should exist")
+	assert.NotNil(t, createCmd.Flags().Lookup("estimate"), "--estimate flag should exist")
 }
 
 // TestTaskListCommand_Flags verifies list command has filter flags
 func TestTaskListCommand_Flags(t *testing.T) {
-	taskCommands := cli.NewTaskCommands(nil, nil)
+	taskCommands := cli.NewTaskCommands(nil, nil, nil)
 	listCmd := findCommand(taskCommands, "list")
 
 	assert.NotNil(t, listCmd, "list command should exist")
 	assert.NotNil(t, listCmd.Flags().Lookup("track"), "--track flag should exist")
 	assert.NotNil(t, listCmd.Flags().Lookup("status"), "--status flag should exist")
+	assert.NotNil(t, listCmd.Flags().Lookup("ready"), "--ready flag should exist")
 }
 
 // TestTaskShowCommand_Arguments verifies show command requires task ID
 func TestTaskShowCommand_Arguments(t *testing.T) {
-	taskCommands := cli.NewTaskCommands(nil, nil)
+	taskCommands := cli.NewTaskCommands(nil, nil, nil)
 	showCmd := findCommand(taskCommands, "show")
 
 	assert.NotNil(t, showCmd, "show command should exist")
@@ -81,7 +87,7 @@ func TestTaskShowCommand_Arguments(t *testing.T) {
 
 // TestTaskUpdateCommand_Flags verifies update command has optional field flags
 func TestTaskUpdateCommand_Flags(t *testing.T) {
-	taskCommands := cli.NewTaskCommands(nil, nil)
+	taskCommands := cli.NewTaskCommands(nil, nil, nil)
 	updateCmd := findCommand(taskCommands, "update")
 
 	assert.NotNil(t, updateCmd, "update command should exist")
@@ -89,11 +95,13 @@ func TestTaskUpdateCommand_Flags(t *testing.T) {
 	assert.NotNil(t, updateCmd.Flags().Lookup("description"), "--description flag should exist")
 	assert.NotNil(t, updateCmd.Flags().Lookup("status"), "--status flag should exist")
 	assert.NotNil(t, updateCmd.Flags().Lookup("rank"), "--rank flag should exist")
+	assert.NotNil(t, updateCmd.Flags().Lookup("branch"), "--branch flag should exist")
+	assert.NotNil(t, updateCmd.Flags().Lookup("estimate"), "--estimate flag should exist")
 }
 
 // TestTaskDeleteCommand_Arguments verifies delete command requires task ID
 func TestTaskDeleteCommand_Arguments(t *testing.T) {
-	taskCommands := cli.NewTaskCommands(nil, nil)
+	taskCommands := cli.NewTaskCommands(nil, nil, nil)
 	deleteCmd := findCommand(taskCommands, "delete")
 
 	assert.NotNil(t, deleteCmd, "delete command should exist")
@@ -102,7 +110,7 @@ func TestTaskDeleteCommand_Arguments(t *testing.T) {
 
 // TestTaskMoveCommand_Flags verifies move command has track flag
 func TestTaskMoveCommand_Flags(t *testing.T) {
-	taskCommands := cli.NewTaskCommands(nil, nil)
+	taskCommands := cli.NewTaskCommands(nil, nil, nil)
 	moveCmd := findCommand(taskCommands, "move")
 
 	assert.NotNil(t, moveCmd, "move command should exist")
@@ -111,7 +119,7 @@ func TestTaskMoveCommand_Flags(t *testing.T) {
 
 // TestTaskBacklogCommand_Structure verifies backlog command exists
 func TestTaskBacklogCommand_Structure(t *testing.T) {
-	taskCommands := cli.NewTaskCommands(nil, nil)
+	taskCommands := cli.NewTaskCommands(nil, nil, nil)
 	backlogCmd := findCommand(taskCommands, "backlog")
 
 	assert.NotNil(t, backlogCmd, "backlog command should exist")
@@ -120,9 +128,35 @@ func TestTaskBacklogCommand_Structure(t *testing.T) {
 
 // TestTaskCheckReadyCommand_Arguments verifies check-ready command requires task ID
 func TestTaskCheckReadyCommand_Arguments(t *testing.T) {
-	taskCommands := cli.NewTaskCommands(nil, nil)
+	taskCommands := cli.NewTaskCommands(nil, nil, nil)
 	checkCmd := findCommand(taskCommands, "check-ready")
 
 	assert.NotNil(t, checkCmd, "check-ready command should exist")
 	assert.NotNil(t, checkCmd.Args, "check-ready command should have argument validation")
 }
+
+// TestTaskStartAndCommitsCommands_Arguments verifies start and commits require a task ID
+func TestTaskStartAndCommitsCommands_Arguments(t *testing.T) {
+	taskCommands := cli.NewTaskCommands(nil, nil, nil)
+
+	for _, name := range []string{"start", "commits"} {
+		cmd := findCommand(taskCommands, name)
+		if assert.NotNil(t, cmd, "%s command should exist", name) {
+			assert.Error(t, cmd.Args(cmd, []string{}), "%s should require a task ID", name)
+			assert.NoError(t, cmd.Args(cmd, []string{"TM-task-1"}))
+		}
+	}
+}
+
+// TestTaskBlockCommands_Arguments verifies block and unblock take a task ID and a blocker ID
+func TestTaskBlockCommands_Arguments(t *testing.T) {
+	taskCommands := cli.NewTaskCommands(nil, nil, nil)
+
+	for _, name := range []string{"block", "unblock"} {
+		cmd := findCommand(taskCommands, name)
+		if assert.NotNil(t, cmd, "%s command should exist", name) {
+			assert.Error(t, cmd.Args(cmd, []string{"TM-task-2"}), "%s should require a blocker ID", name)
+			assert.NoError(t, cmd.Args(cmd, []string{"TM-task-2", "TM-task-1"}))
+		}
+	}
+}
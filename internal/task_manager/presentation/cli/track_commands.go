@@ -15,7 +15,7 @@ import (
 // ============================================================================
 
 // NewTrackCommands creates and returns the track command group with all subcommands.
-func NewTrackCommands(trackService *application.TrackApplicationService, docService *application.DocumentApplicationService) *cobra.Command {
+func NewTrackCommands(trackService *application.TrackApplicationService, docService *application.DocumentApplicationService, planService *application.TrackPlanApplicationService) *cobra.Command {
 	trackCmd := &cobra.Command{
 		Use:     "track",
 		Short:   "Manage tracks",
@@ -29,12 +29,13 @@ func NewTrackCommands(trackService *application.TrackApplicationService, docServ
 	// Add all track subcommands
 	trackCmd.AddCommand(
 		newTrackCreateCommand(trackService),
-		newTrackListCommand(trackService),
+		newTrackListCommand(trackService, planService),
 		newTrackShowCommand(trackService, docService),
 		newTrackUpdateCommand(trackService),
 		newTrackDeleteCommand(trackService),
 		newTrackAddDependencyCommand(trackService),
 		newTrackRemoveDependencyCommand(trackService),
+		newTrackPlanCommand(planService),
 	)
 
 	return trackCmd
@@ -87,6 +88,10 @@ func newTrackCreateCommand(trackService *application.TrackApplicationService) *c
 				return fmt.Errorf("failed to create track: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "track", &TrackDetailOutput{Track: track}); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Track created successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:          %s\n", track.ID)
@@ -114,11 +119,12 @@ func newTrackCreateCommand(trackService *application.TrackApplicationService) *c
 // track list command
 // ============================================================================
 
-func newTrackListCommand(trackService *application.TrackApplicationService) *cobra.Command {
+func newTrackListCommand(trackService *application.TrackApplicationService, planService *application.TrackPlanApplicationService) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all tracks with optional filtering",
-		Long:  `Lists all tracks in the active roadmap with optional filtering by status.`,
+		Long: `Lists all tracks in the active roadmap with optional filtering by status.
+Tracks in progress while a dependency is not complete are marked with "!".`,
 		Example: `  # List all tracks
   tm track list
 
@@ -154,27 +160,56 @@ func newTrackListCommand(trackService *application.TrackApplicationService) *cob
 				return fmt.Errorf("failed to list tracks: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "track_list", tracks); ok {
+				return err
+			}
+
 			// Format output
 			if len(tracks) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No tracks found\n")
 				return nil
 			}
 
+			// Check statuses against dependencies; the listing stands on its own if that fails
+			violations := make(map[string]*dto.TrackViolationDTO)
+			if plan, err := planService.PlanTracks(ctx); err == nil {
+				for _, violation := range plan.Violations {
+					violations[violation.TrackID] = violation
+				}
+			} else {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: could not check track dependencies: %v\n", err)
+			}
+
 			// Print header
-			fmt.Fprintf(cmd.OutOrStdout(), "%-25s %-30s %-12s %-6s %s\n",
+			fmt.Fprintf(cmd.OutOrStdout(), "%-25s %-30s %-14s %-6s %s\n",
 				"ID", "Title", "Status", "Rank", "Dependencies")
 			fmt.Fprintf(cmd.OutOrStdout(), "%s\n",
-				strings.Repeat("-", 90))
+				strings.Repeat("-", 92))
 
 			// Print tracks
+			var flagged []*dto.TrackViolationDTO
 			for _, track := range tracks {
 				depCount := len(track.Dependencies)
 				depStr := fmt.Sprintf("%d", depCount)
-				fmt.Fprintf(cmd.OutOrStdout(), "%-25s %-30s %-12s %-6d %s\n",
-					track.ID, truncateString(track.Title, 29), track.Status, track.Rank, depStr)
+				status := track.Status
+				if violation := violations[track.ID]; violation != nil {
+					status += " !"
+					flagged = append(flagged, violation)
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%-25s %-30s %-14s %-6d %s\n",
+					track.ID, truncateString(track.Title, 29), status, track.Rank, depStr)
 			}
 
 			fmt.Fprintf(cmd.OutOrStdout(), "\nTotal: %d track(s)\n", len(tracks))
+
+			if len(flagged) > 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "\nDependency violations:\n")
+				for _, violation := range flagged {
+					fmt.Fprintf(cmd.OutOrStdout(), "  ! %s is %s but depends on incomplete %s\n",
+						violation.TrackID, violation.Status, strings.Join(violation.IncompleteDependencies, ", "))
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "Run 'tm track plan' for suggested status changes.\n")
+			}
 			return nil
 		},
 	}
@@ -206,6 +241,15 @@ func newTrackShowCommand(trackService *application.TrackApplicationService, docS
 				return fmt.Errorf("failed to get track: %w", err)
 			}
 
+			if GetOutputFormat(cmd) != OutputTable {
+				docs, err := docService.ListDocuments(ctx, &trackID, nil, nil)
+				if err != nil {
+					return fmt.Errorf("failed to list track documents: %w", err)
+				}
+				_, err = writeStructured(cmd, "track", &TrackDetailOutput{Track: track, Documents: docs})
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Track Details\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "=============\n\n")
@@ -243,6 +287,12 @@ func newTrackShowCommand(trackService *application.TrackApplicationService, docS
 	return cmd
 }
 
+// TrackDetailOutput is the structured form of `track show`, also returned by the API server.
+type TrackDetailOutput struct {
+	Track     *entities.TrackEntity  `json:"track"`
+	Documents []*dto.DocumentViewDTO `json:"documents,omitempty"`
+}
+
 // ============================================================================
 // track update command
 // ============================================================================
@@ -306,6 +356,10 @@ func newTrackUpdateCommand(trackService *application.TrackApplicationService) *c
 				return fmt.Errorf("failed to update track: %w", err)
 			}
 
+			if ok, err := writeStructured(cmd, "track", &TrackDetailOutput{Track: track}); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Track updated successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  ID:          %s\n", track.ID)
@@ -337,7 +391,10 @@ func newTrackDeleteCommand(trackService *application.TrackApplicationService) *c
 	cmd := &cobra.Command{
 		Use:   "delete <track-id>",
 		Short: "Delete a track",
-		Long:  `Deletes a track and removes it from the roadmap. Requires the --force flag for safety.`,
+		Long: `Deletes a track and removes it from the roadmap. Its tasks (with their acceptance
+criteria), ADRs and attached documents are deleted with it. Requires the --force
+flag for safety. The project is snapshotted first (see 'tm project snapshots')
+unless automatic snapshots are disabled.`,
 		Example: `  # Delete a track
   tm track delete TM-track-1 --force`,
 		Args: cobra.ExactArgs(1),
@@ -353,11 +410,17 @@ func newTrackDeleteCommand(trackService *application.TrackApplicationService) *c
 				return fmt.Errorf("--force flag is required to confirm deletion")
 			}
 
-			// Execute via application service
-			if err := trackService.DeleteTrack(ctx, trackID); err != nil {
+			// Execute via application service, which snapshots the project first
+			snapshot, err := trackService.DeleteTrack(ctx, trackID)
+			printSnapshotTaken(cmd, snapshot)
+			if err != nil {
 				return fmt.Errorf("failed to delete track: %w", err)
 			}
 
+			if ok, err := writeDeleted(cmd, "track", trackID); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Track %s deleted successfully\n", trackID)
 
@@ -392,6 +455,13 @@ func newTrackAddDependencyCommand(trackService *application.TrackApplicationServ
 				return fmt.Errorf("failed to add dependency: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "track", func() (interface{}, error) {
+				track, err := trackService.GetTrack(ctx, trackID)
+				return &TrackDetailOutput{Track: track}, err
+			}); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Dependency added successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  %s depends on %s\n", trackID, dependsOnID)
@@ -425,6 +495,13 @@ func newTrackRemoveDependencyCommand(trackService *application.TrackApplicationS
 				return fmt.Errorf("failed to remove dependency: %w", err)
 			}
 
+			if ok, err := writeStructuredFrom(cmd, "track", func() (interface{}, error) {
+				track, err := trackService.GetTrack(ctx, trackID)
+				return &TrackDetailOutput{Track: track}, err
+			}); ok {
+				return err
+			}
+
 			// Format output
 			fmt.Fprintf(cmd.OutOrStdout(), "Dependency removed successfully\n")
 			fmt.Fprintf(cmd.OutOrStdout(), "  %s no longer depends on %s\n", trackID, dependsOnID)
@@ -435,3 +512,98 @@ func newTrackRemoveDependencyCommand(trackService *application.TrackApplicationS
 
 	return cmd
 }
+
+// ============================================================================
+// track plan command
+// ============================================================================
+
+func newTrackPlanCommand(planService *application.TrackPlanApplicationService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show the order to work on tracks in",
+		Long: `Orders the unfinished tracks of the active roadmap along their dependencies.
+Tracks are grouped into waves: every track of a wave only depends on tracks of
+earlier waves (or complete ones), so the tracks of a wave can be worked on in parallel.
+
+The plan also flags tracks that are in progress while a dependency is not complete
+and suggests status corrections:
+  - blocked      when a dependency is blocked
+  - waiting      when a not-started or in-progress track has incomplete dependencies
+  - not-started  when a waiting track's dependencies are all complete
+
+Use --apply to make the suggested changes.`,
+		Example: `  # Show the plan
+  tm track plan
+
+  # Apply the suggested status changes
+  tm track plan --apply`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			apply, _ := cmd.Flags().GetBool("apply")
+
+			var plan *dto.TrackPlanDTO
+			var err error
+			if apply {
+				plan, err = planService.ApplySuggestions(ctx)
+			} else {
+				plan, err = planService.PlanTracks(ctx)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to plan tracks: %w", err)
+			}
+
+			if ok, err := writeStructured(cmd, "track_plan", plan); ok {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Track Plan\n")
+			fmt.Fprintf(out, "==========\n")
+			if len(plan.Waves) == 0 {
+				fmt.Fprintf(out, "\nNo unfinished tracks\n")
+			}
+			for _, wave := range plan.Waves {
+				fmt.Fprintf(out, "\nWave %d\n", wave.Number)
+				for _, track := range wave.Tracks {
+					line := fmt.Sprintf("  %-20s %-30s %-12s", track.ID, truncateString(track.Title, 29), track.Status)
+					if len(track.OpenDependencies) > 0 {
+						line += " after " + strings.Join(track.OpenDependencies, ", ")
+					}
+					fmt.Fprintf(out, "%s\n", strings.TrimRight(line, " "))
+				}
+			}
+			if len(plan.Completed) > 0 {
+				fmt.Fprintf(out, "\nComplete: %s\n", strings.Join(plan.Completed, ", "))
+			}
+
+			if len(plan.Violations) > 0 {
+				fmt.Fprintf(out, "\nDependency violations:\n")
+				for _, violation := range plan.Violations {
+					fmt.Fprintf(out, "  ! %s is %s but depends on incomplete %s\n",
+						violation.TrackID, violation.Status, strings.Join(violation.IncompleteDependencies, ", "))
+				}
+			}
+
+			if len(plan.Suggestions) > 0 {
+				if plan.Applied {
+					fmt.Fprintf(out, "\nApplied status changes:\n")
+				} else {
+					fmt.Fprintf(out, "\nSuggested status changes:\n")
+				}
+				for _, suggestion := range plan.Suggestions {
+					fmt.Fprintf(out, "  %-20s %s → %s (%s)\n", suggestion.TrackID, suggestion.From, suggestion.To, suggestion.Reason)
+				}
+				if !plan.Applied {
+					fmt.Fprintf(out, "Run 'tm track plan --apply' to apply them.\n")
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool("apply", false, "Apply the suggested status changes")
+
+	return cmd
+}
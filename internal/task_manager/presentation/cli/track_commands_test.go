@@ -13,7 +13,7 @@ import (
 
 // TestNewTrackCommands verifies that NewTrackCommands returns a valid Cobra command group
 func TestNewTrackCommands_Structure(t *testing.T) {
-	trackCommands := cli.NewTrackCommands(nil, nil)
+	trackCommands := cli.NewTrackCommands(nil, nil, nil)
 
 	assert.NotNil(t, trackCommands, "NewTrackCommands should return a command group")
 	assert.Equal(t, "track", trackCommands.Name(), "command name should be 'track'")
@@ -21,9 +21,9 @@ func TestNewTrackCommands_Structure(t *testing.T) {
 	assert.NotEmpty(t, trackCommands.Long, "command should have long description")
 }
 
-// TestTrackCommands_AllSubcommands verifies all 7 subcommands are present
+// TestTrackCommands_AllSubcommands verifies all 8 subcommands are present
 func TestTrackCommands_AllSubcommands(t *testing.T) {
-	trackCommands := cli.NewTrackCommands(nil, nil)
+	trackCommands := cli.NewTrackCommands(nil, nil, nil)
 
 	expectedSubcommands := []string{
 		"create",
@@ -33,6 +33,7 @@ func TestTrackCommands_AllSubcommands(t *testing.T) {
 		"delete",
 		"add-dependency",
 		"remove-dependency",
+		"plan",
 	}
 
 	commandNames := make(map[string]bool)
@@ -47,7 +48,7 @@ func TestTrackCommands_AllSubcommands(t *testing.T) {
 
 // TestTrackCreateCommand_Flags verifies create command has required flags
 func TestTrackCreateCommand_Flags(t *testing.T) {
-	trackCommands := cli.NewTrackCommands(nil, nil)
+	trackCommands := cli.NewTrackCommands(nil, nil, nil)
 	createCmd := findCommand(trackCommands, "create")
 
 	assert.NotNil(t, createCmd, "create command should exist")
@@ -58,7 +59,7 @@ func TestTrackCreateCommand_Flags(t *testing.T) {
 
 // TestTrackListCommand_Flags verifies list command has filter flags
 func TestTrackListCommand_Flags(t *testing.T) {
-	trackCommands := cli.NewTrackCommands(nil, nil)
+	trackCommands := cli.NewTrackCommands(nil, nil, nil)
 	listCmd := findCommand(trackCommands, "list")
 
 	assert.NotNil(t, listCmd, "list command should exist")
@@ -67,7 +68,7 @@ func TestTrackListCommand_Flags(t *testing.T) {
 
 // TestTrackShowCommand_Arguments verifies show command requires track ID
 func TestTrackShowCommand_Arguments(t *testing.T) {
-	trackCommands := cli.NewTrackCommands(nil, nil)
+	trackCommands := cli.NewTrackCommands(nil, nil, nil)
 	showCmd := findCommand(trackCommands, "show")
 
 	assert.NotNil(t, showCmd, "show command should exist")
@@ -77,7 +78,7 @@ func TestTrackShowCommand_Arguments(t *testing.T) {
 
 // TestTrackUpdateCommand_Flags verifies update command has optional field flags
 func TestTrackUpdateCommand_Flags(t *testing.T) {
-	trackCommands := cli.NewTrackCommands(nil, nil)
+	trackCommands := cli.NewTrackCommands(nil, nil, nil)
 	updateCmd := findCommand(trackCommands, "update")
 
 	assert.NotNil(t, updateCmd, "update command should exist")
@@ -89,7 +90,7 @@ func TestTrackUpdateCommand_Flags(t *testing.T) {
 
 // TestTrackDeleteCommand_Flags verifies delete command has force flag
 func TestTrackDeleteCommand_Flags(t *testing.T) {
-	trackCommands := cli.NewTrackCommands(nil, nil)
+	trackCommands := cli.NewTrackCommands(nil, nil, nil)
 	deleteCmd := findCommand(trackCommands, "delete")
 
 	assert.NotNil(t, deleteCmd, "delete command should exist")
@@ -98,7 +99,7 @@ func TestTrackDeleteCommand_Flags(t *testing.T) {
 
 // TestTrackAddDependencyCommand_Arguments verifies add-dependency command requires two IDs
 func TestTrackAddDependencyCommand_Arguments(t *testing.T) {
-	trackCommands := cli.NewTrackCommands(nil, nil)
+	trackCommands := cli.NewTrackCommands(nil, nil, nil)
 	addDepCmd := findCommand(trackCommands, "add-dependency")
 
 	assert.NotNil(t, addDepCmd, "add-dependency command should exist")
@@ -107,13 +108,23 @@ func TestTrackAddDependencyCommand_Arguments(t *testing.T) {
 
 // TestTrackRemoveDependencyCommand_Arguments verifies remove-dependency command requires two IDs
 func TestTrackRemoveDependencyCommand_Arguments(t *testing.T) {
-	trackCommands := cli.NewTrackCommands(nil, nil)
+	trackCommands := cli.NewTrackCommands(nil, nil, nil)
 	removeDepCmd := findCommand(trackCommands, "remove-dependency")
 
 	assert.NotNil(t, removeDepCmd, "remove-dependency command should exist")
 	assert.NotNil(t, removeDepCmd.Args, "remove-dependency command should have argument validation")
 }
 
+// TestTrackPlanCommand_Flags verifies plan command takes no arguments and can apply suggestions
+func TestTrackPlanCommand_Flags(t *testing.T) {
+	trackCommands := cli.NewTrackCommands(nil, nil, nil)
+	planCmd := findCommand(trackCommands, "plan")
+
+	assert.NotNil(t, planCmd, "plan command should exist")
+	assert.NotNil(t, planCmd.Flags().Lookup("apply"), "--apply flag should exist")
+	assert.Error(t, planCmd.Args(planCmd, []string{"TM-track-1"}), "plan command should take no arguments")
+}
+
 // ============================================================================
 // Helper function to find subcommand by name
 // ============================================================================
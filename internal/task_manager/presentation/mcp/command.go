@@ -0,0 +1,30 @@
+package mcp
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewMCPCommand creates the mcp command serving the project over stdio until the client disconnects
+func NewMCPCommand(server *Server) *cobra.Command {
+	return &cobra.Command{
+		Use:   "mcp",
+		Short: "Serve the project to agents over the Model Context Protocol",
+		Long: `Serves the active project as an MCP server over stdio, so agents can plan and
+track work through typed tools instead of parsing CLI output.
+
+Tools cover tracks, tasks, iterations, acceptance criteria, documents and ADRs
+(for example iteration_current, task_update_status, ac_add, ac_fail and
+doc_create); their results are the envelopes of 'tm -o json'. The roadmap, the
+current iteration and the documents are also offered as resources, and the
+output of 'tm prompt' as the tm_system_prompt prompt.
+
+The client starts the server itself: configure it to run 'tm mcp' in the
+project directory. Protocol messages use stdout; logs go to stderr.`,
+		Example: `  # Register with an MCP client (for example in .mcp.json)
+  {"mcpServers": {"tm": {"command": "tm", "args": ["mcp"]}}}`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return server.Serve(cmd.Context(), cmd.InOrStdin(), cmd.OutOrStdout())
+		},
+	}
+}
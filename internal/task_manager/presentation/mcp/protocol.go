@@ -0,0 +1,134 @@
+package mcp
+
+import "encoding/json"
+
+// JSON-RPC 2.0 messages exchanged over stdio, one per line
+
+// jsonrpcVersion is the only JSON-RPC version the protocol uses
+const jsonrpcVersion = "2.0"
+
+// JSON-RPC error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+
+	// codeResourceNotFound is the MCP error for reads of unknown resources
+	codeResourceNotFound = -32002
+)
+
+// supportedProtocolVersions lists the MCP revisions the server speaks, newest first
+var supportedProtocolVersions = []string{"2025-06-18", "2025-03-26", "2024-11-05"}
+
+// request is a JSON-RPC request, or a notification when ID is absent
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// isNotification returns true if the sender expects no response
+func (r *request) isNotification() bool {
+	return len(r.ID) == 0
+}
+
+// response is a JSON-RPC response carrying either a result or an error
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError is a JSON-RPC error object
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+// ============================================================================
+// MCP payloads
+// ============================================================================
+
+type initializeParams struct {
+	ProtocolVersion string `json:"protocolVersion"`
+}
+
+type initializeResult struct {
+	ProtocolVersion string                 `json:"protocolVersion"`
+	Capabilities    map[string]interface{} `json:"capabilities"`
+	ServerInfo      implementation         `json:"serverInfo"`
+	Instructions    string                 `json:"instructions,omitempty"`
+}
+
+type implementation struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type toolDescription struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	InputSchema map[string]interface{} `json:"inputSchema"`
+}
+
+type callToolParams struct {
+	Name      string          `json:"name"`
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+}
+
+type callToolResult struct {
+	Content           []textContent `json:"content"`
+	StructuredContent interface{}   `json:"structuredContent,omitempty"`
+	IsError           bool          `json:"isError,omitempty"`
+}
+
+type textContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type resourceDescription struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType"`
+}
+
+type resourceTemplate struct {
+	URITemplate string `json:"uriTemplate"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType"`
+}
+
+type readResourceParams struct {
+	URI string `json:"uri"`
+}
+
+type resourceContents struct {
+	URI      string `json:"uri"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type promptDescription struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type getPromptParams struct {
+	Name string `json:"name"`
+}
+
+type promptMessage struct {
+	Role    string      `json:"role"`
+	Content textContent `json:"content"`
+}
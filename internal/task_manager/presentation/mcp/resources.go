@@ -0,0 +1,168 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+)
+
+// Resource URIs
+const (
+	roadmapURI          = "tm://roadmap"
+	currentIterationURI = "tm://iterations/current"
+	documentsURI        = "tm://documents"
+	documentURIPrefix   = documentsURI + "/"
+)
+
+// systemPromptName names the prompt carrying the tm system prompt
+const systemPromptName = "tm_system_prompt"
+
+// Mime types of resource contents
+const (
+	mimeJSON     = "application/json"
+	mimeMarkdown = "text/markdown"
+)
+
+// ============================================================================
+// Resources
+// ============================================================================
+
+// listResources lists the fixed resources followed by one resource per document
+func (s *Server) listResources(ctx context.Context) (interface{}, *rpcError) {
+	resources := []resourceDescription{
+		{URI: roadmapURI, Name: "Roadmap", Description: "Vision and success criteria of the roadmap", MimeType: mimeJSON},
+		{URI: currentIterationURI, Name: "Current iteration", Description: "The current iteration with its tasks, capacity and documents", MimeType: mimeJSON},
+		{URI: documentsURI, Name: "Documents", Description: "Every document, with its content", MimeType: mimeJSON},
+	}
+
+	docs, err := s.documentService.ListDocuments(ctx, nil, nil, nil)
+	if err != nil {
+		return nil, resourceError(fmt.Errorf("failed to list documents: %w", err))
+	}
+	for _, doc := range docs {
+		resources = append(resources, resourceDescription{
+			URI:         documentURIPrefix + doc.ID,
+			Name:        doc.Title,
+			Description: fmt.Sprintf("%s document (%s)", doc.Type, doc.Status),
+			MimeType:    mimeMarkdown,
+		})
+	}
+	return map[string]interface{}{"resources": resources}, nil
+}
+
+// listResourceTemplates describes the parameterized resources
+func (s *Server) listResourceTemplates() interface{} {
+	return map[string]interface{}{
+		"resourceTemplates": []resourceTemplate{
+			{URITemplate: documentURIPrefix + "{id}", Name: "Document", Description: "Markdown content of a document", MimeType: mimeMarkdown},
+		},
+	}
+}
+
+// readResource returns the contents of a resource: JSON envelopes for the roadmap,
+// current iteration and document list, and the markdown content of a single document
+func (s *Server) readResource(ctx context.Context, raw json.RawMessage) (interface{}, *rpcError) {
+	var params readResourceParams
+	if err := decodeParams(raw, &params); err != nil {
+		return nil, err
+	}
+
+	var contents resourceContents
+	var err error
+	switch {
+	case params.URI == roadmapURI:
+		contents, err = s.readEnvelope(params.URI, func() (string, interface{}, error) {
+			return s.roadmapShow(ctx, nil)
+		})
+	case params.URI == currentIterationURI:
+		contents, err = s.readEnvelope(params.URI, func() (string, interface{}, error) {
+			return s.iterationCurrent(ctx, nil)
+		})
+	case params.URI == documentsURI:
+		contents, err = s.readEnvelope(params.URI, func() (string, interface{}, error) {
+			return s.docList(ctx, nil)
+		})
+	case strings.HasPrefix(params.URI, documentURIPrefix):
+		contents, err = s.readDocument(ctx, strings.TrimPrefix(params.URI, documentURIPrefix))
+	default:
+		err = fmt.Errorf("%w: unknown resource %s", tmerrors.ErrNotFound, params.URI)
+	}
+	if err != nil {
+		return nil, resourceError(err)
+	}
+	return map[string]interface{}{"contents": []resourceContents{contents}}, nil
+}
+
+// readEnvelope returns the envelope of a read as JSON contents
+func (s *Server) readEnvelope(uri string, read func() (string, interface{}, error)) (resourceContents, error) {
+	kind, data, err := read()
+	if err != nil {
+		return resourceContents{}, err
+	}
+	var buf bytes.Buffer
+	if err := cli.WriteEnvelope(&buf, cli.OutputJSON, cli.Envelope{
+		SchemaVersion: cli.OutputSchemaVersion,
+		Kind:          kind,
+		Data:          emptyList(data),
+	}); err != nil {
+		return resourceContents{}, err
+	}
+	return resourceContents{URI: uri, MimeType: mimeJSON, Text: buf.String()}, nil
+}
+
+// readDocument returns the markdown content of a document
+func (s *Server) readDocument(ctx context.Context, docID string) (resourceContents, error) {
+	doc, err := s.documentService.GetDocument(ctx, docID)
+	if err != nil {
+		return resourceContents{}, fmt.Errorf("failed to get document: %w", err)
+	}
+	return resourceContents{URI: documentURIPrefix + doc.ID, MimeType: mimeMarkdown, Text: doc.Content}, nil
+}
+
+// resourceError maps a failed read to its JSON-RPC error
+func resourceError(err error) *rpcError {
+	switch {
+	case errors.Is(err, tmerrors.ErrNotFound):
+		return &rpcError{Code: codeResourceNotFound, Message: err.Error()}
+	case errors.Is(err, tmerrors.ErrInvalidArgument):
+		return &rpcError{Code: codeInvalidParams, Message: err.Error()}
+	default:
+		return &rpcError{Code: codeInternalError, Message: err.Error()}
+	}
+}
+
+// ============================================================================
+// Prompts
+// ============================================================================
+
+// listPrompts describes the system prompt
+func (s *Server) listPrompts() interface{} {
+	return map[string]interface{}{
+		"prompts": []promptDescription{
+			{Name: systemPromptName, Description: "How to work with tm: entities, workflows and commands (the output of `tm prompt`)"},
+		},
+	}
+}
+
+// getPromptMessages returns the system prompt as a single user message
+func (s *Server) getPromptMessages(ctx context.Context, raw json.RawMessage) (interface{}, *rpcError) {
+	var params getPromptParams
+	if err := decodeParams(raw, &params); err != nil {
+		return nil, err
+	}
+	if params.Name != systemPromptName {
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("unknown prompt %q", params.Name)}
+	}
+	return map[string]interface{}{
+		"description": "tm system prompt",
+		"messages": []promptMessage{
+			{Role: "user", Content: textContent{Type: "text", Text: s.getPrompt(ctx)}},
+		},
+	}, nil
+}
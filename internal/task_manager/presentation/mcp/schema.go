@@ -0,0 +1,72 @@
+package mcp
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// inputSchema derives a tool's JSON schema from its argument struct, so the schema
+// advertised to clients and the decoding of calls cannot drift apart.
+//
+// Properties are named by their json tags; fields without omitempty are required.
+// A desc tag documents a property and an enum tag lists its comma-separated values.
+func inputSchema(args interface{}) map[string]interface{} {
+	properties := map[string]interface{}{}
+	required := []string{}
+
+	t := reflect.TypeOf(args)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		property := typeSchema(field.Type)
+		if desc := field.Tag.Get("desc"); desc != "" {
+			property["description"] = desc
+		}
+		if enum := field.Tag.Get("enum"); enum != "" {
+			property["enum"] = strings.Split(enum, ",")
+		}
+		properties[name] = property
+		if !strings.Contains(options, "omitempty") {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// typeSchema returns the JSON schema of an argument field type
+func typeSchema(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Int, reflect.Int64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": typeSchema(t.Elem())}
+	default:
+		panic(fmt.Sprintf("mcp: unsupported argument type %s", t))
+	}
+}
@@ -0,0 +1,186 @@
+// Package mcp serves the application services to agents over the Model Context Protocol.
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+)
+
+// serverName identifies the server to MCP clients
+const serverName = "tm"
+
+// instructions is the short usage hint returned on initialize; the full guide is the system prompt
+const instructions = "Call iteration_current first to see the work in progress. " +
+	"Results are the JSON envelopes of `tm -o json`. The tm_system_prompt prompt explains the entities and workflows."
+
+// Server answers MCP requests with the application services.
+// Tool results use the same envelopes, kinds and DTOs as the CLI's structured output.
+type Server struct {
+	roadmapService   *application.RoadmapApplicationService
+	trackService     *application.TrackApplicationService
+	taskService      *application.TaskApplicationService
+	iterationService *application.IterationApplicationService
+	acService        *application.ACApplicationService
+	adrService       *application.ADRApplicationService
+	documentService  *application.DocumentApplicationService
+	getPrompt        cli.PromptGetter
+	version          string
+
+	tools           []*tool
+	protocolVersion string // Negotiated on initialize
+}
+
+// NewServer creates an MCP server over the application services.
+// getPrompt supplies the system prompt offered as a server prompt; version is reported to clients.
+func NewServer(
+	roadmapService *application.RoadmapApplicationService,
+	trackService *application.TrackApplicationService,
+	taskService *application.TaskApplicationService,
+	iterationService *application.IterationApplicationService,
+	acService *application.ACApplicationService,
+	adrService *application.ADRApplicationService,
+	documentService *application.DocumentApplicationService,
+	getPrompt cli.PromptGetter,
+	version string,
+) *Server {
+	s := &Server{
+		roadmapService:   roadmapService,
+		trackService:     trackService,
+		taskService:      taskService,
+		iterationService: iterationService,
+		acService:        acService,
+		adrService:       adrService,
+		documentService:  documentService,
+		getPrompt:        getPrompt,
+		version:          version,
+		protocolVersion:  supportedProtocolVersions[0],
+	}
+	s.tools = s.registerTools()
+	return s
+}
+
+// Serve reads newline-delimited JSON-RPC messages from in and writes the responses to out,
+// one request at a time, until in is closed or ctx is cancelled.
+func (s *Server) Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	reader := bufio.NewReader(in)
+	encoder := json.NewEncoder(out)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil
+		}
+		line, readErr := reader.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if resp := s.HandleMessage(ctx, line); resp != nil {
+				if err := encoder.Encode(resp); err != nil {
+					return fmt.Errorf("failed to write response: %w", err)
+				}
+			}
+		}
+		if readErr != nil {
+			if errors.Is(readErr, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to read request: %w", readErr)
+		}
+	}
+}
+
+// HandleMessage handles one JSON-RPC message and returns its response,
+// or nil for notifications
+func (s *Server) HandleMessage(ctx context.Context, message []byte) interface{} {
+	var req request
+	if err := json.Unmarshal(message, &req); err != nil {
+		return &response{JSONRPC: jsonrpcVersion, ID: json.RawMessage("null"), Error: &rpcError{Code: codeParseError, Message: fmt.Sprintf("invalid JSON-RPC message: %v", err)}}
+	}
+	if req.JSONRPC != jsonrpcVersion || req.Method == "" {
+		if req.isNotification() {
+			return nil
+		}
+		return &response{JSONRPC: jsonrpcVersion, ID: req.ID, Error: &rpcError{Code: codeInvalidRequest, Message: "expected a JSON-RPC 2.0 request with a method"}}
+	}
+
+	result, rpcErr := s.dispatch(ctx, &req)
+	if req.isNotification() {
+		return nil
+	}
+	if rpcErr != nil {
+		return &response{JSONRPC: jsonrpcVersion, ID: req.ID, Error: rpcErr}
+	}
+	return &response{JSONRPC: jsonrpcVersion, ID: req.ID, Result: result}
+}
+
+// dispatch routes a request to its method handler
+func (s *Server) dispatch(ctx context.Context, req *request) (interface{}, *rpcError) {
+	switch req.Method {
+	case "initialize":
+		return s.initialize(req.Params)
+	case "ping":
+		return struct{}{}, nil
+	case "tools/list":
+		return s.listTools(), nil
+	case "tools/call":
+		return s.callTool(ctx, req.Params)
+	case "resources/list":
+		return s.listResources(ctx)
+	case "resources/templates/list":
+		return s.listResourceTemplates(), nil
+	case "resources/read":
+		return s.readResource(ctx, req.Params)
+	case "prompts/list":
+		return s.listPrompts(), nil
+	case "prompts/get":
+		return s.getPromptMessages(ctx, req.Params)
+	default:
+		if req.isNotification() {
+			return nil, nil // notifications/initialized, notifications/cancelled, ...
+		}
+		return nil, &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("method %q not found", req.Method)}
+	}
+}
+
+// initialize negotiates the protocol version and announces the server's capabilities
+func (s *Server) initialize(raw json.RawMessage) (interface{}, *rpcError) {
+	var params initializeParams
+	if err := decodeParams(raw, &params); err != nil {
+		return nil, err
+	}
+
+	// Answer with the client's version when supported, otherwise with the latest one
+	s.protocolVersion = supportedProtocolVersions[0]
+	for _, version := range supportedProtocolVersions {
+		if version == params.ProtocolVersion {
+			s.protocolVersion = version
+		}
+	}
+
+	return &initializeResult{
+		ProtocolVersion: s.protocolVersion,
+		Capabilities: map[string]interface{}{
+			"tools":     map[string]interface{}{},
+			"resources": map[string]interface{}{},
+			"prompts":   map[string]interface{}{},
+		},
+		ServerInfo:   implementation{Name: serverName, Version: s.version},
+		Instructions: instructions,
+	}, nil
+}
+
+// decodeParams decodes request params, reporting malformed ones as invalid params
+func decodeParams(raw json.RawMessage, v interface{}) *rpcError {
+	if len(raw) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("invalid params: %v", err)}
+	}
+	return nil
+}
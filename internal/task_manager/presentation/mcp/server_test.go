@@ -0,0 +1,265 @@
+package mcp_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rpcResponse is a decoded JSON-RPC response with its result left raw
+type rpcResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
+
+// toolResult is a decoded tools/call result
+type toolResult struct {
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+	StructuredContent *cli.Envelope `json:"structuredContent"`
+	IsError           bool          `json:"isError"`
+}
+
+// setupServerTest returns a server whose task service holds TM-task-1 on TM-track-1
+func setupServerTest(t *testing.T) *mcp.Server {
+	t.Helper()
+	now := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+
+	trackRepo := mocks.NewMockTrackRepository()
+	track, err := entities.NewTrackEntity("TM-track-1", "roadmap-1", "Core", "", "in-progress", 100, nil, now, now)
+	require.NoError(t, err)
+	require.NoError(t, trackRepo.SaveTrack(context.Background(), track))
+
+	taskRepo := mocks.NewMockTaskRepository()
+	task, err := entities.NewTaskEntity("TM-task-1", track.ID, "Model", "", "todo", 100, "", now, now)
+	require.NoError(t, err)
+	require.NoError(t, taskRepo.SaveTask(context.Background(), task))
+	taskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		if id != task.ID {
+			return nil, fmt.Errorf("%w: task %s not found", tmerrors.ErrNotFound, id)
+		}
+		return task, nil
+	}
+
+	taskService := application.NewTaskApplicationService(taskRepo, trackRepo, &mocks.MockAggregateRepository{}, &mocks.MockAcceptanceCriteriaRepository{}, services.NewValidationService(), nil, nil, nil)
+	getPrompt := func(ctx context.Context) string { return "# Task Manager System Prompt" }
+	return mcp.NewServer(nil, nil, taskService, nil, nil, nil, nil, getPrompt, "1.2.3")
+}
+
+// exchange sends newline-delimited messages to the server and decodes its responses
+func exchange(t *testing.T, server *mcp.Server, messages ...string) []rpcResponse {
+	t.Helper()
+	var out strings.Builder
+	require.NoError(t, server.Serve(context.Background(), strings.NewReader(strings.Join(messages, "\n")+"\n"), &out))
+
+	var responses []rpcResponse
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var resp rpcResponse
+		require.NoError(t, json.Unmarshal([]byte(line), &resp), line)
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+// call sends a tools/call request after initializing with the given protocol version
+func call(t *testing.T, server *mcp.Server, protocolVersion, name, arguments string) toolResult {
+	t.Helper()
+	responses := exchange(t, server,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"`+protocolVersion+`"}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"`+name+`","arguments":`+arguments+`}}`,
+	)
+	require.Len(t, responses, 2)
+	require.Nil(t, responses[1].Error)
+	var result toolResult
+	require.NoError(t, json.Unmarshal(responses[1].Result, &result))
+	require.Len(t, result.Content, 1)
+	return result
+}
+
+// TestServer_Initialize verifies version negotiation and that notifications get no response
+func TestServer_Initialize(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested string
+		expected  string
+	}{
+		{"supported version", "2025-03-26", "2025-03-26"},
+		{"unknown version", "2023-01-01", "2025-06-18"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responses := exchange(t, setupServerTest(t),
+				`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"`+tt.requested+`"}}`,
+				`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+				`{"jsonrpc":"2.0","id":2,"method":"ping"}`,
+			)
+			require.Len(t, responses, 2)
+
+			var result struct {
+				ProtocolVersion string `json:"protocolVersion"`
+				ServerInfo      struct {
+					Name    string `json:"name"`
+					Version string `json:"version"`
+				} `json:"serverInfo"`
+				Capabilities map[string]json.RawMessage `json:"capabilities"`
+			}
+			require.NoError(t, json.Unmarshal(responses[0].Result, &result))
+			assert.Equal(t, tt.expected, result.ProtocolVersion)
+			assert.Equal(t, "tm", result.ServerInfo.Name)
+			assert.Equal(t, "1.2.3", result.ServerInfo.Version)
+			assert.Contains(t, result.Capabilities, "tools")
+			assert.Contains(t, result.Capabilities, "resources")
+			assert.Contains(t, result.Capabilities, "prompts")
+			assert.JSONEq(t, "2", string(responses[1].ID))
+		})
+	}
+}
+
+// TestServer_ListTools verifies that tools advertise schemas derived from their arguments
+func TestServer_ListTools(t *testing.T) {
+	responses := exchange(t, setupServerTest(t), `{"jsonrpc":"2.0","id":1,"method":"tools/list"}`)
+	require.Len(t, responses, 1)
+
+	var result struct {
+		Tools []struct {
+			Name        string `json:"name"`
+			InputSchema struct {
+				Type       string                            `json:"type"`
+				Properties map[string]map[string]interface{} `json:"properties"`
+				Required   []string                          `json:"required"`
+			} `json:"inputSchema"`
+		} `json:"tools"`
+	}
+	require.NoError(t, json.Unmarshal(responses[0].Result, &result))
+
+	tools := map[string]int{}
+	for i, tool := range result.Tools {
+		tools[tool.Name] = i
+		assert.Equal(t, "object", tool.InputSchema.Type, tool.Name)
+	}
+	for _, name := range []string{"iteration_current", "task_update_status", "ac_add", "ac_fail", "doc_create"} {
+		assert.Contains(t, tools, name)
+	}
+
+	status := result.Tools[tools["task_update_status"]].InputSchema
+	assert.ElementsMatch(t, []string{"id", "status"}, status.Required)
+	assert.Equal(t, "string", status.Properties["status"]["type"])
+	assert.Equal(t, []interface{}{"todo", "in-progress", "review", "done", "cancelled"}, status.Properties["status"]["enum"])
+
+	create := result.Tools[tools["task_create"]].InputSchema
+	assert.ElementsMatch(t, []string{"track_id", "title"}, create.Required)
+	assert.Equal(t, "number", create.Properties["estimate"]["type"])
+	assert.Equal(t, "integer", create.Properties["rank"]["type"])
+}
+
+// TestServer_CallTool verifies that tool results are CLI envelopes and failures are tool errors
+func TestServer_CallTool(t *testing.T) {
+	t.Run("result envelope", func(t *testing.T) {
+		result := call(t, setupServerTest(t), "2025-06-18", "task_show", `{"id":"TM-task-1"}`)
+		assert.False(t, result.IsError)
+		assert.Equal(t, "text", result.Content[0].Type)
+
+		var envelope cli.Envelope
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &envelope))
+		assert.Equal(t, cli.OutputSchemaVersion, envelope.SchemaVersion)
+		assert.Equal(t, "task", envelope.Kind)
+		require.NotNil(t, result.StructuredContent)
+		assert.Equal(t, "task", result.StructuredContent.Kind)
+	})
+
+	t.Run("older protocol has no structured content", func(t *testing.T) {
+		result := call(t, setupServerTest(t), "2024-11-05", "task_show", `{"id":"TM-task-1"}`)
+		assert.False(t, result.IsError)
+		assert.Nil(t, result.StructuredContent)
+	})
+
+	errorTests := []struct {
+		name      string
+		tool      string
+		arguments string
+		code      string
+	}{
+		{"unknown task", "task_show", `{"id":"TM-task-9"}`, cli.ErrorCodeNotFound},
+		{"missing argument", "task_update_status", `{"id":"TM-task-1"}`, cli.ErrorCodeInvalidArgument},
+		{"unknown argument", "task_show", `{"id":"TM-task-1","titel":"x"}`, cli.ErrorCodeInvalidArgument},
+		{"invalid status", "task_update_status", `{"id":"TM-task-1","status":"sleeping"}`, cli.ErrorCodeInvalidArgument},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := call(t, setupServerTest(t), "2025-06-18", tt.tool, tt.arguments)
+			assert.True(t, result.IsError)
+			require.NotNil(t, result.StructuredContent)
+			assert.Equal(t, "error", result.StructuredContent.Kind)
+			require.NotNil(t, result.StructuredContent.Error)
+			assert.Equal(t, tt.code, result.StructuredContent.Error.Code)
+		})
+	}
+}
+
+// TestServer_ProtocolErrors verifies the JSON-RPC errors of malformed or unsupported requests
+func TestServer_ProtocolErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		code    int
+	}{
+		{"parse error", `{not json`, -32700},
+		{"missing method", `{"jsonrpc":"2.0","id":1}`, -32600},
+		{"unknown method", `{"jsonrpc":"2.0","id":1,"method":"sampling/createMessage"}`, -32601},
+		{"unknown tool", `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"task_explode"}}`, -32602},
+		{"unknown prompt", `{"jsonrpc":"2.0","id":1,"method":"prompts/get","params":{"name":"other"}}`, -32602},
+		{"unknown resource", `{"jsonrpc":"2.0","id":1,"method":"resources/read","params":{"uri":"tm://nowhere"}}`, -32002},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responses := exchange(t, setupServerTest(t), tt.message)
+			require.Len(t, responses, 1)
+			require.NotNil(t, responses[0].Error)
+			assert.Equal(t, tt.code, responses[0].Error.Code)
+		})
+	}
+}
+
+// TestServer_Prompt verifies that the system prompt is offered as a server prompt
+func TestServer_Prompt(t *testing.T) {
+	responses := exchange(t, setupServerTest(t),
+		`{"jsonrpc":"2.0","id":1,"method":"prompts/list"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"prompts/get","params":{"name":"tm_system_prompt"}}`,
+	)
+	require.Len(t, responses, 2)
+	assert.Contains(t, string(responses[0].Result), "tm_system_prompt")
+
+	var result struct {
+		Messages []struct {
+			Role    string `json:"role"`
+			Content struct {
+				Text string `json:"text"`
+			} `json:"content"`
+		} `json:"messages"`
+	}
+	require.NoError(t, json.Unmarshal(responses[1].Result, &result))
+	require.Len(t, result.Messages, 1)
+	assert.Equal(t, "user", result.Messages[0].Role)
+	assert.Equal(t, "# Task Manager System Prompt", result.Messages[0].Content.Text)
+}
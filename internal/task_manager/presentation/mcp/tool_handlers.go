@@ -0,0 +1,687 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+)
+
+// defaultRank is the rank of tasks created without one, as in the CLI
+const defaultRank = 500
+
+// registerTools lists every tool in the order clients show them
+func (s *Server) registerTools() []*tool {
+	return []*tool{
+		newTool("roadmap_show", "Show the roadmap: vision and success criteria.", noArgs{}, s.roadmapShow),
+		newTool("track_list", "List the tracks of the roadmap.", trackListArgs{}, s.trackList),
+		newTool("track_show", "Show a track with its dependencies and documents.", idArgs{}, s.trackShow),
+
+		newTool("task_list", "List tasks, optionally filtered by track, status or readiness.", taskListArgs{}, s.taskList),
+		newTool("task_show", "Show a task.", idArgs{}, s.taskShow),
+		newTool("task_create", "Create a task on a track. New tasks start as todo.", taskCreateArgs{}, s.taskCreate),
+		newTool("task_update", "Update a task's fields; omitted fields are left unchanged.", taskUpdateArgs{}, s.taskUpdate),
+		newTool("task_update_status", "Move a task to another status.", taskUpdateStatusArgs{}, s.taskUpdateStatus),
+
+		newTool("iteration_list", "List the iterations.", noArgs{}, s.iterationList),
+		newTool("iteration_current", "Show the current iteration with its tasks, capacity and documents. Falls back to the next planned iteration.", noArgs{}, s.iterationCurrent),
+		newTool("iteration_show", "Show an iteration with its tasks, capacity and documents.", numberArgs{}, s.iterationShow),
+		newTool("iteration_create", "Create a planned iteration.", iterationCreateArgs{}, s.iterationCreate),
+		newTool("iteration_add_tasks", "Add tasks to an iteration.", iterationAddTasksArgs{}, s.iterationAddTasks),
+		newTool("iteration_remove_task", "Remove a task from an iteration.", iterationRemoveTaskArgs{}, s.iterationRemoveTask),
+		newTool("iteration_start", "Start a planned iteration.", numberArgs{}, s.iterationStart),
+		newTool("iteration_complete", "Complete the current iteration.", iterationCompleteArgs{}, s.iterationComplete),
+
+		newTool("ac_list", "List the acceptance criteria of a task or of every task in an iteration.", acListArgs{}, s.acList),
+		newTool("ac_add", "Add an acceptance criterion to a task.", acAddArgs{}, s.acAdd),
+		newTool("ac_verify", "Mark an acceptance criterion as verified.", acVerifyArgs{}, s.acVerify),
+		newTool("ac_fail", "Mark an acceptance criterion as failed, with feedback on what is wrong.", acFailArgs{}, s.acFail),
+		newTool("ac_skip", "Skip an acceptance criterion that no longer applies.", acSkipArgs{}, s.acSkip),
+
+		newTool("doc_list", "List documents, optionally filtered by track, iteration or type.", docListArgs{}, s.docList),
+		newTool("doc_show", "Show a document with its content.", idArgs{}, s.docShow),
+		newTool("doc_create", "Create a document, optionally attached to a track or an iteration.", docCreateArgs{}, s.docCreate),
+		newTool("doc_update", "Update a document's content, status or attachment.", docUpdateArgs{}, s.docUpdate),
+
+		newTool("adr_list", "List architecture decision records.", adrListArgs{}, s.adrList),
+		newTool("adr_show", "Show an architecture decision record.", idArgs{}, s.adrShow),
+		newTool("adr_create", "Record an architecture decision on a track.", adrCreateArgs{}, s.adrCreate),
+	}
+}
+
+// ============================================================================
+// Arguments
+// ============================================================================
+
+type noArgs struct{}
+
+type idArgs struct {
+	ID string `json:"id" desc:"Entity ID"`
+}
+
+type numberArgs struct {
+	Number int `json:"number" desc:"Iteration number"`
+}
+
+type trackListArgs struct {
+	Status []string `json:"status,omitempty" desc:"Only tracks with these statuses (not-started, in-progress, complete, blocked, waiting)"`
+}
+
+type taskListArgs struct {
+	TrackID string   `json:"track_id,omitempty" desc:"Only tasks on this track"`
+	Status  []string `json:"status,omitempty" desc:"Only tasks with these statuses (todo, in-progress, review, done, cancelled)"`
+	Ready   bool     `json:"ready,omitempty" desc:"Only todo tasks whose blockers and track dependencies are done"`
+}
+
+type taskCreateArgs struct {
+	TrackID     string   `json:"track_id" desc:"Track the task belongs to"`
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Rank        int      `json:"rank,omitempty" desc:"Priority, lower first (default 500)"`
+	Branch      string   `json:"branch,omitempty" desc:"Git branch implementing the task"`
+	Estimate    *float64 `json:"estimate,omitempty" desc:"Estimate in points"`
+}
+
+type taskUpdateArgs struct {
+	ID          string   `json:"id" desc:"Task ID"`
+	TrackID     *string  `json:"track_id,omitempty" desc:"Move the task to this track"`
+	Title       *string  `json:"title,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Rank        *int     `json:"rank,omitempty"`
+	Branch      *string  `json:"branch,omitempty" desc:"Empty string unlinks the branch"`
+	Estimate    *float64 `json:"estimate,omitempty" desc:"Zero clears the estimate"`
+	Version     *int     `json:"version,omitempty" desc:"Version of the task the update is based on; fails with conflict if it changed since"`
+}
+
+type taskUpdateStatusArgs struct {
+	ID      string `json:"id" desc:"Task ID"`
+	Status  string `json:"status" enum:"todo,in-progress,review,done,cancelled"`
+	Version *int   `json:"version,omitempty" desc:"Version of the task the update is based on; fails with conflict if it changed since"`
+}
+
+type iterationCreateArgs struct {
+	Name        string   `json:"name"`
+	Goal        string   `json:"goal,omitempty"`
+	Deliverable string   `json:"deliverable,omitempty"`
+	Capacity    *float64 `json:"capacity,omitempty" desc:"Points the iteration can take"`
+}
+
+type iterationAddTasksArgs struct {
+	Number  int      `json:"number" desc:"Iteration number"`
+	TaskIDs []string `json:"task_ids"`
+}
+
+type iterationRemoveTaskArgs struct {
+	Number int    `json:"number" desc:"Iteration number"`
+	TaskID string `json:"task_id"`
+}
+
+type iterationCompleteArgs struct {
+	Number int  `json:"number" desc:"Iteration number"`
+	Force  bool `json:"force,omitempty" desc:"Complete even if the completion policy is not met"`
+}
+
+type acListArgs struct {
+	TaskID    string `json:"task_id,omitempty" desc:"Task whose criteria to list"`
+	Iteration *int   `json:"iteration,omitempty" desc:"Iteration whose tasks' criteria to list, when task_id is not given"`
+}
+
+type acAddArgs struct {
+	TaskID              string `json:"task_id"`
+	Description         string `json:"description"`
+	TestingInstructions string `json:"testing_instructions,omitempty" desc:"Steps a reviewer follows to verify the criterion"`
+	CheckCommand        string `json:"check_command,omitempty" desc:"Shell command whose exit status verifies the criterion"`
+}
+
+type acVerifyArgs struct {
+	ID         string `json:"id" desc:"Acceptance criterion ID"`
+	VerifiedBy string `json:"verified_by,omitempty" desc:"Who verified it (default user)"`
+}
+
+type acFailArgs struct {
+	ID       string `json:"id" desc:"Acceptance criterion ID"`
+	Feedback string `json:"feedback" desc:"What is wrong and needs fixing"`
+}
+
+type acSkipArgs struct {
+	ID     string `json:"id" desc:"Acceptance criterion ID"`
+	Reason string `json:"reason"`
+}
+
+type docListArgs struct {
+	TrackID   *string `json:"track_id,omitempty"`
+	Iteration *int    `json:"iteration,omitempty"`
+	Type      *string `json:"type,omitempty" enum:"adr,plan,retrospective,other"`
+}
+
+type docCreateArgs struct {
+	Title           string  `json:"title"`
+	Type            string  `json:"type" enum:"adr,plan,retrospective,other"`
+	Content         string  `json:"content" desc:"Markdown content"`
+	Status          string  `json:"status,omitempty" enum:"draft,published,archived" desc:"Defaults to draft"`
+	TrackID         *string `json:"track_id,omitempty" desc:"Attach the document to this track"`
+	IterationNumber *int    `json:"iteration_number,omitempty" desc:"Attach the document to this iteration"`
+}
+
+type docUpdateArgs struct {
+	ID              string  `json:"id" desc:"Document ID"`
+	Content         *string `json:"content,omitempty" desc:"Markdown content"`
+	Status          *string `json:"status,omitempty" enum:"draft,published,archived"`
+	TrackID         *string `json:"track_id,omitempty" desc:"Attach the document to this track"`
+	IterationNumber *int    `json:"iteration_number,omitempty" desc:"Attach the document to this iteration"`
+	Detach          bool    `json:"detach,omitempty" desc:"Remove the track or iteration attachment"`
+	Version         *int    `json:"version,omitempty" desc:"Version of the document the update is based on; fails with conflict if it changed since"`
+}
+
+type adrListArgs struct {
+	TrackID *string `json:"track_id,omitempty"`
+}
+
+type adrCreateArgs struct {
+	TrackID      string `json:"track_id"`
+	Title        string `json:"title"`
+	Context      string `json:"context" desc:"The forces at play"`
+	Decision     string `json:"decision"`
+	Consequences string `json:"consequences"`
+	Alternatives string `json:"alternatives,omitempty"`
+}
+
+// ============================================================================
+// Roadmap and tracks
+// ============================================================================
+
+func (s *Server) roadmapShow(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	if err := decodeArgs(raw, &noArgs{}); err != nil {
+		return "", nil, err
+	}
+	roadmap, err := s.roadmapService.GetRoadmap(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get roadmap: %w", err)
+	}
+	return "roadmap", roadmap, nil
+}
+
+func (s *Server) trackList(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args trackListArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	roadmap, err := s.trackService.GetActiveRoadmap(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get active roadmap: %w", err)
+	}
+	tracks, err := s.trackService.ListTracks(ctx, roadmap.ID, entities.TrackFilters{Status: args.Status})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list tracks: %w", err)
+	}
+	return "track_list", tracks, nil
+}
+
+func (s *Server) trackShow(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args idArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	track, err := s.trackService.GetTrack(ctx, args.ID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get track: %w", err)
+	}
+	docs, err := s.documentService.ListDocuments(ctx, &track.ID, nil, nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list track documents: %w", err)
+	}
+	return "track", &cli.TrackDetailOutput{Track: track, Documents: docs}, nil
+}
+
+// ============================================================================
+// Tasks
+// ============================================================================
+
+func (s *Server) taskList(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args taskListArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	tasks, err := s.taskService.ListTasks(ctx, entities.TaskFilters{
+		TrackID: args.TrackID,
+		Status:  args.Status,
+		Ready:   args.Ready,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	return "task_list", tasks, nil
+}
+
+func (s *Server) taskShow(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args idArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	task, err := s.taskService.GetTask(ctx, args.ID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	return "task", task, nil
+}
+
+func (s *Server) taskCreate(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args taskCreateArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	if args.Rank == 0 {
+		args.Rank = defaultRank
+	}
+	task, err := s.taskService.CreateTask(ctx, dto.CreateTaskDTO{
+		TrackID:     args.TrackID,
+		Title:       args.Title,
+		Description: args.Description,
+		Status:      string(entities.TaskStatusTodo),
+		Rank:        args.Rank,
+		Branch:      args.Branch,
+		Estimate:    args.Estimate,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create task: %w", err)
+	}
+	return "task", task, nil
+}
+
+func (s *Server) taskUpdate(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args taskUpdateArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	task, err := s.taskService.UpdateTask(ctx, dto.UpdateTaskDTO{
+		ID:          args.ID,
+		Title:       args.Title,
+		Description: args.Description,
+		Rank:        args.Rank,
+		TrackID:     args.TrackID,
+		Branch:      args.Branch,
+		Estimate:    args.Estimate,
+		Version:     args.Version,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to update task: %w", err)
+	}
+	return "task", task, nil
+}
+
+func (s *Server) taskUpdateStatus(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args taskUpdateStatusArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	task, err := s.taskService.UpdateTask(ctx, dto.UpdateTaskDTO{ID: args.ID, Status: &args.Status, Version: args.Version})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to update task status: %w", err)
+	}
+	return "task", task, nil
+}
+
+// ============================================================================
+// Iterations
+// ============================================================================
+
+func (s *Server) iterationList(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	if err := decodeArgs(raw, &noArgs{}); err != nil {
+		return "", nil, err
+	}
+	iterations, err := s.iterationService.ListIterations(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list iterations: %w", err)
+	}
+	return "iteration_list", iterations, nil
+}
+
+func (s *Server) iterationCurrent(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	if err := decodeArgs(raw, &noArgs{}); err != nil {
+		return "", nil, err
+	}
+	output, err := s.currentIteration(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return "iteration", output, nil
+}
+
+func (s *Server) iterationShow(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args numberArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	return s.reloadIteration(ctx, args.Number)
+}
+
+func (s *Server) iterationCreate(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args iterationCreateArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	iteration, err := s.iterationService.CreateIteration(ctx, dto.CreateIterationDTO{
+		Name:        args.Name,
+		Goal:        args.Goal,
+		Deliverable: args.Deliverable,
+		Capacity:    args.Capacity,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create iteration: %w", err)
+	}
+	return s.reloadIteration(ctx, iteration.Number)
+}
+
+func (s *Server) iterationAddTasks(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args iterationAddTasksArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	if len(args.TaskIDs) == 0 {
+		return "", nil, fmt.Errorf("%w: task_ids is required", tmerrors.ErrInvalidArgument)
+	}
+	if err := s.iterationService.AddTasks(ctx, args.Number, args.TaskIDs); err != nil {
+		return "", nil, fmt.Errorf("failed to add tasks to iteration: %w", err)
+	}
+	return s.reloadIteration(ctx, args.Number)
+}
+
+func (s *Server) iterationRemoveTask(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args iterationRemoveTaskArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	if err := s.iterationService.RemoveTask(ctx, args.Number, args.TaskID); err != nil {
+		return "", nil, fmt.Errorf("failed to remove task from iteration: %w", err)
+	}
+	return s.reloadIteration(ctx, args.Number)
+}
+
+func (s *Server) iterationStart(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args numberArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	if err := s.iterationService.StartIteration(ctx, args.Number); err != nil {
+		return "", nil, fmt.Errorf("failed to start iteration: %w", err)
+	}
+	return s.reloadIteration(ctx, args.Number)
+}
+
+func (s *Server) iterationComplete(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args iterationCompleteArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	if err := s.iterationService.CompleteIteration(ctx, args.Number, args.Force); err != nil {
+		return "", nil, fmt.Errorf("failed to complete iteration: %w", err)
+	}
+	return s.reloadIteration(ctx, args.Number)
+}
+
+// reloadIteration returns the detail of an iteration read from storage
+func (s *Server) reloadIteration(ctx context.Context, number int) (string, interface{}, error) {
+	iteration, err := s.iterationService.GetIteration(ctx, number)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get iteration: %w", err)
+	}
+	output, err := s.iterationDetail(ctx, iteration)
+	if err != nil {
+		return "", nil, err
+	}
+	return "iteration", output, nil
+}
+
+// currentIteration returns the current iteration, or the fallback the CLI would show
+func (s *Server) currentIteration(ctx context.Context) (*cli.IterationDetailOutput, error) {
+	result, err := s.iterationService.GetCurrentIteration(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current iteration: %w", err)
+	}
+	if result.Iteration == nil {
+		return &cli.IterationDetailOutput{Message: result.FallbackMsg}, nil
+	}
+	iteration, isEntity := result.Iteration.(*entities.IterationEntity)
+	if !isEntity {
+		return nil, fmt.Errorf("%w: unexpected iteration type", tmerrors.ErrInternal)
+	}
+
+	output, err := s.iterationDetail(ctx, iteration)
+	if err != nil {
+		return nil, err
+	}
+	output.IsFallback = result.IsFallback
+	if result.IsFallback {
+		output.Message = result.FallbackMsg
+	}
+	return output, nil
+}
+
+// iterationDetail returns an iteration with its tasks, capacity and documents,
+// the shape of `tm iteration show -o json`
+func (s *Server) iterationDetail(ctx context.Context, iteration *entities.IterationEntity) (*cli.IterationDetailOutput, error) {
+	tasks, err := s.iterationService.GetIterationTasks(ctx, iteration.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iteration tasks: %w", err)
+	}
+	docs, err := s.documentService.ListDocuments(ctx, nil, &iteration.Number, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list iteration documents: %w", err)
+	}
+	return cli.NewIterationDetailOutput(iteration, tasks, docs), nil
+}
+
+// ============================================================================
+// Acceptance criteria
+// ============================================================================
+
+func (s *Server) acList(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args acListArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+
+	var acs []*entities.AcceptanceCriteriaEntity
+	var err error
+	switch {
+	case args.TaskID != "":
+		acs, err = s.acService.ListAC(ctx, args.TaskID)
+	case args.Iteration != nil:
+		acs, err = s.acService.ListACByIteration(ctx, *args.Iteration)
+	default:
+		return "", nil, fmt.Errorf("%w: task_id or iteration is required", tmerrors.ErrInvalidArgument)
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list acceptance criteria: %w", err)
+	}
+	return "ac_list", acs, nil
+}
+
+func (s *Server) acAdd(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args acAddArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	ac, err := s.acService.CreateAC(ctx, dto.CreateACDTO{
+		TaskID:              args.TaskID,
+		Description:         args.Description,
+		TestingInstructions: args.TestingInstructions,
+		CheckCommand:        args.CheckCommand,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to add acceptance criterion: %w", err)
+	}
+	return "ac", ac, nil
+}
+
+func (s *Server) acVerify(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args acVerifyArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	if args.VerifiedBy == "" {
+		args.VerifiedBy = "user"
+	}
+	if err := s.acService.VerifyAC(ctx, dto.VerifyACDTO{ID: args.ID, VerifiedBy: args.VerifiedBy, VerifiedAt: "now"}); err != nil {
+		return "", nil, fmt.Errorf("failed to verify acceptance criterion: %w", err)
+	}
+	return s.reloadAC(ctx, args.ID)
+}
+
+func (s *Server) acFail(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args acFailArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	if err := s.acService.FailAC(ctx, dto.FailACDTO{ID: args.ID, Feedback: args.Feedback}); err != nil {
+		return "", nil, fmt.Errorf("failed to mark acceptance criterion as failed: %w", err)
+	}
+	return s.reloadAC(ctx, args.ID)
+}
+
+func (s *Server) acSkip(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args acSkipArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	if err := s.acService.SkipAC(ctx, dto.SkipACDTO{ID: args.ID, Reason: args.Reason}); err != nil {
+		return "", nil, fmt.Errorf("failed to skip acceptance criterion: %w", err)
+	}
+	return s.reloadAC(ctx, args.ID)
+}
+
+// reloadAC returns an acceptance criterion read from storage
+func (s *Server) reloadAC(ctx context.Context, acID string) (string, interface{}, error) {
+	ac, err := s.acService.GetAC(ctx, acID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get acceptance criterion: %w", err)
+	}
+	return "ac", ac, nil
+}
+
+// ============================================================================
+// Documents
+// ============================================================================
+
+func (s *Server) docList(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args docListArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	docs, err := s.documentService.ListDocuments(ctx, args.TrackID, args.Iteration, args.Type)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+	return "document_list", docs, nil
+}
+
+func (s *Server) docShow(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args idArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	return s.reloadDocument(ctx, args.ID)
+}
+
+func (s *Server) docCreate(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args docCreateArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	if args.Status == "" {
+		args.Status = string(entities.DocumentStatusDraft)
+	}
+	id, err := s.documentService.CreateDocument(ctx, dto.CreateDocumentDTO{
+		Title:           args.Title,
+		Type:            args.Type,
+		Status:          args.Status,
+		Content:         args.Content,
+		TrackID:         args.TrackID,
+		IterationNumber: args.IterationNumber,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create document: %w", err)
+	}
+	return s.reloadDocument(ctx, id)
+}
+
+func (s *Server) docUpdate(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args docUpdateArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	err := s.documentService.UpdateDocument(ctx, dto.UpdateDocumentDTO{
+		ID:              args.ID,
+		Content:         args.Content,
+		Status:          args.Status,
+		TrackID:         args.TrackID,
+		IterationNumber: args.IterationNumber,
+		Detach:          args.Detach,
+		Version:         args.Version,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to update document: %w", err)
+	}
+	return s.reloadDocument(ctx, args.ID)
+}
+
+// reloadDocument returns a document read from storage
+func (s *Server) reloadDocument(ctx context.Context, docID string) (string, interface{}, error) {
+	doc, err := s.documentService.GetDocument(ctx, docID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get document: %w", err)
+	}
+	return "document", doc, nil
+}
+
+// ============================================================================
+// ADRs
+// ============================================================================
+
+func (s *Server) adrList(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args adrListArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	adrs, err := s.adrService.ListADRs(ctx, args.TrackID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list ADRs: %w", err)
+	}
+	return "adr_list", adrs, nil
+}
+
+func (s *Server) adrShow(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args idArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	adr, err := s.adrService.GetADR(ctx, args.ID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get ADR: %w", err)
+	}
+	return "adr", adr, nil
+}
+
+func (s *Server) adrCreate(ctx context.Context, raw json.RawMessage) (string, interface{}, error) {
+	var args adrCreateArgs
+	if err := decodeArgs(raw, &args); err != nil {
+		return "", nil, err
+	}
+	adr, err := s.adrService.CreateADR(ctx, dto.CreateADRDTO{
+		TrackID:      args.TrackID,
+		Title:        args.Title,
+		Context:      args.Context,
+		Decision:     args.Decision,
+		Consequences: args.Consequences,
+		Alternatives: args.Alternatives,
+		Status:       string(entities.ADRStatusProposed),
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create ADR: %w", err)
+	}
+	return "adr", adr, nil
+}
@@ -0,0 +1,132 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/cli"
+)
+
+// structuredContentVersion is the first protocol revision with structured tool output
+const structuredContentVersion = "2025-06-18"
+
+// tool is an MCP tool backed by an application service call
+type tool struct {
+	name        string
+	description string
+	schema      map[string]interface{}
+	// call decodes the arguments and returns the envelope kind and data of the result
+	call func(ctx context.Context, args json.RawMessage) (string, interface{}, error)
+}
+
+// newTool creates a tool whose input schema is derived from the args struct the call decodes
+func newTool(name, description string, args interface{}, call func(ctx context.Context, args json.RawMessage) (string, interface{}, error)) *tool {
+	return &tool{name: name, description: description, schema: inputSchema(args), call: call}
+}
+
+// listTools describes every tool
+func (s *Server) listTools() interface{} {
+	tools := make([]toolDescription, 0, len(s.tools))
+	for _, t := range s.tools {
+		tools = append(tools, toolDescription{Name: t.name, Description: t.description, InputSchema: t.schema})
+	}
+	return map[string]interface{}{"tools": tools}
+}
+
+// callTool runs a tool. Failures of the call itself are tool results with isError set,
+// so the model sees the error envelope; only unknown tools are protocol errors.
+func (s *Server) callTool(ctx context.Context, raw json.RawMessage) (interface{}, *rpcError) {
+	var params callToolParams
+	if err := decodeParams(raw, &params); err != nil {
+		return nil, err
+	}
+	var t *tool
+	for _, candidate := range s.tools {
+		if candidate.name == params.Name {
+			t = candidate
+		}
+	}
+	if t == nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("unknown tool %q", params.Name)}
+	}
+
+	var kind string
+	var data interface{}
+	err := checkRequired(t.schema, params.Arguments)
+	if err == nil {
+		kind, data, err = t.call(ctx, params.Arguments)
+	}
+	if err != nil {
+		return s.toolResult(cli.Envelope{
+			SchemaVersion: cli.OutputSchemaVersion,
+			Kind:          "error",
+			Error:         &cli.ErrorPayload{Code: cli.ErrorCode(err), Message: err.Error()},
+		}, true)
+	}
+	return s.toolResult(cli.Envelope{
+		SchemaVersion: cli.OutputSchemaVersion,
+		Kind:          kind,
+		Data:          emptyList(data),
+	}, false)
+}
+
+// toolResult returns an envelope as text content, and as structured content when the client supports it
+func (s *Server) toolResult(envelope cli.Envelope, isError bool) (interface{}, *rpcError) {
+	var buf bytes.Buffer
+	if err := cli.WriteEnvelope(&buf, cli.OutputJSON, envelope); err != nil {
+		return nil, &rpcError{Code: codeInternalError, Message: err.Error()}
+	}
+	result := &callToolResult{
+		Content: []textContent{{Type: "text", Text: buf.String()}},
+		IsError: isError,
+	}
+	if s.protocolVersion >= structuredContentVersion {
+		result.StructuredContent = envelope
+	}
+	return result, nil
+}
+
+// checkRequired reports the required arguments missing from a call
+func checkRequired(schema map[string]interface{}, raw json.RawMessage) error {
+	required, _ := schema["required"].([]string)
+	if len(required) == 0 {
+		return nil
+	}
+	present := map[string]json.RawMessage{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &present); err != nil {
+			return fmt.Errorf("%w: arguments must be an object: %v", tmerrors.ErrInvalidArgument, err)
+		}
+	}
+	for _, name := range required {
+		if _, ok := present[name]; !ok {
+			return fmt.Errorf("%w: %s is required", tmerrors.ErrInvalidArgument, name)
+		}
+	}
+	return nil
+}
+
+// decodeArgs decodes tool arguments into v, rejecting unknown ones
+func decodeArgs(raw json.RawMessage, v interface{}) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("%w: invalid arguments: %v", tmerrors.ErrInvalidArgument, err)
+	}
+	return nil
+}
+
+// emptyList turns nil slices into empty ones so clients can iterate unconditionally
+func emptyList(data interface{}) interface{} {
+	if v := reflect.ValueOf(data); v.Kind() == reflect.Slice && v.IsNil() {
+		return []interface{}{}
+	}
+	return data
+}
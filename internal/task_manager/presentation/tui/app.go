@@ -7,6 +7,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/logger"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/presenters"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/queries"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/viewmodels"
@@ -23,13 +24,17 @@ const (
 	ViewTaskDetailNew
 	ViewTrackDetailNew
 	ViewDocumentDetailNew
+	ViewSearchNew
+	ViewMetricsNew
 )
 
 // AppModelNew is the root Bubble Tea model for the new MVP TUI
 type AppModelNew struct {
-	ctx    context.Context
-	repo   domain.RoadmapRepository
-	logger logger.Logger
+	ctx      context.Context
+	repo     domain.RoadmapRepository
+	commands presenters.Commands
+	vcs      services.VersionControl
+	logger   logger.Logger
 
 	currentView     ViewStateNew
 	activePresenter presenters.Presenter
@@ -43,6 +48,8 @@ type AppModelNew struct {
 	currentDocumentID      string                        // Track current document being viewed
 	currentActiveTab       presenters.IterationDetailTab // Track active tab for AC actions
 	dashboardSelectedIndex int                           // Dashboard selected index (for restoring focus on return)
+	searchQuery            string                        // Last search query (for restoring the search view on return)
+	searchSelectedIndex    int                           // Selected search result (for restoring focus on return)
 
 	// Document viewer state (for restoration on ESC)
 	previousActiveTab     presenters.IterationDetailTab
@@ -56,11 +63,15 @@ type AppModelNew struct {
 func NewAppModelNew(
 	ctx context.Context,
 	repo domain.RoadmapRepository,
+	commands presenters.Commands,
+	vcs services.VersionControl,
 	logger logger.Logger,
 ) *AppModelNew {
 	return &AppModelNew{
 		ctx:         ctx,
 		repo:        repo,
+		commands:    commands,
+		vcs:         vcs,
 		logger:      logger,
 		currentView: ViewLoadingNew,
 	}
@@ -83,7 +94,11 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = msg.Height
 
 	case tea.KeyMsg:
-		if msg.String() == "q" || msg.String() == "ctrl+c" {
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		// "q" is typed into the query while searching
+		if msg.String() == "q" && m.currentView != ViewSearchNew {
 			return m, tea.Quit
 		}
 
@@ -92,9 +107,9 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.currentView = ViewRoadmapListNew
 		// Use the selected index from message if provided (non-nil)
 		if msg.selectedIndex != nil {
-			m.activePresenter = presenters.NewRoadmapListPresenterWithSelection(msg.viewModel, m.repo, m.ctx, *msg.selectedIndex)
+			m.activePresenter = presenters.NewRoadmapListPresenterWithSelection(msg.viewModel, m.repo, m.commands, m.ctx, *msg.selectedIndex)
 		} else {
-			m.activePresenter = presenters.NewRoadmapListPresenter(msg.viewModel, m.repo, m.ctx)
+			m.activePresenter = presenters.NewRoadmapListPresenter(msg.viewModel, m.repo, m.commands, m.ctx)
 		}
 		return m, m.activePresenter.Init()
 
@@ -113,6 +128,33 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, m.activePresenter.Init()
 
 	case presenters.BackMsgNew:
+		if m.currentView == ViewMetricsNew {
+			// Go back to dashboard from metrics
+			m.currentView = ViewLoadingNew
+			loadingVM := viewmodels.NewLoadingViewModel("Loading dashboard...")
+			m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+			return m, tea.Batch(
+				m.activePresenter.Init(),
+				m.loadRoadmapListWithIndex(m.dashboardSelectedIndex),
+			)
+		}
+		if m.currentView == ViewSearchNew {
+			// Go back to dashboard from search
+			m.searchQuery = ""
+			m.searchSelectedIndex = 0
+			m.currentView = ViewLoadingNew
+			loadingVM := viewmodels.NewLoadingViewModel("Loading dashboard...")
+			m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+			return m, tea.Batch(
+				m.activePresenter.Init(),
+				m.loadRoadmapListWithIndex(m.dashboardSelectedIndex),
+			)
+		}
+		if m.previousView == ViewSearchNew &&
+			(m.currentView == ViewTaskDetailNew || m.currentView == ViewTrackDetailNew || m.currentView == ViewDocumentDetailNew) {
+			// Return to the search results the item was opened from
+			return m, m.openSearch()
+		}
 		if m.currentView == ViewDocumentDetailNew {
 			// Return from document viewer to previous view with restored state
 			if m.previousView == ViewIterationDetailNew && m.currentIterationNumber > 0 {
@@ -240,6 +282,20 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			)
 		}
 
+	case presenters.SearchRequestedMsg:
+		// Open search from dashboard
+		m.dashboardSelectedIndex = msg.SelectedIndex
+		m.searchQuery = ""
+		m.searchSelectedIndex = 0
+		return m, m.openSearch()
+
+	case presenters.MetricsRequestedMsg:
+		// Open metrics from dashboard
+		m.dashboardSelectedIndex = msg.SelectedIndex
+		m.currentView = ViewMetricsNew
+		m.activePresenter = presenters.NewMetricsPresenter(m.repo, m.ctx)
+		return m, m.activePresenter.Init()
+
 	case presenters.IterationSelectedMsg:
 		// Load iteration detail
 		m.previousView = m.currentView
@@ -257,7 +313,11 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Load track detail
 		m.previousView = m.currentView
 		m.currentTrackID = msg.TrackID
-		m.dashboardSelectedIndex = msg.SelectedIndex
+		if m.currentView == ViewSearchNew {
+			m.saveSearchState()
+		} else {
+			m.dashboardSelectedIndex = msg.SelectedIndex
+		}
 		m.currentView = ViewLoadingNew
 		loadingVM := viewmodels.NewLoadingViewModel(fmt.Sprintf("Loading track %s...", msg.TrackID))
 		m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
@@ -280,9 +340,9 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Transition to IterationDetailPresenter with saved activeTab and optional selectedIndex
 		m.currentView = ViewIterationDetailNew
 		if msg.selectedIndex != nil {
-			m.activePresenter = presenters.NewIterationDetailPresenterWithSelection(msg.viewModel, m.repo, m.ctx, msg.activeTab, *msg.selectedIndex)
+			m.activePresenter = presenters.NewIterationDetailPresenterWithSelection(msg.viewModel, m.repo, m.commands, m.ctx, msg.activeTab, *msg.selectedIndex)
 		} else {
-			m.activePresenter = presenters.NewIterationDetailPresenterWithTab(msg.viewModel, m.repo, m.ctx, msg.activeTab)
+			m.activePresenter = presenters.NewIterationDetailPresenterWithTab(msg.viewModel, m.repo, m.commands, m.ctx, msg.activeTab)
 		}
 		return m, m.activePresenter.Init()
 
@@ -290,7 +350,11 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Load task detail
 		m.previousView = m.currentView
 		m.currentTaskID = msg.TaskID
-		m.dashboardSelectedIndex = msg.SelectedIndex
+		if m.currentView == ViewSearchNew {
+			m.saveSearchState()
+		} else {
+			m.dashboardSelectedIndex = msg.SelectedIndex
+		}
 		m.currentView = ViewLoadingNew
 		loadingVM := viewmodels.NewLoadingViewModel(fmt.Sprintf("Loading task %s...", msg.TaskID))
 		m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
@@ -303,9 +367,9 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Transition to TaskDetailPresenter
 		m.currentView = ViewTaskDetailNew
 		if msg.selectedIndex != nil {
-			m.activePresenter = presenters.NewTaskDetailPresenterWithSelection(msg.viewModel, m.repo, m.ctx, *msg.selectedIndex)
+			m.activePresenter = presenters.NewTaskDetailPresenterWithSelection(msg.viewModel, m.repo, m.commands, m.ctx, *msg.selectedIndex)
 		} else {
-			m.activePresenter = presenters.NewTaskDetailPresenter(msg.viewModel, m.repo, m.ctx)
+			m.activePresenter = presenters.NewTaskDetailPresenter(msg.viewModel, m.repo, m.commands, m.ctx)
 		}
 		return m, m.activePresenter.Init()
 
@@ -352,10 +416,12 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if trackPresenter, ok := m.activePresenter.(*presenters.TrackDetailPresenter); ok {
 				m.previousSelectedIndex = trackPresenter.GetSelectedIndex()
 			}
+		} else if m.currentView == ViewSearchNew {
+			m.saveSearchState()
 		}
 
 		m.currentView = ViewDocumentDetailNew
-		presenter := presenters.NewDocumentViewerPresenter(msg.DocumentID, m.repo, m.ctx)
+		presenter := presenters.NewDocumentViewerPresenter(msg.DocumentID, m.repo, m.commands, m.ctx)
 		m.activePresenter = presenter
 		return m, presenter.Init()
 	}
@@ -376,6 +442,21 @@ func (m *AppModelNew) View() string {
 	return "\nInitializing...\n"
 }
 
+// openSearch shows the search view, re-running the last query if there is one
+func (m *AppModelNew) openSearch() tea.Cmd {
+	m.currentView = ViewSearchNew
+	m.activePresenter = presenters.NewSearchPresenter(m.repo, m.ctx, m.searchQuery, m.searchSelectedIndex)
+	return m.activePresenter.Init()
+}
+
+// saveSearchState records the query and selected result of the search view being left
+func (m *AppModelNew) saveSearchState() {
+	if searchPresenter, ok := m.activePresenter.(*presenters.SearchPresenter); ok {
+		m.searchQuery = searchPresenter.GetQuery()
+		m.searchSelectedIndex = searchPresenter.GetSelectedIndex()
+	}
+}
+
 func (m *AppModelNew) loadRoadmapList() tea.Cmd {
 	return func() tea.Msg {
 		vm, err := queries.LoadRoadmapListData(m.ctx, m.repo)
@@ -454,7 +535,7 @@ func (m *AppModelNew) loadTaskDetail(taskID string) tea.Cmd {
 
 func (m *AppModelNew) loadTaskDetailWithSelection(taskID string, selectedIndex int) tea.Cmd {
 	return func() tea.Msg {
-		vm, err := queries.LoadTaskDetailData(m.ctx, m.repo, taskID)
+		vm, err := queries.LoadTaskDetailData(m.ctx, m.repo, m.vcs, taskID)
 		if err != nil {
 			return presenters.ErrorMsg{Err: err}
 		}
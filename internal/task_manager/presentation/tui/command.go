@@ -7,12 +7,18 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/logger"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/presenters"
 	"github.com/spf13/cobra"
 )
 
-// NewUICommand creates a Cobra command for launching the interactive TUI
+// NewUICommand creates a Cobra command for launching the interactive TUI.
+// The TUI reads through repo and makes its changes through commands.
+// vcs supplies the commits shown in task details and may be nil.
 func NewUICommand(
 	repo domain.RoadmapRepository,
+	commands presenters.Commands,
+	vcs services.VersionControl,
 	logger logger.Logger,
 ) *cobra.Command {
 	return &cobra.Command{
@@ -31,7 +37,7 @@ Navigation:
   q              Quit`,
 		Example: `  tm ui`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runTUI(cmd.Context(), repo, logger)
+			return runTUI(cmd.Context(), repo, commands, vcs, logger)
 		},
 	}
 }
@@ -40,10 +46,12 @@ Navigation:
 func runTUI(
 	ctx context.Context,
 	repo domain.RoadmapRepository,
+	commands presenters.Commands,
+	vcs services.VersionControl,
 	logger logger.Logger,
 ) error {
 	// Create the TUI app model
-	appModel := NewAppModelNew(ctx, repo, logger)
+	appModel := NewAppModelNew(ctx, repo, commands, vcs, logger)
 
 	// Start the Bubble Tea program
 	p := tea.NewProgram(appModel, tea.WithAltScreen())
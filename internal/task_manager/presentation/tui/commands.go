@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/dto"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/presenters"
+)
+
+// ServiceCommands implements presenters.Commands with the application services,
+// so TUI changes record history, run hooks and respect transition guards.
+type ServiceCommands struct {
+	taskService      *application.TaskApplicationService
+	acService        *application.ACApplicationService
+	iterationService *application.IterationApplicationService
+	documentService  *application.DocumentApplicationService
+}
+
+var _ presenters.Commands = (*ServiceCommands)(nil)
+
+// NewServiceCommands creates the commands used by the TUI presenters
+func NewServiceCommands(
+	taskService *application.TaskApplicationService,
+	acService *application.ACApplicationService,
+	iterationService *application.IterationApplicationService,
+	documentService *application.DocumentApplicationService,
+) *ServiceCommands {
+	return &ServiceCommands{
+		taskService:      taskService,
+		acService:        acService,
+		iterationService: iterationService,
+		documentService:  documentService,
+	}
+}
+
+// SetTaskStatus moves a task to a new status, starting over from a fresh copy
+// if someone else updated the task in between
+func (c *ServiceCommands) SetTaskStatus(ctx context.Context, taskID, status string) error {
+	return tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+		_, err := c.taskService.UpdateTask(ctx, dto.UpdateTaskDTO{ID: taskID, Status: &status})
+		return err
+	})
+}
+
+// VerifyAC marks an acceptance criterion as verified
+func (c *ServiceCommands) VerifyAC(ctx context.Context, acID string) error {
+	return tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+		return c.acService.VerifyAC(ctx, dto.VerifyACDTO{ID: acID, VerifiedBy: "user", VerifiedAt: "now"})
+	})
+}
+
+// SkipAC marks an acceptance criterion as skipped
+func (c *ServiceCommands) SkipAC(ctx context.Context, acID, reason string) error {
+	return tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+		return c.acService.SkipAC(ctx, dto.SkipACDTO{ID: acID, Reason: reason})
+	})
+}
+
+// FailAC marks an acceptance criterion as failed with feedback
+func (c *ServiceCommands) FailAC(ctx context.Context, acID, feedback string) error {
+	return tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+		return c.acService.FailAC(ctx, dto.FailACDTO{ID: acID, Feedback: feedback})
+	})
+}
+
+// SetIterationRank moves an iteration to a new position in the roadmap
+func (c *ServiceCommands) SetIterationRank(ctx context.Context, iterationNumber int, rank float64) error {
+	return tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+		_, err := c.iterationService.UpdateIteration(ctx, dto.UpdateIterationDTO{Number: iterationNumber, Rank: &rank})
+		return err
+	})
+}
+
+// StartIteration starts a planned iteration
+func (c *ServiceCommands) StartIteration(ctx context.Context, iterationNumber int) error {
+	return c.iterationService.StartIteration(ctx, iterationNumber)
+}
+
+// CompleteIteration completes the current iteration
+func (c *ServiceCommands) CompleteIteration(ctx context.Context, iterationNumber int) error {
+	return c.iterationService.CompleteIteration(ctx, iterationNumber, false)
+}
+
+// RevertIteration moves an iteration back to planned
+func (c *ServiceCommands) RevertIteration(ctx context.Context, iterationNumber int) error {
+	return c.iterationService.RevertIteration(ctx, iterationNumber)
+}
+
+// SetDocumentStatus changes a document's status
+func (c *ServiceCommands) SetDocumentStatus(ctx context.Context, documentID string, status entities.DocumentStatus) error {
+	statusValue := string(status)
+	return tmerrors.RetryOnConflict(tmerrors.DefaultConflictRetries, func() error {
+		return c.documentService.UpdateDocument(ctx, dto.UpdateDocumentDTO{ID: documentID, Status: &statusValue})
+	})
+}
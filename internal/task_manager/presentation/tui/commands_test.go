@@ -0,0 +1,218 @@
+package tui_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/application/mocks"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/events"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui"
+)
+
+// vetoingGuard records consulted events and rejects the configured event type
+type vetoingGuard struct {
+	reject    string
+	consulted []string
+}
+
+func (g *vetoingGuard) BeforeTransition(ctx context.Context, event events.Event) error {
+	g.consulted = append(g.consulted, event.Type)
+	if event.Type == g.reject {
+		return fmt.Errorf("%w: vetoed by test", tmerrors.ErrRejected)
+	}
+	return nil
+}
+
+// recordingEventBus captures published events for assertions
+type recordingEventBus struct {
+	published []string
+}
+
+func (b *recordingEventBus) Publish(ctx context.Context, event events.Event) {
+	b.published = append(b.published, event.Type)
+}
+
+func (b *recordingEventBus) Subscribe(eventType string, handler events.Handler) {}
+
+func assertPublished(t *testing.T, bus *recordingEventBus, want ...string) {
+	t.Helper()
+	if fmt.Sprint(bus.published) != fmt.Sprint(want) {
+		t.Fatalf("published events = %v, want %v", bus.published, want)
+	}
+}
+
+// testRepositories holds the mocks behind the services; nil fields get empty mocks
+type testRepositories struct {
+	tasks      *mocks.MockTaskRepository
+	acs        *mocks.MockAcceptanceCriteriaRepository
+	iterations *mocks.MockIterationRepository
+	documents  *mocks.MockDocumentRepository
+}
+
+// newTestCommands wires ServiceCommands to application services backed by mock repositories
+func newTestCommands(repos testRepositories, bus events.EventBus, guard events.TransitionGuard) *tui.ServiceCommands {
+	if repos.tasks == nil {
+		repos.tasks = &mocks.MockTaskRepository{}
+	}
+	if repos.acs == nil {
+		repos.acs = &mocks.MockAcceptanceCriteriaRepository{}
+	}
+	if repos.iterations == nil {
+		repos.iterations = &mocks.MockIterationRepository{}
+	}
+	if repos.documents == nil {
+		repos.documents = &mocks.MockDocumentRepository{}
+	}
+
+	validation := services.NewValidationService()
+	aggregates := &mocks.MockAggregateRepository{}
+	taskService := application.NewTaskApplicationService(repos.tasks, &mocks.MockTrackRepository{}, aggregates, repos.acs, validation, bus, guard, nil)
+	acService := application.NewACApplicationService(repos.acs, repos.tasks, aggregates, validation, bus, guard)
+	iterationService := application.NewIterationApplicationService(repos.iterations, repos.tasks, repos.acs, repos.documents, aggregates, services.NewIterationService(), validation, nil, bus, guard, nil)
+	documentService := application.NewDocumentApplicationService(repos.documents, &mocks.MockTrackRepository{}, repos.iterations, bus, nil)
+	return tui.NewServiceCommands(taskService, acService, iterationService, documentService)
+}
+
+// TestServiceCommands_SetTaskStatusConsultsGuard verifies a task status change made in the TUI can be vetoed
+func TestServiceCommands_SetTaskStatusConsultsGuard(t *testing.T) {
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "todo", 100, "", now, now)
+
+	persisted := false
+	taskRepo := &mocks.MockTaskRepository{
+		GetTaskFunc: func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+			return task, nil
+		},
+		UpdateTaskFunc: func(ctx context.Context, task *entities.TaskEntity) error {
+			persisted = true
+			return nil
+		},
+	}
+	guard := &vetoingGuard{reject: events.EventTaskStatusChanged}
+	commands := newTestCommands(testRepositories{tasks: taskRepo}, nil, guard)
+
+	err := commands.SetTaskStatus(context.Background(), "TM-task-1", "in-progress")
+	if !errors.Is(err, tmerrors.ErrRejected) {
+		t.Fatalf("SetTaskStatus() error = %v, want ErrRejected", err)
+	}
+	if persisted {
+		t.Error("vetoed status change should not be persisted")
+	}
+	if len(guard.consulted) != 1 || guard.consulted[0] != events.EventTaskStatusChanged {
+		t.Errorf("guard consulted for %v, want [%s]", guard.consulted, events.EventTaskStatusChanged)
+	}
+}
+
+// TestServiceCommands_VerifyACConsultsGuard verifies an AC verified in the TUI can be vetoed
+func TestServiceCommands_VerifyACConsultsGuard(t *testing.T) {
+	now := time.Now().UTC()
+	ac := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "AC", entities.VerificationTypeManual, "", now, now)
+
+	persisted := false
+	acRepo := &mocks.MockAcceptanceCriteriaRepository{
+		GetACFunc: func(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
+			return ac, nil
+		},
+		UpdateACFunc: func(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+			persisted = true
+			return nil
+		},
+	}
+	guard := &vetoingGuard{reject: events.EventACVerified}
+	commands := newTestCommands(testRepositories{acs: acRepo}, nil, guard)
+
+	err := commands.VerifyAC(context.Background(), "TM-ac-1")
+	if !errors.Is(err, tmerrors.ErrRejected) {
+		t.Fatalf("VerifyAC() error = %v, want ErrRejected", err)
+	}
+	if persisted {
+		t.Error("vetoed AC verification should not be persisted")
+	}
+}
+
+// TestServiceCommands_PublishEvents verifies changes made in the TUI publish the same events as the CLI
+func TestServiceCommands_PublishEvents(t *testing.T) {
+	now := time.Now().UTC()
+	newRepos := func() testRepositories {
+		task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "todo", 100, "", now, now)
+		ac := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "AC", entities.VerificationTypeManual, "", now, now)
+		iteration, _ := entities.NewIterationEntity(1, "Sprint 1", "", "", nil, "planned", 500, time.Time{}, time.Time{}, now, now)
+		doc, _ := entities.NewDocumentEntity("TM-doc-1", "Plan", entities.DocumentTypePlan, entities.DocumentStatusDraft, "# Plan", nil, nil, now, now)
+		return testRepositories{
+			tasks: &mocks.MockTaskRepository{
+				GetTaskFunc: func(ctx context.Context, id string) (*entities.TaskEntity, error) { return task, nil },
+			},
+			acs: &mocks.MockAcceptanceCriteriaRepository{
+				GetACFunc: func(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) { return ac, nil },
+			},
+			iterations: &mocks.MockIterationRepository{
+				GetIterationFunc: func(ctx context.Context, number int) (*entities.IterationEntity, error) { return iteration, nil },
+			},
+			documents: &mocks.MockDocumentRepository{
+				FindDocumentByIDFunc: func(ctx context.Context, id string) (*entities.DocumentEntity, error) { return doc, nil },
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		change func(commands *tui.ServiceCommands) error
+		want   []string
+	}{
+		{
+			name: "task status",
+			change: func(c *tui.ServiceCommands) error {
+				return c.SetTaskStatus(context.Background(), "TM-task-1", "in-progress")
+			},
+			want: []string{events.EventTaskUpdated, events.EventTaskStatusChanged},
+		},
+		{
+			name:   "verify AC",
+			change: func(c *tui.ServiceCommands) error { return c.VerifyAC(context.Background(), "TM-ac-1") },
+			want:   []string{events.EventACVerified},
+		},
+		{
+			name: "skip AC",
+			change: func(c *tui.ServiceCommands) error {
+				return c.SkipAC(context.Background(), "TM-ac-1", "Skipped via TUI")
+			},
+			want: []string{events.EventACSkipped},
+		},
+		{
+			name:   "fail AC",
+			change: func(c *tui.ServiceCommands) error { return c.FailAC(context.Background(), "TM-ac-1", "Broken") },
+			want:   []string{events.EventACFailed},
+		},
+		{
+			name:   "iteration rank",
+			change: func(c *tui.ServiceCommands) error { return c.SetIterationRank(context.Background(), 1, 250.5) },
+			want:   []string{events.EventIterationUpdated},
+		},
+		{
+			name: "document status",
+			change: func(c *tui.ServiceCommands) error {
+				return c.SetDocumentStatus(context.Background(), "TM-doc-1", entities.DocumentStatusPublished)
+			},
+			want: []string{events.EventDocumentUpdated},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := &recordingEventBus{}
+			commands := newTestCommands(newRepos(), bus, nil)
+
+			if err := tt.change(commands); err != nil {
+				t.Fatalf("change failed: %v", err)
+			}
+			assertPublished(t, bus, tt.want...)
+		})
+	}
+}
@@ -10,17 +10,17 @@ package presenters
 //
 // Architecture:
 // - Stateless rendering: RenderACList accepts ACs and selectedIndex as parameters
-// - Stateful actions: Repository operations encapsulated in VerifyAC/SkipAC/FailAC
+// - Stateful actions: VerifyAC/SkipAC/FailAC go through Commands
 // - Interface-based: Works with both ACDetailViewModel and IterationACViewModel via ACViewModel interface
 // - Delegate feedback: Uses FeedbackInputComponent for failure reason input
 //
 // Usage in TaskDetailPresenter:
-//   component := NewACListComponent(repo, ctx, true)  // enableExpand=true for testing instructions
+//   component := NewACListComponent(commands, ctx, true)  // enableExpand=true for testing instructions
 //   acVMs := WrapACDetailViewModels(presenter.viewModel.AcceptanceCriteria)
 //   component.RenderACList(&b, acVMs, selectedIndex, width)
 //
 // Usage in IterationDetailPresenter:
-//   component := NewACListComponent(repo, ctx, true)  // enableExpand=true (same as TaskDetail)
+//   component := NewACListComponent(commands, ctx, true)  // enableExpand=true (same as TaskDetail)
 //   acVMs := WrapIterationACViewModels(presenter.viewModel.AllAcceptanceCriteria)
 //   component.RenderACList(&b, acVMs, selectedIndex, width)
 //
@@ -35,11 +35,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/components"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/viewmodels"
@@ -115,20 +113,20 @@ func getACStyleForStatus(statusColor string) lipgloss.Style {
 //
 // Design:
 // - Stateless for rendering: accepts ACs and selectedIndex as parameters
-// - Stateful for actions: encapsulates repository operations (verify/skip/fail)
+// - Stateful for actions: encapsulates AC commands (verify/skip/fail)
 // - Delegates feedback input to FeedbackInputComponent
 // - Preserves selection after actions via ACActionCompletedMsg
 type ACListComponent struct {
-	repo          domain.RoadmapRepository
+	commands      Commands
 	ctx           context.Context
 	feedbackInput *FeedbackInputComponent
 	enableExpand  bool // Whether to allow expanding ACs (TaskDetail only)
 }
 
 // NewACListComponent creates a new AC list component
-func NewACListComponent(repo domain.RoadmapRepository, ctx context.Context, enableExpand bool) *ACListComponent {
+func NewACListComponent(commands Commands, ctx context.Context, enableExpand bool) *ACListComponent {
 	return &ACListComponent{
-		repo:          repo,
+		commands:      commands,
 		ctx:           ctx,
 		feedbackInput: NewFeedbackInputComponent(),
 		enableExpand:  enableExpand,
@@ -139,19 +137,7 @@ func NewACListComponent(repo domain.RoadmapRepository, ctx context.Context, enab
 // Returns ACActionCompletedMsg to preserve selection and active tab.
 func (c *ACListComponent) VerifyAC(acID string, activeTab IterationDetailTab, currentSelectedIndex int) tea.Cmd {
 	return func() tea.Msg {
-		// Fetch AC
-		ac, err := c.repo.GetAC(c.ctx, acID)
-		if err != nil {
-			return ErrorMsg{Err: err}
-		}
-
-		// Update status to verified
-		ac.Status = entities.ACStatusVerified
-		ac.UpdatedAt = time.Now()
-
-		// Save
-		err = c.repo.UpdateAC(c.ctx, ac)
-		if err != nil {
+		if err := c.commands.VerifyAC(c.ctx, acID); err != nil {
 			return ErrorMsg{Err: err}
 		}
 
@@ -163,20 +149,7 @@ func (c *ACListComponent) VerifyAC(acID string, activeTab IterationDetailTab, cu
 // Returns ACActionCompletedMsg to preserve selection and active tab.
 func (c *ACListComponent) SkipAC(acID string, activeTab IterationDetailTab, currentSelectedIndex int) tea.Cmd {
 	return func() tea.Msg {
-		// Fetch AC
-		ac, err := c.repo.GetAC(c.ctx, acID)
-		if err != nil {
-			return ErrorMsg{Err: err}
-		}
-
-		// Update status to skipped
-		ac.Status = entities.ACStatusSkipped
-		ac.Notes = "Skipped via TUI"
-		ac.UpdatedAt = time.Now()
-
-		// Save
-		err = c.repo.UpdateAC(c.ctx, ac)
-		if err != nil {
+		if err := c.commands.SkipAC(c.ctx, acID, "Skipped via TUI"); err != nil {
 			return ErrorMsg{Err: err}
 		}
 
@@ -188,20 +161,7 @@ func (c *ACListComponent) SkipAC(acID string, activeTab IterationDetailTab, curr
 // Returns ACActionCompletedMsg to preserve selection and active tab.
 func (c *ACListComponent) FailAC(acID, feedback string, activeTab IterationDetailTab, currentSelectedIndex int) tea.Cmd {
 	return func() tea.Msg {
-		// Fetch AC
-		ac, err := c.repo.GetAC(c.ctx, acID)
-		if err != nil {
-			return ErrorMsg{Err: err}
-		}
-
-		// Update status to failed with feedback
-		ac.Status = entities.ACStatusFailed
-		ac.Notes = feedback
-		ac.UpdatedAt = time.Now()
-
-		// Save
-		err = c.repo.UpdateAC(c.ctx, ac)
-		if err != nil {
+		if err := c.commands.FailAC(c.ctx, acID, feedback); err != nil {
 			return ErrorMsg{Err: err}
 		}
 
@@ -0,0 +1,26 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+)
+
+// Commands performs the mutations triggered from the TUI.
+// Presenters read through the repository but write through Commands, so a change made
+// in the TUI passes the same transition guards and publishes the same domain events
+// (history, hooks) as one made through the CLI.
+type Commands interface {
+	SetTaskStatus(ctx context.Context, taskID, status string) error
+
+	VerifyAC(ctx context.Context, acID string) error
+	SkipAC(ctx context.Context, acID, reason string) error
+	FailAC(ctx context.Context, acID, feedback string) error
+
+	SetIterationRank(ctx context.Context, iterationNumber int, rank float64) error
+	StartIteration(ctx context.Context, iterationNumber int) error
+	CompleteIteration(ctx context.Context, iterationNumber int) error
+	RevertIteration(ctx context.Context, iterationNumber int) error
+
+	SetDocumentStatus(ctx context.Context, documentID string, status entities.DocumentStatus) error
+}
@@ -31,6 +31,8 @@ type RoadmapListKeyMap struct {
 	StartIteration  key.Binding // s - Start iteration (planned → current)
 	CompleteIter    key.Binding // c - Complete iteration (current → complete)
 	RevertIteration key.Binding // p - Revert iteration (complete → planned)
+	Search          key.Binding // / - Full-text search
+	Metrics         key.Binding // m - Velocity, burndown, cycle time and throughput
 }
 
 // NewRoadmapListKeyMap creates default keybindings for dashboard
@@ -77,19 +79,27 @@ func NewRoadmapListKeyMap() RoadmapListKeyMap {
 			key.WithKeys("p"),
 			key.WithHelp("p", "revert iteration"),
 		),
+		Search: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "search"),
+		),
+		Metrics: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "metrics"),
+		),
 	}
 }
 
 // ShortHelp returns keybindings to show in short help view
 func (k RoadmapListKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Up, k.Down, k.Enter, k.Tab, k.Refresh, k.Quit}
+	return []key.Binding{k.Up, k.Down, k.Enter, k.Tab, k.Search, k.Refresh, k.Quit}
 }
 
 // FullHelp returns all keybindings for full help view
 func (k RoadmapListKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Up, k.Down, k.Enter},
-		{k.Tab, k.Refresh},
+		{k.Tab, k.Search, k.Metrics, k.Refresh},
 		{k.StartIteration, k.CompleteIter, k.RevertIteration},
 		{k.PageUp, k.PageDown},
 		{k.MoveUp, k.MoveDown},
@@ -99,7 +109,7 @@ func (k RoadmapListKeyMap) FullHelp() [][]key.Binding {
 
 // ContextualHelp returns keybindings based on selected item state
 func (k RoadmapListKeyMap) ContextualHelp(iterationStatus string) []key.Binding {
-	base := []key.Binding{k.Up, k.Down, k.Enter, k.Tab, k.Refresh}
+	base := []key.Binding{k.Up, k.Down, k.Enter, k.Tab, k.Search, k.Refresh}
 
 	// Add iteration state transition keys based on current status
 	switch iterationStatus {
@@ -134,17 +144,18 @@ type RoadmapListPresenter struct {
 	width         int
 	height        int
 	repo          domain.RoadmapRepository
+	commands      Commands
 	ctx           context.Context
 	scrollHelper  *components.ScrollHelper
 }
 
 // NewRoadmapListPresenter creates a new dashboard presenter
-func NewRoadmapListPresenter(vm *viewmodels.RoadmapListViewModel, repo domain.RoadmapRepository, ctx context.Context) *RoadmapListPresenter {
-	return NewRoadmapListPresenterWithSelection(vm, repo, ctx, 0)
+func NewRoadmapListPresenter(vm *viewmodels.RoadmapListViewModel, repo domain.RoadmapRepository, commands Commands, ctx context.Context) *RoadmapListPresenter {
+	return NewRoadmapListPresenterWithSelection(vm, repo, commands, ctx, 0)
 }
 
 // NewRoadmapListPresenterWithSelection creates a new dashboard presenter with initial selection
-func NewRoadmapListPresenterWithSelection(vm *viewmodels.RoadmapListViewModel, repo domain.RoadmapRepository, ctx context.Context, selectedIndex int) *RoadmapListPresenter {
+func NewRoadmapListPresenterWithSelection(vm *viewmodels.RoadmapListViewModel, repo domain.RoadmapRepository, commands Commands, ctx context.Context, selectedIndex int) *RoadmapListPresenter {
 	return &RoadmapListPresenter{
 		viewModel:     vm,
 		help:          components.NewHelp(),
@@ -153,6 +164,7 @@ func NewRoadmapListPresenterWithSelection(vm *viewmodels.RoadmapListViewModel, r
 		selectedIndex: selectedIndex,
 		activeSection: SectionIterations, // Default to iterations section
 		repo:          repo,
+		commands:      commands,
 		ctx:           ctx,
 		width:         80, // Default width until WindowSizeMsg arrives
 		height:        24,
@@ -200,6 +212,14 @@ func (p *RoadmapListPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 			return p, func() tea.Msg {
 				return RefreshDashboardMsg{SelectedIndex: p.selectedIndex}
 			}
+		case key.Matches(msg, p.keys.Search):
+			return p, func() tea.Msg {
+				return SearchRequestedMsg{SelectedIndex: p.selectedIndex}
+			}
+		case key.Matches(msg, p.keys.Metrics):
+			return p, func() tea.Msg {
+				return MetricsRequestedMsg{SelectedIndex: p.selectedIndex}
+			}
 		case key.Matches(msg, p.keys.Up):
 			totalItems := getTotalItems(p.viewModel)
 			if p.selectedIndex > 0 {
@@ -525,12 +545,6 @@ func (p *RoadmapListPresenter) reorderIterations(fromIndex, toIndex int) tea.Cmd
 		// Get the iteration being moved
 		iterToMove := p.viewModel.ActiveIterations[fromIndex]
 
-		// Fetch full iteration entity
-		iteration, err := p.repo.GetIteration(p.ctx, iterToMove.Number)
-		if err != nil {
-			return ErrorMsg{Err: err}
-		}
-
 		// Calculate new rank using fractional ranking
 		// This ensures stable ordering regardless of how many times we move
 		var newRank float64
@@ -608,9 +622,7 @@ func (p *RoadmapListPresenter) reorderIterations(fromIndex, toIndex int) tea.Cmd
 		}
 
 		// Update iteration rank
-		iteration.Rank = newRank
-		err = p.repo.UpdateIteration(p.ctx, iteration)
-		if err != nil {
+		if err := p.commands.SetIterationRank(p.ctx, iterToMove.Number, newRank); err != nil {
 			return ErrorMsg{Err: err}
 		}
 
@@ -624,8 +636,7 @@ func (p *RoadmapListPresenter) reorderIterations(fromIndex, toIndex int) tea.Cmd
 // startIteration starts a planned iteration (planned → current)
 func (p *RoadmapListPresenter) startIteration(iterationNumber int) tea.Cmd {
 	return func() tea.Msg {
-		// Call repository lifecycle method
-		if err := p.repo.StartIteration(p.ctx, iterationNumber); err != nil {
+		if err := p.commands.StartIteration(p.ctx, iterationNumber); err != nil {
 			return ErrorMsg{Err: err}
 		}
 
@@ -637,8 +648,7 @@ func (p *RoadmapListPresenter) startIteration(iterationNumber int) tea.Cmd {
 // completeIteration completes the current iteration (current → complete)
 func (p *RoadmapListPresenter) completeIteration(iterationNumber int) tea.Cmd {
 	return func() tea.Msg {
-		// Call repository lifecycle method
-		if err := p.repo.CompleteIteration(p.ctx, iterationNumber); err != nil {
+		if err := p.commands.CompleteIteration(p.ctx, iterationNumber); err != nil {
 			return ErrorMsg{Err: err}
 		}
 
@@ -650,8 +660,7 @@ func (p *RoadmapListPresenter) completeIteration(iterationNumber int) tea.Cmd {
 // revertIteration reverts a completed iteration (complete → planned)
 func (p *RoadmapListPresenter) revertIteration(iterationNumber int) tea.Cmd {
 	return func() tea.Msg {
-		// Call repository lifecycle method
-		if err := p.repo.RevertIteration(p.ctx, iterationNumber); err != nil {
+		if err := p.commands.RevertIteration(p.ctx, iterationNumber); err != nil {
 			return ErrorMsg{Err: err}
 		}
 
@@ -24,7 +24,7 @@ func TestRoadmapListPresenter_TabNavigation(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, nil, context.Background())
 
 	// Simulate Tab key press
 	tabMsg := tea.KeyMsg{Type: tea.KeyTab}
@@ -57,7 +57,7 @@ func TestRoadmapListPresenter_TabNavigationCycle(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, nil, context.Background())
 
 	// Press Tab 3 times to cycle through all sections
 	tabMsg := tea.KeyMsg{Type: tea.KeyTab}
@@ -80,7 +80,7 @@ func TestRoadmapListPresenter_RefreshKey(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, nil, context.Background())
 
 	// Simulate 'r' key press (refresh)
 	rMsg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}}
@@ -150,7 +150,7 @@ func TestRefreshDashboardMsg_PreservesSelection(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, nil, context.Background())
 
 	// Navigate to second item
 	downMsg := tea.KeyMsg{Type: tea.KeyDown}
@@ -188,7 +188,7 @@ func TestRoadmapListPresenter_EnterOnBacklogTask(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, nil, context.Background())
 
 	// Navigate to first backlog task (index = 1 iteration + 1 track + 0 = 2)
 	downMsg := tea.KeyMsg{Type: tea.KeyDown}
@@ -235,7 +235,7 @@ func TestRoadmapListPresenter_EnterOnSecondBacklogTask(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, nil, context.Background())
 
 	// Navigate to second backlog task (index = 2 iterations + 2 tracks + 1 = 5)
 	downMsg := tea.KeyMsg{Type: tea.KeyDown}
@@ -272,7 +272,7 @@ func TestRoadmapListPresenter_EnterOnIteration(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, nil, context.Background())
 
 	// Press Enter on first iteration (index=0)
 	enterMsg := tea.KeyMsg{Type: tea.KeyEnter}
@@ -305,7 +305,7 @@ func TestRoadmapListPresenter_EnterOnTrack(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, nil, context.Background())
 
 	// Navigate to track (index=1)
 	downMsg := tea.KeyMsg{Type: tea.KeyDown}
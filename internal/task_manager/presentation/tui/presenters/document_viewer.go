@@ -9,6 +9,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/repositories"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/components"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/queries"
@@ -97,6 +98,7 @@ type DocumentViewerPresenter struct {
 	width        int
 	height       int
 	repo         repositories.DocumentRepository
+	commands     Commands
 	ctx          context.Context
 	scrollHelper *components.ScrollHelper
 	err          error
@@ -107,7 +109,7 @@ type DocumentViewerPresenter struct {
 }
 
 // NewDocumentViewerPresenter creates a new document viewer presenter
-func NewDocumentViewerPresenter(documentID string, repo repositories.DocumentRepository, ctx context.Context) *DocumentViewerPresenter {
+func NewDocumentViewerPresenter(documentID string, repo repositories.DocumentRepository, commands Commands, ctx context.Context) *DocumentViewerPresenter {
 	return &DocumentViewerPresenter{
 		documentID:   documentID,
 		help:         components.NewHelp(),
@@ -116,6 +118,7 @@ func NewDocumentViewerPresenter(documentID string, repo repositories.DocumentRep
 		width:        80, // Default width until WindowSizeMsg arrives
 		height:       24,
 		repo:         repo,
+		commands:     commands,
 		ctx:          ctx,
 		scrollHelper: components.NewScrollHelper(),
 		isLoading:    true,
@@ -412,48 +415,21 @@ func (p *DocumentViewerPresenter) loadDocumentCmd() tea.Cmd {
 
 // approveDocumentCmd updates document status to published
 func (p *DocumentViewerPresenter) approveDocumentCmd() tea.Cmd {
-	return func() tea.Msg {
-		doc, err := p.repo.FindDocumentByID(p.ctx, p.documentID)
-		if err != nil {
-			return ErrorMsg{Err: fmt.Errorf("failed to load document: %w", err)}
-		}
-
-		// Update status to published
-		err = doc.UpdateStatus("published")
-		if err != nil {
-			return ErrorMsg{Err: fmt.Errorf("failed to update document status: %w", err)}
-		}
-
-		// Save updated document
-		err = p.repo.UpdateDocument(p.ctx, doc)
-		if err != nil {
-			return ErrorMsg{Err: fmt.Errorf("failed to save document: %w", err)}
-		}
-
-		return DocumentActionCompletedMsg{}
-	}
+	return p.setDocumentStatusCmd("published")
 }
 
 // disapproveDocumentCmd updates document status to draft
 func (p *DocumentViewerPresenter) disapproveDocumentCmd() tea.Cmd {
-	return func() tea.Msg {
-		doc, err := p.repo.FindDocumentByID(p.ctx, p.documentID)
-		if err != nil {
-			return ErrorMsg{Err: fmt.Errorf("failed to load document: %w", err)}
-		}
+	return p.setDocumentStatusCmd("draft")
+}
 
-		// Update status to draft
-		err = doc.UpdateStatus("draft")
-		if err != nil {
+// setDocumentStatusCmd saves the document with a new status
+func (p *DocumentViewerPresenter) setDocumentStatusCmd(status entities.DocumentStatus) tea.Cmd {
+	return func() tea.Msg {
+		if err := p.commands.SetDocumentStatus(p.ctx, p.documentID, status); err != nil {
 			return ErrorMsg{Err: fmt.Errorf("failed to update document status: %w", err)}
 		}
 
-		// Save updated document
-		err = p.repo.UpdateDocument(p.ctx, doc)
-		if err != nil {
-			return ErrorMsg{Err: fmt.Errorf("failed to save document: %w", err)}
-		}
-
 		return DocumentActionCompletedMsg{}
 	}
 }
@@ -110,7 +110,7 @@ func TestNewDocumentViewerPresenter(t *testing.T) {
 	repo := newMockDocumentRepository()
 	ctx := context.Background()
 
-	presenter := presenters.NewDocumentViewerPresenter("TM-doc-1", repo, ctx)
+	presenter := presenters.NewDocumentViewerPresenter("TM-doc-1", repo, nil, ctx)
 
 	if presenter == nil {
 		t.Fatal("expected presenter, got nil")
@@ -124,7 +124,7 @@ func TestDocumentViewerPresenter_DocumentLoading(t *testing.T) {
 	repo.documents["TM-doc-1"] = doc
 
 	ctx := context.Background()
-	presenter := presenters.NewDocumentViewerPresenter("TM-doc-1", repo, ctx)
+	presenter := presenters.NewDocumentViewerPresenter("TM-doc-1", repo, nil, ctx)
 
 	// Init should return a command (async load)
 	cmd := presenter.Init()
@@ -139,7 +139,7 @@ func TestDocumentViewerPresenter_ApproveWorkflow(t *testing.T) {
 	repo.documents["TM-doc-1"] = doc
 
 	ctx := context.Background()
-	presenter := presenters.NewDocumentViewerPresenter("TM-doc-1", repo, ctx)
+	presenter := presenters.NewDocumentViewerPresenter("TM-doc-1", repo, nil, ctx)
 	_ = presenter
 
 	// Get the approve command (would be triggered by key message in real usage)
@@ -162,7 +162,7 @@ func TestDocumentViewerPresenter_ErrorHandling(t *testing.T) {
 	repo.err = errors.New("database error")
 
 	ctx := context.Background()
-	presenter := presenters.NewDocumentViewerPresenter("TM-doc-1", repo, ctx)
+	presenter := presenters.NewDocumentViewerPresenter("TM-doc-1", repo, nil, ctx)
 
 	cmd := presenter.Init()
 	if cmd == nil {
@@ -175,7 +175,7 @@ func TestDocumentViewerPresenter_WindowResize(t *testing.T) {
 	repo := newMockDocumentRepository()
 	ctx := context.Background()
 
-	presenter := presenters.NewDocumentViewerPresenter("TM-doc-1", repo, ctx)
+	presenter := presenters.NewDocumentViewerPresenter("TM-doc-1", repo, nil, ctx)
 	_ = presenter
 
 	// Update with window size message should not panic
@@ -349,7 +349,7 @@ func TestDocumentViewerPresenter_Init_RequestsWindowSize(t *testing.T) {
 	repo := newMockDocumentRepository()
 	ctx := context.Background()
 
-	presenter := presenters.NewDocumentViewerPresenter("TM-doc-1", repo, ctx)
+	presenter := presenters.NewDocumentViewerPresenter("TM-doc-1", repo, nil, ctx)
 
 	// Init should return a command (batch of loadDocumentCmd and WindowSize)
 	cmd := presenter.Init()
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
@@ -144,6 +143,7 @@ type IterationDetailPresenter struct {
 	width           int
 	height          int
 	repo            domain.RoadmapRepository
+	commands        Commands
 	ctx             context.Context
 	acListComponent *ACListComponent
 
@@ -154,17 +154,17 @@ type IterationDetailPresenter struct {
 	terminalHeight        int
 }
 
-func NewIterationDetailPresenter(vm *viewmodels.IterationDetailViewModel, repo domain.RoadmapRepository, ctx context.Context) *IterationDetailPresenter {
-	return NewIterationDetailPresenterWithTab(vm, repo, ctx, IterationDetailTabTasks)
+func NewIterationDetailPresenter(vm *viewmodels.IterationDetailViewModel, repo domain.RoadmapRepository, commands Commands, ctx context.Context) *IterationDetailPresenter {
+	return NewIterationDetailPresenterWithTab(vm, repo, commands, ctx, IterationDetailTabTasks)
 }
 
 // NewIterationDetailPresenterWithTab creates a new iteration detail presenter with a specific active tab
-func NewIterationDetailPresenterWithTab(vm *viewmodels.IterationDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, activeTab IterationDetailTab) *IterationDetailPresenter {
-	return NewIterationDetailPresenterWithSelection(vm, repo, ctx, activeTab, 0)
+func NewIterationDetailPresenterWithTab(vm *viewmodels.IterationDetailViewModel, repo domain.RoadmapRepository, commands Commands, ctx context.Context, activeTab IterationDetailTab) *IterationDetailPresenter {
+	return NewIterationDetailPresenterWithSelection(vm, repo, commands, ctx, activeTab, 0)
 }
 
 // NewIterationDetailPresenterWithSelection creates a new iteration detail presenter with a specific active tab and selected index
-func NewIterationDetailPresenterWithSelection(vm *viewmodels.IterationDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, activeTab IterationDetailTab, selectedIndex int) *IterationDetailPresenter {
+func NewIterationDetailPresenterWithSelection(vm *viewmodels.IterationDetailViewModel, repo domain.RoadmapRepository, commands Commands, ctx context.Context, activeTab IterationDetailTab, selectedIndex int) *IterationDetailPresenter {
 	return &IterationDetailPresenter{
 		viewModel:       vm,
 		help:            components.NewHelp(),
@@ -173,9 +173,10 @@ func NewIterationDetailPresenterWithSelection(vm *viewmodels.IterationDetailView
 		activeTab:       activeTab,
 		selectedIndex:   selectedIndex,
 		repo:            repo,
+		commands:        commands,
 		ctx:             ctx,
-		acListComponent: NewACListComponent(repo, ctx, true), // enableExpand=true (same behavior as task detail)
-		width:           80,                                  // Default width until WindowSizeMsg arrives
+		acListComponent: NewACListComponent(commands, ctx, true), // enableExpand=true (same behavior as task detail)
+		width:           80,                                      // Default width until WindowSizeMsg arrives
 		height:          24,
 
 		// Initialize scroll helpers
@@ -472,7 +473,12 @@ func (p *IterationDetailPresenter) View() string {
 		p.viewModel.Progress.Total,
 		p.viewModel.Progress.Percent*100)
 	b.WriteString(components.Styles.ProgressStyle.Render(progressText))
-	b.WriteString("\n\n")
+	b.WriteString("\n")
+	if p.viewModel.Capacity != "" {
+		b.WriteString(components.Styles.MetadataStyle.Render(p.viewModel.Capacity))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
 
 	// Tab headers
 	if p.activeTab == IterationDetailTabTasks {
@@ -641,13 +647,17 @@ func (p *IterationDetailPresenter) renderTasksView(b *strings.Builder) {
 			b.WriteString("\n")
 		}
 
-		// Render task with colored status
+		// Render task with estimate and colored status
 		statusText := getStatusStyle(item.task.StatusColor).Render(item.task.Status)
+		title := item.task.Title
+		if item.task.Estimate != "" {
+			title += fmt.Sprintf(" [%s]", item.task.Estimate)
+		}
 		var output string
 		if i == p.selectedIndex {
-			output = components.Styles.SelectedStyle.Render(fmt.Sprintf("  %s: %s - %s", item.task.ID, item.task.Title, statusText))
+			output = components.Styles.SelectedStyle.Render(fmt.Sprintf("  %s: %s - %s", item.task.ID, title, statusText))
 		} else {
-			output = fmt.Sprintf("  %s: %s - %s", item.task.ID, item.task.Title, statusText)
+			output = fmt.Sprintf("  %s: %s - %s", item.task.ID, title, statusText)
 		}
 		b.WriteString(output)
 		b.WriteString("\n")
@@ -902,23 +912,12 @@ func (p *IterationDetailPresenter) getSelectedACID() string {
 	return ""
 }
 
-// transitionTaskStatus transitions a task to a new status using repository
+// transitionTaskStatus transitions a task to a new status
 func (p *IterationDetailPresenter) transitionTaskStatus(taskID, newStatus string, activeTab IterationDetailTab, currentSelectedIndex int) tea.Cmd {
 	return func() tea.Msg {
-		// Fetch task
-		task, err := p.repo.GetTask(p.ctx, taskID)
-		if err != nil {
-			return ErrorMsg{Err: fmt.Errorf("failed to get task: %w", err)}
-		}
-
 		// Update task status (newStatus is already a valid string)
-		task.Status = newStatus
-		task.UpdatedAt = time.Now()
-
-		// Save
-		err = p.repo.UpdateTask(p.ctx, task)
-		if err != nil {
-			return ErrorMsg{Err: fmt.Errorf("failed to update task: %w", err)}
+		if err := p.commands.SetTaskStatus(p.ctx, taskID, newStatus); err != nil {
+			return ErrorMsg{Err: err}
 		}
 
 		return TaskTransitionCompletedMsg{ActiveTab: activeTab, SelectedIndex: currentSelectedIndex}
@@ -952,20 +951,8 @@ func (p *IterationDetailPresenter) transitionTaskToDone(taskID string, activeTab
 		}
 
 		// All ACs verified/skipped - proceed with transition
-		// Fetch task
-		task, err := p.repo.GetTask(p.ctx, taskID)
-		if err != nil {
-			return ErrorMsg{Err: fmt.Errorf("failed to get task: %w", err)}
-		}
-
-		// Update task status to done
-		task.Status = "done"
-		task.UpdatedAt = time.Now()
-
-		// Save
-		err = p.repo.UpdateTask(p.ctx, task)
-		if err != nil {
-			return ErrorMsg{Err: fmt.Errorf("failed to update task: %w", err)}
+		if err := p.commands.SetTaskStatus(p.ctx, taskID, "done"); err != nil {
+			return ErrorMsg{Err: err}
 		}
 
 		return TaskTransitionCompletedMsg{ActiveTab: activeTab, SelectedIndex: currentSelectedIndex}
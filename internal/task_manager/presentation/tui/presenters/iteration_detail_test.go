@@ -146,7 +146,7 @@ func TestIterationDetailPresenter_TaskTransitionOnlyOnTasksTab(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewIterationDetailPresenter(vm, nil, context.Background())
+	presenter := presenters.NewIterationDetailPresenter(vm, nil, nil, context.Background())
 
 	// Press 'i' on Tasks tab - should trigger transition
 	iMsg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}}
@@ -183,7 +183,7 @@ func TestIterationDetailPresenter_DocumentsTabShowsCount(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewIterationDetailPresenter(vm, nil, context.Background())
+	presenter := presenters.NewIterationDetailPresenter(vm, nil, nil, context.Background())
 
 	// Simulate window size message
 	p, _ := presenter.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
@@ -211,7 +211,7 @@ func TestIterationDetailPresenter_GetterMethods(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewIterationDetailPresenter(vm, nil, context.Background())
+	presenter := presenters.NewIterationDetailPresenter(vm, nil, nil, context.Background())
 
 	// Test GetActiveTab
 	if presenter.GetActiveTab() != presenters.IterationDetailTabTasks {
@@ -265,7 +265,7 @@ func TestIterationDetailPresenter_ViewportHeightSetOnTabSwitch(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewIterationDetailPresenter(vm, nil, context.Background())
+	presenter := presenters.NewIterationDetailPresenter(vm, nil, nil, context.Background())
 
 	// Simulate window size message to set up terminal height
 	sizeMsg := tea.WindowSizeMsg{Width: 80, Height: 30}
@@ -321,7 +321,7 @@ func TestIterationDetailPresenter_ViewportHeightSetOnACExpansion(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewIterationDetailPresenter(vm, nil, context.Background())
+	presenter := presenters.NewIterationDetailPresenter(vm, nil, nil, context.Background())
 
 	// Simulate window size message
 	sizeMsg := tea.WindowSizeMsg{Width: 80, Height: 30}
@@ -64,6 +64,29 @@ type DrillIntoDocumentMsg struct {
 	DocumentID string
 }
 
+// SearchRequestedMsg is sent when a user opens the search view from the dashboard
+type SearchRequestedMsg struct {
+	SelectedIndex int // Dashboard selected index (for restoring focus on return)
+}
+
+// SearchResultsLoadedMsg is sent when a search query has been run against the search index
+type SearchResultsLoadedMsg struct {
+	Query   string
+	Results []viewmodels.SearchResultViewModel
+	Error   error
+}
+
+// MetricsRequestedMsg is sent when a user opens the metrics view from the dashboard
+type MetricsRequestedMsg struct {
+	SelectedIndex int // Dashboard selected index (for restoring focus on return)
+}
+
+// MetricsLoadedMsg is sent when the metrics view's metrics have been computed
+type MetricsLoadedMsg struct {
+	ViewModel *viewmodels.MetricsViewModel
+	Error     error
+}
+
 // Ensure these are valid Bubble Tea messages
 var (
 	_ tea.Msg = IterationSelectedMsg{}
@@ -77,5 +100,9 @@ var (
 	_ tea.Msg = DocumentLoadedMsg{}
 	_ tea.Msg = DocumentActionCompletedMsg{}
 	_ tea.Msg = DrillIntoDocumentMsg{}
+	_ tea.Msg = SearchRequestedMsg{}
+	_ tea.Msg = SearchResultsLoadedMsg{}
+	_ tea.Msg = MetricsRequestedMsg{}
+	_ tea.Msg = MetricsLoadedMsg{}
 	_ tea.Msg = BackMsgNew{}
 )
@@ -0,0 +1,237 @@
+package presenters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/components"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/queries"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/viewmodels"
+)
+
+// MetricsKeyMap defines keybindings for the metrics view
+type MetricsKeyMap struct {
+	Up      key.Binding
+	Down    key.Binding
+	Refresh key.Binding
+	Back    key.Binding
+	Quit    key.Binding
+}
+
+// NewMetricsKeyMap creates default keybindings for the metrics view
+func NewMetricsKeyMap() MetricsKeyMap {
+	return MetricsKeyMap{
+		Up:   components.NewUpKey(),
+		Down: components.NewDownKey(),
+		Refresh: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "refresh"),
+		),
+		Back: components.NewBackKey(),
+		Quit: components.NewQuitKey(),
+	}
+}
+
+// ShortHelp returns keybindings to show in short help view
+func (k MetricsKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Refresh, k.Back, k.Quit}
+}
+
+// FullHelp returns all keybindings for full help view
+func (k MetricsKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down},
+		{k.Refresh, k.Back, k.Quit},
+	}
+}
+
+// MetricsPresenter presents velocity, burndown, cycle time and throughput as sparklines.
+// Metrics are loaded when the view opens and on refresh; the content scrolls line by line.
+type MetricsPresenter struct {
+	viewModel    *viewmodels.MetricsViewModel
+	isLoading    bool
+	err          error
+	help         components.Help
+	keys         MetricsKeyMap
+	width        int
+	height       int
+	repo         domain.RoadmapRepository
+	ctx          context.Context
+	scrollHelper *components.ScrollHelper
+}
+
+// NewMetricsPresenter creates a new metrics presenter
+func NewMetricsPresenter(repo domain.RoadmapRepository, ctx context.Context) *MetricsPresenter {
+	return &MetricsPresenter{
+		isLoading:    true,
+		help:         components.NewHelp(),
+		keys:         NewMetricsKeyMap(),
+		repo:         repo,
+		ctx:          ctx,
+		width:        80, // Default width until WindowSizeMsg arrives
+		height:       24,
+		scrollHelper: components.NewScrollHelper(),
+	}
+}
+
+// Init loads the metrics on initialization
+func (p *MetricsPresenter) Init() tea.Cmd {
+	return tea.Batch(
+		p.loadMetricsCmd(),
+		tea.WindowSize(),
+	)
+}
+
+func (p *MetricsPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		p.width = msg.Width
+		p.height = msg.Height
+		p.help.SetWidth(msg.Width)
+
+		// Account for: title (1) + blank line (1) + scroll indicator (1) + blank line (1) + help (2)
+		availableHeight := msg.Height - 6
+		if availableHeight < 5 {
+			availableHeight = 5
+		}
+		p.scrollHelper.SetViewportHeight(availableHeight)
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, p.keys.Quit):
+			return p, tea.Quit
+		case key.Matches(msg, p.keys.Back):
+			return p, func() tea.Msg { return BackMsgNew{} }
+		case key.Matches(msg, p.keys.Refresh):
+			p.isLoading = true
+			return p, p.loadMetricsCmd()
+		case key.Matches(msg, p.keys.Up):
+			p.scrollHelper.ScrollLineUp(len(p.renderLines()))
+		case key.Matches(msg, p.keys.Down):
+			p.scrollHelper.ScrollLineDown(len(p.renderLines()))
+		}
+
+	case MetricsLoadedMsg:
+		p.isLoading = false
+		if msg.Error != nil {
+			p.err = msg.Error
+			return p, nil
+		}
+		p.err = nil
+		p.viewModel = msg.ViewModel
+	}
+
+	return p, nil
+}
+
+// View renders the metrics sections
+func (p *MetricsPresenter) View() string {
+	var b strings.Builder
+
+	b.WriteString(components.Styles.TitleStyle.Render("Metrics"))
+	b.WriteString("\n\n")
+
+	switch {
+	case p.isLoading:
+		b.WriteString(fmt.Sprintf("%s Computing metrics...", components.Styles.LoadingStyle.Render("●")))
+		b.WriteString("\n")
+	case p.err != nil:
+		b.WriteString(components.Styles.ErrorMessageStyle.Render(fmt.Sprintf("Error: %v", p.err)))
+		b.WriteString("\n")
+	default:
+		lines := p.renderLines()
+		offset := p.scrollHelper.ViewportOffset()
+		end := offset + p.scrollHelper.ViewportHeight()
+		if end > len(lines) {
+			end = len(lines)
+		}
+		for i := offset; i < end; i++ {
+			b.WriteString(lines[i])
+			b.WriteString("\n")
+		}
+		if len(lines) > p.scrollHelper.ViewportHeight() {
+			b.WriteString(components.Styles.MetadataStyle.Render(fmt.Sprintf("[%s]", p.scrollHelper.ScrollPosition(len(lines)))))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(p.help.ShortHelpView(p.keys.ShortHelp()))
+
+	return b.String()
+}
+
+// renderLines renders all metrics sections as lines for scrolling
+func (p *MetricsPresenter) renderLines() []string {
+	vm := p.viewModel
+	if vm == nil {
+		return nil
+	}
+	var lines []string
+	section := func(title string) {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, components.Styles.SectionStyle.Render(title))
+	}
+	muted := components.Styles.MetadataStyle.Render
+
+	section("Velocity (tasks done per iteration)")
+	if len(vm.Velocity) == 0 {
+		lines = append(lines, muted("  No started iterations"))
+	} else {
+		lines = append(lines, fmt.Sprintf("  %s  %s", components.Styles.ProgressStyle.Render(vm.VelocitySparkline), muted("average "+vm.AverageVelocity)))
+		for _, v := range vm.Velocity {
+			lines = append(lines, fmt.Sprintf("  %s #%-3d %-30s %3d/%-3d %s",
+				v.StatusIcon, v.Number, v.Name, v.Completed, v.Planned, muted(v.Days+" days")))
+		}
+	}
+
+	if vm.Burndown == nil {
+		section("Burndown")
+		lines = append(lines, muted("  No iteration in progress"))
+	} else {
+		burndown := vm.Burndown
+		section(fmt.Sprintf("Burndown: Iteration #%d - %s", burndown.IterationNumber, burndown.IterationName))
+		lines = append(lines,
+			fmt.Sprintf("  Remaining  %s  %d", components.Styles.StatusInProgressStyle.Render(burndown.RemainingSparkline), burndown.Remaining),
+			fmt.Sprintf("  Done       %s  %d", components.Styles.ProgressStyle.Render(burndown.CompletedSparkline), burndown.Completed),
+			muted(fmt.Sprintf("  %d of %d task(s) done after %d day(s)", burndown.Completed, burndown.Scope, burndown.Days)),
+		)
+	}
+
+	section("Cycle Time (in-progress to done)")
+	if vm.CycleTime.Count == 0 {
+		lines = append(lines, muted("  No tasks done yet"))
+	} else {
+		lines = append(lines,
+			fmt.Sprintf("  %s", components.Styles.ProgressStyle.Render(vm.CycleTime.Sparkline)),
+			muted(fmt.Sprintf("  %d task(s)  average %s  median %s  max %s",
+				vm.CycleTime.Count, vm.CycleTime.Average, vm.CycleTime.Median, vm.CycleTime.Max)),
+		)
+	}
+
+	section(fmt.Sprintf("Throughput (tasks done per week, last %d weeks)", vm.Weeks))
+	if len(vm.Throughput) == 0 {
+		lines = append(lines, muted("  No tracks"))
+	} else {
+		for _, track := range vm.Throughput {
+			lines = append(lines, fmt.Sprintf("  %-30s %s  %s",
+				track.Title, components.Styles.ProgressStyle.Render(track.Sparkline), muted(fmt.Sprintf("%d total", track.Total))))
+		}
+	}
+
+	return lines
+}
+
+// loadMetricsCmd computes the metrics from the repository
+func (p *MetricsPresenter) loadMetricsCmd() tea.Cmd {
+	return func() tea.Msg {
+		vm, err := queries.LoadMetricsData(p.ctx, p.repo)
+		return MetricsLoadedMsg{ViewModel: vm, Error: err}
+	}
+}
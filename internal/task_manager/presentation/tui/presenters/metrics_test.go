@@ -0,0 +1,57 @@
+package presenters_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/presenters"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/viewmodels"
+)
+
+func TestMetricsPresenter_RendersSections(t *testing.T) {
+	presenter := presenters.NewMetricsPresenter(nil, context.Background())
+	presenter.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+	presenter.Update(presenters.MetricsLoadedMsg{ViewModel: &viewmodels.MetricsViewModel{
+		Velocity: []*viewmodels.IterationVelocityViewModel{
+			{Number: 1, Name: "Sprint 1", Status: "complete", Completed: 4, Planned: 4, StatusIcon: "✓", Days: "7.0"},
+		},
+		VelocitySparkline: "█",
+		AverageVelocity:   "4.0",
+		CycleTime:         viewmodels.CycleTimeViewModel{Count: 4, Sparkline: "▂█▁▅", Average: "1d 2h", Median: "20h", Max: "3d 0h"},
+		Throughput: []*viewmodels.TrackThroughputViewModel{
+			{TrackID: "TM-track-1", Title: "Core", Total: 4, Sparkline: "▁▁█▅"},
+		},
+		Weeks: 4,
+	}})
+
+	view := presenter.View()
+	for _, want := range []string{"Sprint 1", "average 4.0", "No iteration in progress", "median 20h", "▁▁█▅", "last 4 weeks"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in view, got:\n%s", want, view)
+		}
+	}
+}
+
+func TestMetricsPresenter_ShowsLoadError(t *testing.T) {
+	presenter := presenters.NewMetricsPresenter(nil, context.Background())
+	presenter.Update(presenters.MetricsLoadedMsg{Error: errors.New("no roadmap")})
+
+	if !strings.Contains(presenter.View(), "no roadmap") {
+		t.Errorf("expected error in view, got:\n%s", presenter.View())
+	}
+}
+
+func TestMetricsPresenter_BackReturnsToDashboard(t *testing.T) {
+	presenter := presenters.NewMetricsPresenter(nil, context.Background())
+
+	_, cmd := presenter.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("expected a command on esc")
+	}
+	if _, ok := cmd().(presenters.BackMsgNew); !ok {
+		t.Errorf("expected BackMsgNew, got %#v", cmd())
+	}
+}
@@ -300,10 +300,14 @@ func (p *TrackDetailPresenter) renderTasksView(b *strings.Builder) {
 		// Render item based on type
 		var output string
 		if item.itemType == "task" {
+			title := item.task.Title
+			if item.task.Estimate != "" {
+				title += fmt.Sprintf(" [%s]", item.task.Estimate)
+			}
 			if i == p.selectedIndex {
-				output = components.Styles.SelectedStyle.Render(fmt.Sprintf("  %s: %s", item.task.ID, item.task.Title))
+				output = components.Styles.SelectedStyle.Render(fmt.Sprintf("  %s: %s", item.task.ID, title))
 			} else {
-				output = fmt.Sprintf("  %s: %s", item.task.ID, item.task.Title)
+				output = fmt.Sprintf("  %s: %s", item.task.ID, title)
 			}
 			b.WriteString(output)
 			b.WriteString("\n")
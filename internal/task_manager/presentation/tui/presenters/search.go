@@ -0,0 +1,333 @@
+package presenters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/components"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/queries"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/viewmodels"
+)
+
+// SearchKeyMap defines keybindings for the search view
+type SearchKeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Enter  key.Binding
+	Back   key.Binding
+	Focus  key.Binding // Switch between query input and results
+	Search key.Binding // Run the query (enter while typing)
+}
+
+// NewSearchKeyMap creates default keybindings for the search view.
+// Only non-printable keys are bound so every character can be typed into the query.
+func NewSearchKeyMap() SearchKeyMap {
+	return SearchKeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up"),
+			key.WithHelp("↑", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down"),
+			key.WithHelp("↓", "down"),
+		),
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "open"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "back"),
+		),
+		Focus: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "edit query/results"),
+		),
+		Search: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "search"),
+		),
+	}
+}
+
+// ShortHelp returns keybindings to show in short help view
+func (k SearchKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Search, k.Up, k.Down, k.Focus, k.Back}
+}
+
+// FullHelp returns all keybindings for full help view
+func (k SearchKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Search, k.Enter},
+		{k.Up, k.Down},
+		{k.Focus, k.Back},
+	}
+}
+
+// SearchPresenter presents full-text search across tasks, ACs, ADRs and documents.
+// The query is typed into an input; enter runs it, and enter on a result opens its task, track or document.
+type SearchPresenter struct {
+	input         textinput.Model
+	results       []viewmodels.SearchResultViewModel
+	searchedQuery string // Query the results belong to
+	selectedIndex int
+	inputFocused  bool
+	isLoading     bool
+	err           error
+	help          components.Help
+	keys          SearchKeyMap
+	width         int
+	height        int
+	repo          domain.RoadmapRepository
+	ctx           context.Context
+	scrollHelper  *components.ScrollHelper
+}
+
+// NewSearchPresenter creates a new search presenter.
+// A non-empty query is run immediately with selectedIndex restored (used when returning from a result).
+func NewSearchPresenter(repo domain.RoadmapRepository, ctx context.Context, query string, selectedIndex int) *SearchPresenter {
+	ti := textinput.New()
+	ti.Placeholder = "Search tasks, ACs, ADRs and documents..."
+	ti.Prompt = "/ "
+	ti.CharLimit = 200
+	ti.SetValue(query)
+
+	p := &SearchPresenter{
+		input:         ti,
+		selectedIndex: selectedIndex,
+		inputFocused:  query == "",
+		help:          components.NewHelp(),
+		keys:          NewSearchKeyMap(),
+		repo:          repo,
+		ctx:           ctx,
+		width:         80, // Default width until WindowSizeMsg arrives
+		height:        24,
+		scrollHelper:  components.NewScrollHelper(),
+	}
+	if p.inputFocused {
+		p.input.Focus()
+	}
+	return p
+}
+
+func (p *SearchPresenter) Init() tea.Cmd {
+	cmds := []tea.Cmd{tea.WindowSize()}
+	if p.inputFocused {
+		cmds = append(cmds, textinput.Blink)
+	}
+	if query := strings.TrimSpace(p.input.Value()); query != "" {
+		p.isLoading = true
+		cmds = append(cmds, p.searchCmd(query))
+	}
+	return tea.Batch(cmds...)
+}
+
+func (p *SearchPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		p.width = msg.Width
+		p.height = msg.Height
+		p.help.SetWidth(msg.Width)
+		p.input.Width = msg.Width - 4
+
+		// Account for: title (1) + input (1) + status line (1) + blank lines (2) + help (2)
+		// Each result takes two lines (title + snippet)
+		availableHeight := (msg.Height - 7) / 2
+		if availableHeight < 3 {
+			availableHeight = 3
+		}
+		p.scrollHelper.SetViewportHeight(availableHeight)
+		p.scrollHelper.EnsureVisible(len(p.results), p.selectedIndex)
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, p.keys.Back):
+			return p, func() tea.Msg { return BackMsgNew{} }
+		case key.Matches(msg, p.keys.Focus):
+			return p, p.toggleFocus()
+		case key.Matches(msg, p.keys.Up):
+			if p.inputFocused {
+				return p, nil
+			}
+			if p.selectedIndex == 0 {
+				return p, p.toggleFocus()
+			}
+			p.selectedIndex--
+			p.scrollHelper.EnsureVisible(len(p.results), p.selectedIndex)
+			return p, nil
+		case key.Matches(msg, p.keys.Down):
+			if p.inputFocused {
+				return p, p.toggleFocus()
+			}
+			if p.selectedIndex < len(p.results)-1 {
+				p.selectedIndex++
+				p.scrollHelper.EnsureVisible(len(p.results), p.selectedIndex)
+			}
+			return p, nil
+		case key.Matches(msg, p.keys.Enter):
+			if p.inputFocused {
+				query := strings.TrimSpace(p.input.Value())
+				if query == "" {
+					return p, nil
+				}
+				p.isLoading = true
+				p.err = nil
+				return p, p.searchCmd(query)
+			}
+			return p, p.openSelected()
+		}
+
+		if p.inputFocused {
+			var cmd tea.Cmd
+			p.input, cmd = p.input.Update(msg)
+			return p, cmd
+		}
+
+	case SearchResultsLoadedMsg:
+		p.isLoading = false
+		p.searchedQuery = msg.Query
+		if msg.Error != nil {
+			p.err = msg.Error
+			p.results = nil
+			return p, nil
+		}
+		p.err = nil
+		p.results = msg.Results
+		if p.selectedIndex >= len(p.results) {
+			p.selectedIndex = 0
+		}
+		p.scrollHelper.EnsureVisible(len(p.results), p.selectedIndex)
+		if p.inputFocused && len(p.results) > 0 {
+			return p, p.toggleFocus()
+		}
+		return p, nil
+	}
+
+	return p, nil
+}
+
+// View renders the search input and results
+func (p *SearchPresenter) View() string {
+	var b strings.Builder
+
+	b.WriteString(components.Styles.TitleStyle.Render("Search"))
+	b.WriteString("\n\n")
+	b.WriteString(p.input.View())
+	b.WriteString("\n")
+
+	switch {
+	case p.isLoading:
+		b.WriteString(fmt.Sprintf("%s Searching...", components.Styles.LoadingStyle.Render("●")))
+	case p.err != nil:
+		b.WriteString(components.Styles.ErrorMessageStyle.Render(fmt.Sprintf("Error: %v", p.err)))
+	case p.searchedQuery == "":
+		b.WriteString(components.Styles.MetadataStyle.Render("Type a query and press enter"))
+	case len(p.results) == 0:
+		b.WriteString(components.Styles.MetadataStyle.Render(fmt.Sprintf("No results for %q", p.searchedQuery)))
+	default:
+		b.WriteString(components.Styles.MetadataStyle.Render(fmt.Sprintf("%d result(s) for %q", len(p.results), p.searchedQuery)))
+	}
+	b.WriteString("\n\n")
+
+	if !p.isLoading && p.err == nil {
+		start, end := p.scrollHelper.VisibleRange(len(p.results))
+		for i := start; i < end; i++ {
+			b.WriteString(p.renderResult(i))
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(p.help.ShortHelpView(p.keys.ShortHelp()))
+
+	return b.String()
+}
+
+// GetQuery returns the query of the displayed results (for restoring the view on return)
+func (p *SearchPresenter) GetQuery() string {
+	return p.searchedQuery
+}
+
+// GetSelectedIndex returns the current selected index
+func (p *SearchPresenter) GetSelectedIndex() int {
+	return p.selectedIndex
+}
+
+// renderResult renders one result as a title line and an indented snippet line
+func (p *SearchPresenter) renderResult(index int) string {
+	result := p.results[index]
+	selected := !p.inputFocused && index == p.selectedIndex
+
+	cursor := "  "
+	title := result.Title
+	if selected {
+		cursor = "> "
+		title = components.Styles.SelectedStyle.Render(title)
+	}
+
+	var b strings.Builder
+	b.WriteString(cursor)
+	b.WriteString(components.Styles.MetadataStyle.Render(fmt.Sprintf("%-5s %s", result.TypeLabel, result.EntityID)))
+	b.WriteString("  ")
+	b.WriteString(title)
+	b.WriteString("\n    ")
+	b.WriteString(renderSnippet(result.Snippet))
+	b.WriteString("\n")
+	return b.String()
+}
+
+// renderSnippet renders a snippet on one line with the highlighted terms emphasized
+func renderSnippet(snippet string) string {
+	snippet = strings.Join(strings.Fields(snippet), " ")
+
+	var b strings.Builder
+	for i, part := range strings.Split(snippet, entities.SearchHighlightStart) {
+		if i%2 == 1 {
+			b.WriteString(components.Styles.TitleStyle.Render(part))
+		} else {
+			b.WriteString(components.Styles.MetadataStyle.Render(part))
+		}
+	}
+	return b.String()
+}
+
+// toggleFocus moves focus between the query input and the results list
+func (p *SearchPresenter) toggleFocus() tea.Cmd {
+	if p.inputFocused && len(p.results) > 0 {
+		p.inputFocused = false
+		p.input.Blur()
+		return nil
+	}
+	p.inputFocused = true
+	return p.input.Focus()
+}
+
+// openSelected navigates to the task, track or document of the selected result
+func (p *SearchPresenter) openSelected() tea.Cmd {
+	if p.selectedIndex < 0 || p.selectedIndex >= len(p.results) {
+		return nil
+	}
+	result := p.results[p.selectedIndex]
+	switch {
+	case result.TaskID != "":
+		return func() tea.Msg { return TaskSelectedMsg{TaskID: result.TaskID, SelectedIndex: p.selectedIndex} }
+	case result.TrackID != "":
+		return func() tea.Msg { return TrackSelectedMsg{TrackID: result.TrackID, SelectedIndex: p.selectedIndex} }
+	case result.DocumentID != "":
+		return func() tea.Msg { return DrillIntoDocumentMsg{DocumentID: result.DocumentID} }
+	}
+	return nil
+}
+
+// searchCmd runs the query against the search index
+func (p *SearchPresenter) searchCmd(query string) tea.Cmd {
+	return func() tea.Msg {
+		results, err := queries.LoadSearchResults(p.ctx, p.repo, query)
+		return SearchResultsLoadedMsg{Query: query, Results: results, Error: err}
+	}
+}
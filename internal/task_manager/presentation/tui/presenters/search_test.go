@@ -0,0 +1,112 @@
+package presenters_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/presenters"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/viewmodels"
+)
+
+func searchResultsMsg() presenters.SearchResultsLoadedMsg {
+	return presenters.SearchResultsLoadedMsg{
+		Query: "login",
+		Results: []viewmodels.SearchResultViewModel{
+			{EntityType: "task", EntityID: "TM-task-1", Title: "Login form", Snippet: "**Login** form", TypeLabel: "Task", TaskID: "TM-task-1"},
+			{EntityType: "adr", EntityID: "TM-adr-1", Title: "Use OAuth", Snippet: "OAuth for **login**", TypeLabel: "ADR", TrackID: "TM-track-1"},
+			{EntityType: "document", EntityID: "TM-doc-1", Title: "Plan", Snippet: "**login** rollout", TypeLabel: "Doc", DocumentID: "TM-doc-1"},
+		},
+	}
+}
+
+func TestSearchPresenter_TypingDoesNotTriggerShortcuts(t *testing.T) {
+	presenter := presenters.NewSearchPresenter(nil, context.Background(), "", 0)
+
+	for _, r := range "q/r" {
+		presenter.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	if !strings.Contains(presenter.View(), "q/r") {
+		t.Errorf("expected typed query in view, got:\n%s", presenter.View())
+	}
+}
+
+func TestSearchPresenter_OpensSelectedResult(t *testing.T) {
+	presenter := presenters.NewSearchPresenter(nil, context.Background(), "", 0)
+	presenter.Update(searchResultsMsg())
+
+	view := presenter.View()
+	for _, want := range []string{"3 result(s)", "TM-task-1", "Use OAuth", "rollout"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in view, got:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "**") {
+		t.Errorf("expected highlight markers to be rendered, got:\n%s", view)
+	}
+
+	// Results take focus after a search; enter opens the first one
+	_, cmd := presenter.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if msg, ok := cmd().(presenters.TaskSelectedMsg); !ok || msg.TaskID != "TM-task-1" {
+		t.Errorf("expected TaskSelectedMsg for TM-task-1, got %#v", msg)
+	}
+
+	presenter.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, cmd = presenter.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if msg, ok := cmd().(presenters.TrackSelectedMsg); !ok || msg.TrackID != "TM-track-1" {
+		t.Errorf("expected TrackSelectedMsg for TM-track-1, got %#v", msg)
+	}
+
+	presenter.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, cmd = presenter.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if msg, ok := cmd().(presenters.DrillIntoDocumentMsg); !ok || msg.DocumentID != "TM-doc-1" {
+		t.Errorf("expected DrillIntoDocumentMsg for TM-doc-1, got %#v", msg)
+	}
+
+	if presenter.GetQuery() != "login" || presenter.GetSelectedIndex() != 2 {
+		t.Errorf("expected query and selection to be kept, got %q/%d", presenter.GetQuery(), presenter.GetSelectedIndex())
+	}
+}
+
+func TestSearchPresenter_ShowsError(t *testing.T) {
+	presenter := presenters.NewSearchPresenter(nil, context.Background(), "", 0)
+	presenter.Update(presenters.SearchResultsLoadedMsg{Query: "\"", Error: errors.New("search query must contain at least one word")})
+
+	if !strings.Contains(presenter.View(), "at least one word") {
+		t.Errorf("expected error in view, got:\n%s", presenter.View())
+	}
+}
+
+func TestSearchPresenter_EscGoesBack(t *testing.T) {
+	presenter := presenters.NewSearchPresenter(nil, context.Background(), "", 0)
+
+	_, cmd := presenter.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("expected a command on esc")
+	}
+	if _, ok := cmd().(presenters.BackMsgNew); !ok {
+		t.Errorf("expected BackMsgNew on esc")
+	}
+}
+
+func TestRoadmapListPresenter_SlashOpensSearch(t *testing.T) {
+	vm := &viewmodels.RoadmapListViewModel{
+		ActiveIterations: []*viewmodels.IterationCardViewModel{
+			{Number: 1, Name: "Iteration 1"},
+			{Number: 2, Name: "Iteration 2"},
+		},
+	}
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, nil, context.Background())
+	presenter.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	_, cmd := presenter.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	if cmd == nil {
+		t.Fatal("expected a command on /")
+	}
+	if msg, ok := cmd().(presenters.SearchRequestedMsg); !ok || msg.SelectedIndex != 1 {
+		t.Errorf("expected SearchRequestedMsg preserving selection, got %#v", msg)
+	}
+}
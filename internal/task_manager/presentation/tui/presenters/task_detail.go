@@ -85,6 +85,7 @@ type TaskDetailPresenter struct {
 	width           int
 	height          int
 	repo            domain.RoadmapRepository
+	commands        Commands
 	ctx             context.Context
 	acListComponent *ACListComponent
 
@@ -94,12 +95,12 @@ type TaskDetailPresenter struct {
 }
 
 // NewTaskDetailPresenter creates a new task detail presenter
-func NewTaskDetailPresenter(vm *viewmodels.TaskDetailViewModel, repo domain.RoadmapRepository, ctx context.Context) *TaskDetailPresenter {
-	return NewTaskDetailPresenterWithSelection(vm, repo, ctx, 0)
+func NewTaskDetailPresenter(vm *viewmodels.TaskDetailViewModel, repo domain.RoadmapRepository, commands Commands, ctx context.Context) *TaskDetailPresenter {
+	return NewTaskDetailPresenterWithSelection(vm, repo, commands, ctx, 0)
 }
 
 // NewTaskDetailPresenterWithSelection creates a new task detail presenter with a specific selected index
-func NewTaskDetailPresenterWithSelection(vm *viewmodels.TaskDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, selectedIndex int) *TaskDetailPresenter {
+func NewTaskDetailPresenterWithSelection(vm *viewmodels.TaskDetailViewModel, repo domain.RoadmapRepository, commands Commands, ctx context.Context, selectedIndex int) *TaskDetailPresenter {
 	return &TaskDetailPresenter{
 		viewModel:       vm,
 		help:            components.NewHelp(),
@@ -107,9 +108,10 @@ func NewTaskDetailPresenterWithSelection(vm *viewmodels.TaskDetailViewModel, rep
 		showFullHelp:    false,
 		selectedIndex:   selectedIndex,
 		repo:            repo,
+		commands:        commands,
 		ctx:             ctx,
-		acListComponent: NewACListComponent(repo, ctx, true), // enableExpand=true for task detail
-		width:           80,                                  // Default width until WindowSizeMsg arrives
+		acListComponent: NewACListComponent(commands, ctx, true), // enableExpand=true for task detail
+		width:           80,                                      // Default width until WindowSizeMsg arrives
 		height:          24,
 
 		// Scrolling support
@@ -265,6 +267,12 @@ func (p *TaskDetailPresenter) View() string {
 	b.WriteString(components.Styles.MetadataStyle.Render(statusText))
 	b.WriteString("\n")
 
+	if p.viewModel.Estimate != "" {
+		estimateText := lipgloss.NewStyle().Width(availableWidth).Render(fmt.Sprintf("Estimate: %s", p.viewModel.Estimate))
+		b.WriteString(components.Styles.MetadataStyle.Render(estimateText))
+		b.WriteString("\n")
+	}
+
 	if p.viewModel.Branch != "" {
 		branchText := lipgloss.NewStyle().Width(availableWidth).Render(fmt.Sprintf("Branch: %s", p.viewModel.Branch))
 		b.WriteString(components.Styles.MetadataStyle.Render(branchText))
@@ -324,6 +332,19 @@ func (p *TaskDetailPresenter) View() string {
 		p.renderACsWithComponent(&b, availableWidth)
 	}
 
+	// Code trail
+	if len(p.viewModel.Commits) > 0 {
+		b.WriteString("\n")
+		b.WriteString(components.Styles.SectionStyle.Render("Commits"))
+		b.WriteString("\n")
+		for _, commit := range p.viewModel.Commits {
+			commitText := lipgloss.NewStyle().Width(availableWidth).Render(
+				fmt.Sprintf("  %s %s %s", commit.ShortHash, commit.Date, commit.Subject))
+			b.WriteString(commitText)
+			b.WriteString("\n")
+		}
+	}
+
 	// Feedback input component renders inline at bottom if active
 	feedbackView := p.acListComponent.ViewFeedback(p.width)
 	if feedbackView != "" {
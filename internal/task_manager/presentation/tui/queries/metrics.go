@@ -0,0 +1,88 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	tmerrors "github.com/kgatilin/ai-task-manager/internal/task_manager/domain/errors"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/transformers"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/viewmodels"
+)
+
+// MetricsWeeks is the number of weeks of throughput shown in the metrics view
+const MetricsWeeks = 8
+
+// LoadMetricsData loads all data needed for the metrics view and computes the metrics.
+//
+// Pre-loads:
+// - Task status history (for status timing)
+// - All iterations and the tasks of every started iteration (velocity)
+// - Current iteration, if any (burndown)
+// - Active roadmap, its tracks and all tasks (cycle time and throughput)
+func LoadMetricsData(
+	ctx context.Context,
+	repo domain.RoadmapRepository,
+) (*viewmodels.MetricsViewModel, error) {
+	now := time.Now().UTC()
+	metricsService := services.NewMetricsService()
+
+	changes, err := repo.ListTaskStatusChanges(ctx, entities.TaskStatusChangeFilters{})
+	if err != nil {
+		return nil, err
+	}
+	history := services.GroupStatusChanges(changes)
+
+	iterations, err := repo.ListIterations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasksByIteration := make(map[int][]*entities.TaskEntity)
+	for _, iteration := range iterations {
+		if iteration.StartedAt == nil {
+			continue
+		}
+		tasks, err := repo.GetIterationTasks(ctx, iteration.Number)
+		if err != nil {
+			return nil, err
+		}
+		tasksByIteration[iteration.Number] = tasks
+	}
+
+	var burndown *entities.IterationBurndown
+	current, err := repo.GetCurrentIteration(ctx)
+	if err != nil && !errors.Is(err, tmerrors.ErrNotFound) {
+		return nil, err
+	}
+	if current != nil {
+		burndown = metricsService.Burndown(current, tasksByIteration[current.Number], history, now)
+	}
+
+	roadmap, err := repo.GetActiveRoadmap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tracks, err := repo.ListTracks(ctx, roadmap.ID, entities.TrackFilters{})
+	if err != nil {
+		return nil, err
+	}
+	allTasks, err := repo.ListTasks(ctx, entities.TaskFilters{})
+	if err != nil {
+		return nil, err
+	}
+	cycleTimes := metricsService.CycleTimes(allTasks, history)
+
+	metrics := &entities.ProjectMetrics{
+		GeneratedAt:      now,
+		Velocity:         metricsService.Velocity(iterations, tasksByIteration, history, now),
+		Burndown:         burndown,
+		CycleTimes:       cycleTimes,
+		CycleTimeSummary: metricsService.SummarizeCycleTimes(cycleTimes),
+		Throughput:       metricsService.Throughput(tracks, allTasks, history, MetricsWeeks, now),
+		Weeks:            MetricsWeeks,
+	}
+	return transformers.TransformToMetricsViewModel(metrics), nil
+}
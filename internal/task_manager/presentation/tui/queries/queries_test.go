@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/queries"
@@ -26,6 +27,11 @@ type MockRepository struct {
 	dependencyTracks            map[string]*entities.TrackEntity
 	documentsByTrack            map[string][]*entities.DocumentEntity
 	documentsByIteration        map[int][]*entities.DocumentEntity
+	acsByID                     map[string]*entities.AcceptanceCriteriaEntity
+	adrsByID                    map[string]*entities.ADREntity
+	searchHits                  []*entities.SearchHit
+	currentIteration            *entities.IterationEntity
+	statusChanges               []*entities.TaskStatusChange
 	listTracksErr               error
 	listIterationsErr           error
 	getActiveRoadmapErr         error
@@ -40,6 +46,8 @@ type MockRepository struct {
 	listTasksErr                error
 	findDocumentsByTrackErr     error
 	findDocumentsByIterationErr error
+	searchErr                   error
+	listStatusChangesErr        error
 }
 
 // ListIterations returns all iterations.
@@ -324,7 +332,7 @@ func TestLoadTaskDetailDataSuccess(t *testing.T) {
 		iterationsForTask: iterations,
 	}
 
-	vm, err := queries.LoadTaskDetailData(ctx, repo, "task-1")
+	vm, err := queries.LoadTaskDetailData(ctx, repo, nil, "task-1")
 	if err != nil {
 		t.Fatalf("LoadTaskDetailData failed: %v", err)
 	}
@@ -346,7 +354,7 @@ func TestLoadTaskDetailDataGetTaskError(t *testing.T) {
 		getTaskErr: errors.New("task not found"),
 	}
 
-	vm, err := queries.LoadTaskDetailData(ctx, repo, "task-1")
+	vm, err := queries.LoadTaskDetailData(ctx, repo, nil, "task-1")
 	if err == nil {
 		t.Fatal("Expected error but got nil")
 	}
@@ -429,12 +437,35 @@ func (m *MockRepository) MoveTaskToTrack(ctx context.Context, taskID, newTrackID
 	return nil
 }
 
+func (m *MockRepository) AddTaskDependency(ctx context.Context, taskID, blockedByID string) error {
+	return nil
+}
+
+func (m *MockRepository) RemoveTaskDependency(ctx context.Context, taskID, blockedByID string) error {
+	return nil
+}
+
+func (m *MockRepository) GetTaskDependencies(ctx context.Context, taskID string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) GetBlockedTasks(ctx context.Context, taskID string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) ListTaskStatusChanges(ctx context.Context, filters entities.TaskStatusChangeFilters) ([]*entities.TaskStatusChange, error) {
+	if m.listStatusChangesErr != nil {
+		return nil, m.listStatusChangesErr
+	}
+	return m.statusChanges, nil
+}
+
 func (m *MockRepository) SaveIteration(ctx context.Context, iteration *entities.IterationEntity) error {
 	return nil
 }
 
 func (m *MockRepository) GetCurrentIteration(ctx context.Context) (*entities.IterationEntity, error) {
-	return nil, nil
+	return m.currentIteration, nil
 }
 
 func (m *MockRepository) UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error {
@@ -478,6 +509,9 @@ func (m *MockRepository) SaveADR(ctx context.Context, adr *entities.ADREntity) e
 }
 
 func (m *MockRepository) GetADR(ctx context.Context, id string) (*entities.ADREntity, error) {
+	if adr, ok := m.adrsByID[id]; ok {
+		return adr, nil
+	}
 	return nil, nil
 }
 
@@ -506,6 +540,9 @@ func (m *MockRepository) SaveAC(ctx context.Context, ac *entities.AcceptanceCrit
 }
 
 func (m *MockRepository) GetAC(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
+	if ac, ok := m.acsByID[id]; ok {
+		return ac, nil
+	}
 	return nil, nil
 }
 
@@ -600,6 +637,13 @@ func (m *MockRepository) DeleteDocument(ctx context.Context, id string) error {
 	return nil
 }
 
+func (m *MockRepository) Search(ctx context.Context, filters entities.SearchFilters) ([]*entities.SearchHit, error) {
+	if m.searchErr != nil {
+		return nil, m.searchErr
+	}
+	return m.searchHits, nil
+}
+
 // TestLoadTrackDetailDataSuccess verifies that LoadTrackDetailData successfully loads and transforms data.
 func TestLoadTrackDetailDataSuccess(t *testing.T) {
 	ctx := context.Background()
@@ -960,3 +1004,107 @@ func TestLoadIterationDetailDataDocumentLoadError(t *testing.T) {
 		t.Fatalf("Expected 0 documents on error, got %d", len(vm.Documents))
 	}
 }
+
+// TestLoadSearchResultsResolvesTargets verifies that AC and ADR hits open their parent task and track.
+func TestLoadSearchResultsResolvesTargets(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &MockRepository{
+		searchHits: []*entities.SearchHit{
+			{EntityType: entities.SearchEntityTask, EntityID: "TM-task-1", Title: "Login"},
+			{EntityType: entities.SearchEntityAC, EntityID: "TM-ac-1", Title: "Users can log in"},
+			{EntityType: entities.SearchEntityADR, EntityID: "TM-adr-1", Title: "Use OAuth"},
+			{EntityType: entities.SearchEntityAC, EntityID: "TM-ac-2", Title: "Orphaned AC"},
+		},
+		acsByID: map[string]*entities.AcceptanceCriteriaEntity{
+			"TM-ac-1": {ID: "TM-ac-1", TaskID: "TM-task-7"},
+		},
+		adrsByID: map[string]*entities.ADREntity{
+			"TM-adr-1": {ID: "TM-adr-1", TrackID: "TM-track-3"},
+		},
+	}
+
+	results, err := queries.LoadSearchResults(ctx, repo, "login")
+	if err != nil {
+		t.Fatalf("LoadSearchResults failed: %v", err)
+	}
+	if len(results) != 4 {
+		t.Fatalf("Expected 4 results, got %d", len(results))
+	}
+	if results[0].TaskID != "TM-task-1" {
+		t.Errorf("Expected task hit to open itself, got %+v", results[0])
+	}
+	if results[1].TaskID != "TM-task-7" {
+		t.Errorf("Expected AC hit to open its task, got %+v", results[1])
+	}
+	if results[2].TrackID != "TM-track-3" {
+		t.Errorf("Expected ADR hit to open its track, got %+v", results[2])
+	}
+	if results[3].TaskID != "" {
+		t.Errorf("Expected AC without a known task to have no target, got %+v", results[3])
+	}
+}
+
+// TestLoadSearchResultsError verifies that search failures are returned.
+func TestLoadSearchResultsError(t *testing.T) {
+	repo := &MockRepository{searchErr: errors.New("index missing")}
+
+	if _, err := queries.LoadSearchResults(context.Background(), repo, "login"); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+}
+
+// TestLoadMetricsDataSuccess verifies metrics are computed from the status history of the current iteration's tasks.
+func TestLoadMetricsDataSuccess(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now().UTC().Add(-50 * time.Hour)
+
+	current := &entities.IterationEntity{Number: 1, Name: "Sprint 1", Status: "current", StartedAt: &start}
+	done := &entities.TaskEntity{ID: "TM-task-1", TrackID: "TM-track-1", Title: "Schema", Status: "done", CreatedAt: start, UpdatedAt: start.Add(30 * time.Hour)}
+	todo := &entities.TaskEntity{ID: "TM-task-2", TrackID: "TM-track-1", Title: "API", Status: "todo", CreatedAt: start, UpdatedAt: start}
+
+	repo := &MockRepository{
+		iterations:       []*entities.IterationEntity{current},
+		currentIteration: current,
+		iterationTasks:   []*entities.TaskEntity{done, todo},
+		activeRoadmap:    &entities.RoadmapEntity{ID: "roadmap-1"},
+		tracks:           []*entities.TrackEntity{{ID: "TM-track-1", Title: "Core"}},
+		tasksForTrack:    []*entities.TaskEntity{done, todo},
+		statusChanges: []*entities.TaskStatusChange{
+			{TaskID: "TM-task-1", ToStatus: "todo", ChangedAt: start},
+			{TaskID: "TM-task-2", ToStatus: "todo", ChangedAt: start},
+			{TaskID: "TM-task-1", FromStatus: "todo", ToStatus: "in-progress", ChangedAt: start.Add(4 * time.Hour)},
+			{TaskID: "TM-task-1", FromStatus: "in-progress", ToStatus: "done", ChangedAt: start.Add(30 * time.Hour)},
+		},
+	}
+
+	vm, err := queries.LoadMetricsData(ctx, repo)
+	if err != nil {
+		t.Fatalf("LoadMetricsData failed: %v", err)
+	}
+
+	if len(vm.Velocity) != 1 || vm.Velocity[0].Completed != 1 || vm.Velocity[0].Planned != 2 {
+		t.Errorf("Expected iteration 1 to have completed 1 of 2 tasks, got %+v", vm.Velocity)
+	}
+	if vm.Burndown == nil {
+		t.Fatal("Expected a burndown for the current iteration")
+	}
+	if vm.Burndown.Remaining != 1 || vm.Burndown.Completed != 1 || vm.Burndown.Scope != 2 {
+		t.Errorf("Unexpected burndown: %+v", vm.Burndown)
+	}
+	if vm.CycleTime.Count != 1 || vm.CycleTime.Average != "1d 2h" {
+		t.Errorf("Expected one 26h cycle time, got %+v", vm.CycleTime)
+	}
+	if len(vm.Throughput) != 1 || vm.Throughput[0].Total != 1 || vm.Weeks != queries.MetricsWeeks {
+		t.Errorf("Unexpected throughput: %+v (weeks %d)", vm.Throughput, vm.Weeks)
+	}
+}
+
+// TestLoadMetricsDataHistoryError verifies that status history failures are returned.
+func TestLoadMetricsDataHistoryError(t *testing.T) {
+	repo := &MockRepository{listStatusChangesErr: errors.New("no such table")}
+
+	if _, err := queries.LoadMetricsData(context.Background(), repo); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+}
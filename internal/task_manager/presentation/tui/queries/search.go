@@ -0,0 +1,48 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/transformers"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/viewmodels"
+)
+
+// SearchResultLimit is the maximum number of hits shown in the search view
+const SearchResultLimit = 50
+
+// LoadSearchResults runs a full-text search and returns the hits transformed into view models.
+//
+// Pre-loads:
+// - Search hits, most relevant first
+// - Parent task of every AC hit and track of every ADR hit (for navigation)
+//
+// A parent that cannot be loaded leaves the hit without a navigation target rather than failing the search.
+func LoadSearchResults(
+	ctx context.Context,
+	repo domain.RoadmapRepository,
+	query string,
+) ([]viewmodels.SearchResultViewModel, error) {
+	hits, err := repo.Search(ctx, entities.SearchFilters{Query: query, Limit: SearchResultLimit})
+	if err != nil {
+		return nil, err
+	}
+
+	acTaskIDs := make(map[string]string)
+	adrTrackIDs := make(map[string]string)
+	for _, hit := range hits {
+		switch hit.EntityType {
+		case entities.SearchEntityAC:
+			if ac, err := repo.GetAC(ctx, hit.EntityID); err == nil && ac != nil {
+				acTaskIDs[hit.EntityID] = ac.TaskID
+			}
+		case entities.SearchEntityADR:
+			if adr, err := repo.GetADR(ctx, hit.EntityID); err == nil && adr != nil {
+				adrTrackIDs[hit.EntityID] = adr.TrackID
+			}
+		}
+	}
+
+	return transformers.TransformToSearchResults(hits, acTaskIDs, adrTrackIDs), nil
+}
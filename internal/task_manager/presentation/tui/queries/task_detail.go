@@ -4,23 +4,27 @@ import (
 	"context"
 
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/services"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/transformers"
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/viewmodels"
 )
 
 // LoadTaskDetailData loads task detail data for a specific task.
-// Returns task + ACs + track + iteration membership transformed into view model ready for presentation.
+// Returns task + ACs + track + iteration membership + commits transformed into view model ready for presentation.
 //
 // Pre-loads:
 // - Task entity
 // - All acceptance criteria for the task
 // - Track entity that owns the task
 // - All iterations the task belongs to
+// - Commits mentioning the task (best effort; vcs may be nil outside a git repository)
 //
 // Eliminates N+1 queries by loading all related data upfront.
 func LoadTaskDetailData(
 	ctx context.Context,
 	repo domain.RoadmapRepository,
+	vcs services.VersionControl,
 	taskID string,
 ) (*viewmodels.TaskDetailViewModel, error) {
 	// Fetch task
@@ -47,8 +51,20 @@ func LoadTaskDetailData(
 		return nil, err
 	}
 
+	// Fetch the code trail; a missing or broken repository just means no commits
+	var commits []entities.Commit
+	if vcs != nil {
+		if candidates, err := vcs.FindCommits(ctx, taskID); err == nil {
+			for _, commit := range candidates {
+				if commit.References(taskID) {
+					commits = append(commits, commit)
+				}
+			}
+		}
+	}
+
 	// Transform to view model
-	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations)
+	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations, commits)
 
 	return vm, nil
 }
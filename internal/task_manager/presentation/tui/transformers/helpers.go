@@ -1,6 +1,9 @@
 package transformers
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
 )
 
@@ -117,6 +120,31 @@ func GetTaskStatusLabel(status string) string {
 	}
 }
 
+// FormatEstimate formats a task estimate without trailing zeros, or returns "" when there is none
+func FormatEstimate(estimate *float64) string {
+	if estimate == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*estimate, 'f', -1, 64)
+}
+
+// FormatIterationCapacity summarizes committed and remaining estimates against the iteration's capacity.
+// Returns "" for iterations without a capacity and without estimated tasks.
+func FormatIterationCapacity(capacity *entities.IterationCapacity) string {
+	if capacity.Capacity == nil && capacity.Committed == 0 {
+		return ""
+	}
+	committed := strconv.FormatFloat(capacity.Committed, 'f', -1, 64)
+	if capacity.Capacity != nil {
+		committed += "/" + FormatEstimate(capacity.Capacity)
+	}
+	text := fmt.Sprintf("Capacity: %s committed, %s remaining", committed, strconv.FormatFloat(capacity.Remaining, 'f', -1, 64))
+	if capacity.IsOvercommitted() {
+		text += " (over capacity)"
+	}
+	return text
+}
+
 // GetTrackIcon returns the icon for a track status
 func GetTrackIcon(status string) string {
 	switch status {
@@ -175,6 +175,32 @@ func TestGetTaskStatusLabel(t *testing.T) {
 	}
 }
 
+func TestFormatIterationCapacity(t *testing.T) {
+	value := func(v float64) *float64 { return &v }
+	tests := []struct {
+		name     string
+		capacity *entities.IterationCapacity
+		expected string
+	}{
+		{"Nothing estimated", &entities.IterationCapacity{Unestimated: 3}, ""},
+		{"Estimates without capacity", &entities.IterationCapacity{Committed: 5, Remaining: 2}, "Capacity: 5 committed, 2 remaining"},
+		{"Within capacity", &entities.IterationCapacity{Capacity: value(20), Committed: 12.5, Remaining: 12.5}, "Capacity: 12.5/20 committed, 12.5 remaining"},
+		{"Over capacity", &entities.IterationCapacity{Capacity: value(10), Committed: 13, Remaining: 8}, "Capacity: 13/10 committed, 8 remaining (over capacity)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := transformers.FormatIterationCapacity(tt.capacity)
+			if result != tt.expected {
+				t.Errorf("FormatIterationCapacity() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+	if got := transformers.FormatEstimate(nil); got != "" {
+		t.Errorf("FormatEstimate(nil) = %q, want empty", got)
+	}
+}
+
 func TestGetTrackIcon(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -42,6 +42,7 @@ func TransformToIterationDetailViewModel(
 			Status:      task.Status,
 			Description: task.Description,
 			// Pre-computed display fields
+			Estimate:    FormatEstimate(task.Estimate),
 			StatusLabel: GetTaskStatusLabel(task.Status),
 			StatusColor: GetTaskColor(task.Status),
 			Icon:        GetTaskIcon(task.Status),
@@ -101,6 +102,7 @@ func TransformToIterationDetailViewModel(
 	totalTasks := len(tasks)
 	doneTasks := len(vm.DoneTasks)
 	vm.Progress = viewmodels.NewProgressViewModel(doneTasks, totalTasks)
+	vm.Capacity = FormatIterationCapacity(entities.NewIterationCapacity(iteration, tasks))
 
 	return vm
 }
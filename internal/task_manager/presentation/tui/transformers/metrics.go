@@ -0,0 +1,124 @@
+package transformers
+
+import (
+	"fmt"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/viewmodels"
+)
+
+// sparklineBars are the block characters sparklines are drawn with, lowest first
+var sparklineBars = []rune("▁▂▃▄▅▆▇█")
+
+// TransformToMetricsViewModel converts computed project metrics to the metrics view model,
+// rendering every series as a sparkline and every duration as a readable string
+func TransformToMetricsViewModel(metrics *entities.ProjectMetrics) *viewmodels.MetricsViewModel {
+	vm := &viewmodels.MetricsViewModel{
+		Velocity:   make([]*viewmodels.IterationVelocityViewModel, 0, len(metrics.Velocity)),
+		Throughput: make([]*viewmodels.TrackThroughputViewModel, 0, len(metrics.Throughput)),
+		Weeks:      metrics.Weeks,
+	}
+
+	completed := make([]float64, 0, len(metrics.Velocity))
+	total := 0
+	for _, v := range metrics.Velocity {
+		vm.Velocity = append(vm.Velocity, &viewmodels.IterationVelocityViewModel{
+			Number:     v.IterationNumber,
+			Name:       v.Name,
+			Status:     v.Status,
+			Completed:  v.CompletedTasks,
+			Planned:    v.PlannedTasks,
+			StatusIcon: GetIterationIcon(v.Status),
+			Days:       fmt.Sprintf("%.1f", v.Days),
+		})
+		completed = append(completed, float64(v.CompletedTasks))
+		total += v.CompletedTasks
+	}
+	vm.VelocitySparkline = Sparkline(completed)
+	if len(metrics.Velocity) > 0 {
+		vm.AverageVelocity = fmt.Sprintf("%.1f", float64(total)/float64(len(metrics.Velocity)))
+	}
+
+	if burndown := metrics.Burndown; burndown != nil {
+		remainingSeries := make([]float64, 0, len(burndown.Points))
+		completedSeries := make([]float64, 0, len(burndown.Points))
+		for _, point := range burndown.Points {
+			remainingSeries = append(remainingSeries, float64(point.Remaining))
+			completedSeries = append(completedSeries, float64(point.Completed))
+		}
+		vm.Burndown = &viewmodels.BurndownViewModel{
+			IterationNumber:    burndown.IterationNumber,
+			IterationName:      burndown.Name,
+			Days:               len(burndown.Points),
+			RemainingSparkline: Sparkline(remainingSeries),
+			CompletedSparkline: Sparkline(completedSeries),
+		}
+		if len(burndown.Points) > 0 {
+			last := burndown.Points[len(burndown.Points)-1]
+			vm.Burndown.Scope = last.Scope
+			vm.Burndown.Completed = last.Completed
+			vm.Burndown.Remaining = last.Remaining
+		}
+	}
+
+	hours := make([]float64, 0, len(metrics.CycleTimes))
+	for _, cycleTime := range metrics.CycleTimes {
+		hours = append(hours, cycleTime.Hours)
+	}
+	vm.CycleTime = viewmodels.CycleTimeViewModel{
+		Count:     metrics.CycleTimeSummary.Count,
+		Sparkline: Sparkline(hours),
+		Average:   FormatHours(metrics.CycleTimeSummary.AverageHours),
+		Median:    FormatHours(metrics.CycleTimeSummary.MedianHours),
+		Max:       FormatHours(metrics.CycleTimeSummary.MaxHours),
+	}
+
+	for _, track := range metrics.Throughput {
+		weekly := make([]float64, 0, len(track.Weekly))
+		for _, count := range track.Weekly {
+			weekly = append(weekly, float64(count))
+		}
+		vm.Throughput = append(vm.Throughput, &viewmodels.TrackThroughputViewModel{
+			TrackID:   track.TrackID,
+			Title:     track.Title,
+			Total:     track.Total,
+			Sparkline: Sparkline(weekly),
+		})
+	}
+
+	return vm
+}
+
+// Sparkline draws values as a row of block characters scaled to the largest value.
+// Example: [0, 1, 2, 4] -> "▁▃▅█"
+func Sparkline(values []float64) string {
+	max := 0.0
+	for _, value := range values {
+		if value > max {
+			max = value
+		}
+	}
+
+	bars := make([]rune, 0, len(values))
+	for _, value := range values {
+		level := 0
+		if max > 0 && value > 0 {
+			level = int(value/max*float64(len(sparklineBars)-1) + 0.5)
+		}
+		bars = append(bars, sparklineBars[level])
+	}
+	return string(bars)
+}
+
+// FormatHours formats a duration in hours as days and hours.
+// Examples: 0.5 -> "<1h", 5 -> "5h", 52 -> "2d 4h"
+func FormatHours(hours float64) string {
+	switch {
+	case hours < 1:
+		return "<1h"
+	case hours < 24:
+		return fmt.Sprintf("%dh", int(hours))
+	default:
+		return fmt.Sprintf("%dd %dh", int(hours)/24, int(hours)%24)
+	}
+}
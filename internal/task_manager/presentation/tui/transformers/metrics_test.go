@@ -0,0 +1,96 @@
+package transformers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/transformers"
+)
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   string
+	}{
+		{"empty", nil, ""},
+		{"all zero", []float64{0, 0, 0}, "▁▁▁"},
+		{"scaled to max", []float64{0, 1, 2, 4}, "▁▃▅█"},
+		{"constant", []float64{3, 3}, "██"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := transformers.Sparkline(tt.values); got != tt.want {
+				t.Errorf("Sparkline(%v) = %q, want %q", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatHours(t *testing.T) {
+	tests := []struct {
+		hours float64
+		want  string
+	}{
+		{0.5, "<1h"},
+		{5, "5h"},
+		{52, "2d 4h"},
+	}
+	for _, tt := range tests {
+		if got := transformers.FormatHours(tt.hours); got != tt.want {
+			t.Errorf("FormatHours(%v) = %q, want %q", tt.hours, got, tt.want)
+		}
+	}
+}
+
+func TestTransformToMetricsViewModel(t *testing.T) {
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	metrics := &entities.ProjectMetrics{
+		Velocity: []entities.IterationVelocity{
+			{IterationNumber: 1, Name: "Sprint 1", Status: "complete", PlannedTasks: 4, CompletedTasks: 4, Days: 7},
+			{IterationNumber: 2, Name: "Sprint 2", Status: "current", PlannedTasks: 3, CompletedTasks: 1, Days: 2.5},
+		},
+		Burndown: &entities.IterationBurndown{
+			IterationNumber: 2,
+			Name:            "Sprint 2",
+			Points: []entities.BurndownPoint{
+				{Date: day, Scope: 3, Completed: 0, Remaining: 3},
+				{Date: day.Add(24 * time.Hour), Scope: 3, Completed: 1, Remaining: 2},
+			},
+		},
+		CycleTimes:       []entities.TaskCycleTime{{TaskID: "TM-task-1", Hours: 26}},
+		CycleTimeSummary: entities.CycleTimeSummary{Count: 1, AverageHours: 26, MedianHours: 26, MaxHours: 26},
+		Throughput:       []entities.TrackThroughput{{TrackID: "TM-track-1", Title: "Core", Weekly: []int{0, 2}, Total: 2}},
+		Weeks:            2,
+	}
+
+	vm := transformers.TransformToMetricsViewModel(metrics)
+
+	if vm.VelocitySparkline != "█▃" || vm.AverageVelocity != "2.5" {
+		t.Errorf("velocity sparkline/average = %q/%q", vm.VelocitySparkline, vm.AverageVelocity)
+	}
+	if len(vm.Velocity) != 2 || vm.Velocity[1].Days != "2.5" || vm.Velocity[1].StatusIcon != transformers.GetIterationIcon("current") {
+		t.Errorf("unexpected velocity rows: %+v", vm.Velocity)
+	}
+	if vm.Burndown == nil || vm.Burndown.RemainingSparkline != "█▆" || vm.Burndown.Remaining != 2 || vm.Burndown.Days != 2 {
+		t.Errorf("unexpected burndown: %+v", vm.Burndown)
+	}
+	if vm.CycleTime.Count != 1 || vm.CycleTime.Median != "1d 2h" {
+		t.Errorf("unexpected cycle time: %+v", vm.CycleTime)
+	}
+	if len(vm.Throughput) != 1 || vm.Throughput[0].Sparkline != "▁█" || vm.Throughput[0].Total != 2 {
+		t.Errorf("unexpected throughput: %+v", vm.Throughput)
+	}
+}
+
+func TestTransformToMetricsViewModel_NoIterationInProgress(t *testing.T) {
+	vm := transformers.TransformToMetricsViewModel(&entities.ProjectMetrics{Weeks: 8})
+
+	if vm.Burndown != nil {
+		t.Errorf("expected no burndown, got %+v", vm.Burndown)
+	}
+	if len(vm.Velocity) != 0 || vm.AverageVelocity != "" || vm.CycleTime.Count != 0 {
+		t.Errorf("expected empty metrics, got %+v", vm)
+	}
+}
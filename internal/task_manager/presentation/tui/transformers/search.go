@@ -0,0 +1,61 @@
+package transformers
+
+import (
+	"strings"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/viewmodels"
+)
+
+// TransformToSearchResults converts search hits to search result view models, resolving where each hit opens:
+// tasks and documents open themselves, ACs open their parent task (acTaskIDs, by AC ID)
+// and ADRs open their track (adrTrackIDs, by ADR ID). Hits whose parent is unknown cannot be opened.
+func TransformToSearchResults(
+	hits []*entities.SearchHit,
+	acTaskIDs map[string]string,
+	adrTrackIDs map[string]string,
+) []viewmodels.SearchResultViewModel {
+	results := make([]viewmodels.SearchResultViewModel, 0, len(hits))
+	for _, hit := range hits {
+		if hit == nil {
+			continue
+		}
+
+		result := viewmodels.SearchResultViewModel{
+			EntityType: hit.EntityType,
+			EntityID:   hit.EntityID,
+			Title:      hit.Title,
+			Snippet:    hit.Snippet,
+			TypeLabel:  formatSearchEntityType(hit.EntityType),
+		}
+		switch hit.EntityType {
+		case entities.SearchEntityTask:
+			result.TaskID = hit.EntityID
+		case entities.SearchEntityAC:
+			result.TaskID = acTaskIDs[hit.EntityID]
+		case entities.SearchEntityADR:
+			result.TrackID = adrTrackIDs[hit.EntityID]
+		case entities.SearchEntityDocument:
+			result.DocumentID = hit.EntityID
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// formatSearchEntityType converts a search entity type to a display label.
+// Examples: "task" -> "Task", "ac" -> "AC", "adr" -> "ADR"
+func formatSearchEntityType(entityType string) string {
+	switch entityType {
+	case entities.SearchEntityTask:
+		return "Task"
+	case entities.SearchEntityAC:
+		return "AC"
+	case entities.SearchEntityADR:
+		return "ADR"
+	case entities.SearchEntityDocument:
+		return "Doc"
+	default:
+		return strings.ToUpper(entityType)
+	}
+}
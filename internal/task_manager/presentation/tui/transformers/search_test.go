@@ -0,0 +1,56 @@
+package transformers_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/domain/entities"
+	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/transformers"
+)
+
+func TestTransformToSearchResults(t *testing.T) {
+	hits := []*entities.SearchHit{
+		{EntityType: entities.SearchEntityTask, EntityID: "TM-task-1", Title: "Login", Snippet: "**Login** form"},
+		{EntityType: entities.SearchEntityAC, EntityID: "TM-ac-1", Title: "Users can log in"},
+		{EntityType: entities.SearchEntityADR, EntityID: "TM-adr-1", Title: "Use OAuth"},
+		{EntityType: entities.SearchEntityDocument, EntityID: "TM-doc-1", Title: "Plan"},
+		nil,
+	}
+
+	results := transformers.TransformToSearchResults(hits,
+		map[string]string{"TM-ac-1": "TM-task-2"},
+		map[string]string{"TM-adr-1": "TM-track-1"},
+	)
+
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(results))
+	}
+
+	tests := []struct {
+		label      string
+		taskID     string
+		trackID    string
+		documentID string
+	}{
+		{"Task", "TM-task-1", "", ""},
+		{"AC", "TM-task-2", "", ""},
+		{"ADR", "", "TM-track-1", ""},
+		{"Doc", "", "", "TM-doc-1"},
+	}
+	for i, tt := range tests {
+		got := results[i]
+		if got.TypeLabel != tt.label || got.TaskID != tt.taskID || got.TrackID != tt.trackID || got.DocumentID != tt.documentID {
+			t.Errorf("result %d: expected %+v, got %+v", i, tt, got)
+		}
+	}
+	if results[0].Snippet != "**Login** form" {
+		t.Errorf("expected snippet to be kept, got %q", results[0].Snippet)
+	}
+}
+
+func TestTransformToSearchResults_Empty(t *testing.T) {
+	results := transformers.TransformToSearchResults(nil, nil, nil)
+
+	if results == nil || len(results) != 0 {
+		t.Errorf("expected empty non-nil slice, got %v", results)
+	}
+}
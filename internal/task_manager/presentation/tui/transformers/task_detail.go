@@ -5,12 +5,13 @@ import (
 	"github.com/kgatilin/ai-task-manager/internal/task_manager/presentation/tui/viewmodels"
 )
 
-// TransformToTaskDetailViewModel transforms task + ACs + track + iterations to task detail view model
+// TransformToTaskDetailViewModel transforms task + ACs + track + iterations + commits to task detail view model
 func TransformToTaskDetailViewModel(
 	task *entities.TaskEntity,
 	acs []*entities.AcceptanceCriteriaEntity,
 	track *entities.TrackEntity,
 	iterations []*entities.IterationEntity,
+	commits []entities.Commit,
 ) *viewmodels.TaskDetailViewModel {
 	vm := viewmodels.NewTaskDetailViewModel(
 		task.ID,
@@ -24,6 +25,7 @@ func TransformToTaskDetailViewModel(
 	vm.StatusLabel = GetTaskStatusLabel(task.Status)
 	vm.StatusColor = GetTaskColor(task.Status)
 	vm.Icon = GetTaskIcon(task.Status)
+	vm.Estimate = FormatEstimate(task.Estimate)
 
 	// Format timestamps
 	vm.CreatedAt = task.CreatedAt.Format("2006-01-02 15:04:05")
@@ -74,5 +76,15 @@ func TransformToTaskDetailViewModel(
 		vm.AcceptanceCriteria = append(vm.AcceptanceCriteria, acVM)
 	}
 
+	// Code trail
+	for _, commit := range commits {
+		vm.Commits = append(vm.Commits, &viewmodels.CommitViewModel{
+			ShortHash: commit.ShortHash(),
+			Date:      commit.Date.Local().Format("2006-01-02"),
+			Author:    commit.Author,
+			Subject:   commit.Subject,
+		})
+	}
+
 	return vm
 }
@@ -16,7 +16,7 @@ func TestTransformToTaskDetailViewModel_Basic(t *testing.T) {
 	acs := []*entities.AcceptanceCriteriaEntity{}
 	iterations := []*entities.IterationEntity{}
 
-	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations)
+	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations, nil)
 
 	if vm == nil {
 		t.Fatal("expected non-nil view model")
@@ -85,7 +85,7 @@ func TestTransformToTaskDetailViewModel_WithIterations(t *testing.T) {
 		mustCreateIteration(3, "Sprint 3", "Goal 3", "Deliverable 3", []string{}, "complete", 300, now, now),
 	}
 
-	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations)
+	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations, nil)
 
 	if len(vm.Iterations) != 3 {
 		t.Errorf("expected 3 iterations, got %d", len(vm.Iterations))
@@ -130,7 +130,7 @@ func TestTransformToTaskDetailViewModel_WithACs(t *testing.T) {
 
 	iterations := []*entities.IterationEntity{}
 
-	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations)
+	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations, nil)
 
 	if len(vm.AcceptanceCriteria) != 3 {
 		t.Errorf("expected 3 ACs, got %d", len(vm.AcceptanceCriteria))
@@ -185,7 +185,7 @@ func TestTransformToTaskDetailViewModel_NilTrack(t *testing.T) {
 	acs := []*entities.AcceptanceCriteriaEntity{}
 	iterations := []*entities.IterationEntity{}
 
-	vm := transformers.TransformToTaskDetailViewModel(task, acs, nil, iterations)
+	vm := transformers.TransformToTaskDetailViewModel(task, acs, nil, iterations, nil)
 
 	if vm.TrackInfo != nil {
 		t.Error("expected nil TrackInfo when track is nil")
@@ -200,7 +200,7 @@ func TestTransformToTaskDetailViewModel_EmptyBranch(t *testing.T) {
 	acs := []*entities.AcceptanceCriteriaEntity{}
 	iterations := []*entities.IterationEntity{}
 
-	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations)
+	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations, nil)
 
 	if vm.Branch != "" {
 		t.Errorf("expected empty Branch, got %q", vm.Branch)
@@ -216,7 +216,7 @@ func TestTransformToTaskDetailViewModel_TimestampFormat(t *testing.T) {
 	acs := []*entities.AcceptanceCriteriaEntity{}
 	iterations := []*entities.IterationEntity{}
 
-	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations)
+	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations, nil)
 
 	// Check timestamp format (YYYY-MM-DD HH:MM:SS)
 	expectedCreatedAt := "2025-11-14 10:30:45"
@@ -230,3 +230,24 @@ func TestTransformToTaskDetailViewModel_TimestampFormat(t *testing.T) {
 		t.Errorf("expected UpdatedAt %q, got %q", expectedUpdatedAt, vm.UpdatedAt)
 	}
 }
+
+func TestTransformToTaskDetailViewModel_Commits(t *testing.T) {
+	now := time.Now()
+
+	task := mustCreateTask("TM-task-1", "TM-track-1", "Test Task", "", "in-progress", 100, "TM-task-1-test-task", now, now)
+	commits := []entities.Commit{
+		{Hash: "0123456789abcdef", Author: "dev", Date: now, Subject: "TM-task-1: add handler"},
+	}
+
+	vm := transformers.TransformToTaskDetailViewModel(task, nil, nil, nil, commits)
+
+	if len(vm.Commits) != 1 {
+		t.Fatalf("expected 1 commit, got %d", len(vm.Commits))
+	}
+	if vm.Commits[0].ShortHash != "0123456" {
+		t.Errorf("expected short hash '0123456', got %q", vm.Commits[0].ShortHash)
+	}
+	if vm.Commits[0].Subject != "TM-task-1: add handler" {
+		t.Errorf("expected subject 'TM-task-1: add handler', got %q", vm.Commits[0].Subject)
+	}
+}
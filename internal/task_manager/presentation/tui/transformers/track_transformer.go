@@ -54,6 +54,7 @@ func TransformToTrackDetailViewModel(
 			Status:      task.Status,
 			Description: task.Description,
 			// Pre-computed display fields
+			Estimate:    FormatEstimate(task.Estimate),
 			StatusLabel: GetTaskStatusLabel(task.Status),
 			StatusColor: GetTaskColor(task.Status),
 			Icon:        GetTaskIcon(task.Status),
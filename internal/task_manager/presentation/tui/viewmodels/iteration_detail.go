@@ -27,6 +27,7 @@ type TaskRowViewModel struct {
 	Status      string
 	Description string
 	// Display fields (pre-computed by transformer)
+	Estimate    string // Formatted estimate, empty when the task has none
 	StatusLabel string // Human-readable status label
 	StatusColor string // Color name for status styling
 	Icon        string // Status icon
@@ -80,6 +81,7 @@ type IterationDetailViewModel struct {
 
 	// Progress tracking
 	Progress *ProgressViewModel
+	Capacity string // Committed/remaining estimates summary, empty when nothing is estimated
 
 	// Display fields (pre-computed by transformer)
 	StatusLabel string // Human-readable status label
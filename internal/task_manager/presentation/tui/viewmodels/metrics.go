@@ -0,0 +1,54 @@
+package viewmodels
+
+// MetricsViewModel represents velocity, burndown, cycle time and throughput in the metrics view
+type MetricsViewModel struct {
+	Velocity          []*IterationVelocityViewModel // Started iterations, by number
+	VelocitySparkline string                        // Tasks done per iteration
+	AverageVelocity   string                        // Pre-formatted, e.g. "2.5"
+	Burndown          *BurndownViewModel            // Nil when no iteration is current
+	CycleTime         CycleTimeViewModel
+	Throughput        []*TrackThroughputViewModel
+	Weeks             int // Number of weeks covered by throughput
+}
+
+// IterationVelocityViewModel represents the work one iteration delivered
+type IterationVelocityViewModel struct {
+	Number    int
+	Name      string
+	Status    string
+	Completed int
+	Planned   int
+	// Display fields (pre-computed by transformer)
+	StatusIcon string
+	Days       string // Pre-formatted duration, e.g. "4.5"
+}
+
+// BurndownViewModel represents the day-by-day progress of the current iteration
+type BurndownViewModel struct {
+	IterationNumber    int
+	IterationName      string
+	Days               int
+	Scope              int // Tasks in the iteration today
+	Completed          int
+	Remaining          int
+	RemainingSparkline string // Remaining tasks per day (burndown)
+	CompletedSparkline string // Done tasks per day (burnup)
+}
+
+// CycleTimeViewModel summarizes how long done tasks took from in-progress to done
+type CycleTimeViewModel struct {
+	Count     int
+	Sparkline string // Cycle time per task, by completion time
+	// Display fields (pre-computed by transformer), e.g. "2d 4h"
+	Average string
+	Median  string
+	Max     string
+}
+
+// TrackThroughputViewModel represents the tasks one track completed per week
+type TrackThroughputViewModel struct {
+	TrackID   string
+	Title     string
+	Total     int
+	Sparkline string // Tasks done per week, oldest first
+}
@@ -0,0 +1,15 @@
+package viewmodels
+
+// SearchResultViewModel represents one full-text search hit in the search view
+type SearchResultViewModel struct {
+	EntityType string // "task", "ac", "adr" or "document"
+	EntityID   string
+	Title      string
+	Snippet    string // Matched text; highlighted terms are wrapped in ** markers
+	// Display fields (pre-computed by transformer)
+	TypeLabel string // Human-readable entity type label
+	// Navigation targets (pre-resolved by query; at most one is set)
+	TaskID     string // Task to open (tasks, and ACs via their parent task)
+	TrackID    string // Track to open (ADRs via their track)
+	DocumentID string // Document to open
+}
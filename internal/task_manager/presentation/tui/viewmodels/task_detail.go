@@ -38,6 +38,14 @@ type IterationMembershipViewModel struct {
 	Icon        string // Status icon
 }
 
+// CommitViewModel represents a git commit that mentions the task
+type CommitViewModel struct {
+	ShortHash string
+	Date      string
+	Author    string
+	Subject   string
+}
+
 // TaskDetailViewModel represents the task detail view with expandable ACs
 type TaskDetailViewModel struct {
 	// Task metadata
@@ -46,6 +54,7 @@ type TaskDetailViewModel struct {
 	Description string
 	Status      string
 	Branch      string
+	Estimate    string // Formatted estimate, empty when the task has none
 	CreatedAt   string
 	UpdatedAt   string
 
@@ -58,6 +67,9 @@ type TaskDetailViewModel struct {
 	// Acceptance criteria with expandable testing instructions
 	AcceptanceCriteria []*ACDetailViewModel
 
+	// Commits mentioning the task, newest first
+	Commits []*CommitViewModel
+
 	// Display fields (pre-computed by transformer)
 	StatusLabel string // Human-readable status label
 	StatusColor string // Color name for status styling
@@ -74,5 +86,6 @@ func NewTaskDetailViewModel(id, title, description, status, branch string) *Task
 		Branch:             branch,
 		Iterations:         []*IterationMembershipViewModel{},
 		AcceptanceCriteria: []*ACDetailViewModel{},
+		Commits:            []*CommitViewModel{},
 	}
 }
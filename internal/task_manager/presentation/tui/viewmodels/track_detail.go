@@ -7,6 +7,7 @@ type TrackDetailTaskViewModel struct {
 	Status      string
 	Description string
 	// Display fields (pre-computed by transformer)
+	Estimate    string // Formatted estimate, empty when the task has none
 	StatusLabel string // Human-readable status label
 	StatusColor string // Color name for status styling
 	Icon        string // Status icon